@@ -0,0 +1,284 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package bench generates load on configserver like observers, obproxies and other readers do.
+package bench
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/oceanbase/configserver/client"
+	"github.com/oceanbase/configserver/model"
+)
+
+const (
+	DEFAULT_CLUSTER_PREFIX = "bench"
+	DEFAULT_TIMEOUT        = 5 * time.Second
+	// rootservers of each simulated cluster
+	serversPerCluster = 3
+)
+
+// Options is the workload of a benchmark, observers post rs list of Clusters every ClusterInterval,
+// obproxies poll the version of obproxy config every ObProxyInterval and fetch the config when it changes,
+// readers query rootservice info of random clusters with version 2 by V2Ratio, waiting ReaderInterval between queries
+type Options struct {
+	Target    string
+	Namespace string
+	Username  string
+	Password  string
+	Duration  time.Duration
+	Timeout   time.Duration
+
+	Clusters        int
+	ClusterInterval time.Duration
+	ObProxies       int
+	ObProxyInterval time.Duration
+	Readers         int
+	ReaderInterval  time.Duration
+	V2Ratio         float64
+
+	// ClusterPrefix is the name prefix of simulated clusters, which are deleted and purged after the benchmark if Cleanup
+	ClusterPrefix string
+	Cleanup       bool
+}
+
+func (o *Options) validate() error {
+	if o.Target == "" {
+		return errors.New("target is required")
+	}
+	if o.Duration <= 0 {
+		return errors.New("duration should be positive")
+	}
+	if o.Clusters < 0 || o.ObProxies < 0 || o.Readers < 0 {
+		return errors.New("number of clusters, obproxies and readers should not be negative")
+	}
+	if o.Clusters+o.ObProxies+o.Readers == 0 {
+		return errors.New("no workload specified")
+	}
+	if o.Readers > 0 && o.Clusters == 0 {
+		return errors.New("readers require clusters to query")
+	}
+	if o.Clusters > 0 && o.ClusterInterval <= 0 {
+		return errors.New("cluster interval should be positive")
+	}
+	if o.ObProxies > 0 && o.ObProxyInterval <= 0 {
+		return errors.New("obproxy interval should be positive")
+	}
+	if o.V2Ratio < 0 || o.V2Ratio > 1 {
+		return errors.New("v2 ratio should be between 0 and 1")
+	}
+	return nil
+}
+
+type benchmark struct {
+	options  *Options
+	client   *client.Client
+	recorder *recorder
+}
+
+// Run registers the simulated clusters, runs the workload against target for duration and reports the result
+func Run(ctx context.Context, options Options) (*Report, error) {
+	if err := options.validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid options")
+	}
+	if options.Timeout <= 0 {
+		options.Timeout = DEFAULT_TIMEOUT
+	}
+	if options.ClusterPrefix == "" {
+		options.ClusterPrefix = DEFAULT_CLUSTER_PREFIX
+	}
+	workers := options.Clusters + options.ObProxies + options.Readers
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConns = workers
+	transport.MaxIdleConnsPerHost = workers
+	// every request is sent once, so failures are reported as they are
+	benchClient, err := client.New(client.Options{
+		Endpoints:   []string{options.Target},
+		Namespace:   options.Namespace,
+		Username:    options.Username,
+		Password:    options.Password,
+		Timeout:     options.Timeout,
+		MaxAttempts: 1,
+		HttpClient:  &http.Client{Transport: transport},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "create client")
+	}
+	b := &benchmark{
+		options:  &options,
+		client:   benchClient,
+		recorder: newRecorder(),
+	}
+
+	for i := 0; i < options.Clusters; i++ {
+		if err := b.client.Register(ctx, b.rootServiceInfo(i)); err != nil {
+			return nil, errors.Wrapf(err, "register cluster %s", b.clusterName(i))
+		}
+	}
+	if options.Cleanup {
+		defer b.cleanup()
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, options.Duration)
+	defer cancel()
+	start := time.Now()
+	var wg sync.WaitGroup
+	run := func(worker func(context.Context, int), count int) {
+		for i := 0; i < count; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				worker(runCtx, i)
+			}(i)
+		}
+	}
+	run(b.runObserver, options.Clusters)
+	run(b.runObProxy, options.ObProxies)
+	run(b.runReader, options.Readers)
+	wg.Wait()
+	return b.recorder.report(options.Target, time.Since(start)), nil
+}
+
+func (b *benchmark) clusterName(i int) string {
+	return fmt.Sprintf("%s%d", b.options.ClusterPrefix, i)
+}
+
+func (b *benchmark) rootServiceInfo(i int) *model.ObRootServiceInfo {
+	rsList := make([]*model.ObServerInfo, 0, serversPerCluster)
+	for j := 0; j < serversPerCluster; j++ {
+		role := "FOLLOWER"
+		if j == 0 {
+			role = "LEADER"
+		}
+		rsList = append(rsList, &model.ObServerInfo{
+			Address: fmt.Sprintf("10.%d.%d.%d:2882", i/256%256, i%256, j+1),
+			Role:    role,
+			SqlPort: 2881,
+		})
+	}
+	return &model.ObRootServiceInfo{
+		ObCluster:      b.clusterName(i),
+		ObClusterId:    int64(i + 1),
+		ReadonlyRsList: []*model.ObServerInfo{},
+		RsList:         rsList,
+		Type:           model.OB_CLUSTER_TYPE_PRIMARY,
+		TimeStamp:      time.Now().UnixMicro(),
+	}
+}
+
+// call runs operation and records it, operations interrupted by the end of the benchmark are not recorded
+func (b *benchmark) call(ctx context.Context, operation string, f func() error) error {
+	start := time.Now()
+	err := f()
+	if ctx.Err() != nil {
+		return err
+	}
+	b.recorder.record(operation, time.Since(start), err)
+	return err
+}
+
+// every runs f every interval until ctx is done, the first run is delayed randomly to spread the load
+func every(ctx context.Context, interval time.Duration, f func()) {
+	timer := time.NewTimer(time.Duration(rand.Int63n(int64(interval))))
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			f()
+			timer.Reset(interval)
+		}
+	}
+}
+
+// runObserver posts rs list of the cluster periodically, as observers report rootservice changes
+func (b *benchmark) runObserver(ctx context.Context, i int) {
+	every(ctx, b.options.ClusterInterval, func() {
+		_ = b.call(ctx, OPERATION_REGISTER, func() error {
+			return b.client.Register(ctx, b.rootServiceInfo(i))
+		})
+	})
+}
+
+// runObProxy polls the version of obproxy config, and fetches the whole config when the version changes
+func (b *benchmark) runObProxy(ctx context.Context, i int) {
+	version := ""
+	every(ctx, b.options.ObProxyInterval, func() {
+		current := new(model.ObProxyConfigVersionOnly)
+		err := b.call(ctx, OPERATION_PROXY_VERSION, func() error {
+			return b.client.Do(ctx, http.MethodGet, "GetObProxyConfig", url.Values{"VersionOnly": []string{"true"}}, nil, current)
+		})
+		if err != nil || current.Version == version {
+			return
+		}
+		obProxyConfig := new(model.ObProxyConfig)
+		err = b.call(ctx, OPERATION_PROXY_CONFIG, func() error {
+			return b.client.Do(ctx, http.MethodGet, "GetObProxyConfig", url.Values{}, nil, obProxyConfig)
+		})
+		if err == nil {
+			version = obProxyConfig.Version
+		}
+	})
+}
+
+// runReader queries rootservice info of random clusters one after another
+func (b *benchmark) runReader(ctx context.Context, i int) {
+	random := rand.New(rand.NewSource(time.Now().UnixNano() + int64(i)))
+	for ctx.Err() == nil {
+		params := url.Values{"ObCluster": []string{b.clusterName(random.Intn(b.options.Clusters))}}
+		operation := OPERATION_READ_V1
+		if random.Float64() < b.options.V2Ratio {
+			operation = OPERATION_READ_V2
+			params.Set("version", strconv.Itoa(client.API_VERSION_2))
+		}
+		var data json.RawMessage
+		_ = b.call(ctx, operation, func() error {
+			return b.client.Do(ctx, http.MethodGet, "ObRootServiceInfo", params, nil, &data)
+		})
+		if b.options.ReaderInterval > 0 {
+			select {
+			case <-ctx.Done():
+			case <-time.After(b.options.ReaderInterval):
+			}
+		}
+	}
+}
+
+// cleanup deletes the simulated clusters and purges them from recycle bin
+func (b *benchmark) cleanup() {
+	ctx, cancel := context.WithTimeout(context.Background(), b.options.Timeout*time.Duration(b.options.Clusters+1))
+	defer cancel()
+	for i := 0; i < b.options.Clusters; i++ {
+		name := b.clusterName(i)
+		if err := b.client.Delete(ctx, name, int64(i+1)); err != nil {
+			continue
+		}
+		params := url.Values{"ObCluster": []string{name}, "ObClusterId": []string{strconv.Itoa(i + 1)}}
+		var result string
+		_ = b.client.Do(ctx, http.MethodDelete, "PurgeObCluster", params, nil, &result)
+	}
+}
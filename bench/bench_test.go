@@ -0,0 +1,147 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bench
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oceanbase/configserver/client"
+	"github.com/oceanbase/configserver/model"
+)
+
+func writeTestResponse(w http.ResponseWriter, code int, errorCode string, data interface{}) {
+	content, _ := json.Marshal(map[string]interface{}{
+		"Code":      code,
+		"Message":   http.StatusText(code),
+		"Success":   code < http.StatusBadRequest,
+		"ErrorCode": errorCode,
+		"Data":      data,
+	})
+	w.WriteHeader(code)
+	_, _ = w.Write(content)
+}
+
+func TestPercentile(t *testing.T) {
+	require.Equal(t, time.Duration(0), percentile(nil, 0.5))
+	sorted := make([]time.Duration, 0, 100)
+	for i := 1; i <= 100; i++ {
+		sorted = append(sorted, time.Duration(i))
+	}
+	require.Equal(t, time.Duration(50), percentile(sorted, 0.5))
+	require.Equal(t, time.Duration(99), percentile(sorted, 0.99))
+	require.Equal(t, time.Duration(100), percentile(sorted, 1))
+	require.Equal(t, time.Duration(1), percentile(sorted[:1], 0.99))
+}
+
+func TestGetErrorType(t *testing.T) {
+	require.Equal(t, "StorageUnavailable", getErrorType(&client.Error{StatusCode: http.StatusServiceUnavailable, ErrorCode: "StorageUnavailable"}))
+	require.Equal(t, "HTTP 502", getErrorType(errors.Wrap(&client.Error{StatusCode: http.StatusBadGateway}, "send")))
+	require.Equal(t, ERROR_TYPE_TIMEOUT, getErrorType(errors.Wrap(context.DeadlineExceeded, "send")))
+	require.Equal(t, ERROR_TYPE_NETWORK, getErrorType(errors.New("connection refused")))
+}
+
+func TestOptionsValidate(t *testing.T) {
+	require.NotNil(t, (&Options{Duration: time.Second, Clusters: 1, ClusterInterval: time.Second}).validate())
+	require.NotNil(t, (&Options{Target: "http://127.0.0.1:8080", Duration: time.Second}).validate())
+	require.NotNil(t, (&Options{Target: "http://127.0.0.1:8080", Duration: time.Second, Readers: 1}).validate())
+	require.NotNil(t, (&Options{Target: "http://127.0.0.1:8080", Duration: time.Second, Clusters: 1}).validate())
+	require.NotNil(t, (&Options{Target: "http://127.0.0.1:8080", Duration: time.Second, Clusters: 1, ClusterInterval: time.Second, V2Ratio: 2}).validate())
+	require.Nil(t, (&Options{Target: "http://127.0.0.1:8080", Duration: time.Second, Clusters: 1, ClusterInterval: time.Second, Readers: 1}).validate())
+}
+
+func TestRun(t *testing.T) {
+	var reads, deletes, purges int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch r.Method + " " + query.Get("Action") {
+		case "POST ObRootServiceInfo":
+			writeTestResponse(w, http.StatusOK, "", "successful")
+		case "GET ObRootServiceInfo":
+			// every fourth read fails
+			if atomic.AddInt32(&reads, 1)%4 == 0 {
+				writeTestResponse(w, http.StatusServiceUnavailable, "StorageUnavailable", nil)
+				return
+			}
+			info := &model.ObRootServiceInfo{ObCluster: query.Get("ObCluster")}
+			if query.Get("version") == "2" {
+				writeTestResponse(w, http.StatusOK, "", []*model.ObRootServiceInfo{info})
+			} else {
+				writeTestResponse(w, http.StatusOK, "", info)
+			}
+		case "GET GetObProxyConfig":
+			if query.Get("VersionOnly") == "true" {
+				writeTestResponse(w, http.StatusOK, "", &model.ObProxyConfigVersionOnly{Version: "v1"})
+			} else {
+				writeTestResponse(w, http.StatusOK, "", &model.ObProxyConfig{Version: "v1"})
+			}
+		case "DELETE ObRootServiceInfo":
+			atomic.AddInt32(&deletes, 1)
+			writeTestResponse(w, http.StatusOK, "", "success")
+		case "DELETE PurgeObCluster":
+			atomic.AddInt32(&purges, 1)
+			writeTestResponse(w, http.StatusOK, "", "successful")
+		default:
+			writeTestResponse(w, http.StatusBadRequest, "InvalidAction", nil)
+		}
+	}))
+	defer server.Close()
+
+	report, err := Run(context.Background(), Options{
+		Target:          server.URL,
+		Duration:        300 * time.Millisecond,
+		Clusters:        2,
+		ClusterInterval: 20 * time.Millisecond,
+		ObProxies:       2,
+		ObProxyInterval: 20 * time.Millisecond,
+		Readers:         2,
+		ReaderInterval:  5 * time.Millisecond,
+		V2Ratio:         0.5,
+		Cleanup:         true,
+	})
+	require.Nil(t, err)
+	require.Equal(t, int32(2), atomic.LoadInt32(&deletes))
+	require.Equal(t, int32(2), atomic.LoadInt32(&purges))
+
+	operations := make(map[string]*OperationReport)
+	for _, operation := range report.Operations {
+		operations[operation.Operation] = operation
+		require.True(t, operation.P50 <= operation.P99 && operation.P99 <= operation.Max)
+	}
+	require.Greater(t, operations[OPERATION_REGISTER].Requests, 0)
+	require.Equal(t, 0, operations[OPERATION_REGISTER].Errors)
+	require.Greater(t, operations[OPERATION_PROXY_VERSION].Requests, operations[OPERATION_PROXY_CONFIG].Requests)
+	// the config is fetched once by each obproxy as the version never changes
+	require.Equal(t, 2, operations[OPERATION_PROXY_CONFIG].Requests)
+	readErrors := operations[OPERATION_READ_V1].ErrorsBy["StorageUnavailable"] + operations[OPERATION_READ_V2].ErrorsBy["StorageUnavailable"]
+	require.Greater(t, readErrors, 0)
+	require.Equal(t, readErrors, operations[OPERATION_READ_V1].Errors+operations[OPERATION_READ_V2].Errors)
+
+	var buffer bytes.Buffer
+	report.Print(&buffer)
+	require.Contains(t, buffer.String(), OPERATION_READ_V2)
+	require.Contains(t, buffer.String(), "StorageUnavailable")
+}
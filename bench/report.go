@@ -0,0 +1,195 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bench
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/oceanbase/configserver/client"
+)
+
+const (
+	OPERATION_REGISTER      = "register"
+	OPERATION_PROXY_VERSION = "proxy_version"
+	OPERATION_PROXY_CONFIG  = "proxy_config"
+	OPERATION_READ_V1       = "read_v1"
+	OPERATION_READ_V2       = "read_v2"
+
+	ERROR_TYPE_TIMEOUT     = "Timeout"
+	ERROR_TYPE_NETWORK     = "NetworkError"
+	ERROR_TYPE_HTTP_FORMAT = "HTTP %d"
+
+	// latencies are printed in microseconds
+	latencyPrintResolution = time.Microsecond
+)
+
+// OperationReport is the throughput and latency of an operation, latencies include failed requests
+type OperationReport struct {
+	Operation string         `json:"operation"`
+	Requests  int            `json:"requests"`
+	Errors    int            `json:"errors"`
+	Qps       float64        `json:"qps"`
+	P50       time.Duration  `json:"p50"`
+	P90       time.Duration  `json:"p90"`
+	P99       time.Duration  `json:"p99"`
+	Max       time.Duration  `json:"max"`
+	ErrorsBy  map[string]int `json:"errors_by"`
+}
+
+type Report struct {
+	Target     string             `json:"target"`
+	Backend    string             `json:"backend,omitempty"`
+	Duration   time.Duration      `json:"duration"`
+	Operations []*OperationReport `json:"operations"`
+}
+
+// recorder collects latencies and errors of operations from all the workers
+type recorder struct {
+	lock      sync.Mutex
+	latencies map[string][]time.Duration
+	errors    map[string]map[string]int
+}
+
+func newRecorder() *recorder {
+	return &recorder{
+		latencies: make(map[string][]time.Duration),
+		errors:    make(map[string]map[string]int),
+	}
+}
+
+func (r *recorder) record(operation string, latency time.Duration, err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.latencies[operation] = append(r.latencies[operation], latency)
+	if err != nil {
+		if r.errors[operation] == nil {
+			r.errors[operation] = make(map[string]int)
+		}
+		r.errors[operation][getErrorType(err)]++
+	}
+}
+
+// getErrorType returns the ErrorCode of failed responses, or the kind of failure if there is no response
+func getErrorType(err error) string {
+	var apiErr *client.Error
+	if errors.As(err, &apiErr) {
+		if apiErr.ErrorCode != "" {
+			return apiErr.ErrorCode
+		}
+		return fmt.Sprintf(ERROR_TYPE_HTTP_FORMAT, apiErr.StatusCode)
+	}
+	if errors.Is(err, context.DeadlineExceeded) || strings.Contains(err.Error(), "Client.Timeout") {
+		return ERROR_TYPE_TIMEOUT
+	}
+	return ERROR_TYPE_NETWORK
+}
+
+// percentile returns the latency at p of sorted latencies with nearest rank
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(p*float64(len(sorted))+0.5) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	if rank >= len(sorted) {
+		rank = len(sorted) - 1
+	}
+	return sorted[rank]
+}
+
+func (r *recorder) report(target string, duration time.Duration) *Report {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	report := &Report{
+		Target:     target,
+		Duration:   duration,
+		Operations: make([]*OperationReport, 0, len(r.latencies)),
+	}
+	for operation, latencies := range r.latencies {
+		sorted := make([]time.Duration, len(latencies))
+		copy(sorted, latencies)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		operationReport := &OperationReport{
+			Operation: operation,
+			Requests:  len(sorted),
+			P50:       percentile(sorted, 0.5),
+			P90:       percentile(sorted, 0.9),
+			P99:       percentile(sorted, 0.99),
+			Max:       sorted[len(sorted)-1],
+			ErrorsBy:  make(map[string]int),
+		}
+		if duration > 0 {
+			operationReport.Qps = float64(len(sorted)) / duration.Seconds()
+		}
+		for errorType, count := range r.errors[operation] {
+			operationReport.ErrorsBy[errorType] = count
+			operationReport.Errors += count
+		}
+		report.Operations = append(report.Operations, operationReport)
+	}
+	sort.Slice(report.Operations, func(i, j int) bool {
+		return report.Operations[i].Operation < report.Operations[j].Operation
+	})
+	return report
+}
+
+// Print writes the report as tables for humans
+func (r *Report) Print(writer io.Writer) {
+	fmt.Fprintf(writer, "target: %s\n", r.Target)
+	if r.Backend != "" {
+		fmt.Fprintf(writer, "backend: %s\n", r.Backend)
+	}
+	fmt.Fprintf(writer, "duration: %s\n\n", r.Duration.Round(time.Millisecond))
+
+	table := tabwriter.NewWriter(writer, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(table, "OPERATION\tREQUESTS\tERRORS\tQPS\tP50\tP90\tP99\tMAX")
+	for _, operation := range r.Operations {
+		fmt.Fprintf(table, "%s\t%d\t%d\t%.1f\t%s\t%s\t%s\t%s\n", operation.Operation, operation.Requests, operation.Errors, operation.Qps,
+			operation.P50.Round(latencyPrintResolution), operation.P90.Round(latencyPrintResolution),
+			operation.P99.Round(latencyPrintResolution), operation.Max.Round(latencyPrintResolution))
+	}
+	_ = table.Flush()
+
+	errorLines := make([]string, 0)
+	for _, operation := range r.Operations {
+		for errorType, count := range operation.ErrorsBy {
+			errorLines = append(errorLines, fmt.Sprintf("%s\t%s\t%d", operation.Operation, errorType, count))
+		}
+	}
+	if len(errorLines) == 0 {
+		return
+	}
+	sort.Strings(errorLines)
+	fmt.Fprintln(writer)
+	table = tabwriter.NewWriter(writer, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(table, "OPERATION\tERROR\tCOUNT")
+	for _, line := range errorLines {
+		fmt.Fprintln(table, line)
+	}
+	_ = table.Flush()
+}
@@ -0,0 +1,80 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/pkg/errors"
+)
+
+var unsafeCacheKeyPattern = regexp.MustCompile(`[^a-zA-Z0-9_.-]`)
+
+// cached responses may include credentials like the meta database password in obproxy config,
+// so they are only readable by the owner
+const (
+	cacheDirMode  = 0700
+	cacheFileMode = 0600
+)
+
+// fileCache keeps the last known good response of each request in a file under dir
+type fileCache struct {
+	dir string
+}
+
+func newFileCache(dir string) (*fileCache, error) {
+	if err := os.MkdirAll(dir, cacheDirMode); err != nil {
+		return nil, errors.Wrapf(err, "create cache dir %s", dir)
+	}
+	return &fileCache{dir: dir}, nil
+}
+
+func (f *fileCache) path(key string) string {
+	return filepath.Join(f.dir, unsafeCacheKeyPattern.ReplaceAllString(key, "_")+".json")
+}
+
+// save writes data to a temporary file and renames it, so readers never see a partial file
+func (f *fileCache) save(key string, data []byte) error {
+	file, err := os.CreateTemp(f.dir, ".tmp-*")
+	if err != nil {
+		return errors.Wrap(err, "create cache file")
+	}
+	defer os.Remove(file.Name())
+	if err := file.Chmod(cacheFileMode); err != nil {
+		file.Close()
+		return errors.Wrap(err, "chmod cache file")
+	}
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return errors.Wrap(err, "write cache file")
+	}
+	if err := file.Close(); err != nil {
+		return errors.Wrap(err, "close cache file")
+	}
+	return errors.Wrap(os.Rename(file.Name(), f.path(key)), "rename cache file")
+}
+
+func (f *fileCache) load(key string, data interface{}) error {
+	content, err := os.ReadFile(f.path(key))
+	if err != nil {
+		return errors.Wrap(err, "read cache file")
+	}
+	return errors.Wrap(json.Unmarshal(content, data), "parse cache file")
+}
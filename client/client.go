@@ -0,0 +1,323 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package client is the go client of configserver, it reuses types in package model.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	SERVICES_PATH = "/services"
+	USER_AGENT    = "configserver-client"
+
+	API_VERSION_1 = 1
+	API_VERSION_2 = 2
+
+	DEFAULT_TIMEOUT         = 5 * time.Second
+	DEFAULT_MAX_ATTEMPTS    = 3
+	DEFAULT_INITIAL_BACKOFF = 100 * time.Millisecond
+	DEFAULT_MAX_BACKOFF     = 2 * time.Second
+
+	// failed responses are read up to the limit for the error message
+	maxErrorBodySize = 64 * 1024
+)
+
+// Options of the client, only Endpoints is required
+type Options struct {
+	// Endpoints are addresses of configserver like http://127.0.0.1:8080, a request fails over to the next one
+	// when the current one is unavailable, and later requests start with the last one succeeded
+	Endpoints []string
+	// Namespace is the namespace of clusters, the default namespace is used if empty,
+	// it's not applied to requests specifying Namespace in params
+	Namespace string
+	// Username and Password are sent with http basic auth if Username is not empty
+	Username string
+	Password string
+	// Timeout limits each attempt, the deadline of the context limits the whole call
+	Timeout time.Duration
+	// MaxAttempts limits attempts of a request, it's at least the number of endpoints
+	MaxAttempts int
+	// InitialBackoff is the delay before the second attempt, it's doubled after every failed attempt up to MaxBackoff
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Version pins the api version, it's negotiated with the server if 0
+	Version int
+	// CacheDir keeps the last known good response of each query, it's returned when no endpoint is available
+	CacheDir   string
+	HttpClient *http.Client
+}
+
+type Client struct {
+	options    Options
+	httpClient *http.Client
+	cache      *fileCache
+	// index of the endpoint of the last successful request
+	current int32
+	version int32
+}
+
+// apiResponse is the envelope of all the responses of configserver
+type apiResponse struct {
+	Code       int             `json:"Code"`
+	Message    string          `json:"Message"`
+	Successful bool            `json:"Success"`
+	ErrorCode  string          `json:"ErrorCode"`
+	Data       json.RawMessage `json:"Data"`
+	TraceId    string          `json:"Trace"`
+}
+
+// request is an action sent to configserver, the data of a successful response is cached with cacheKey if it's not empty
+type request struct {
+	method   string
+	action   string
+	params   url.Values
+	body     interface{}
+	cacheKey string
+}
+
+func New(options Options) (*Client, error) {
+	if len(options.Endpoints) == 0 {
+		return nil, errors.New("no configserver endpoint")
+	}
+	endpoints := make([]string, 0, len(options.Endpoints))
+	for _, endpoint := range options.Endpoints {
+		if _, err := url.Parse(endpoint); err != nil {
+			return nil, errors.Wrapf(err, "invalid configserver endpoint %s", endpoint)
+		}
+		endpoints = append(endpoints, strings.TrimSuffix(endpoint, "/"))
+	}
+	options.Endpoints = endpoints
+	if options.Timeout <= 0 {
+		options.Timeout = DEFAULT_TIMEOUT
+	}
+	if options.MaxAttempts <= 0 {
+		options.MaxAttempts = DEFAULT_MAX_ATTEMPTS
+	}
+	if options.MaxAttempts < len(endpoints) {
+		options.MaxAttempts = len(endpoints)
+	}
+	if options.InitialBackoff <= 0 {
+		options.InitialBackoff = DEFAULT_INITIAL_BACKOFF
+	}
+	if options.MaxBackoff <= 0 {
+		options.MaxBackoff = DEFAULT_MAX_BACKOFF
+	}
+	switch options.Version {
+	case 0, API_VERSION_1, API_VERSION_2:
+	default:
+		return nil, errors.Errorf("unsupported api version %d", options.Version)
+	}
+	client := &Client{
+		options:    options,
+		httpClient: options.HttpClient,
+		version:    API_VERSION_2,
+	}
+	if options.Version > 0 {
+		client.version = int32(options.Version)
+	}
+	if client.httpClient == nil {
+		client.httpClient = http.DefaultClient
+	}
+	if options.CacheDir != "" {
+		cache, err := newFileCache(options.CacheDir)
+		if err != nil {
+			return nil, err
+		}
+		client.cache = cache
+	}
+	return client, nil
+}
+
+// Version returns the api version used by the client
+func (c *Client) Version() int {
+	return int(atomic.LoadInt32(&c.version))
+}
+
+// downgradeVersion falls back to version 1 after the server answers a version 2 request in version 1
+func (c *Client) downgradeVersion() {
+	if c.options.Version == 0 {
+		atomic.StoreInt32(&c.version, API_VERSION_1)
+	}
+}
+
+// getBackoff returns the delay before the attempt with jitter, it's doubled after every failed attempt
+func (c *Client) getBackoff(attempt int) time.Duration {
+	backoff := c.options.InitialBackoff
+	for i := 1; i < attempt && backoff < c.options.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > c.options.MaxBackoff {
+		backoff = c.options.MaxBackoff
+	}
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
+
+// Do sends action with params and body to configserver, and decodes data of the response into data,
+// it's used by actions without typed methods, Namespace in params takes precedence over the one in options
+func (c *Client) Do(ctx context.Context, method, action string, params url.Values, body interface{}, data interface{}) error {
+	return c.do(ctx, &request{
+		method: method,
+		action: action,
+		params: params,
+		body:   body,
+	}, data)
+}
+
+// do sends the request to endpoints in turn until it succeeds, and decodes data of the response into data,
+// the cached data is used if no endpoint gives a definite answer
+func (c *Client) do(ctx context.Context, req *request, data interface{}) error {
+	var body []byte
+	if req.body != nil {
+		var err error
+		if body, err = json.Marshal(req.body); err != nil {
+			return errors.Wrap(err, "marshal request body")
+		}
+	}
+	start := int(atomic.LoadInt32(&c.current))
+	var lastErr error
+	for attempt := 0; attempt < c.options.MaxAttempts; attempt++ {
+		if attempt > 0 {
+			backoff := c.getBackoff(attempt)
+			if retryAfter := getRetryAfter(lastErr); retryAfter > backoff {
+				backoff = retryAfter
+			}
+			timer := time.NewTimer(backoff)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return c.fallback(req, data, errors.Wrapf(ctx.Err(), "%s after %d attempts, last error: %v", req.action, attempt, lastErr))
+			case <-timer.C:
+			}
+		}
+		index := (start + attempt) % len(c.options.Endpoints)
+		raw, err := c.send(ctx, c.options.Endpoints[index], req, body)
+		if err == nil {
+			atomic.StoreInt32(&c.current, int32(index))
+			if err := json.Unmarshal(raw, data); err != nil {
+				return errors.Wrapf(err, "parse data of %s", req.action)
+			}
+			if c.cache != nil && req.cacheKey != "" {
+				// the cache is only a fallback, failing to update it doesn't fail the request
+				_ = c.cache.save(req.cacheKey, raw)
+			}
+			return nil
+		}
+		lastErr = err
+		if !isRetriable(err) || ctx.Err() != nil {
+			break
+		}
+	}
+	if !isRetriable(lastErr) {
+		return lastErr
+	}
+	return c.fallback(req, data, lastErr)
+}
+
+func (c *Client) fallback(req *request, data interface{}, err error) error {
+	if c.cache == nil || req.cacheKey == "" {
+		return err
+	}
+	if cacheErr := c.cache.load(req.cacheKey, data); cacheErr != nil {
+		return err
+	}
+	return nil
+}
+
+// send sends the request to endpoint once, and returns data of the response if it's successful
+func (c *Client) send(ctx context.Context, endpoint string, req *request, body []byte) (json.RawMessage, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.options.Timeout)
+	defer cancel()
+
+	params := url.Values{}
+	for key, values := range req.params {
+		params[key] = values
+	}
+	params.Set("Action", req.action)
+	if c.options.Namespace != "" && params.Get("Namespace") == "" {
+		params.Set("Namespace", c.options.Namespace)
+	}
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	httpRequest, err := http.NewRequestWithContext(ctx, req.method, endpoint+SERVICES_PATH+"?"+params.Encode(), reader)
+	if err != nil {
+		return nil, errors.Wrapf(err, "create request to %s", endpoint)
+	}
+	httpRequest.Header.Set("User-Agent", USER_AGENT)
+	if body != nil {
+		httpRequest.Header.Set("Content-Type", "application/json")
+	}
+	if c.options.Username != "" {
+		httpRequest.SetBasicAuth(c.options.Username, c.options.Password)
+	}
+	httpResponse, err := c.httpClient.Do(httpRequest)
+	if err != nil {
+		return nil, errors.Wrapf(err, "send %s to %s", req.action, endpoint)
+	}
+	defer httpResponse.Body.Close()
+
+	content, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read response of %s from %s", req.action, endpoint)
+	}
+	response := new(apiResponse)
+	if err := json.Unmarshal(content, response); err != nil || response.Code == 0 {
+		// not a response of configserver, like the error page of a load balancer
+		if len(content) > maxErrorBodySize {
+			content = content[:maxErrorBodySize]
+		}
+		return nil, &Error{
+			Endpoint:   endpoint,
+			StatusCode: httpResponse.StatusCode,
+			Message:    strings.TrimSpace(string(content)),
+			RetryAfter: parseRetryAfter(httpResponse.Header.Get("Retry-After")),
+		}
+	}
+	if !response.Successful || httpResponse.StatusCode >= http.StatusBadRequest {
+		return nil, &Error{
+			Endpoint:   endpoint,
+			StatusCode: httpResponse.StatusCode,
+			ErrorCode:  response.ErrorCode,
+			Message:    response.Message,
+			TraceId:    response.TraceId,
+			RetryAfter: parseRetryAfter(httpResponse.Header.Get("Retry-After")),
+		}
+	}
+	return response.Data, nil
+}
+
+func parseRetryAfter(value string) time.Duration {
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
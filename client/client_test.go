@@ -0,0 +1,292 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/oceanbase/configserver/model"
+)
+
+var testRootServiceInfo = &model.ObRootServiceInfo{
+	ObClusterId: 1,
+	ObRegionId:  1,
+	ObCluster:   "c1",
+	ObRegion:    "c1",
+	RsList:      []*model.ObServerInfo{{Address: "1.1.1.1:2882", Role: "LEADER", SqlPort: 2881}},
+	Type:        model.OB_CLUSTER_TYPE_PRIMARY,
+}
+
+func writeTestResponse(w http.ResponseWriter, code int, errorCode string, data interface{}) {
+	content, _ := json.Marshal(map[string]interface{}{
+		"Code":      code,
+		"Message":   http.StatusText(code),
+		"Success":   code < http.StatusBadRequest,
+		"ErrorCode": errorCode,
+		"Data":      data,
+		"Trace":     "trace",
+	})
+	w.WriteHeader(code)
+	_, _ = w.Write(content)
+}
+
+func newTestClient(t *testing.T, options Options) *Client {
+	options.InitialBackoff = time.Millisecond
+	options.MaxBackoff = 10 * time.Millisecond
+	client, err := New(options)
+	require.Nil(t, err)
+	return client
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(Options{})
+	require.NotNil(t, err)
+	_, err = New(Options{Endpoints: []string{"http://127.0.0.1:8080"}, Version: 3})
+	require.NotNil(t, err)
+	client, err := New(Options{Endpoints: []string{"http://127.0.0.1:8080/", "http://127.0.0.2:8080", "http://127.0.0.3:8080", "http://127.0.0.4:8080"}})
+	require.Nil(t, err)
+	require.Equal(t, "http://127.0.0.1:8080", client.options.Endpoints[0])
+	require.Equal(t, 4, client.options.MaxAttempts)
+	require.Equal(t, API_VERSION_2, client.Version())
+}
+
+func TestClientApis(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, _ := r.BasicAuth()
+		require.Equal(t, "user", username)
+		require.Equal(t, "pass", password)
+		require.Equal(t, "ns1", r.URL.Query().Get("Namespace"))
+		query := r.URL.Query()
+		switch r.Method + " " + query.Get("Action") {
+		case "GET ObRootServiceInfo":
+			require.Equal(t, "c1", query.Get("ObCluster"))
+			if query.Get("version") == "2" {
+				writeTestResponse(w, http.StatusOK, "", []*model.ObRootServiceInfo{testRootServiceInfo})
+			} else {
+				writeTestResponse(w, http.StatusOK, "", testRootServiceInfo)
+			}
+		case "POST ObRootServiceInfo":
+			require.Equal(t, "2", query.Get("version"))
+			require.Equal(t, "1", query.Get("ObClusterId"))
+			info := new(model.ObRootServiceInfo)
+			body, _ := io.ReadAll(r.Body)
+			require.Nil(t, json.Unmarshal(body, info))
+			require.Equal(t, testRootServiceInfo.RsList, info.RsList)
+			writeTestResponse(w, http.StatusOK, "", "successful")
+		case "DELETE ObRootServiceInfo":
+			require.Equal(t, "2", query.Get("version"))
+			require.Equal(t, "1", query.Get("ObClusterId"))
+			writeTestResponse(w, http.StatusOK, "", "success")
+		case "GET ObIDCRegionInfo":
+			writeTestResponse(w, http.StatusOK, "", &model.ObClusterIdcRegionInfo{Cluster: "c1", ClusterId: 1, IdcList: []*model.IdcRegionInfo{}})
+		case "GET GetObProxyConfig":
+			writeTestResponse(w, http.StatusOK, "", &model.ObProxyConfig{Version: "v1", ConfigUrlList: []*model.RootServiceInfoUrl{{ObCluster: "c1", Url: "url"}}})
+		default:
+			writeTestResponse(w, http.StatusBadRequest, "InvalidAction", nil)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	client := newTestClient(t, Options{Endpoints: []string{server.URL}, Namespace: "ns1", Username: "user", Password: "pass"})
+	info, err := client.GetRootServiceInfo(ctx, "c1", 0)
+	require.Nil(t, err)
+	require.Equal(t, testRootServiceInfo, info)
+	infos, err := client.ListRootServiceInfos(ctx, "c1")
+	require.Nil(t, err)
+	require.Equal(t, []*model.ObRootServiceInfo{testRootServiceInfo}, infos)
+	require.Nil(t, client.Register(ctx, testRootServiceInfo))
+	require.Nil(t, client.Delete(ctx, "c1", 1))
+	require.NotNil(t, client.Delete(ctx, "c1", 0))
+	idcRegionInfo, err := client.GetIdcRegionInfo(ctx, "c1", 0)
+	require.Nil(t, err)
+	require.Equal(t, int64(1), idcRegionInfo.ClusterId)
+	obProxyConfig, err := client.GetObProxyConfig(ctx)
+	require.Nil(t, err)
+	require.Equal(t, "v1", obProxyConfig.Version)
+	require.Equal(t, API_VERSION_2, client.Version())
+}
+
+func TestClientFailover(t *testing.T) {
+	var unavailableHits, availableHits int32
+	unavailable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&unavailableHits, 1)
+		writeTestResponse(w, http.StatusServiceUnavailable, "StorageUnavailable", nil)
+	}))
+	defer unavailable.Close()
+	available := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&availableHits, 1)
+		writeTestResponse(w, http.StatusOK, "", testRootServiceInfo)
+	}))
+	defer available.Close()
+
+	client := newTestClient(t, Options{Endpoints: []string{unavailable.URL, available.URL}})
+	for i := 0; i < 3; i++ {
+		info, err := client.GetRootServiceInfo(context.Background(), "c1", 0)
+		require.Nil(t, err)
+		require.Equal(t, "c1", info.ObCluster)
+	}
+	// later requests start with the endpoint succeeded
+	require.Equal(t, int32(1), atomic.LoadInt32(&unavailableHits))
+	require.Equal(t, int32(3), atomic.LoadInt32(&availableHits))
+}
+
+func TestClientRetry(t *testing.T) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("ObCluster") {
+		case "throttled":
+			if atomic.AddInt32(&hits, 1) < 3 {
+				writeTestResponse(w, http.StatusTooManyRequests, "TooManyRequests", nil)
+				return
+			}
+			writeTestResponse(w, http.StatusOK, "", testRootServiceInfo)
+		case "proxy":
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = w.Write([]byte("<html>bad gateway</html>"))
+		default:
+			atomic.AddInt32(&hits, 1)
+			writeTestResponse(w, http.StatusNotFound, "ClusterNotFound", nil)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, Options{Endpoints: []string{server.URL}})
+	_, err := client.GetRootServiceInfo(context.Background(), "throttled", 0)
+	require.Nil(t, err)
+	require.Equal(t, int32(3), atomic.LoadInt32(&hits))
+
+	// not found is not retried
+	atomic.StoreInt32(&hits, 0)
+	_, err = client.GetRootServiceInfo(context.Background(), "c2", 0)
+	require.True(t, IsNotFound(err))
+	require.Equal(t, int32(1), atomic.LoadInt32(&hits))
+	var apiErr *Error
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, "ClusterNotFound", apiErr.ErrorCode)
+	require.Equal(t, "trace", apiErr.TraceId)
+
+	_, err = client.GetRootServiceInfo(context.Background(), "proxy", 0)
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusBadGateway, apiErr.StatusCode)
+	require.Contains(t, apiErr.Message, "bad gateway")
+}
+
+func TestClientDeadline(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+		writeTestResponse(w, http.StatusOK, "", testRootServiceInfo)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, Options{Endpoints: []string{server.URL}, Timeout: 50 * time.Millisecond, MaxAttempts: 100})
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := client.GetRootServiceInfo(ctx, "c1", 0)
+	require.NotNil(t, err)
+	require.Less(t, time.Since(start), time.Second)
+}
+
+func TestClientVersionNegotiation(t *testing.T) {
+	var versions []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// a server of version 1 ignores the version
+		versions = append(versions, r.URL.Query().Get("version"))
+		writeTestResponse(w, http.StatusOK, "", testRootServiceInfo)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, Options{Endpoints: []string{server.URL}})
+	for i := 0; i < 2; i++ {
+		infos, err := client.ListRootServiceInfos(context.Background(), "c1")
+		require.Nil(t, err)
+		require.Equal(t, []*model.ObRootServiceInfo{testRootServiceInfo}, infos)
+		require.Equal(t, API_VERSION_1, client.Version())
+	}
+	require.Equal(t, []string{"2", ""}, versions)
+
+	// pinned version is never changed
+	client = newTestClient(t, Options{Endpoints: []string{server.URL}, Version: API_VERSION_2})
+	_, err := client.ListRootServiceInfos(context.Background(), "c1")
+	require.Nil(t, err)
+	require.Equal(t, API_VERSION_2, client.Version())
+}
+
+func TestClientCache(t *testing.T) {
+	var available int32 = 1
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("ObCluster") == "c2" {
+			writeTestResponse(w, http.StatusNotFound, "ClusterNotFound", nil)
+			return
+		}
+		if atomic.LoadInt32(&available) == 0 {
+			writeTestResponse(w, http.StatusServiceUnavailable, "StorageUnavailable", nil)
+			return
+		}
+		writeTestResponse(w, http.StatusOK, "", testRootServiceInfo)
+	}))
+	defer server.Close()
+
+	cacheDir := filepath.Join(t.TempDir(), "cache")
+	client := newTestClient(t, Options{Endpoints: []string{server.URL}, CacheDir: cacheDir})
+	_, err := client.GetRootServiceInfo(context.Background(), "c1", 0)
+	require.Nil(t, err)
+
+	// cached responses are only readable by the owner
+	stat, err := os.Stat(cacheDir)
+	require.Nil(t, err)
+	require.Equal(t, os.FileMode(0700), stat.Mode().Perm())
+	files, err := filepath.Glob(filepath.Join(cacheDir, "*.json"))
+	require.Nil(t, err)
+	require.Equal(t, 1, len(files))
+	stat, err = os.Stat(files[0])
+	require.Nil(t, err)
+	require.Equal(t, os.FileMode(0600), stat.Mode().Perm())
+
+	// the last known good response is returned when no endpoint is available, even by a new client
+	atomic.StoreInt32(&available, 0)
+	client = newTestClient(t, Options{Endpoints: []string{server.URL}, CacheDir: cacheDir})
+	info, err := client.GetRootServiceInfo(context.Background(), "c1", 0)
+	require.Nil(t, err)
+	require.Equal(t, testRootServiceInfo, info)
+	_, err = client.GetRootServiceInfo(context.Background(), "c1", 1)
+	require.NotNil(t, err)
+
+	// definite failures are not hidden by the cache
+	_, err = client.GetRootServiceInfo(context.Background(), "c2", 0)
+	require.True(t, IsNotFound(err))
+
+	// without cache
+	client = newTestClient(t, Options{Endpoints: []string{server.URL}})
+	_, err = client.GetRootServiceInfo(context.Background(), "c1", 0)
+	require.NotNil(t, err)
+}
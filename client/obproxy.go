@@ -0,0 +1,41 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/oceanbase/configserver/model"
+)
+
+// GetObProxyConfig returns obproxy config with rootservice info urls of all the clusters visible to the client
+func (c *Client) GetObProxyConfig(ctx context.Context) (*model.ObProxyConfig, error) {
+	params := url.Values{}
+	obProxyConfig := new(model.ObProxyConfig)
+	err := c.do(ctx, &request{
+		method:   http.MethodGet,
+		action:   "GetObProxyConfig",
+		params:   params,
+		cacheKey: c.cacheKey("GetObProxyConfig", params),
+	}, obProxyConfig)
+	if err != nil {
+		return nil, err
+	}
+	return obProxyConfig, nil
+}
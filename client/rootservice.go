@@ -0,0 +1,158 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/oceanbase/configserver/model"
+)
+
+func rootServiceParams(obCluster string, obClusterId int64) url.Values {
+	params := url.Values{}
+	params.Set("ObCluster", obCluster)
+	if obClusterId > 0 {
+		params.Set("ObClusterId", strconv.FormatInt(obClusterId, 10))
+	}
+	return params
+}
+
+func (c *Client) cacheKey(action string, params url.Values) string {
+	namespace := c.options.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+	return fmt.Sprintf("%s_%s_%s", namespace, action, params.Encode())
+}
+
+// GetRootServiceInfo returns rootservice info of the cluster with obClusterId,
+// or the primary cluster of the name if obClusterId is 0
+func (c *Client) GetRootServiceInfo(ctx context.Context, obCluster string, obClusterId int64) (*model.ObRootServiceInfo, error) {
+	params := rootServiceParams(obCluster, obClusterId)
+	info := new(model.ObRootServiceInfo)
+	err := c.do(ctx, &request{
+		method:   http.MethodGet,
+		action:   "ObRootServiceInfo",
+		params:   params,
+		cacheKey: c.cacheKey("ObRootServiceInfo", params),
+	}, info)
+	if err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// ListRootServiceInfos returns rootservice info of the primary and standby clusters of the name,
+// only the primary cluster is returned by servers supporting version 1 only
+func (c *Client) ListRootServiceInfos(ctx context.Context, obCluster string) ([]*model.ObRootServiceInfo, error) {
+	if c.Version() < API_VERSION_2 {
+		info, err := c.GetRootServiceInfo(ctx, obCluster, 0)
+		if err != nil {
+			return nil, err
+		}
+		return []*model.ObRootServiceInfo{info}, nil
+	}
+	params := rootServiceParams(obCluster, 0)
+	params.Set("version", strconv.Itoa(API_VERSION_2))
+	var data json.RawMessage
+	err := c.do(ctx, &request{
+		method:   http.MethodGet,
+		action:   "ObRootServiceInfo",
+		params:   params,
+		cacheKey: c.cacheKey("ObRootServiceInfo", params),
+	}, &data)
+	if err != nil {
+		return nil, err
+	}
+	// servers of version 1 ignore the version and return the primary cluster
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		c.downgradeVersion()
+		info := new(model.ObRootServiceInfo)
+		if err := json.Unmarshal(data, info); err != nil {
+			return nil, errors.Wrap(err, "parse rootservice info")
+		}
+		return []*model.ObRootServiceInfo{info}, nil
+	}
+	infos := make([]*model.ObRootServiceInfo, 0)
+	if err := json.Unmarshal(data, &infos); err != nil {
+		return nil, errors.Wrap(err, "parse rootservice info list")
+	}
+	return infos, nil
+}
+
+// Register saves rootservice info of the cluster, Type of the cluster is required with version 2
+func (c *Client) Register(ctx context.Context, info *model.ObRootServiceInfo) error {
+	obCluster := info.ObCluster
+	if obCluster == "" {
+		obCluster = info.ObRegion
+	}
+	obClusterId := info.ObClusterId
+	if obClusterId == 0 {
+		obClusterId = info.ObRegionId
+	}
+	params := rootServiceParams(obCluster, obClusterId)
+	if info.Type != "" && c.Version() >= API_VERSION_2 {
+		params.Set("version", strconv.Itoa(API_VERSION_2))
+	}
+	var result string
+	return c.do(ctx, &request{
+		method: http.MethodPost,
+		action: "ObRootServiceInfo",
+		params: params,
+		body:   info,
+	}, &result)
+}
+
+// Delete deletes rootservice info of the cluster with obClusterId
+func (c *Client) Delete(ctx context.Context, obCluster string, obClusterId int64) error {
+	if obClusterId <= 0 {
+		return errors.New("ob cluster id is required to delete rootservice info")
+	}
+	params := rootServiceParams(obCluster, obClusterId)
+	params.Set("version", strconv.Itoa(API_VERSION_2))
+	var result string
+	return c.do(ctx, &request{
+		method: http.MethodDelete,
+		action: "ObRootServiceInfo",
+		params: params,
+	}, &result)
+}
+
+// GetIdcRegionInfo returns idc and region info of the cluster with obClusterId,
+// or the primary cluster of the name if obClusterId is 0
+func (c *Client) GetIdcRegionInfo(ctx context.Context, obCluster string, obClusterId int64) (*model.ObClusterIdcRegionInfo, error) {
+	params := rootServiceParams(obCluster, obClusterId)
+	info := new(model.ObClusterIdcRegionInfo)
+	err := c.do(ctx, &request{
+		method:   http.MethodGet,
+		action:   "ObIDCRegionInfo",
+		params:   params,
+		cacheKey: c.cacheKey("ObIDCRegionInfo", params),
+	}, info)
+	if err != nil {
+		return nil, err
+	}
+	return info, nil
+}
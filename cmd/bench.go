@@ -0,0 +1,159 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/oceanbase/configserver/bench"
+	"github.com/oceanbase/configserver/config"
+	"github.com/oceanbase/configserver/lib/trace"
+	"github.com/oceanbase/configserver/logger"
+	"github.com/oceanbase/configserver/server"
+)
+
+const (
+	BENCH_OUTPUT_TEXT = "text"
+	BENCH_OUTPUT_JSON = "json"
+	// time to wait for the embedded configserver to serve requests
+	benchServerStartTimeout = 30 * time.Second
+)
+
+var (
+	benchOptions bench.Options
+	benchOutput  string
+
+	benchCommand = &cobra.Command{
+		Use:   "bench",
+		Short: "run a load test against configserver",
+		Long: "run a load test against configserver with simulated observers, obproxies and readers, " +
+			"a configserver is started with the config file if target is not specified, so that the storage backend in the config file is measured",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runBench()
+		},
+	}
+)
+
+func init() {
+	flags := benchCommand.Flags()
+	flags.StringVar(&benchOptions.Target, "target", "", "url of the configserver to test, e.g. http://127.0.0.1:8080, start one with the config file if not specified")
+	flags.StringVar(&benchOptions.Namespace, "namespace", "", "namespace of the simulated clusters")
+	flags.StringVar(&benchOptions.Username, "username", "", "username for basic auth")
+	flags.StringVar(&benchOptions.Password, "password", "", "password for basic auth")
+	flags.DurationVar(&benchOptions.Duration, "duration", time.Minute, "duration of the load test")
+	flags.DurationVar(&benchOptions.Timeout, "timeout", bench.DEFAULT_TIMEOUT, "timeout of each request")
+	flags.IntVar(&benchOptions.Clusters, "clusters", 10, "number of observer clusters posting rs list")
+	flags.DurationVar(&benchOptions.ClusterInterval, "cluster-interval", 5*time.Second, "interval between rs list posts of each cluster")
+	flags.IntVar(&benchOptions.ObProxies, "obproxies", 10, "number of obproxies polling the version of obproxy config")
+	flags.DurationVar(&benchOptions.ObProxyInterval, "obproxy-interval", 5*time.Second, "interval between polls of each obproxy")
+	flags.IntVar(&benchOptions.Readers, "readers", 10, "number of readers querying rootservice info")
+	flags.DurationVar(&benchOptions.ReaderInterval, "reader-interval", 0, "interval between queries of each reader, 0 means as fast as possible")
+	flags.Float64Var(&benchOptions.V2Ratio, "v2-ratio", 0.5, "ratio of reader queries with version 2")
+	flags.StringVar(&benchOptions.ClusterPrefix, "cluster-prefix", bench.DEFAULT_CLUSTER_PREFIX, "name prefix of the simulated clusters")
+	flags.BoolVar(&benchOptions.Cleanup, "cleanup", true, "delete and purge the simulated clusters after the load test")
+	flags.StringVar(&benchOutput, "output", BENCH_OUTPUT_TEXT, "format of the report, text or json")
+	configserverCommand.AddCommand(benchCommand)
+}
+
+func runBench() error {
+	if benchOutput != BENCH_OUTPUT_TEXT && benchOutput != BENCH_OUTPUT_JSON {
+		return errors.Errorf("invalid output format %s", benchOutput)
+	}
+	ctx, cancel := signal.NotifyContext(trace.ContextWithTraceId(logger.INIT_TRACEID), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	backend := ""
+	if benchOptions.Target == "" {
+		configServer, err := startBenchServer(ctx)
+		if err != nil {
+			return err
+		}
+		defer configServer.Server.Cancel()
+		benchOptions.Target = server.GetLocalUrl(configServer.Config.Server.Address)
+		backend = configServer.Config.Storage.DatabaseType
+	}
+
+	report, err := bench.Run(ctx, benchOptions)
+	if err != nil {
+		return errors.Wrap(err, "run bench")
+	}
+	report.Backend = backend
+	if benchOutput == BENCH_OUTPUT_JSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	}
+	report.Print(os.Stdout)
+	return nil
+}
+
+// startBenchServer starts a configserver with the config file and waits until it serves requests
+func startBenchServer(ctx context.Context) (*server.ConfigServer, error) {
+	configServerConfig, err := config.ParseConfigServerConfig(viper.GetString("config"))
+	if err != nil {
+		return nil, errors.Wrap(err, "read and parse configserver config")
+	}
+	initLogger(configServerConfig.Log)
+	// gin writes every request to stdout in debug mode, keep the report readable
+	gin.SetMode(gin.ReleaseMode)
+	gin.DefaultWriter = io.Discard
+
+	configServer := server.NewConfigServer(configServerConfig)
+	done := make(chan error, 1)
+	go func() {
+		done <- configServer.Run()
+	}()
+
+	url := server.GetLocalUrl(configServerConfig.Server.Address) + "/services"
+	httpClient := &http.Client{Timeout: time.Second}
+	deadline := time.Now().Add(benchServerStartTimeout)
+	for {
+		select {
+		case err := <-done:
+			if err == nil {
+				err = errors.New("server exited")
+			}
+			return nil, errors.Wrap(err, "start config server")
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(100 * time.Millisecond):
+		}
+		// the cancel function of the server is set before it starts listening
+		if resp, err := httpClient.Get(url); err == nil {
+			resp.Body.Close()
+			log.WithContext(ctx).Infof("config server started on %s", configServerConfig.Server.Address)
+			return configServer, nil
+		}
+		if time.Now().After(deadline) {
+			configServer.Server.Cancel()
+			return nil, errors.Errorf("config server not ready in %s", benchServerStartTimeout)
+		}
+	}
+}
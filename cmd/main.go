@@ -17,7 +17,12 @@
 package main
 
 import (
+	"bufio"
+	"fmt"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
@@ -25,6 +30,8 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/oceanbase/configserver/config"
+	"github.com/oceanbase/configserver/lib/crypto"
+	"github.com/oceanbase/configserver/lib/trace"
 	"github.com/oceanbase/configserver/logger"
 	"github.com/oceanbase/configserver/server"
 )
@@ -41,11 +48,34 @@ var (
 			}
 		},
 	}
+
+	agentCommand = &cobra.Command{
+		Use:   "agent",
+		Short: "run configserver agent on the host",
+		Long:  "run configserver agent on the host, it serves read only apis from the last known good data refreshed from upstream configservers",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAgent()
+		},
+	}
+
+	encryptPasswordCommand = &cobra.Command{
+		Use:   "encrypt-password",
+		Short: "encrypt password read from stdin",
+		Long:  "encrypt password read from stdin with the key file, the result is used as encrypted_password of meta database",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return encryptPassword()
+		},
+	}
 )
 
 func init() {
 	configserverCommand.PersistentFlags().StringP("config", "c", "etc/config.yaml", "config file")
 	_ = viper.BindPFlag("config", configserverCommand.PersistentFlags().Lookup("config"))
+
+	encryptPasswordCommand.Flags().String("key-file", "", "key file, key_file of meta database in config file is used if not specified")
+	_ = viper.BindPFlag("key-file", encryptPasswordCommand.Flags().Lookup("key-file"))
+	configserverCommand.AddCommand(encryptPasswordCommand)
+	configserverCommand.AddCommand(agentCommand)
 }
 
 func main() {
@@ -62,15 +92,7 @@ func runConfigServer() error {
 	}
 
 	// init logger
-	logger.InitLogger(logger.LoggerConfig{
-		Level:      configServerConfig.Log.Level,
-		Filename:   configServerConfig.Log.Filename,
-		MaxSize:    configServerConfig.Log.MaxSize,
-		MaxAge:     configServerConfig.Log.MaxAge,
-		MaxBackups: configServerConfig.Log.MaxBackups,
-		LocalTime:  configServerConfig.Log.LocalTime,
-		Compress:   configServerConfig.Log.Compress,
-	})
+	initLogger(configServerConfig.Log)
 
 	// init config server
 	configServer := server.NewConfigServer(configServerConfig)
@@ -82,3 +104,63 @@ func runConfigServer() error {
 
 	return nil
 }
+
+func initLogger(logConfig *config.LogConfig) {
+	logger.InitLogger(logger.LoggerConfig{
+		Level:      logConfig.Level,
+		Format:     logConfig.Format,
+		Filename:   logConfig.Filename,
+		MaxSize:    logConfig.MaxSize,
+		MaxAge:     logConfig.MaxAge,
+		MaxBackups: logConfig.MaxBackups,
+		LocalTime:  logConfig.LocalTime,
+		Compress:   logConfig.Compress,
+	})
+}
+
+func runAgent() error {
+	agentConfig, err := config.ParseAgentConfig(viper.GetString("config"))
+	if err != nil {
+		return errors.Wrap(err, "read and parse agent config")
+	}
+	if agentConfig.Log != nil {
+		initLogger(agentConfig.Log)
+	}
+
+	agent, err := server.NewAgent(agentConfig)
+	if err != nil {
+		return errors.Wrap(err, "create agent")
+	}
+	// stop serving on SIGINT or SIGTERM
+	ctx, cancel := signal.NotifyContext(trace.ContextWithTraceId(logger.INIT_TRACEID), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+	return agent.Run(ctx)
+}
+
+func encryptPassword() error {
+	keyFile := viper.GetString("key-file")
+	if keyFile == "" {
+		configServerConfig, err := config.ParseConfigServerConfig(viper.GetString("config"))
+		if err != nil {
+			return errors.Wrap(err, "read and parse configserver config")
+		}
+		if configServerConfig.MetaDatabase == nil || configServerConfig.MetaDatabase.KeyFile == "" {
+			return errors.New("key file not specified")
+		}
+		keyFile = configServerConfig.MetaDatabase.KeyFile
+	}
+	key, err := crypto.LoadKey(keyFile)
+	if err != nil {
+		return err
+	}
+	password, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && password == "" {
+		return errors.Wrap(err, "read password from stdin")
+	}
+	encrypted, err := crypto.Encrypt(key, strings.TrimRight(password, "\r\n"))
+	if err != nil {
+		return err
+	}
+	fmt.Println(encrypted)
+	return nil
+}
@@ -0,0 +1,31 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+// AccessLogConfig decides where requests are logged, one line per request in combined or json format,
+// it's rotated by its own settings, and is disabled if Filename is empty.
+// successful reads are logged by SuccessfulReadSampleRate if it's between 0 and 1, all requests are logged otherwise
+type AccessLogConfig struct {
+	Filename                 string  `yaml:"filename"`
+	Format                   string  `yaml:"format"`
+	MaxSize                  int     `yaml:"maxsize"`
+	MaxAge                   int     `yaml:"maxage"`
+	MaxBackups               int     `yaml:"maxbackups"`
+	LocalTime                bool    `yaml:"localtime"`
+	Compress                 bool    `yaml:"compress"`
+	SuccessfulReadSampleRate float64 `yaml:"successful_read_sample_rate"`
+}
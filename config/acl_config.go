@@ -0,0 +1,28 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+// AclConfig lists the ip addresses or CIDR blocks allowed to send read, write, admin and heartbeat requests, an empty list allows all.
+// heartbeats of obproxies are checked by Read if Heartbeat is empty, they don't need to be allowed to write.
+// client ip is taken from X-Forwarded-For or X-Real-IP only when the request comes from trusted proxies
+type AclConfig struct {
+	Read           []string `yaml:"read"`
+	Write          []string `yaml:"write"`
+	Admin          []string `yaml:"admin"`
+	Heartbeat      []string `yaml:"heartbeat"`
+	TrustedProxies []string `yaml:"trusted_proxies"`
+}
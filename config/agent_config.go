@@ -0,0 +1,51 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"time"
+)
+
+// AgentConfig is the config of configserver agent, which serves read only apis from the last known good data
+// refreshed from upstream configservers
+type AgentConfig struct {
+	Log       *LogConfig       `yaml:"log"`
+	AccessLog *AccessLogConfig `yaml:"access_log"`
+	// Address and Socket are the tcp address and unix socket path the agent listens on, at least one is required
+	Address string `yaml:"address"`
+	Socket  string `yaml:"socket"`
+	// AdvertiseAddress replaces the address of upstream in urls returned to obproxy, like http://127.0.0.1:8080
+	AdvertiseAddress string          `yaml:"advertise_address"`
+	Upstream         *UpstreamConfig `yaml:"upstream"`
+	// DataDir keeps the last known good data across restarts
+	DataDir string `yaml:"data_dir"`
+	// RefreshInterval is the interval to refresh data from upstream, data not refreshed for StaleAfter is stale,
+	// data not requested for IdleTimeout is removed
+	RefreshInterval time.Duration      `yaml:"refresh_interval"`
+	StaleAfter      time.Duration      `yaml:"stale_after"`
+	IdleTimeout     time.Duration      `yaml:"idle_timeout"`
+	Compression     *CompressionConfig `yaml:"compression"`
+}
+
+// UpstreamConfig is the configservers the agent refreshes data from, requests fail over between endpoints
+type UpstreamConfig struct {
+	Endpoints []string      `yaml:"endpoints"`
+	Namespace string        `yaml:"namespace"`
+	Username  string        `yaml:"username"`
+	Password  string        `yaml:"password"`
+	Timeout   time.Duration `yaml:"timeout"`
+}
@@ -0,0 +1,26 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+// CompressionConfig decides how responses are compressed for clients accepting gzip or deflate,
+// responses smaller than MinSize are sent as is, Level is the compression level of compress/flate,
+// nil Level means the default level, as 0 is flate.NoCompression
+type CompressionConfig struct {
+	Disabled bool `yaml:"disabled"`
+	MinSize  int  `yaml:"min_size"`
+	Level    *int `yaml:"level"`
+}
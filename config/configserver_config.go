@@ -25,27 +25,49 @@ import (
 )
 
 type ConfigServerConfig struct {
-	Log     *LogConfig     `yaml:"log"`
-	Server  *ServerConfig  `yaml:"server"`
-	Storage *StorageConfig `yaml:"storage"`
-	Vip     *VipConfig     `yaml:"vip"`
+	Log               *LogConfig               `yaml:"log"`
+	AccessLog         *AccessLogConfig         `yaml:"access_log"`
+	Server            *ServerConfig            `yaml:"server"`
+	Storage           *StorageConfig           `yaml:"storage"`
+	Vip               *VipConfig               `yaml:"vip"`
+	Auth              *AuthConfig              `yaml:"auth"`
+	RecycleBin        *RecycleBinConfig        `yaml:"recycle_bin"`
+	Audit             *AuditConfig             `yaml:"audit"`
+	Webhook           *WebhookConfig           `yaml:"webhook"`
+	RateLimit         *RateLimitConfig         `yaml:"rate_limit"`
+	Acl               *AclConfig               `yaml:"acl"`
+	ObProxyRepository *ObProxyRepositoryConfig `yaml:"obproxy_repository"`
+	MetaDatabase      *MetaDatabaseConfig      `yaml:"meta_database"`
+	ObProxyHeartbeat  *ObProxyHeartbeatConfig  `yaml:"obproxy_heartbeat"`
+	Compression       *CompressionConfig       `yaml:"compression"`
 }
 
 func ParseConfigServerConfig(configFilePath string) (*ConfigServerConfig, error) {
-	_, err := os.Stat(configFilePath)
-	if err != nil {
+	config := new(ConfigServerConfig)
+	if err := parseYamlConfig(configFilePath, config); err != nil {
 		return nil, err
 	}
+	return config, nil
+}
 
-	content, err := ioutil.ReadFile(configFilePath)
-	if err != nil {
+func ParseAgentConfig(configFilePath string) (*AgentConfig, error) {
+	config := new(AgentConfig)
+	if err := parseYamlConfig(configFilePath, config); err != nil {
 		return nil, err
 	}
+	return config, nil
+}
+
+func parseYamlConfig(configFilePath string, config interface{}) error {
+	_, err := os.Stat(configFilePath)
+	if err != nil {
+		return err
+	}
 
-	config := new(ConfigServerConfig)
-	err = yaml.NewDecoder(bytes.NewReader(content)).Decode(config)
+	content, err := ioutil.ReadFile(configFilePath)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return config, nil
+
+	return yaml.NewDecoder(bytes.NewReader(content)).Decode(config)
 }
@@ -18,6 +18,7 @@ package config
 
 type LogConfig struct {
 	Level      string `yaml:"level"`
+	Format     string `yaml:"format"`
 	Filename   string `yaml:"filename"`
 	MaxSize    int    `yaml:"maxsize"`
 	MaxAge     int    `yaml:"maxage"`
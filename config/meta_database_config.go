@@ -0,0 +1,33 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+// MetaDatabaseConfig describes the meta database returned to obproxy, settings of a namespace override the default ones,
+// passwords are encrypted with AES-GCM using the hex encoded key in key file
+type MetaDatabaseConfig struct {
+	KeyFile    string                             `yaml:"key_file"`
+	Default    *MetaDatabaseInfoConfig            `yaml:"default"`
+	Namespaces map[string]*MetaDatabaseInfoConfig `yaml:"namespaces"`
+}
+
+// MetaDatabaseInfoConfig is the meta database in ob cluster, empty fields are inherited from the default settings
+type MetaDatabaseInfoConfig struct {
+	ObCluster         string `yaml:"ob_cluster"`
+	Database          string `yaml:"database"`
+	User              string `yaml:"user"`
+	EncryptedPassword string `yaml:"encrypted_password"`
+}
@@ -0,0 +1,33 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+// ObProxyRepositoryConfig describes the local directory serving obproxy binaries, laid out as dir/arch/version/obproxy.
+// the version for a client is decided by the first matching rule, then the default version, then the latest version
+type ObProxyRepositoryConfig struct {
+	Dir            string                      `yaml:"dir"`
+	DefaultArch    string                      `yaml:"default_arch"`
+	DefaultVersion string                      `yaml:"default_version"`
+	Rules          []*ObProxyVersionRuleConfig `yaml:"rules"`
+}
+
+// ObProxyVersionRuleConfig selects version for clients with ip in clients and requesting in namespaces, empty means all
+type ObProxyVersionRuleConfig struct {
+	Version    string   `yaml:"version"`
+	Clients    []string `yaml:"clients"`
+	Namespaces []string `yaml:"namespaces"`
+}
@@ -0,0 +1,36 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+// RateLimitConfig limits requests of each client ip and action with token buckets,
+// reads, writes and heartbeats have separate rules, and rules of actions take precedence over them,
+// heartbeats share the bucket of reads if Heartbeat is not set.
+// requests exceeding max concurrency are rejected to shed load, clients in allowlist are never rate limited
+type RateLimitConfig struct {
+	Read           *RateLimitRuleConfig            `yaml:"read"`
+	Write          *RateLimitRuleConfig            `yaml:"write"`
+	Heartbeat      *RateLimitRuleConfig            `yaml:"heartbeat"`
+	Actions        map[string]*RateLimitRuleConfig `yaml:"actions"`
+	MaxConcurrency int                             `yaml:"max_concurrency"`
+	Allowlist      []string                        `yaml:"allowlist"`
+}
+
+// RateLimitRuleConfig allows burst requests at once and rate requests per second afterwards
+type RateLimitRuleConfig struct {
+	Rate  float64 `yaml:"rate"`
+	Burst int     `yaml:"burst"`
+}
@@ -17,6 +17,16 @@
 package config
 
 type VipConfig struct {
-	Address string `yaml:"address"`
-	Port    int    `yaml:"port"`
+	Address   string               `yaml:"address"`
+	Port      int                  `yaml:"port"`
+	Endpoints []*VipEndpointConfig `yaml:"endpoints"`
+}
+
+// VipEndpointConfig is an address reachable from a part of the network, it's used in urls generated for
+// requests from Clients (ip or CIDR) or requests to Hosts (host name, with or without port)
+type VipEndpointConfig struct {
+	Address string   `yaml:"address"`
+	Port    int      `yaml:"port"`
+	Clients []string `yaml:"clients"`
+	Hosts   []string `yaml:"hosts"`
 }
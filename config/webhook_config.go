@@ -0,0 +1,43 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"time"
+)
+
+// WebhookConfig describes subscribers notified on cluster changes and how deliveries are retried
+type WebhookConfig struct {
+	Subscribers      []*WebhookSubscriberConfig `yaml:"subscribers"`
+	MaxAttempts      int                        `yaml:"max_attempts"`
+	InitialBackoff   time.Duration              `yaml:"initial_backoff"`
+	MaxBackoff       time.Duration              `yaml:"max_backoff"`
+	Timeout          time.Duration              `yaml:"timeout"`
+	DispatchInterval time.Duration              `yaml:"dispatch_interval"`
+	Retention        time.Duration              `yaml:"retention"`
+}
+
+// WebhookSubscriberConfig is a receiver of events, payloads are signed with secret,
+// empty events, namespaces or clusters means no filter
+type WebhookSubscriberConfig struct {
+	Name       string   `yaml:"name"`
+	Url        string   `yaml:"url"`
+	Secret     string   `yaml:"secret"`
+	Events     []string `yaml:"events"`
+	Namespaces []string `yaml:"namespaces"`
+	Clusters   []string `yaml:"clusters"`
+}
@@ -0,0 +1,260 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/oceanbase/configserver/ent/auditlog"
+)
+
+// AuditLog is the model entity for the AuditLog schema.
+type AuditLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// Namespace holds the value of the "namespace" field.
+	Namespace string `json:"namespace,omitempty"`
+	// Action holds the value of the "action" field.
+	Action string `json:"action,omitempty"`
+	// Operation holds the value of the "operation" field.
+	Operation string `json:"operation,omitempty"`
+	// Entity holds the value of the "entity" field.
+	Entity string `json:"entity,omitempty"`
+	// EntityName holds the value of the "entity_name" field.
+	EntityName string `json:"entity_name,omitempty"`
+	// ObCluster holds the value of the "ob_cluster" field.
+	ObCluster string `json:"ob_cluster,omitempty"`
+	// ObClusterID holds the value of the "ob_cluster_id" field.
+	ObClusterID int64 `json:"ob_cluster_id,omitempty"`
+	// ObproxyGroup holds the value of the "obproxy_group" field.
+	ObproxyGroup string `json:"obproxy_group,omitempty"`
+	// RemoteIP holds the value of the "remote_ip" field.
+	RemoteIP string `json:"remote_ip,omitempty"`
+	// Principal holds the value of the "principal" field.
+	Principal string `json:"principal,omitempty"`
+	// UserAgent holds the value of the "user_agent" field.
+	UserAgent string `json:"user_agent,omitempty"`
+	// TraceID holds the value of the "trace_id" field.
+	TraceID string `json:"trace_id,omitempty"`
+	// BeforeJSON holds the value of the "before_json" field.
+	BeforeJSON string `json:"before_json,omitempty"`
+	// AfterJSON holds the value of the "after_json" field.
+	AfterJSON    string `json:"after_json,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditLog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditlog.FieldID, auditlog.FieldObClusterID:
+			values[i] = new(sql.NullInt64)
+		case auditlog.FieldNamespace, auditlog.FieldAction, auditlog.FieldOperation, auditlog.FieldEntity, auditlog.FieldEntityName, auditlog.FieldObCluster, auditlog.FieldObproxyGroup, auditlog.FieldRemoteIP, auditlog.FieldPrincipal, auditlog.FieldUserAgent, auditlog.FieldTraceID, auditlog.FieldBeforeJSON, auditlog.FieldAfterJSON:
+			values[i] = new(sql.NullString)
+		case auditlog.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditLog fields.
+func (al *AuditLog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditlog.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			al.ID = int(value.Int64)
+		case auditlog.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				al.CreateTime = value.Time
+			}
+		case auditlog.FieldNamespace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace", values[i])
+			} else if value.Valid {
+				al.Namespace = value.String
+			}
+		case auditlog.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				al.Action = value.String
+			}
+		case auditlog.FieldOperation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operation", values[i])
+			} else if value.Valid {
+				al.Operation = value.String
+			}
+		case auditlog.FieldEntity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entity", values[i])
+			} else if value.Valid {
+				al.Entity = value.String
+			}
+		case auditlog.FieldEntityName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_name", values[i])
+			} else if value.Valid {
+				al.EntityName = value.String
+			}
+		case auditlog.FieldObCluster:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ob_cluster", values[i])
+			} else if value.Valid {
+				al.ObCluster = value.String
+			}
+		case auditlog.FieldObClusterID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ob_cluster_id", values[i])
+			} else if value.Valid {
+				al.ObClusterID = value.Int64
+			}
+		case auditlog.FieldObproxyGroup:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field obproxy_group", values[i])
+			} else if value.Valid {
+				al.ObproxyGroup = value.String
+			}
+		case auditlog.FieldRemoteIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remote_ip", values[i])
+			} else if value.Valid {
+				al.RemoteIP = value.String
+			}
+		case auditlog.FieldPrincipal:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field principal", values[i])
+			} else if value.Valid {
+				al.Principal = value.String
+			}
+		case auditlog.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				al.UserAgent = value.String
+			}
+		case auditlog.FieldTraceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trace_id", values[i])
+			} else if value.Valid {
+				al.TraceID = value.String
+			}
+		case auditlog.FieldBeforeJSON:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field before_json", values[i])
+			} else if value.Valid {
+				al.BeforeJSON = value.String
+			}
+		case auditlog.FieldAfterJSON:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field after_json", values[i])
+			} else if value.Valid {
+				al.AfterJSON = value.String
+			}
+		default:
+			al.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AuditLog.
+// This includes values selected through modifiers, order, etc.
+func (al *AuditLog) Value(name string) (ent.Value, error) {
+	return al.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AuditLog.
+// Note that you need to call AuditLog.Unwrap() before calling this method if this AuditLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (al *AuditLog) Update() *AuditLogUpdateOne {
+	return NewAuditLogClient(al.config).UpdateOne(al)
+}
+
+// Unwrap unwraps the AuditLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (al *AuditLog) Unwrap() *AuditLog {
+	_tx, ok := al.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AuditLog is not a transactional entity")
+	}
+	al.config.driver = _tx.drv
+	return al
+}
+
+// String implements the fmt.Stringer.
+func (al *AuditLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", al.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(al.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("namespace=")
+	builder.WriteString(al.Namespace)
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(al.Action)
+	builder.WriteString(", ")
+	builder.WriteString("operation=")
+	builder.WriteString(al.Operation)
+	builder.WriteString(", ")
+	builder.WriteString("entity=")
+	builder.WriteString(al.Entity)
+	builder.WriteString(", ")
+	builder.WriteString("entity_name=")
+	builder.WriteString(al.EntityName)
+	builder.WriteString(", ")
+	builder.WriteString("ob_cluster=")
+	builder.WriteString(al.ObCluster)
+	builder.WriteString(", ")
+	builder.WriteString("ob_cluster_id=")
+	builder.WriteString(fmt.Sprintf("%v", al.ObClusterID))
+	builder.WriteString(", ")
+	builder.WriteString("obproxy_group=")
+	builder.WriteString(al.ObproxyGroup)
+	builder.WriteString(", ")
+	builder.WriteString("remote_ip=")
+	builder.WriteString(al.RemoteIP)
+	builder.WriteString(", ")
+	builder.WriteString("principal=")
+	builder.WriteString(al.Principal)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(al.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("trace_id=")
+	builder.WriteString(al.TraceID)
+	builder.WriteString(", ")
+	builder.WriteString("before_json=")
+	builder.WriteString(al.BeforeJSON)
+	builder.WriteString(", ")
+	builder.WriteString("after_json=")
+	builder.WriteString(al.AfterJSON)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditLogs is a parsable slice of AuditLog.
+type AuditLogs []*AuditLog
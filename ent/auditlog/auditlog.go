@@ -0,0 +1,182 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auditlog
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the auditlog type in the database.
+	Label = "audit_log"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldNamespace holds the string denoting the namespace field in the database.
+	FieldNamespace = "namespace"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldOperation holds the string denoting the operation field in the database.
+	FieldOperation = "operation"
+	// FieldEntity holds the string denoting the entity field in the database.
+	FieldEntity = "entity"
+	// FieldEntityName holds the string denoting the entity_name field in the database.
+	FieldEntityName = "entity_name"
+	// FieldObCluster holds the string denoting the ob_cluster field in the database.
+	FieldObCluster = "ob_cluster"
+	// FieldObClusterID holds the string denoting the ob_cluster_id field in the database.
+	FieldObClusterID = "ob_cluster_id"
+	// FieldObproxyGroup holds the string denoting the obproxy_group field in the database.
+	FieldObproxyGroup = "obproxy_group"
+	// FieldRemoteIP holds the string denoting the remote_ip field in the database.
+	FieldRemoteIP = "remote_ip"
+	// FieldPrincipal holds the string denoting the principal field in the database.
+	FieldPrincipal = "principal"
+	// FieldUserAgent holds the string denoting the user_agent field in the database.
+	FieldUserAgent = "user_agent"
+	// FieldTraceID holds the string denoting the trace_id field in the database.
+	FieldTraceID = "trace_id"
+	// FieldBeforeJSON holds the string denoting the before_json field in the database.
+	FieldBeforeJSON = "before_json"
+	// FieldAfterJSON holds the string denoting the after_json field in the database.
+	FieldAfterJSON = "after_json"
+	// Table holds the table name of the auditlog in the database.
+	Table = "audit_logs"
+)
+
+// Columns holds all SQL columns for auditlog fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldNamespace,
+	FieldAction,
+	FieldOperation,
+	FieldEntity,
+	FieldEntityName,
+	FieldObCluster,
+	FieldObClusterID,
+	FieldObproxyGroup,
+	FieldRemoteIP,
+	FieldPrincipal,
+	FieldUserAgent,
+	FieldTraceID,
+	FieldBeforeJSON,
+	FieldAfterJSON,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultNamespace holds the default value on creation for the "namespace" field.
+	DefaultNamespace string
+	// DefaultEntity holds the default value on creation for the "entity" field.
+	DefaultEntity string
+	// DefaultEntityName holds the default value on creation for the "entity_name" field.
+	DefaultEntityName string
+	// DefaultObproxyGroup holds the default value on creation for the "obproxy_group" field.
+	DefaultObproxyGroup string
+	// DefaultRemoteIP holds the default value on creation for the "remote_ip" field.
+	DefaultRemoteIP string
+	// DefaultPrincipal holds the default value on creation for the "principal" field.
+	DefaultPrincipal string
+	// DefaultUserAgent holds the default value on creation for the "user_agent" field.
+	DefaultUserAgent string
+	// DefaultTraceID holds the default value on creation for the "trace_id" field.
+	DefaultTraceID string
+)
+
+// OrderOption defines the ordering options for the AuditLog queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByNamespace orders the results by the namespace field.
+func ByNamespace(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNamespace, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByOperation orders the results by the operation field.
+func ByOperation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOperation, opts...).ToFunc()
+}
+
+// ByEntity orders the results by the entity field.
+func ByEntity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntity, opts...).ToFunc()
+}
+
+// ByEntityName orders the results by the entity_name field.
+func ByEntityName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntityName, opts...).ToFunc()
+}
+
+// ByObCluster orders the results by the ob_cluster field.
+func ByObCluster(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldObCluster, opts...).ToFunc()
+}
+
+// ByObClusterID orders the results by the ob_cluster_id field.
+func ByObClusterID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldObClusterID, opts...).ToFunc()
+}
+
+// ByObproxyGroup orders the results by the obproxy_group field.
+func ByObproxyGroup(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldObproxyGroup, opts...).ToFunc()
+}
+
+// ByRemoteIP orders the results by the remote_ip field.
+func ByRemoteIP(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemoteIP, opts...).ToFunc()
+}
+
+// ByPrincipal orders the results by the principal field.
+func ByPrincipal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrincipal, opts...).ToFunc()
+}
+
+// ByUserAgent orders the results by the user_agent field.
+func ByUserAgent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserAgent, opts...).ToFunc()
+}
+
+// ByTraceID orders the results by the trace_id field.
+func ByTraceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTraceID, opts...).ToFunc()
+}
+
+// ByBeforeJSON orders the results by the before_json field.
+func ByBeforeJSON(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBeforeJSON, opts...).ToFunc()
+}
+
+// ByAfterJSON orders the results by the after_json field.
+func ByAfterJSON(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAfterJSON, opts...).ToFunc()
+}
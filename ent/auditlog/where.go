@@ -0,0 +1,1090 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auditlog
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/oceanbase/configserver/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// Namespace applies equality check predicate on the "namespace" field. It's identical to NamespaceEQ.
+func Namespace(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldNamespace, v))
+}
+
+// Action applies equality check predicate on the "action" field. It's identical to ActionEQ.
+func Action(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldAction, v))
+}
+
+// Operation applies equality check predicate on the "operation" field. It's identical to OperationEQ.
+func Operation(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldOperation, v))
+}
+
+// Entity applies equality check predicate on the "entity" field. It's identical to EntityEQ.
+func Entity(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldEntity, v))
+}
+
+// EntityName applies equality check predicate on the "entity_name" field. It's identical to EntityNameEQ.
+func EntityName(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldEntityName, v))
+}
+
+// ObCluster applies equality check predicate on the "ob_cluster" field. It's identical to ObClusterEQ.
+func ObCluster(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldObCluster, v))
+}
+
+// ObClusterID applies equality check predicate on the "ob_cluster_id" field. It's identical to ObClusterIDEQ.
+func ObClusterID(v int64) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldObClusterID, v))
+}
+
+// ObproxyGroup applies equality check predicate on the "obproxy_group" field. It's identical to ObproxyGroupEQ.
+func ObproxyGroup(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldObproxyGroup, v))
+}
+
+// RemoteIP applies equality check predicate on the "remote_ip" field. It's identical to RemoteIPEQ.
+func RemoteIP(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldRemoteIP, v))
+}
+
+// Principal applies equality check predicate on the "principal" field. It's identical to PrincipalEQ.
+func Principal(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldPrincipal, v))
+}
+
+// UserAgent applies equality check predicate on the "user_agent" field. It's identical to UserAgentEQ.
+func UserAgent(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// TraceID applies equality check predicate on the "trace_id" field. It's identical to TraceIDEQ.
+func TraceID(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldTraceID, v))
+}
+
+// BeforeJSON applies equality check predicate on the "before_json" field. It's identical to BeforeJSONEQ.
+func BeforeJSON(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldBeforeJSON, v))
+}
+
+// AfterJSON applies equality check predicate on the "after_json" field. It's identical to AfterJSONEQ.
+func AfterJSON(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldAfterJSON, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// NamespaceEQ applies the EQ predicate on the "namespace" field.
+func NamespaceEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldNamespace, v))
+}
+
+// NamespaceNEQ applies the NEQ predicate on the "namespace" field.
+func NamespaceNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldNamespace, v))
+}
+
+// NamespaceIn applies the In predicate on the "namespace" field.
+func NamespaceIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldNamespace, vs...))
+}
+
+// NamespaceNotIn applies the NotIn predicate on the "namespace" field.
+func NamespaceNotIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldNamespace, vs...))
+}
+
+// NamespaceGT applies the GT predicate on the "namespace" field.
+func NamespaceGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldNamespace, v))
+}
+
+// NamespaceGTE applies the GTE predicate on the "namespace" field.
+func NamespaceGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldNamespace, v))
+}
+
+// NamespaceLT applies the LT predicate on the "namespace" field.
+func NamespaceLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldNamespace, v))
+}
+
+// NamespaceLTE applies the LTE predicate on the "namespace" field.
+func NamespaceLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldNamespace, v))
+}
+
+// NamespaceContains applies the Contains predicate on the "namespace" field.
+func NamespaceContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContains(FieldNamespace, v))
+}
+
+// NamespaceHasPrefix applies the HasPrefix predicate on the "namespace" field.
+func NamespaceHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasPrefix(FieldNamespace, v))
+}
+
+// NamespaceHasSuffix applies the HasSuffix predicate on the "namespace" field.
+func NamespaceHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasSuffix(FieldNamespace, v))
+}
+
+// NamespaceEqualFold applies the EqualFold predicate on the "namespace" field.
+func NamespaceEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEqualFold(FieldNamespace, v))
+}
+
+// NamespaceContainsFold applies the ContainsFold predicate on the "namespace" field.
+func NamespaceContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContainsFold(FieldNamespace, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ActionGT applies the GT predicate on the "action" field.
+func ActionGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldAction, v))
+}
+
+// ActionGTE applies the GTE predicate on the "action" field.
+func ActionGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldAction, v))
+}
+
+// ActionLT applies the LT predicate on the "action" field.
+func ActionLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldAction, v))
+}
+
+// ActionLTE applies the LTE predicate on the "action" field.
+func ActionLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldAction, v))
+}
+
+// ActionContains applies the Contains predicate on the "action" field.
+func ActionContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContains(FieldAction, v))
+}
+
+// ActionHasPrefix applies the HasPrefix predicate on the "action" field.
+func ActionHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasPrefix(FieldAction, v))
+}
+
+// ActionHasSuffix applies the HasSuffix predicate on the "action" field.
+func ActionHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasSuffix(FieldAction, v))
+}
+
+// ActionEqualFold applies the EqualFold predicate on the "action" field.
+func ActionEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEqualFold(FieldAction, v))
+}
+
+// ActionContainsFold applies the ContainsFold predicate on the "action" field.
+func ActionContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContainsFold(FieldAction, v))
+}
+
+// OperationEQ applies the EQ predicate on the "operation" field.
+func OperationEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldOperation, v))
+}
+
+// OperationNEQ applies the NEQ predicate on the "operation" field.
+func OperationNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldOperation, v))
+}
+
+// OperationIn applies the In predicate on the "operation" field.
+func OperationIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldOperation, vs...))
+}
+
+// OperationNotIn applies the NotIn predicate on the "operation" field.
+func OperationNotIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldOperation, vs...))
+}
+
+// OperationGT applies the GT predicate on the "operation" field.
+func OperationGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldOperation, v))
+}
+
+// OperationGTE applies the GTE predicate on the "operation" field.
+func OperationGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldOperation, v))
+}
+
+// OperationLT applies the LT predicate on the "operation" field.
+func OperationLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldOperation, v))
+}
+
+// OperationLTE applies the LTE predicate on the "operation" field.
+func OperationLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldOperation, v))
+}
+
+// OperationContains applies the Contains predicate on the "operation" field.
+func OperationContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContains(FieldOperation, v))
+}
+
+// OperationHasPrefix applies the HasPrefix predicate on the "operation" field.
+func OperationHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasPrefix(FieldOperation, v))
+}
+
+// OperationHasSuffix applies the HasSuffix predicate on the "operation" field.
+func OperationHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasSuffix(FieldOperation, v))
+}
+
+// OperationEqualFold applies the EqualFold predicate on the "operation" field.
+func OperationEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEqualFold(FieldOperation, v))
+}
+
+// OperationContainsFold applies the ContainsFold predicate on the "operation" field.
+func OperationContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContainsFold(FieldOperation, v))
+}
+
+// EntityEQ applies the EQ predicate on the "entity" field.
+func EntityEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldEntity, v))
+}
+
+// EntityNEQ applies the NEQ predicate on the "entity" field.
+func EntityNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldEntity, v))
+}
+
+// EntityIn applies the In predicate on the "entity" field.
+func EntityIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldEntity, vs...))
+}
+
+// EntityNotIn applies the NotIn predicate on the "entity" field.
+func EntityNotIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldEntity, vs...))
+}
+
+// EntityGT applies the GT predicate on the "entity" field.
+func EntityGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldEntity, v))
+}
+
+// EntityGTE applies the GTE predicate on the "entity" field.
+func EntityGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldEntity, v))
+}
+
+// EntityLT applies the LT predicate on the "entity" field.
+func EntityLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldEntity, v))
+}
+
+// EntityLTE applies the LTE predicate on the "entity" field.
+func EntityLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldEntity, v))
+}
+
+// EntityContains applies the Contains predicate on the "entity" field.
+func EntityContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContains(FieldEntity, v))
+}
+
+// EntityHasPrefix applies the HasPrefix predicate on the "entity" field.
+func EntityHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasPrefix(FieldEntity, v))
+}
+
+// EntityHasSuffix applies the HasSuffix predicate on the "entity" field.
+func EntityHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasSuffix(FieldEntity, v))
+}
+
+// EntityEqualFold applies the EqualFold predicate on the "entity" field.
+func EntityEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEqualFold(FieldEntity, v))
+}
+
+// EntityContainsFold applies the ContainsFold predicate on the "entity" field.
+func EntityContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContainsFold(FieldEntity, v))
+}
+
+// EntityNameEQ applies the EQ predicate on the "entity_name" field.
+func EntityNameEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldEntityName, v))
+}
+
+// EntityNameNEQ applies the NEQ predicate on the "entity_name" field.
+func EntityNameNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldEntityName, v))
+}
+
+// EntityNameIn applies the In predicate on the "entity_name" field.
+func EntityNameIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldEntityName, vs...))
+}
+
+// EntityNameNotIn applies the NotIn predicate on the "entity_name" field.
+func EntityNameNotIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldEntityName, vs...))
+}
+
+// EntityNameGT applies the GT predicate on the "entity_name" field.
+func EntityNameGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldEntityName, v))
+}
+
+// EntityNameGTE applies the GTE predicate on the "entity_name" field.
+func EntityNameGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldEntityName, v))
+}
+
+// EntityNameLT applies the LT predicate on the "entity_name" field.
+func EntityNameLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldEntityName, v))
+}
+
+// EntityNameLTE applies the LTE predicate on the "entity_name" field.
+func EntityNameLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldEntityName, v))
+}
+
+// EntityNameContains applies the Contains predicate on the "entity_name" field.
+func EntityNameContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContains(FieldEntityName, v))
+}
+
+// EntityNameHasPrefix applies the HasPrefix predicate on the "entity_name" field.
+func EntityNameHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasPrefix(FieldEntityName, v))
+}
+
+// EntityNameHasSuffix applies the HasSuffix predicate on the "entity_name" field.
+func EntityNameHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasSuffix(FieldEntityName, v))
+}
+
+// EntityNameEqualFold applies the EqualFold predicate on the "entity_name" field.
+func EntityNameEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEqualFold(FieldEntityName, v))
+}
+
+// EntityNameContainsFold applies the ContainsFold predicate on the "entity_name" field.
+func EntityNameContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContainsFold(FieldEntityName, v))
+}
+
+// ObClusterEQ applies the EQ predicate on the "ob_cluster" field.
+func ObClusterEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldObCluster, v))
+}
+
+// ObClusterNEQ applies the NEQ predicate on the "ob_cluster" field.
+func ObClusterNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldObCluster, v))
+}
+
+// ObClusterIn applies the In predicate on the "ob_cluster" field.
+func ObClusterIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldObCluster, vs...))
+}
+
+// ObClusterNotIn applies the NotIn predicate on the "ob_cluster" field.
+func ObClusterNotIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldObCluster, vs...))
+}
+
+// ObClusterGT applies the GT predicate on the "ob_cluster" field.
+func ObClusterGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldObCluster, v))
+}
+
+// ObClusterGTE applies the GTE predicate on the "ob_cluster" field.
+func ObClusterGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldObCluster, v))
+}
+
+// ObClusterLT applies the LT predicate on the "ob_cluster" field.
+func ObClusterLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldObCluster, v))
+}
+
+// ObClusterLTE applies the LTE predicate on the "ob_cluster" field.
+func ObClusterLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldObCluster, v))
+}
+
+// ObClusterContains applies the Contains predicate on the "ob_cluster" field.
+func ObClusterContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContains(FieldObCluster, v))
+}
+
+// ObClusterHasPrefix applies the HasPrefix predicate on the "ob_cluster" field.
+func ObClusterHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasPrefix(FieldObCluster, v))
+}
+
+// ObClusterHasSuffix applies the HasSuffix predicate on the "ob_cluster" field.
+func ObClusterHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasSuffix(FieldObCluster, v))
+}
+
+// ObClusterEqualFold applies the EqualFold predicate on the "ob_cluster" field.
+func ObClusterEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEqualFold(FieldObCluster, v))
+}
+
+// ObClusterContainsFold applies the ContainsFold predicate on the "ob_cluster" field.
+func ObClusterContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContainsFold(FieldObCluster, v))
+}
+
+// ObClusterIDEQ applies the EQ predicate on the "ob_cluster_id" field.
+func ObClusterIDEQ(v int64) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldObClusterID, v))
+}
+
+// ObClusterIDNEQ applies the NEQ predicate on the "ob_cluster_id" field.
+func ObClusterIDNEQ(v int64) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldObClusterID, v))
+}
+
+// ObClusterIDIn applies the In predicate on the "ob_cluster_id" field.
+func ObClusterIDIn(vs ...int64) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldObClusterID, vs...))
+}
+
+// ObClusterIDNotIn applies the NotIn predicate on the "ob_cluster_id" field.
+func ObClusterIDNotIn(vs ...int64) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldObClusterID, vs...))
+}
+
+// ObClusterIDGT applies the GT predicate on the "ob_cluster_id" field.
+func ObClusterIDGT(v int64) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldObClusterID, v))
+}
+
+// ObClusterIDGTE applies the GTE predicate on the "ob_cluster_id" field.
+func ObClusterIDGTE(v int64) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldObClusterID, v))
+}
+
+// ObClusterIDLT applies the LT predicate on the "ob_cluster_id" field.
+func ObClusterIDLT(v int64) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldObClusterID, v))
+}
+
+// ObClusterIDLTE applies the LTE predicate on the "ob_cluster_id" field.
+func ObClusterIDLTE(v int64) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldObClusterID, v))
+}
+
+// ObproxyGroupEQ applies the EQ predicate on the "obproxy_group" field.
+func ObproxyGroupEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldObproxyGroup, v))
+}
+
+// ObproxyGroupNEQ applies the NEQ predicate on the "obproxy_group" field.
+func ObproxyGroupNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldObproxyGroup, v))
+}
+
+// ObproxyGroupIn applies the In predicate on the "obproxy_group" field.
+func ObproxyGroupIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldObproxyGroup, vs...))
+}
+
+// ObproxyGroupNotIn applies the NotIn predicate on the "obproxy_group" field.
+func ObproxyGroupNotIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldObproxyGroup, vs...))
+}
+
+// ObproxyGroupGT applies the GT predicate on the "obproxy_group" field.
+func ObproxyGroupGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldObproxyGroup, v))
+}
+
+// ObproxyGroupGTE applies the GTE predicate on the "obproxy_group" field.
+func ObproxyGroupGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldObproxyGroup, v))
+}
+
+// ObproxyGroupLT applies the LT predicate on the "obproxy_group" field.
+func ObproxyGroupLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldObproxyGroup, v))
+}
+
+// ObproxyGroupLTE applies the LTE predicate on the "obproxy_group" field.
+func ObproxyGroupLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldObproxyGroup, v))
+}
+
+// ObproxyGroupContains applies the Contains predicate on the "obproxy_group" field.
+func ObproxyGroupContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContains(FieldObproxyGroup, v))
+}
+
+// ObproxyGroupHasPrefix applies the HasPrefix predicate on the "obproxy_group" field.
+func ObproxyGroupHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasPrefix(FieldObproxyGroup, v))
+}
+
+// ObproxyGroupHasSuffix applies the HasSuffix predicate on the "obproxy_group" field.
+func ObproxyGroupHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasSuffix(FieldObproxyGroup, v))
+}
+
+// ObproxyGroupEqualFold applies the EqualFold predicate on the "obproxy_group" field.
+func ObproxyGroupEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEqualFold(FieldObproxyGroup, v))
+}
+
+// ObproxyGroupContainsFold applies the ContainsFold predicate on the "obproxy_group" field.
+func ObproxyGroupContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContainsFold(FieldObproxyGroup, v))
+}
+
+// RemoteIPEQ applies the EQ predicate on the "remote_ip" field.
+func RemoteIPEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldRemoteIP, v))
+}
+
+// RemoteIPNEQ applies the NEQ predicate on the "remote_ip" field.
+func RemoteIPNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldRemoteIP, v))
+}
+
+// RemoteIPIn applies the In predicate on the "remote_ip" field.
+func RemoteIPIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldRemoteIP, vs...))
+}
+
+// RemoteIPNotIn applies the NotIn predicate on the "remote_ip" field.
+func RemoteIPNotIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldRemoteIP, vs...))
+}
+
+// RemoteIPGT applies the GT predicate on the "remote_ip" field.
+func RemoteIPGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldRemoteIP, v))
+}
+
+// RemoteIPGTE applies the GTE predicate on the "remote_ip" field.
+func RemoteIPGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldRemoteIP, v))
+}
+
+// RemoteIPLT applies the LT predicate on the "remote_ip" field.
+func RemoteIPLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldRemoteIP, v))
+}
+
+// RemoteIPLTE applies the LTE predicate on the "remote_ip" field.
+func RemoteIPLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldRemoteIP, v))
+}
+
+// RemoteIPContains applies the Contains predicate on the "remote_ip" field.
+func RemoteIPContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContains(FieldRemoteIP, v))
+}
+
+// RemoteIPHasPrefix applies the HasPrefix predicate on the "remote_ip" field.
+func RemoteIPHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasPrefix(FieldRemoteIP, v))
+}
+
+// RemoteIPHasSuffix applies the HasSuffix predicate on the "remote_ip" field.
+func RemoteIPHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasSuffix(FieldRemoteIP, v))
+}
+
+// RemoteIPEqualFold applies the EqualFold predicate on the "remote_ip" field.
+func RemoteIPEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEqualFold(FieldRemoteIP, v))
+}
+
+// RemoteIPContainsFold applies the ContainsFold predicate on the "remote_ip" field.
+func RemoteIPContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContainsFold(FieldRemoteIP, v))
+}
+
+// PrincipalEQ applies the EQ predicate on the "principal" field.
+func PrincipalEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldPrincipal, v))
+}
+
+// PrincipalNEQ applies the NEQ predicate on the "principal" field.
+func PrincipalNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldPrincipal, v))
+}
+
+// PrincipalIn applies the In predicate on the "principal" field.
+func PrincipalIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldPrincipal, vs...))
+}
+
+// PrincipalNotIn applies the NotIn predicate on the "principal" field.
+func PrincipalNotIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldPrincipal, vs...))
+}
+
+// PrincipalGT applies the GT predicate on the "principal" field.
+func PrincipalGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldPrincipal, v))
+}
+
+// PrincipalGTE applies the GTE predicate on the "principal" field.
+func PrincipalGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldPrincipal, v))
+}
+
+// PrincipalLT applies the LT predicate on the "principal" field.
+func PrincipalLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldPrincipal, v))
+}
+
+// PrincipalLTE applies the LTE predicate on the "principal" field.
+func PrincipalLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldPrincipal, v))
+}
+
+// PrincipalContains applies the Contains predicate on the "principal" field.
+func PrincipalContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContains(FieldPrincipal, v))
+}
+
+// PrincipalHasPrefix applies the HasPrefix predicate on the "principal" field.
+func PrincipalHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasPrefix(FieldPrincipal, v))
+}
+
+// PrincipalHasSuffix applies the HasSuffix predicate on the "principal" field.
+func PrincipalHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasSuffix(FieldPrincipal, v))
+}
+
+// PrincipalEqualFold applies the EqualFold predicate on the "principal" field.
+func PrincipalEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEqualFold(FieldPrincipal, v))
+}
+
+// PrincipalContainsFold applies the ContainsFold predicate on the "principal" field.
+func PrincipalContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContainsFold(FieldPrincipal, v))
+}
+
+// UserAgentEQ applies the EQ predicate on the "user_agent" field.
+func UserAgentEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// UserAgentNEQ applies the NEQ predicate on the "user_agent" field.
+func UserAgentNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldUserAgent, v))
+}
+
+// UserAgentIn applies the In predicate on the "user_agent" field.
+func UserAgentIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldUserAgent, vs...))
+}
+
+// UserAgentNotIn applies the NotIn predicate on the "user_agent" field.
+func UserAgentNotIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldUserAgent, vs...))
+}
+
+// UserAgentGT applies the GT predicate on the "user_agent" field.
+func UserAgentGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldUserAgent, v))
+}
+
+// UserAgentGTE applies the GTE predicate on the "user_agent" field.
+func UserAgentGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldUserAgent, v))
+}
+
+// UserAgentLT applies the LT predicate on the "user_agent" field.
+func UserAgentLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldUserAgent, v))
+}
+
+// UserAgentLTE applies the LTE predicate on the "user_agent" field.
+func UserAgentLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldUserAgent, v))
+}
+
+// UserAgentContains applies the Contains predicate on the "user_agent" field.
+func UserAgentContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContains(FieldUserAgent, v))
+}
+
+// UserAgentHasPrefix applies the HasPrefix predicate on the "user_agent" field.
+func UserAgentHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasPrefix(FieldUserAgent, v))
+}
+
+// UserAgentHasSuffix applies the HasSuffix predicate on the "user_agent" field.
+func UserAgentHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasSuffix(FieldUserAgent, v))
+}
+
+// UserAgentEqualFold applies the EqualFold predicate on the "user_agent" field.
+func UserAgentEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEqualFold(FieldUserAgent, v))
+}
+
+// UserAgentContainsFold applies the ContainsFold predicate on the "user_agent" field.
+func UserAgentContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContainsFold(FieldUserAgent, v))
+}
+
+// TraceIDEQ applies the EQ predicate on the "trace_id" field.
+func TraceIDEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldTraceID, v))
+}
+
+// TraceIDNEQ applies the NEQ predicate on the "trace_id" field.
+func TraceIDNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldTraceID, v))
+}
+
+// TraceIDIn applies the In predicate on the "trace_id" field.
+func TraceIDIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldTraceID, vs...))
+}
+
+// TraceIDNotIn applies the NotIn predicate on the "trace_id" field.
+func TraceIDNotIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldTraceID, vs...))
+}
+
+// TraceIDGT applies the GT predicate on the "trace_id" field.
+func TraceIDGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldTraceID, v))
+}
+
+// TraceIDGTE applies the GTE predicate on the "trace_id" field.
+func TraceIDGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldTraceID, v))
+}
+
+// TraceIDLT applies the LT predicate on the "trace_id" field.
+func TraceIDLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldTraceID, v))
+}
+
+// TraceIDLTE applies the LTE predicate on the "trace_id" field.
+func TraceIDLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldTraceID, v))
+}
+
+// TraceIDContains applies the Contains predicate on the "trace_id" field.
+func TraceIDContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContains(FieldTraceID, v))
+}
+
+// TraceIDHasPrefix applies the HasPrefix predicate on the "trace_id" field.
+func TraceIDHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasPrefix(FieldTraceID, v))
+}
+
+// TraceIDHasSuffix applies the HasSuffix predicate on the "trace_id" field.
+func TraceIDHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasSuffix(FieldTraceID, v))
+}
+
+// TraceIDEqualFold applies the EqualFold predicate on the "trace_id" field.
+func TraceIDEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEqualFold(FieldTraceID, v))
+}
+
+// TraceIDContainsFold applies the ContainsFold predicate on the "trace_id" field.
+func TraceIDContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContainsFold(FieldTraceID, v))
+}
+
+// BeforeJSONEQ applies the EQ predicate on the "before_json" field.
+func BeforeJSONEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldBeforeJSON, v))
+}
+
+// BeforeJSONNEQ applies the NEQ predicate on the "before_json" field.
+func BeforeJSONNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldBeforeJSON, v))
+}
+
+// BeforeJSONIn applies the In predicate on the "before_json" field.
+func BeforeJSONIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldBeforeJSON, vs...))
+}
+
+// BeforeJSONNotIn applies the NotIn predicate on the "before_json" field.
+func BeforeJSONNotIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldBeforeJSON, vs...))
+}
+
+// BeforeJSONGT applies the GT predicate on the "before_json" field.
+func BeforeJSONGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldBeforeJSON, v))
+}
+
+// BeforeJSONGTE applies the GTE predicate on the "before_json" field.
+func BeforeJSONGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldBeforeJSON, v))
+}
+
+// BeforeJSONLT applies the LT predicate on the "before_json" field.
+func BeforeJSONLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldBeforeJSON, v))
+}
+
+// BeforeJSONLTE applies the LTE predicate on the "before_json" field.
+func BeforeJSONLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldBeforeJSON, v))
+}
+
+// BeforeJSONContains applies the Contains predicate on the "before_json" field.
+func BeforeJSONContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContains(FieldBeforeJSON, v))
+}
+
+// BeforeJSONHasPrefix applies the HasPrefix predicate on the "before_json" field.
+func BeforeJSONHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasPrefix(FieldBeforeJSON, v))
+}
+
+// BeforeJSONHasSuffix applies the HasSuffix predicate on the "before_json" field.
+func BeforeJSONHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasSuffix(FieldBeforeJSON, v))
+}
+
+// BeforeJSONIsNil applies the IsNil predicate on the "before_json" field.
+func BeforeJSONIsNil() predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIsNull(FieldBeforeJSON))
+}
+
+// BeforeJSONNotNil applies the NotNil predicate on the "before_json" field.
+func BeforeJSONNotNil() predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotNull(FieldBeforeJSON))
+}
+
+// BeforeJSONEqualFold applies the EqualFold predicate on the "before_json" field.
+func BeforeJSONEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEqualFold(FieldBeforeJSON, v))
+}
+
+// BeforeJSONContainsFold applies the ContainsFold predicate on the "before_json" field.
+func BeforeJSONContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContainsFold(FieldBeforeJSON, v))
+}
+
+// AfterJSONEQ applies the EQ predicate on the "after_json" field.
+func AfterJSONEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldAfterJSON, v))
+}
+
+// AfterJSONNEQ applies the NEQ predicate on the "after_json" field.
+func AfterJSONNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldAfterJSON, v))
+}
+
+// AfterJSONIn applies the In predicate on the "after_json" field.
+func AfterJSONIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldAfterJSON, vs...))
+}
+
+// AfterJSONNotIn applies the NotIn predicate on the "after_json" field.
+func AfterJSONNotIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldAfterJSON, vs...))
+}
+
+// AfterJSONGT applies the GT predicate on the "after_json" field.
+func AfterJSONGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldAfterJSON, v))
+}
+
+// AfterJSONGTE applies the GTE predicate on the "after_json" field.
+func AfterJSONGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldAfterJSON, v))
+}
+
+// AfterJSONLT applies the LT predicate on the "after_json" field.
+func AfterJSONLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldAfterJSON, v))
+}
+
+// AfterJSONLTE applies the LTE predicate on the "after_json" field.
+func AfterJSONLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldAfterJSON, v))
+}
+
+// AfterJSONContains applies the Contains predicate on the "after_json" field.
+func AfterJSONContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContains(FieldAfterJSON, v))
+}
+
+// AfterJSONHasPrefix applies the HasPrefix predicate on the "after_json" field.
+func AfterJSONHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasPrefix(FieldAfterJSON, v))
+}
+
+// AfterJSONHasSuffix applies the HasSuffix predicate on the "after_json" field.
+func AfterJSONHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasSuffix(FieldAfterJSON, v))
+}
+
+// AfterJSONIsNil applies the IsNil predicate on the "after_json" field.
+func AfterJSONIsNil() predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIsNull(FieldAfterJSON))
+}
+
+// AfterJSONNotNil applies the NotNil predicate on the "after_json" field.
+func AfterJSONNotNil() predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotNull(FieldAfterJSON))
+}
+
+// AfterJSONEqualFold applies the EqualFold predicate on the "after_json" field.
+func AfterJSONEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEqualFold(FieldAfterJSON, v))
+}
+
+// AfterJSONContainsFold applies the ContainsFold predicate on the "after_json" field.
+func AfterJSONContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContainsFold(FieldAfterJSON, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AuditLog) predicate.AuditLog {
+	return predicate.AuditLog(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AuditLog) predicate.AuditLog {
+	return predicate.AuditLog(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AuditLog) predicate.AuditLog {
+	return predicate.AuditLog(sql.NotPredicates(p))
+}
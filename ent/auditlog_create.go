@@ -0,0 +1,1381 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/oceanbase/configserver/ent/auditlog"
+)
+
+// AuditLogCreate is the builder for creating a AuditLog entity.
+type AuditLogCreate struct {
+	config
+	mutation *AuditLogMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (alc *AuditLogCreate) SetCreateTime(t time.Time) *AuditLogCreate {
+	alc.mutation.SetCreateTime(t)
+	return alc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableCreateTime(t *time.Time) *AuditLogCreate {
+	if t != nil {
+		alc.SetCreateTime(*t)
+	}
+	return alc
+}
+
+// SetNamespace sets the "namespace" field.
+func (alc *AuditLogCreate) SetNamespace(s string) *AuditLogCreate {
+	alc.mutation.SetNamespace(s)
+	return alc
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableNamespace(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetNamespace(*s)
+	}
+	return alc
+}
+
+// SetAction sets the "action" field.
+func (alc *AuditLogCreate) SetAction(s string) *AuditLogCreate {
+	alc.mutation.SetAction(s)
+	return alc
+}
+
+// SetOperation sets the "operation" field.
+func (alc *AuditLogCreate) SetOperation(s string) *AuditLogCreate {
+	alc.mutation.SetOperation(s)
+	return alc
+}
+
+// SetEntity sets the "entity" field.
+func (alc *AuditLogCreate) SetEntity(s string) *AuditLogCreate {
+	alc.mutation.SetEntity(s)
+	return alc
+}
+
+// SetNillableEntity sets the "entity" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableEntity(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetEntity(*s)
+	}
+	return alc
+}
+
+// SetEntityName sets the "entity_name" field.
+func (alc *AuditLogCreate) SetEntityName(s string) *AuditLogCreate {
+	alc.mutation.SetEntityName(s)
+	return alc
+}
+
+// SetNillableEntityName sets the "entity_name" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableEntityName(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetEntityName(*s)
+	}
+	return alc
+}
+
+// SetObCluster sets the "ob_cluster" field.
+func (alc *AuditLogCreate) SetObCluster(s string) *AuditLogCreate {
+	alc.mutation.SetObCluster(s)
+	return alc
+}
+
+// SetObClusterID sets the "ob_cluster_id" field.
+func (alc *AuditLogCreate) SetObClusterID(i int64) *AuditLogCreate {
+	alc.mutation.SetObClusterID(i)
+	return alc
+}
+
+// SetObproxyGroup sets the "obproxy_group" field.
+func (alc *AuditLogCreate) SetObproxyGroup(s string) *AuditLogCreate {
+	alc.mutation.SetObproxyGroup(s)
+	return alc
+}
+
+// SetNillableObproxyGroup sets the "obproxy_group" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableObproxyGroup(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetObproxyGroup(*s)
+	}
+	return alc
+}
+
+// SetRemoteIP sets the "remote_ip" field.
+func (alc *AuditLogCreate) SetRemoteIP(s string) *AuditLogCreate {
+	alc.mutation.SetRemoteIP(s)
+	return alc
+}
+
+// SetNillableRemoteIP sets the "remote_ip" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableRemoteIP(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetRemoteIP(*s)
+	}
+	return alc
+}
+
+// SetPrincipal sets the "principal" field.
+func (alc *AuditLogCreate) SetPrincipal(s string) *AuditLogCreate {
+	alc.mutation.SetPrincipal(s)
+	return alc
+}
+
+// SetNillablePrincipal sets the "principal" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillablePrincipal(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetPrincipal(*s)
+	}
+	return alc
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (alc *AuditLogCreate) SetUserAgent(s string) *AuditLogCreate {
+	alc.mutation.SetUserAgent(s)
+	return alc
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableUserAgent(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetUserAgent(*s)
+	}
+	return alc
+}
+
+// SetTraceID sets the "trace_id" field.
+func (alc *AuditLogCreate) SetTraceID(s string) *AuditLogCreate {
+	alc.mutation.SetTraceID(s)
+	return alc
+}
+
+// SetNillableTraceID sets the "trace_id" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableTraceID(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetTraceID(*s)
+	}
+	return alc
+}
+
+// SetBeforeJSON sets the "before_json" field.
+func (alc *AuditLogCreate) SetBeforeJSON(s string) *AuditLogCreate {
+	alc.mutation.SetBeforeJSON(s)
+	return alc
+}
+
+// SetNillableBeforeJSON sets the "before_json" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableBeforeJSON(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetBeforeJSON(*s)
+	}
+	return alc
+}
+
+// SetAfterJSON sets the "after_json" field.
+func (alc *AuditLogCreate) SetAfterJSON(s string) *AuditLogCreate {
+	alc.mutation.SetAfterJSON(s)
+	return alc
+}
+
+// SetNillableAfterJSON sets the "after_json" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableAfterJSON(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetAfterJSON(*s)
+	}
+	return alc
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (alc *AuditLogCreate) Mutation() *AuditLogMutation {
+	return alc.mutation
+}
+
+// Save creates the AuditLog in the database.
+func (alc *AuditLogCreate) Save(ctx context.Context) (*AuditLog, error) {
+	alc.defaults()
+	return withHooks(ctx, alc.sqlSave, alc.mutation, alc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (alc *AuditLogCreate) SaveX(ctx context.Context) *AuditLog {
+	v, err := alc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alc *AuditLogCreate) Exec(ctx context.Context) error {
+	_, err := alc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alc *AuditLogCreate) ExecX(ctx context.Context) {
+	if err := alc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (alc *AuditLogCreate) defaults() {
+	if _, ok := alc.mutation.CreateTime(); !ok {
+		v := auditlog.DefaultCreateTime()
+		alc.mutation.SetCreateTime(v)
+	}
+	if _, ok := alc.mutation.Namespace(); !ok {
+		v := auditlog.DefaultNamespace
+		alc.mutation.SetNamespace(v)
+	}
+	if _, ok := alc.mutation.Entity(); !ok {
+		v := auditlog.DefaultEntity
+		alc.mutation.SetEntity(v)
+	}
+	if _, ok := alc.mutation.EntityName(); !ok {
+		v := auditlog.DefaultEntityName
+		alc.mutation.SetEntityName(v)
+	}
+	if _, ok := alc.mutation.ObproxyGroup(); !ok {
+		v := auditlog.DefaultObproxyGroup
+		alc.mutation.SetObproxyGroup(v)
+	}
+	if _, ok := alc.mutation.RemoteIP(); !ok {
+		v := auditlog.DefaultRemoteIP
+		alc.mutation.SetRemoteIP(v)
+	}
+	if _, ok := alc.mutation.Principal(); !ok {
+		v := auditlog.DefaultPrincipal
+		alc.mutation.SetPrincipal(v)
+	}
+	if _, ok := alc.mutation.UserAgent(); !ok {
+		v := auditlog.DefaultUserAgent
+		alc.mutation.SetUserAgent(v)
+	}
+	if _, ok := alc.mutation.TraceID(); !ok {
+		v := auditlog.DefaultTraceID
+		alc.mutation.SetTraceID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (alc *AuditLogCreate) check() error {
+	if _, ok := alc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "AuditLog.create_time"`)}
+	}
+	if _, ok := alc.mutation.Namespace(); !ok {
+		return &ValidationError{Name: "namespace", err: errors.New(`ent: missing required field "AuditLog.namespace"`)}
+	}
+	if _, ok := alc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "AuditLog.action"`)}
+	}
+	if _, ok := alc.mutation.Operation(); !ok {
+		return &ValidationError{Name: "operation", err: errors.New(`ent: missing required field "AuditLog.operation"`)}
+	}
+	if _, ok := alc.mutation.Entity(); !ok {
+		return &ValidationError{Name: "entity", err: errors.New(`ent: missing required field "AuditLog.entity"`)}
+	}
+	if _, ok := alc.mutation.EntityName(); !ok {
+		return &ValidationError{Name: "entity_name", err: errors.New(`ent: missing required field "AuditLog.entity_name"`)}
+	}
+	if _, ok := alc.mutation.ObCluster(); !ok {
+		return &ValidationError{Name: "ob_cluster", err: errors.New(`ent: missing required field "AuditLog.ob_cluster"`)}
+	}
+	if _, ok := alc.mutation.ObClusterID(); !ok {
+		return &ValidationError{Name: "ob_cluster_id", err: errors.New(`ent: missing required field "AuditLog.ob_cluster_id"`)}
+	}
+	if _, ok := alc.mutation.ObproxyGroup(); !ok {
+		return &ValidationError{Name: "obproxy_group", err: errors.New(`ent: missing required field "AuditLog.obproxy_group"`)}
+	}
+	if _, ok := alc.mutation.RemoteIP(); !ok {
+		return &ValidationError{Name: "remote_ip", err: errors.New(`ent: missing required field "AuditLog.remote_ip"`)}
+	}
+	if _, ok := alc.mutation.Principal(); !ok {
+		return &ValidationError{Name: "principal", err: errors.New(`ent: missing required field "AuditLog.principal"`)}
+	}
+	if _, ok := alc.mutation.UserAgent(); !ok {
+		return &ValidationError{Name: "user_agent", err: errors.New(`ent: missing required field "AuditLog.user_agent"`)}
+	}
+	if _, ok := alc.mutation.TraceID(); !ok {
+		return &ValidationError{Name: "trace_id", err: errors.New(`ent: missing required field "AuditLog.trace_id"`)}
+	}
+	return nil
+}
+
+func (alc *AuditLogCreate) sqlSave(ctx context.Context) (*AuditLog, error) {
+	if err := alc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := alc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, alc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	alc.mutation.id = &_node.ID
+	alc.mutation.done = true
+	return _node, nil
+}
+
+func (alc *AuditLogCreate) createSpec() (*AuditLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditLog{config: alc.config}
+		_spec = sqlgraph.NewCreateSpec(auditlog.Table, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = alc.conflict
+	if value, ok := alc.mutation.CreateTime(); ok {
+		_spec.SetField(auditlog.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := alc.mutation.Namespace(); ok {
+		_spec.SetField(auditlog.FieldNamespace, field.TypeString, value)
+		_node.Namespace = value
+	}
+	if value, ok := alc.mutation.Action(); ok {
+		_spec.SetField(auditlog.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := alc.mutation.Operation(); ok {
+		_spec.SetField(auditlog.FieldOperation, field.TypeString, value)
+		_node.Operation = value
+	}
+	if value, ok := alc.mutation.Entity(); ok {
+		_spec.SetField(auditlog.FieldEntity, field.TypeString, value)
+		_node.Entity = value
+	}
+	if value, ok := alc.mutation.EntityName(); ok {
+		_spec.SetField(auditlog.FieldEntityName, field.TypeString, value)
+		_node.EntityName = value
+	}
+	if value, ok := alc.mutation.ObCluster(); ok {
+		_spec.SetField(auditlog.FieldObCluster, field.TypeString, value)
+		_node.ObCluster = value
+	}
+	if value, ok := alc.mutation.ObClusterID(); ok {
+		_spec.SetField(auditlog.FieldObClusterID, field.TypeInt64, value)
+		_node.ObClusterID = value
+	}
+	if value, ok := alc.mutation.ObproxyGroup(); ok {
+		_spec.SetField(auditlog.FieldObproxyGroup, field.TypeString, value)
+		_node.ObproxyGroup = value
+	}
+	if value, ok := alc.mutation.RemoteIP(); ok {
+		_spec.SetField(auditlog.FieldRemoteIP, field.TypeString, value)
+		_node.RemoteIP = value
+	}
+	if value, ok := alc.mutation.Principal(); ok {
+		_spec.SetField(auditlog.FieldPrincipal, field.TypeString, value)
+		_node.Principal = value
+	}
+	if value, ok := alc.mutation.UserAgent(); ok {
+		_spec.SetField(auditlog.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := alc.mutation.TraceID(); ok {
+		_spec.SetField(auditlog.FieldTraceID, field.TypeString, value)
+		_node.TraceID = value
+	}
+	if value, ok := alc.mutation.BeforeJSON(); ok {
+		_spec.SetField(auditlog.FieldBeforeJSON, field.TypeString, value)
+		_node.BeforeJSON = value
+	}
+	if value, ok := alc.mutation.AfterJSON(); ok {
+		_spec.SetField(auditlog.FieldAfterJSON, field.TypeString, value)
+		_node.AfterJSON = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditLog.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditLogUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (alc *AuditLogCreate) OnConflict(opts ...sql.ConflictOption) *AuditLogUpsertOne {
+	alc.conflict = opts
+	return &AuditLogUpsertOne{
+		create: alc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (alc *AuditLogCreate) OnConflictColumns(columns ...string) *AuditLogUpsertOne {
+	alc.conflict = append(alc.conflict, sql.ConflictColumns(columns...))
+	return &AuditLogUpsertOne{
+		create: alc,
+	}
+}
+
+type (
+	// AuditLogUpsertOne is the builder for "upsert"-ing
+	//  one AuditLog node.
+	AuditLogUpsertOne struct {
+		create *AuditLogCreate
+	}
+
+	// AuditLogUpsert is the "OnConflict" setter.
+	AuditLogUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateTime sets the "create_time" field.
+func (u *AuditLogUpsert) SetCreateTime(v time.Time) *AuditLogUpsert {
+	u.Set(auditlog.FieldCreateTime, v)
+	return u
+}
+
+// UpdateCreateTime sets the "create_time" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateCreateTime() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldCreateTime)
+	return u
+}
+
+// SetNamespace sets the "namespace" field.
+func (u *AuditLogUpsert) SetNamespace(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldNamespace, v)
+	return u
+}
+
+// UpdateNamespace sets the "namespace" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateNamespace() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldNamespace)
+	return u
+}
+
+// SetAction sets the "action" field.
+func (u *AuditLogUpsert) SetAction(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldAction, v)
+	return u
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateAction() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldAction)
+	return u
+}
+
+// SetOperation sets the "operation" field.
+func (u *AuditLogUpsert) SetOperation(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldOperation, v)
+	return u
+}
+
+// UpdateOperation sets the "operation" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateOperation() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldOperation)
+	return u
+}
+
+// SetEntity sets the "entity" field.
+func (u *AuditLogUpsert) SetEntity(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldEntity, v)
+	return u
+}
+
+// UpdateEntity sets the "entity" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateEntity() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldEntity)
+	return u
+}
+
+// SetEntityName sets the "entity_name" field.
+func (u *AuditLogUpsert) SetEntityName(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldEntityName, v)
+	return u
+}
+
+// UpdateEntityName sets the "entity_name" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateEntityName() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldEntityName)
+	return u
+}
+
+// SetObCluster sets the "ob_cluster" field.
+func (u *AuditLogUpsert) SetObCluster(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldObCluster, v)
+	return u
+}
+
+// UpdateObCluster sets the "ob_cluster" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateObCluster() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldObCluster)
+	return u
+}
+
+// SetObClusterID sets the "ob_cluster_id" field.
+func (u *AuditLogUpsert) SetObClusterID(v int64) *AuditLogUpsert {
+	u.Set(auditlog.FieldObClusterID, v)
+	return u
+}
+
+// UpdateObClusterID sets the "ob_cluster_id" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateObClusterID() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldObClusterID)
+	return u
+}
+
+// AddObClusterID adds v to the "ob_cluster_id" field.
+func (u *AuditLogUpsert) AddObClusterID(v int64) *AuditLogUpsert {
+	u.Add(auditlog.FieldObClusterID, v)
+	return u
+}
+
+// SetObproxyGroup sets the "obproxy_group" field.
+func (u *AuditLogUpsert) SetObproxyGroup(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldObproxyGroup, v)
+	return u
+}
+
+// UpdateObproxyGroup sets the "obproxy_group" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateObproxyGroup() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldObproxyGroup)
+	return u
+}
+
+// SetRemoteIP sets the "remote_ip" field.
+func (u *AuditLogUpsert) SetRemoteIP(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldRemoteIP, v)
+	return u
+}
+
+// UpdateRemoteIP sets the "remote_ip" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateRemoteIP() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldRemoteIP)
+	return u
+}
+
+// SetPrincipal sets the "principal" field.
+func (u *AuditLogUpsert) SetPrincipal(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldPrincipal, v)
+	return u
+}
+
+// UpdatePrincipal sets the "principal" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdatePrincipal() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldPrincipal)
+	return u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *AuditLogUpsert) SetUserAgent(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldUserAgent, v)
+	return u
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateUserAgent() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldUserAgent)
+	return u
+}
+
+// SetTraceID sets the "trace_id" field.
+func (u *AuditLogUpsert) SetTraceID(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldTraceID, v)
+	return u
+}
+
+// UpdateTraceID sets the "trace_id" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateTraceID() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldTraceID)
+	return u
+}
+
+// SetBeforeJSON sets the "before_json" field.
+func (u *AuditLogUpsert) SetBeforeJSON(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldBeforeJSON, v)
+	return u
+}
+
+// UpdateBeforeJSON sets the "before_json" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateBeforeJSON() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldBeforeJSON)
+	return u
+}
+
+// ClearBeforeJSON clears the value of the "before_json" field.
+func (u *AuditLogUpsert) ClearBeforeJSON() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldBeforeJSON)
+	return u
+}
+
+// SetAfterJSON sets the "after_json" field.
+func (u *AuditLogUpsert) SetAfterJSON(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldAfterJSON, v)
+	return u
+}
+
+// UpdateAfterJSON sets the "after_json" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateAfterJSON() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldAfterJSON)
+	return u
+}
+
+// ClearAfterJSON clears the value of the "after_json" field.
+func (u *AuditLogUpsert) ClearAfterJSON() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldAfterJSON)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AuditLogUpsertOne) UpdateNewValues() *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AuditLogUpsertOne) Ignore() *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditLogUpsertOne) DoNothing() *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditLogCreate.OnConflict
+// documentation for more info.
+func (u *AuditLogUpsertOne) Update(set func(*AuditLogUpsert)) *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateTime sets the "create_time" field.
+func (u *AuditLogUpsertOne) SetCreateTime(v time.Time) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetCreateTime(v)
+	})
+}
+
+// UpdateCreateTime sets the "create_time" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateCreateTime() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateCreateTime()
+	})
+}
+
+// SetNamespace sets the "namespace" field.
+func (u *AuditLogUpsertOne) SetNamespace(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetNamespace(v)
+	})
+}
+
+// UpdateNamespace sets the "namespace" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateNamespace() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateNamespace()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *AuditLogUpsertOne) SetAction(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateAction() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetOperation sets the "operation" field.
+func (u *AuditLogUpsertOne) SetOperation(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetOperation(v)
+	})
+}
+
+// UpdateOperation sets the "operation" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateOperation() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateOperation()
+	})
+}
+
+// SetEntity sets the "entity" field.
+func (u *AuditLogUpsertOne) SetEntity(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetEntity(v)
+	})
+}
+
+// UpdateEntity sets the "entity" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateEntity() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateEntity()
+	})
+}
+
+// SetEntityName sets the "entity_name" field.
+func (u *AuditLogUpsertOne) SetEntityName(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetEntityName(v)
+	})
+}
+
+// UpdateEntityName sets the "entity_name" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateEntityName() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateEntityName()
+	})
+}
+
+// SetObCluster sets the "ob_cluster" field.
+func (u *AuditLogUpsertOne) SetObCluster(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetObCluster(v)
+	})
+}
+
+// UpdateObCluster sets the "ob_cluster" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateObCluster() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateObCluster()
+	})
+}
+
+// SetObClusterID sets the "ob_cluster_id" field.
+func (u *AuditLogUpsertOne) SetObClusterID(v int64) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetObClusterID(v)
+	})
+}
+
+// AddObClusterID adds v to the "ob_cluster_id" field.
+func (u *AuditLogUpsertOne) AddObClusterID(v int64) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.AddObClusterID(v)
+	})
+}
+
+// UpdateObClusterID sets the "ob_cluster_id" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateObClusterID() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateObClusterID()
+	})
+}
+
+// SetObproxyGroup sets the "obproxy_group" field.
+func (u *AuditLogUpsertOne) SetObproxyGroup(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetObproxyGroup(v)
+	})
+}
+
+// UpdateObproxyGroup sets the "obproxy_group" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateObproxyGroup() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateObproxyGroup()
+	})
+}
+
+// SetRemoteIP sets the "remote_ip" field.
+func (u *AuditLogUpsertOne) SetRemoteIP(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetRemoteIP(v)
+	})
+}
+
+// UpdateRemoteIP sets the "remote_ip" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateRemoteIP() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateRemoteIP()
+	})
+}
+
+// SetPrincipal sets the "principal" field.
+func (u *AuditLogUpsertOne) SetPrincipal(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetPrincipal(v)
+	})
+}
+
+// UpdatePrincipal sets the "principal" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdatePrincipal() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdatePrincipal()
+	})
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *AuditLogUpsertOne) SetUserAgent(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetUserAgent(v)
+	})
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateUserAgent() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateUserAgent()
+	})
+}
+
+// SetTraceID sets the "trace_id" field.
+func (u *AuditLogUpsertOne) SetTraceID(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetTraceID(v)
+	})
+}
+
+// UpdateTraceID sets the "trace_id" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateTraceID() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateTraceID()
+	})
+}
+
+// SetBeforeJSON sets the "before_json" field.
+func (u *AuditLogUpsertOne) SetBeforeJSON(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetBeforeJSON(v)
+	})
+}
+
+// UpdateBeforeJSON sets the "before_json" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateBeforeJSON() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateBeforeJSON()
+	})
+}
+
+// ClearBeforeJSON clears the value of the "before_json" field.
+func (u *AuditLogUpsertOne) ClearBeforeJSON() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearBeforeJSON()
+	})
+}
+
+// SetAfterJSON sets the "after_json" field.
+func (u *AuditLogUpsertOne) SetAfterJSON(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetAfterJSON(v)
+	})
+}
+
+// UpdateAfterJSON sets the "after_json" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateAfterJSON() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateAfterJSON()
+	})
+}
+
+// ClearAfterJSON clears the value of the "after_json" field.
+func (u *AuditLogUpsertOne) ClearAfterJSON() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearAfterJSON()
+	})
+}
+
+// Exec executes the query.
+func (u *AuditLogUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuditLogCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditLogUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AuditLogUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AuditLogUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AuditLogCreateBulk is the builder for creating many AuditLog entities in bulk.
+type AuditLogCreateBulk struct {
+	config
+	err      error
+	builders []*AuditLogCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AuditLog entities in the database.
+func (alcb *AuditLogCreateBulk) Save(ctx context.Context) ([]*AuditLog, error) {
+	if alcb.err != nil {
+		return nil, alcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(alcb.builders))
+	nodes := make([]*AuditLog, len(alcb.builders))
+	mutators := make([]Mutator, len(alcb.builders))
+	for i := range alcb.builders {
+		func(i int, root context.Context) {
+			builder := alcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, alcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = alcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, alcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, alcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alcb *AuditLogCreateBulk) SaveX(ctx context.Context) []*AuditLog {
+	v, err := alcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alcb *AuditLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := alcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alcb *AuditLogCreateBulk) ExecX(ctx context.Context) {
+	if err := alcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditLog.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditLogUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (alcb *AuditLogCreateBulk) OnConflict(opts ...sql.ConflictOption) *AuditLogUpsertBulk {
+	alcb.conflict = opts
+	return &AuditLogUpsertBulk{
+		create: alcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (alcb *AuditLogCreateBulk) OnConflictColumns(columns ...string) *AuditLogUpsertBulk {
+	alcb.conflict = append(alcb.conflict, sql.ConflictColumns(columns...))
+	return &AuditLogUpsertBulk{
+		create: alcb,
+	}
+}
+
+// AuditLogUpsertBulk is the builder for "upsert"-ing
+// a bulk of AuditLog nodes.
+type AuditLogUpsertBulk struct {
+	create *AuditLogCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AuditLogUpsertBulk) UpdateNewValues() *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AuditLogUpsertBulk) Ignore() *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditLogUpsertBulk) DoNothing() *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditLogCreateBulk.OnConflict
+// documentation for more info.
+func (u *AuditLogUpsertBulk) Update(set func(*AuditLogUpsert)) *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateTime sets the "create_time" field.
+func (u *AuditLogUpsertBulk) SetCreateTime(v time.Time) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetCreateTime(v)
+	})
+}
+
+// UpdateCreateTime sets the "create_time" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateCreateTime() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateCreateTime()
+	})
+}
+
+// SetNamespace sets the "namespace" field.
+func (u *AuditLogUpsertBulk) SetNamespace(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetNamespace(v)
+	})
+}
+
+// UpdateNamespace sets the "namespace" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateNamespace() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateNamespace()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *AuditLogUpsertBulk) SetAction(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateAction() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetOperation sets the "operation" field.
+func (u *AuditLogUpsertBulk) SetOperation(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetOperation(v)
+	})
+}
+
+// UpdateOperation sets the "operation" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateOperation() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateOperation()
+	})
+}
+
+// SetEntity sets the "entity" field.
+func (u *AuditLogUpsertBulk) SetEntity(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetEntity(v)
+	})
+}
+
+// UpdateEntity sets the "entity" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateEntity() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateEntity()
+	})
+}
+
+// SetEntityName sets the "entity_name" field.
+func (u *AuditLogUpsertBulk) SetEntityName(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetEntityName(v)
+	})
+}
+
+// UpdateEntityName sets the "entity_name" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateEntityName() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateEntityName()
+	})
+}
+
+// SetObCluster sets the "ob_cluster" field.
+func (u *AuditLogUpsertBulk) SetObCluster(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetObCluster(v)
+	})
+}
+
+// UpdateObCluster sets the "ob_cluster" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateObCluster() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateObCluster()
+	})
+}
+
+// SetObClusterID sets the "ob_cluster_id" field.
+func (u *AuditLogUpsertBulk) SetObClusterID(v int64) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetObClusterID(v)
+	})
+}
+
+// AddObClusterID adds v to the "ob_cluster_id" field.
+func (u *AuditLogUpsertBulk) AddObClusterID(v int64) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.AddObClusterID(v)
+	})
+}
+
+// UpdateObClusterID sets the "ob_cluster_id" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateObClusterID() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateObClusterID()
+	})
+}
+
+// SetObproxyGroup sets the "obproxy_group" field.
+func (u *AuditLogUpsertBulk) SetObproxyGroup(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetObproxyGroup(v)
+	})
+}
+
+// UpdateObproxyGroup sets the "obproxy_group" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateObproxyGroup() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateObproxyGroup()
+	})
+}
+
+// SetRemoteIP sets the "remote_ip" field.
+func (u *AuditLogUpsertBulk) SetRemoteIP(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetRemoteIP(v)
+	})
+}
+
+// UpdateRemoteIP sets the "remote_ip" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateRemoteIP() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateRemoteIP()
+	})
+}
+
+// SetPrincipal sets the "principal" field.
+func (u *AuditLogUpsertBulk) SetPrincipal(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetPrincipal(v)
+	})
+}
+
+// UpdatePrincipal sets the "principal" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdatePrincipal() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdatePrincipal()
+	})
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *AuditLogUpsertBulk) SetUserAgent(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetUserAgent(v)
+	})
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateUserAgent() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateUserAgent()
+	})
+}
+
+// SetTraceID sets the "trace_id" field.
+func (u *AuditLogUpsertBulk) SetTraceID(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetTraceID(v)
+	})
+}
+
+// UpdateTraceID sets the "trace_id" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateTraceID() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateTraceID()
+	})
+}
+
+// SetBeforeJSON sets the "before_json" field.
+func (u *AuditLogUpsertBulk) SetBeforeJSON(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetBeforeJSON(v)
+	})
+}
+
+// UpdateBeforeJSON sets the "before_json" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateBeforeJSON() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateBeforeJSON()
+	})
+}
+
+// ClearBeforeJSON clears the value of the "before_json" field.
+func (u *AuditLogUpsertBulk) ClearBeforeJSON() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearBeforeJSON()
+	})
+}
+
+// SetAfterJSON sets the "after_json" field.
+func (u *AuditLogUpsertBulk) SetAfterJSON(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetAfterJSON(v)
+	})
+}
+
+// UpdateAfterJSON sets the "after_json" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateAfterJSON() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateAfterJSON()
+	})
+}
+
+// ClearAfterJSON clears the value of the "after_json" field.
+func (u *AuditLogUpsertBulk) ClearAfterJSON() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearAfterJSON()
+	})
+}
+
+// Exec executes the query.
+func (u *AuditLogUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AuditLogCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuditLogCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditLogUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,742 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/oceanbase/configserver/ent/auditlog"
+	"github.com/oceanbase/configserver/ent/predicate"
+)
+
+// AuditLogUpdate is the builder for updating AuditLog entities.
+type AuditLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuditLogMutation
+}
+
+// Where appends a list predicates to the AuditLogUpdate builder.
+func (alu *AuditLogUpdate) Where(ps ...predicate.AuditLog) *AuditLogUpdate {
+	alu.mutation.Where(ps...)
+	return alu
+}
+
+// SetCreateTime sets the "create_time" field.
+func (alu *AuditLogUpdate) SetCreateTime(t time.Time) *AuditLogUpdate {
+	alu.mutation.SetCreateTime(t)
+	return alu
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableCreateTime(t *time.Time) *AuditLogUpdate {
+	if t != nil {
+		alu.SetCreateTime(*t)
+	}
+	return alu
+}
+
+// SetNamespace sets the "namespace" field.
+func (alu *AuditLogUpdate) SetNamespace(s string) *AuditLogUpdate {
+	alu.mutation.SetNamespace(s)
+	return alu
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableNamespace(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetNamespace(*s)
+	}
+	return alu
+}
+
+// SetAction sets the "action" field.
+func (alu *AuditLogUpdate) SetAction(s string) *AuditLogUpdate {
+	alu.mutation.SetAction(s)
+	return alu
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableAction(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetAction(*s)
+	}
+	return alu
+}
+
+// SetOperation sets the "operation" field.
+func (alu *AuditLogUpdate) SetOperation(s string) *AuditLogUpdate {
+	alu.mutation.SetOperation(s)
+	return alu
+}
+
+// SetNillableOperation sets the "operation" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableOperation(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetOperation(*s)
+	}
+	return alu
+}
+
+// SetEntity sets the "entity" field.
+func (alu *AuditLogUpdate) SetEntity(s string) *AuditLogUpdate {
+	alu.mutation.SetEntity(s)
+	return alu
+}
+
+// SetNillableEntity sets the "entity" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableEntity(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetEntity(*s)
+	}
+	return alu
+}
+
+// SetEntityName sets the "entity_name" field.
+func (alu *AuditLogUpdate) SetEntityName(s string) *AuditLogUpdate {
+	alu.mutation.SetEntityName(s)
+	return alu
+}
+
+// SetNillableEntityName sets the "entity_name" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableEntityName(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetEntityName(*s)
+	}
+	return alu
+}
+
+// SetObCluster sets the "ob_cluster" field.
+func (alu *AuditLogUpdate) SetObCluster(s string) *AuditLogUpdate {
+	alu.mutation.SetObCluster(s)
+	return alu
+}
+
+// SetNillableObCluster sets the "ob_cluster" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableObCluster(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetObCluster(*s)
+	}
+	return alu
+}
+
+// SetObClusterID sets the "ob_cluster_id" field.
+func (alu *AuditLogUpdate) SetObClusterID(i int64) *AuditLogUpdate {
+	alu.mutation.ResetObClusterID()
+	alu.mutation.SetObClusterID(i)
+	return alu
+}
+
+// SetNillableObClusterID sets the "ob_cluster_id" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableObClusterID(i *int64) *AuditLogUpdate {
+	if i != nil {
+		alu.SetObClusterID(*i)
+	}
+	return alu
+}
+
+// AddObClusterID adds i to the "ob_cluster_id" field.
+func (alu *AuditLogUpdate) AddObClusterID(i int64) *AuditLogUpdate {
+	alu.mutation.AddObClusterID(i)
+	return alu
+}
+
+// SetObproxyGroup sets the "obproxy_group" field.
+func (alu *AuditLogUpdate) SetObproxyGroup(s string) *AuditLogUpdate {
+	alu.mutation.SetObproxyGroup(s)
+	return alu
+}
+
+// SetNillableObproxyGroup sets the "obproxy_group" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableObproxyGroup(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetObproxyGroup(*s)
+	}
+	return alu
+}
+
+// SetRemoteIP sets the "remote_ip" field.
+func (alu *AuditLogUpdate) SetRemoteIP(s string) *AuditLogUpdate {
+	alu.mutation.SetRemoteIP(s)
+	return alu
+}
+
+// SetNillableRemoteIP sets the "remote_ip" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableRemoteIP(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetRemoteIP(*s)
+	}
+	return alu
+}
+
+// SetPrincipal sets the "principal" field.
+func (alu *AuditLogUpdate) SetPrincipal(s string) *AuditLogUpdate {
+	alu.mutation.SetPrincipal(s)
+	return alu
+}
+
+// SetNillablePrincipal sets the "principal" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillablePrincipal(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetPrincipal(*s)
+	}
+	return alu
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (alu *AuditLogUpdate) SetUserAgent(s string) *AuditLogUpdate {
+	alu.mutation.SetUserAgent(s)
+	return alu
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableUserAgent(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetUserAgent(*s)
+	}
+	return alu
+}
+
+// SetTraceID sets the "trace_id" field.
+func (alu *AuditLogUpdate) SetTraceID(s string) *AuditLogUpdate {
+	alu.mutation.SetTraceID(s)
+	return alu
+}
+
+// SetNillableTraceID sets the "trace_id" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableTraceID(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetTraceID(*s)
+	}
+	return alu
+}
+
+// SetBeforeJSON sets the "before_json" field.
+func (alu *AuditLogUpdate) SetBeforeJSON(s string) *AuditLogUpdate {
+	alu.mutation.SetBeforeJSON(s)
+	return alu
+}
+
+// SetNillableBeforeJSON sets the "before_json" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableBeforeJSON(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetBeforeJSON(*s)
+	}
+	return alu
+}
+
+// ClearBeforeJSON clears the value of the "before_json" field.
+func (alu *AuditLogUpdate) ClearBeforeJSON() *AuditLogUpdate {
+	alu.mutation.ClearBeforeJSON()
+	return alu
+}
+
+// SetAfterJSON sets the "after_json" field.
+func (alu *AuditLogUpdate) SetAfterJSON(s string) *AuditLogUpdate {
+	alu.mutation.SetAfterJSON(s)
+	return alu
+}
+
+// SetNillableAfterJSON sets the "after_json" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableAfterJSON(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetAfterJSON(*s)
+	}
+	return alu
+}
+
+// ClearAfterJSON clears the value of the "after_json" field.
+func (alu *AuditLogUpdate) ClearAfterJSON() *AuditLogUpdate {
+	alu.mutation.ClearAfterJSON()
+	return alu
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (alu *AuditLogUpdate) Mutation() *AuditLogMutation {
+	return alu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (alu *AuditLogUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, alu.sqlSave, alu.mutation, alu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alu *AuditLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := alu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (alu *AuditLogUpdate) Exec(ctx context.Context) error {
+	_, err := alu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alu *AuditLogUpdate) ExecX(ctx context.Context) {
+	if err := alu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (alu *AuditLogUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditlog.Table, auditlog.Columns, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt))
+	if ps := alu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := alu.mutation.CreateTime(); ok {
+		_spec.SetField(auditlog.FieldCreateTime, field.TypeTime, value)
+	}
+	if value, ok := alu.mutation.Namespace(); ok {
+		_spec.SetField(auditlog.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.Action(); ok {
+		_spec.SetField(auditlog.FieldAction, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.Operation(); ok {
+		_spec.SetField(auditlog.FieldOperation, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.Entity(); ok {
+		_spec.SetField(auditlog.FieldEntity, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.EntityName(); ok {
+		_spec.SetField(auditlog.FieldEntityName, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.ObCluster(); ok {
+		_spec.SetField(auditlog.FieldObCluster, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.ObClusterID(); ok {
+		_spec.SetField(auditlog.FieldObClusterID, field.TypeInt64, value)
+	}
+	if value, ok := alu.mutation.AddedObClusterID(); ok {
+		_spec.AddField(auditlog.FieldObClusterID, field.TypeInt64, value)
+	}
+	if value, ok := alu.mutation.ObproxyGroup(); ok {
+		_spec.SetField(auditlog.FieldObproxyGroup, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.RemoteIP(); ok {
+		_spec.SetField(auditlog.FieldRemoteIP, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.Principal(); ok {
+		_spec.SetField(auditlog.FieldPrincipal, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.UserAgent(); ok {
+		_spec.SetField(auditlog.FieldUserAgent, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.TraceID(); ok {
+		_spec.SetField(auditlog.FieldTraceID, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.BeforeJSON(); ok {
+		_spec.SetField(auditlog.FieldBeforeJSON, field.TypeString, value)
+	}
+	if alu.mutation.BeforeJSONCleared() {
+		_spec.ClearField(auditlog.FieldBeforeJSON, field.TypeString)
+	}
+	if value, ok := alu.mutation.AfterJSON(); ok {
+		_spec.SetField(auditlog.FieldAfterJSON, field.TypeString, value)
+	}
+	if alu.mutation.AfterJSONCleared() {
+		_spec.ClearField(auditlog.FieldAfterJSON, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, alu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	alu.mutation.done = true
+	return n, nil
+}
+
+// AuditLogUpdateOne is the builder for updating a single AuditLog entity.
+type AuditLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuditLogMutation
+}
+
+// SetCreateTime sets the "create_time" field.
+func (aluo *AuditLogUpdateOne) SetCreateTime(t time.Time) *AuditLogUpdateOne {
+	aluo.mutation.SetCreateTime(t)
+	return aluo
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableCreateTime(t *time.Time) *AuditLogUpdateOne {
+	if t != nil {
+		aluo.SetCreateTime(*t)
+	}
+	return aluo
+}
+
+// SetNamespace sets the "namespace" field.
+func (aluo *AuditLogUpdateOne) SetNamespace(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetNamespace(s)
+	return aluo
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableNamespace(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetNamespace(*s)
+	}
+	return aluo
+}
+
+// SetAction sets the "action" field.
+func (aluo *AuditLogUpdateOne) SetAction(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetAction(s)
+	return aluo
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableAction(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetAction(*s)
+	}
+	return aluo
+}
+
+// SetOperation sets the "operation" field.
+func (aluo *AuditLogUpdateOne) SetOperation(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetOperation(s)
+	return aluo
+}
+
+// SetNillableOperation sets the "operation" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableOperation(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetOperation(*s)
+	}
+	return aluo
+}
+
+// SetEntity sets the "entity" field.
+func (aluo *AuditLogUpdateOne) SetEntity(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetEntity(s)
+	return aluo
+}
+
+// SetNillableEntity sets the "entity" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableEntity(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetEntity(*s)
+	}
+	return aluo
+}
+
+// SetEntityName sets the "entity_name" field.
+func (aluo *AuditLogUpdateOne) SetEntityName(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetEntityName(s)
+	return aluo
+}
+
+// SetNillableEntityName sets the "entity_name" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableEntityName(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetEntityName(*s)
+	}
+	return aluo
+}
+
+// SetObCluster sets the "ob_cluster" field.
+func (aluo *AuditLogUpdateOne) SetObCluster(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetObCluster(s)
+	return aluo
+}
+
+// SetNillableObCluster sets the "ob_cluster" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableObCluster(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetObCluster(*s)
+	}
+	return aluo
+}
+
+// SetObClusterID sets the "ob_cluster_id" field.
+func (aluo *AuditLogUpdateOne) SetObClusterID(i int64) *AuditLogUpdateOne {
+	aluo.mutation.ResetObClusterID()
+	aluo.mutation.SetObClusterID(i)
+	return aluo
+}
+
+// SetNillableObClusterID sets the "ob_cluster_id" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableObClusterID(i *int64) *AuditLogUpdateOne {
+	if i != nil {
+		aluo.SetObClusterID(*i)
+	}
+	return aluo
+}
+
+// AddObClusterID adds i to the "ob_cluster_id" field.
+func (aluo *AuditLogUpdateOne) AddObClusterID(i int64) *AuditLogUpdateOne {
+	aluo.mutation.AddObClusterID(i)
+	return aluo
+}
+
+// SetObproxyGroup sets the "obproxy_group" field.
+func (aluo *AuditLogUpdateOne) SetObproxyGroup(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetObproxyGroup(s)
+	return aluo
+}
+
+// SetNillableObproxyGroup sets the "obproxy_group" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableObproxyGroup(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetObproxyGroup(*s)
+	}
+	return aluo
+}
+
+// SetRemoteIP sets the "remote_ip" field.
+func (aluo *AuditLogUpdateOne) SetRemoteIP(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetRemoteIP(s)
+	return aluo
+}
+
+// SetNillableRemoteIP sets the "remote_ip" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableRemoteIP(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetRemoteIP(*s)
+	}
+	return aluo
+}
+
+// SetPrincipal sets the "principal" field.
+func (aluo *AuditLogUpdateOne) SetPrincipal(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetPrincipal(s)
+	return aluo
+}
+
+// SetNillablePrincipal sets the "principal" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillablePrincipal(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetPrincipal(*s)
+	}
+	return aluo
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (aluo *AuditLogUpdateOne) SetUserAgent(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetUserAgent(s)
+	return aluo
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableUserAgent(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetUserAgent(*s)
+	}
+	return aluo
+}
+
+// SetTraceID sets the "trace_id" field.
+func (aluo *AuditLogUpdateOne) SetTraceID(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetTraceID(s)
+	return aluo
+}
+
+// SetNillableTraceID sets the "trace_id" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableTraceID(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetTraceID(*s)
+	}
+	return aluo
+}
+
+// SetBeforeJSON sets the "before_json" field.
+func (aluo *AuditLogUpdateOne) SetBeforeJSON(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetBeforeJSON(s)
+	return aluo
+}
+
+// SetNillableBeforeJSON sets the "before_json" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableBeforeJSON(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetBeforeJSON(*s)
+	}
+	return aluo
+}
+
+// ClearBeforeJSON clears the value of the "before_json" field.
+func (aluo *AuditLogUpdateOne) ClearBeforeJSON() *AuditLogUpdateOne {
+	aluo.mutation.ClearBeforeJSON()
+	return aluo
+}
+
+// SetAfterJSON sets the "after_json" field.
+func (aluo *AuditLogUpdateOne) SetAfterJSON(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetAfterJSON(s)
+	return aluo
+}
+
+// SetNillableAfterJSON sets the "after_json" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableAfterJSON(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetAfterJSON(*s)
+	}
+	return aluo
+}
+
+// ClearAfterJSON clears the value of the "after_json" field.
+func (aluo *AuditLogUpdateOne) ClearAfterJSON() *AuditLogUpdateOne {
+	aluo.mutation.ClearAfterJSON()
+	return aluo
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (aluo *AuditLogUpdateOne) Mutation() *AuditLogMutation {
+	return aluo.mutation
+}
+
+// Where appends a list predicates to the AuditLogUpdate builder.
+func (aluo *AuditLogUpdateOne) Where(ps ...predicate.AuditLog) *AuditLogUpdateOne {
+	aluo.mutation.Where(ps...)
+	return aluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aluo *AuditLogUpdateOne) Select(field string, fields ...string) *AuditLogUpdateOne {
+	aluo.fields = append([]string{field}, fields...)
+	return aluo
+}
+
+// Save executes the query and returns the updated AuditLog entity.
+func (aluo *AuditLogUpdateOne) Save(ctx context.Context) (*AuditLog, error) {
+	return withHooks(ctx, aluo.sqlSave, aluo.mutation, aluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aluo *AuditLogUpdateOne) SaveX(ctx context.Context) *AuditLog {
+	node, err := aluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aluo *AuditLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := aluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aluo *AuditLogUpdateOne) ExecX(ctx context.Context) {
+	if err := aluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (aluo *AuditLogUpdateOne) sqlSave(ctx context.Context) (_node *AuditLog, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditlog.Table, auditlog.Columns, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt))
+	id, ok := aluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuditLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditlog.FieldID)
+		for _, f := range fields {
+			if !auditlog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != auditlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aluo.mutation.CreateTime(); ok {
+		_spec.SetField(auditlog.FieldCreateTime, field.TypeTime, value)
+	}
+	if value, ok := aluo.mutation.Namespace(); ok {
+		_spec.SetField(auditlog.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.Action(); ok {
+		_spec.SetField(auditlog.FieldAction, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.Operation(); ok {
+		_spec.SetField(auditlog.FieldOperation, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.Entity(); ok {
+		_spec.SetField(auditlog.FieldEntity, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.EntityName(); ok {
+		_spec.SetField(auditlog.FieldEntityName, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.ObCluster(); ok {
+		_spec.SetField(auditlog.FieldObCluster, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.ObClusterID(); ok {
+		_spec.SetField(auditlog.FieldObClusterID, field.TypeInt64, value)
+	}
+	if value, ok := aluo.mutation.AddedObClusterID(); ok {
+		_spec.AddField(auditlog.FieldObClusterID, field.TypeInt64, value)
+	}
+	if value, ok := aluo.mutation.ObproxyGroup(); ok {
+		_spec.SetField(auditlog.FieldObproxyGroup, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.RemoteIP(); ok {
+		_spec.SetField(auditlog.FieldRemoteIP, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.Principal(); ok {
+		_spec.SetField(auditlog.FieldPrincipal, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.UserAgent(); ok {
+		_spec.SetField(auditlog.FieldUserAgent, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.TraceID(); ok {
+		_spec.SetField(auditlog.FieldTraceID, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.BeforeJSON(); ok {
+		_spec.SetField(auditlog.FieldBeforeJSON, field.TypeString, value)
+	}
+	if aluo.mutation.BeforeJSONCleared() {
+		_spec.ClearField(auditlog.FieldBeforeJSON, field.TypeString)
+	}
+	if value, ok := aluo.mutation.AfterJSON(); ok {
+		_spec.SetField(auditlog.FieldAfterJSON, field.TypeString, value)
+	}
+	if aluo.mutation.AfterJSONCleared() {
+		_spec.ClearField(auditlog.FieldAfterJSON, field.TypeString)
+	}
+	_node = &AuditLog{config: aluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aluo.mutation.done = true
+	return _node, nil
+}
@@ -1,18 +1,25 @@
-// Code generated by entc, DO NOT EDIT.
+// Code generated by ent, DO NOT EDIT.
 
 package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"reflect"
 
 	"github.com/oceanbase/configserver/ent/migrate"
 
-	"github.com/oceanbase/configserver/ent/obcluster"
-
+	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"github.com/oceanbase/configserver/ent/auditlog"
+	"github.com/oceanbase/configserver/ent/obcluster"
+	"github.com/oceanbase/configserver/ent/obclustergroup"
+	"github.com/oceanbase/configserver/ent/obproxy"
+	"github.com/oceanbase/configserver/ent/obproxygroup"
+	"github.com/oceanbase/configserver/ent/webhookdelivery"
 )
 
 // Client is the client that holds all ent builders.
@@ -20,22 +27,91 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
 	// ObCluster is the client for interacting with the ObCluster builders.
 	ObCluster *ObClusterClient
+	// ObClusterGroup is the client for interacting with the ObClusterGroup builders.
+	ObClusterGroup *ObClusterGroupClient
+	// ObProxy is the client for interacting with the ObProxy builders.
+	ObProxy *ObProxyClient
+	// ObProxyGroup is the client for interacting with the ObProxyGroup builders.
+	ObProxyGroup *ObProxyGroupClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 }
 
 // NewClient creates a new client configured with the given options.
 func NewClient(opts ...Option) *Client {
-	cfg := config{log: log.Println, hooks: &hooks{}}
-	cfg.options(opts...)
-	client := &Client{config: cfg}
+	client := &Client{config: newConfig(opts...)}
 	client.init()
 	return client
 }
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AuditLog = NewAuditLogClient(c.config)
 	c.ObCluster = NewObClusterClient(c.config)
+	c.ObClusterGroup = NewObClusterGroupClient(c.config)
+	c.ObProxy = NewObProxyClient(c.config)
+	c.ObProxyGroup = NewObProxyGroupClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
+}
+
+type (
+	// config is the configuration for the client and its builder.
+	config struct {
+		// driver used for executing database requests.
+		driver dialect.Driver
+		// debug enable a debug logging.
+		debug bool
+		// log used for logging on debug mode.
+		log func(...any)
+		// hooks to execute on mutations.
+		hooks *hooks
+		// interceptors to execute on queries.
+		inters *inters
+	}
+	// Option function to configure the client.
+	Option func(*config)
+)
+
+// newConfig creates a new config for the client.
+func newConfig(opts ...Option) config {
+	cfg := config{log: log.Println, hooks: &hooks{}, inters: &inters{}}
+	cfg.options(opts...)
+	return cfg
+}
+
+// options applies the options on the config object.
+func (c *config) options(opts ...Option) {
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.debug {
+		c.driver = dialect.Debug(c.driver, c.log)
+	}
+}
+
+// Debug enables debug logging on the ent.Driver.
+func Debug() Option {
+	return func(c *config) {
+		c.debug = true
+	}
+}
+
+// Log sets the logging function for debug mode.
+func Log(fn func(...any)) Option {
+	return func(c *config) {
+		c.log = fn
+	}
+}
+
+// Driver configures the client driver.
+func Driver(driver dialect.Driver) Option {
+	return func(c *config) {
+		c.driver = driver
+	}
 }
 
 // Open opens a database/sql.DB specified by the driver name and
@@ -54,11 +130,14 @@ func Open(driverName, dataSourceName string, options ...Option) (*Client, error)
 	}
 }
 
+// ErrTxStarted is returned when trying to start a new transaction from a transactional client.
+var ErrTxStarted = errors.New("ent: cannot start a transaction within a transaction")
+
 // Tx returns a new transactional client. The provided context
 // is used until the transaction is committed or rolled back.
 func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	if _, ok := c.driver.(*txDriver); ok {
-		return nil, fmt.Errorf("ent: cannot start a transaction within a transaction")
+		return nil, ErrTxStarted
 	}
 	tx, err := newTx(ctx, c.driver)
 	if err != nil {
@@ -67,16 +146,21 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:       ctx,
-		config:    cfg,
-		ObCluster: NewObClusterClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		AuditLog:        NewAuditLogClient(cfg),
+		ObCluster:       NewObClusterClient(cfg),
+		ObClusterGroup:  NewObClusterGroupClient(cfg),
+		ObProxy:         NewObProxyClient(cfg),
+		ObProxyGroup:    NewObProxyGroupClient(cfg),
+		WebhookDelivery: NewWebhookDeliveryClient(cfg),
 	}, nil
 }
 
 // BeginTx returns a transactional client with specified options.
 func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
 	if _, ok := c.driver.(*txDriver); ok {
-		return nil, fmt.Errorf("ent: cannot start a transaction within a transaction")
+		return nil, errors.New("ent: cannot start a transaction within a transaction")
 	}
 	tx, err := c.driver.(interface {
 		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
@@ -87,19 +171,23 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:       ctx,
-		config:    cfg,
-		ObCluster: NewObClusterClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		AuditLog:        NewAuditLogClient(cfg),
+		ObCluster:       NewObClusterClient(cfg),
+		ObClusterGroup:  NewObClusterGroupClient(cfg),
+		ObProxy:         NewObProxyClient(cfg),
+		ObProxyGroup:    NewObProxyGroupClient(cfg),
+		WebhookDelivery: NewWebhookDeliveryClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		ObCluster.
+//		AuditLog.
 //		Query().
 //		Count(ctx)
-//
 func (c *Client) Debug() *Client {
 	if c.debug {
 		return c
@@ -119,7 +207,176 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.ObCluster.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.AuditLog, c.ObCluster, c.ObClusterGroup, c.ObProxy, c.ObProxyGroup,
+		c.WebhookDelivery,
+	} {
+		n.Use(hooks...)
+	}
+}
+
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.AuditLog, c.ObCluster, c.ObClusterGroup, c.ObProxy, c.ObProxyGroup,
+		c.WebhookDelivery,
+	} {
+		n.Intercept(interceptors...)
+	}
+}
+
+// Mutate implements the ent.Mutator interface.
+func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
+	switch m := m.(type) {
+	case *AuditLogMutation:
+		return c.AuditLog.mutate(ctx, m)
+	case *ObClusterMutation:
+		return c.ObCluster.mutate(ctx, m)
+	case *ObClusterGroupMutation:
+		return c.ObClusterGroup.mutate(ctx, m)
+	case *ObProxyMutation:
+		return c.ObProxy.mutate(ctx, m)
+	case *ObProxyGroupMutation:
+		return c.ObProxyGroup.mutate(ctx, m)
+	case *WebhookDeliveryMutation:
+		return c.WebhookDelivery.mutate(ctx, m)
+	default:
+		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
+	}
+}
+
+// AuditLogClient is a client for the AuditLog schema.
+type AuditLogClient struct {
+	config
+}
+
+// NewAuditLogClient returns a client for the AuditLog from the given config.
+func NewAuditLogClient(c config) *AuditLogClient {
+	return &AuditLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditlog.Hooks(f(g(h())))`.
+func (c *AuditLogClient) Use(hooks ...Hook) {
+	c.hooks.AuditLog = append(c.hooks.AuditLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditlog.Intercept(f(g(h())))`.
+func (c *AuditLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditLog = append(c.inters.AuditLog, interceptors...)
+}
+
+// Create returns a builder for creating a AuditLog entity.
+func (c *AuditLogClient) Create() *AuditLogCreate {
+	mutation := newAuditLogMutation(c.config, OpCreate)
+	return &AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditLog entities.
+func (c *AuditLogClient) CreateBulk(builders ...*AuditLogCreate) *AuditLogCreateBulk {
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditLogClient) MapCreateBulk(slice any, setFunc func(*AuditLogCreate, int)) *AuditLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditLogCreateBulk{err: fmt.Errorf("calling to AuditLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditLog.
+func (c *AuditLogClient) Update() *AuditLogUpdate {
+	mutation := newAuditLogMutation(c.config, OpUpdate)
+	return &AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditLogClient) UpdateOne(al *AuditLog) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLog(al))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditLogClient) UpdateOneID(id int) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLogID(id))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditLog.
+func (c *AuditLogClient) Delete() *AuditLogDelete {
+	mutation := newAuditLogMutation(c.config, OpDelete)
+	return &AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditLogClient) DeleteOne(al *AuditLog) *AuditLogDeleteOne {
+	return c.DeleteOneID(al.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditLogClient) DeleteOneID(id int) *AuditLogDeleteOne {
+	builder := c.Delete().Where(auditlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditLogDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditLog.
+func (c *AuditLogClient) Query() *AuditLogQuery {
+	return &AuditLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditLog entity by its id.
+func (c *AuditLogClient) Get(ctx context.Context, id int) (*AuditLog, error) {
+	return c.Query().Where(auditlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditLogClient) GetX(ctx context.Context, id int) *AuditLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditLogClient) Hooks() []Hook {
+	return c.hooks.AuditLog
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditLogClient) Interceptors() []Interceptor {
+	return c.inters.AuditLog
+}
+
+func (c *AuditLogClient) mutate(ctx context.Context, m *AuditLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuditLog mutation op: %q", m.Op())
+	}
 }
 
 // ObClusterClient is a client for the ObCluster schema.
@@ -138,7 +395,13 @@ func (c *ObClusterClient) Use(hooks ...Hook) {
 	c.hooks.ObCluster = append(c.hooks.ObCluster, hooks...)
 }
 
-// Create returns a create builder for ObCluster.
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `obcluster.Intercept(f(g(h())))`.
+func (c *ObClusterClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ObCluster = append(c.inters.ObCluster, interceptors...)
+}
+
+// Create returns a builder for creating a ObCluster entity.
 func (c *ObClusterClient) Create() *ObClusterCreate {
 	mutation := newObClusterMutation(c.config, OpCreate)
 	return &ObClusterCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
@@ -149,6 +412,21 @@ func (c *ObClusterClient) CreateBulk(builders ...*ObClusterCreate) *ObClusterCre
 	return &ObClusterCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ObClusterClient) MapCreateBulk(slice any, setFunc func(*ObClusterCreate, int)) *ObClusterCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ObClusterCreateBulk{err: fmt.Errorf("calling to ObClusterClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ObClusterCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ObClusterCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for ObCluster.
 func (c *ObClusterClient) Update() *ObClusterUpdate {
 	mutation := newObClusterMutation(c.config, OpUpdate)
@@ -173,12 +451,12 @@ func (c *ObClusterClient) Delete() *ObClusterDelete {
 	return &ObClusterDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// DeleteOne returns a delete builder for the given entity.
+// DeleteOne returns a builder for deleting the given entity.
 func (c *ObClusterClient) DeleteOne(oc *ObCluster) *ObClusterDeleteOne {
 	return c.DeleteOneID(oc.ID)
 }
 
-// DeleteOneID returns a delete builder for the given id.
+// DeleteOneID returns a builder for deleting the given entity by its id.
 func (c *ObClusterClient) DeleteOneID(id int) *ObClusterDeleteOne {
 	builder := c.Delete().Where(obcluster.ID(id))
 	builder.mutation.id = &id
@@ -190,6 +468,8 @@ func (c *ObClusterClient) DeleteOneID(id int) *ObClusterDeleteOne {
 func (c *ObClusterClient) Query() *ObClusterQuery {
 	return &ObClusterQuery{
 		config: c.config,
+		ctx:    &QueryContext{Type: TypeObCluster},
+		inters: c.Interceptors(),
 	}
 }
 
@@ -211,3 +491,567 @@ func (c *ObClusterClient) GetX(ctx context.Context, id int) *ObCluster {
 func (c *ObClusterClient) Hooks() []Hook {
 	return c.hooks.ObCluster
 }
+
+// Interceptors returns the client interceptors.
+func (c *ObClusterClient) Interceptors() []Interceptor {
+	return c.inters.ObCluster
+}
+
+func (c *ObClusterClient) mutate(ctx context.Context, m *ObClusterMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ObClusterCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ObClusterUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ObClusterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ObClusterDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ObCluster mutation op: %q", m.Op())
+	}
+}
+
+// ObClusterGroupClient is a client for the ObClusterGroup schema.
+type ObClusterGroupClient struct {
+	config
+}
+
+// NewObClusterGroupClient returns a client for the ObClusterGroup from the given config.
+func NewObClusterGroupClient(c config) *ObClusterGroupClient {
+	return &ObClusterGroupClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `obclustergroup.Hooks(f(g(h())))`.
+func (c *ObClusterGroupClient) Use(hooks ...Hook) {
+	c.hooks.ObClusterGroup = append(c.hooks.ObClusterGroup, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `obclustergroup.Intercept(f(g(h())))`.
+func (c *ObClusterGroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ObClusterGroup = append(c.inters.ObClusterGroup, interceptors...)
+}
+
+// Create returns a builder for creating a ObClusterGroup entity.
+func (c *ObClusterGroupClient) Create() *ObClusterGroupCreate {
+	mutation := newObClusterGroupMutation(c.config, OpCreate)
+	return &ObClusterGroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ObClusterGroup entities.
+func (c *ObClusterGroupClient) CreateBulk(builders ...*ObClusterGroupCreate) *ObClusterGroupCreateBulk {
+	return &ObClusterGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ObClusterGroupClient) MapCreateBulk(slice any, setFunc func(*ObClusterGroupCreate, int)) *ObClusterGroupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ObClusterGroupCreateBulk{err: fmt.Errorf("calling to ObClusterGroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ObClusterGroupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ObClusterGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ObClusterGroup.
+func (c *ObClusterGroupClient) Update() *ObClusterGroupUpdate {
+	mutation := newObClusterGroupMutation(c.config, OpUpdate)
+	return &ObClusterGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ObClusterGroupClient) UpdateOne(ocg *ObClusterGroup) *ObClusterGroupUpdateOne {
+	mutation := newObClusterGroupMutation(c.config, OpUpdateOne, withObClusterGroup(ocg))
+	return &ObClusterGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ObClusterGroupClient) UpdateOneID(id int) *ObClusterGroupUpdateOne {
+	mutation := newObClusterGroupMutation(c.config, OpUpdateOne, withObClusterGroupID(id))
+	return &ObClusterGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ObClusterGroup.
+func (c *ObClusterGroupClient) Delete() *ObClusterGroupDelete {
+	mutation := newObClusterGroupMutation(c.config, OpDelete)
+	return &ObClusterGroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ObClusterGroupClient) DeleteOne(ocg *ObClusterGroup) *ObClusterGroupDeleteOne {
+	return c.DeleteOneID(ocg.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ObClusterGroupClient) DeleteOneID(id int) *ObClusterGroupDeleteOne {
+	builder := c.Delete().Where(obclustergroup.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ObClusterGroupDeleteOne{builder}
+}
+
+// Query returns a query builder for ObClusterGroup.
+func (c *ObClusterGroupClient) Query() *ObClusterGroupQuery {
+	return &ObClusterGroupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeObClusterGroup},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ObClusterGroup entity by its id.
+func (c *ObClusterGroupClient) Get(ctx context.Context, id int) (*ObClusterGroup, error) {
+	return c.Query().Where(obclustergroup.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ObClusterGroupClient) GetX(ctx context.Context, id int) *ObClusterGroup {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ObClusterGroupClient) Hooks() []Hook {
+	return c.hooks.ObClusterGroup
+}
+
+// Interceptors returns the client interceptors.
+func (c *ObClusterGroupClient) Interceptors() []Interceptor {
+	return c.inters.ObClusterGroup
+}
+
+func (c *ObClusterGroupClient) mutate(ctx context.Context, m *ObClusterGroupMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ObClusterGroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ObClusterGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ObClusterGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ObClusterGroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ObClusterGroup mutation op: %q", m.Op())
+	}
+}
+
+// ObProxyClient is a client for the ObProxy schema.
+type ObProxyClient struct {
+	config
+}
+
+// NewObProxyClient returns a client for the ObProxy from the given config.
+func NewObProxyClient(c config) *ObProxyClient {
+	return &ObProxyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `obproxy.Hooks(f(g(h())))`.
+func (c *ObProxyClient) Use(hooks ...Hook) {
+	c.hooks.ObProxy = append(c.hooks.ObProxy, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `obproxy.Intercept(f(g(h())))`.
+func (c *ObProxyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ObProxy = append(c.inters.ObProxy, interceptors...)
+}
+
+// Create returns a builder for creating a ObProxy entity.
+func (c *ObProxyClient) Create() *ObProxyCreate {
+	mutation := newObProxyMutation(c.config, OpCreate)
+	return &ObProxyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ObProxy entities.
+func (c *ObProxyClient) CreateBulk(builders ...*ObProxyCreate) *ObProxyCreateBulk {
+	return &ObProxyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ObProxyClient) MapCreateBulk(slice any, setFunc func(*ObProxyCreate, int)) *ObProxyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ObProxyCreateBulk{err: fmt.Errorf("calling to ObProxyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ObProxyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ObProxyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ObProxy.
+func (c *ObProxyClient) Update() *ObProxyUpdate {
+	mutation := newObProxyMutation(c.config, OpUpdate)
+	return &ObProxyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ObProxyClient) UpdateOne(op *ObProxy) *ObProxyUpdateOne {
+	mutation := newObProxyMutation(c.config, OpUpdateOne, withObProxy(op))
+	return &ObProxyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ObProxyClient) UpdateOneID(id int) *ObProxyUpdateOne {
+	mutation := newObProxyMutation(c.config, OpUpdateOne, withObProxyID(id))
+	return &ObProxyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ObProxy.
+func (c *ObProxyClient) Delete() *ObProxyDelete {
+	mutation := newObProxyMutation(c.config, OpDelete)
+	return &ObProxyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ObProxyClient) DeleteOne(op *ObProxy) *ObProxyDeleteOne {
+	return c.DeleteOneID(op.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ObProxyClient) DeleteOneID(id int) *ObProxyDeleteOne {
+	builder := c.Delete().Where(obproxy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ObProxyDeleteOne{builder}
+}
+
+// Query returns a query builder for ObProxy.
+func (c *ObProxyClient) Query() *ObProxyQuery {
+	return &ObProxyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeObProxy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ObProxy entity by its id.
+func (c *ObProxyClient) Get(ctx context.Context, id int) (*ObProxy, error) {
+	return c.Query().Where(obproxy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ObProxyClient) GetX(ctx context.Context, id int) *ObProxy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ObProxyClient) Hooks() []Hook {
+	return c.hooks.ObProxy
+}
+
+// Interceptors returns the client interceptors.
+func (c *ObProxyClient) Interceptors() []Interceptor {
+	return c.inters.ObProxy
+}
+
+func (c *ObProxyClient) mutate(ctx context.Context, m *ObProxyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ObProxyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ObProxyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ObProxyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ObProxyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ObProxy mutation op: %q", m.Op())
+	}
+}
+
+// ObProxyGroupClient is a client for the ObProxyGroup schema.
+type ObProxyGroupClient struct {
+	config
+}
+
+// NewObProxyGroupClient returns a client for the ObProxyGroup from the given config.
+func NewObProxyGroupClient(c config) *ObProxyGroupClient {
+	return &ObProxyGroupClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `obproxygroup.Hooks(f(g(h())))`.
+func (c *ObProxyGroupClient) Use(hooks ...Hook) {
+	c.hooks.ObProxyGroup = append(c.hooks.ObProxyGroup, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `obproxygroup.Intercept(f(g(h())))`.
+func (c *ObProxyGroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ObProxyGroup = append(c.inters.ObProxyGroup, interceptors...)
+}
+
+// Create returns a builder for creating a ObProxyGroup entity.
+func (c *ObProxyGroupClient) Create() *ObProxyGroupCreate {
+	mutation := newObProxyGroupMutation(c.config, OpCreate)
+	return &ObProxyGroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ObProxyGroup entities.
+func (c *ObProxyGroupClient) CreateBulk(builders ...*ObProxyGroupCreate) *ObProxyGroupCreateBulk {
+	return &ObProxyGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ObProxyGroupClient) MapCreateBulk(slice any, setFunc func(*ObProxyGroupCreate, int)) *ObProxyGroupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ObProxyGroupCreateBulk{err: fmt.Errorf("calling to ObProxyGroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ObProxyGroupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ObProxyGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ObProxyGroup.
+func (c *ObProxyGroupClient) Update() *ObProxyGroupUpdate {
+	mutation := newObProxyGroupMutation(c.config, OpUpdate)
+	return &ObProxyGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ObProxyGroupClient) UpdateOne(opg *ObProxyGroup) *ObProxyGroupUpdateOne {
+	mutation := newObProxyGroupMutation(c.config, OpUpdateOne, withObProxyGroup(opg))
+	return &ObProxyGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ObProxyGroupClient) UpdateOneID(id int) *ObProxyGroupUpdateOne {
+	mutation := newObProxyGroupMutation(c.config, OpUpdateOne, withObProxyGroupID(id))
+	return &ObProxyGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ObProxyGroup.
+func (c *ObProxyGroupClient) Delete() *ObProxyGroupDelete {
+	mutation := newObProxyGroupMutation(c.config, OpDelete)
+	return &ObProxyGroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ObProxyGroupClient) DeleteOne(opg *ObProxyGroup) *ObProxyGroupDeleteOne {
+	return c.DeleteOneID(opg.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ObProxyGroupClient) DeleteOneID(id int) *ObProxyGroupDeleteOne {
+	builder := c.Delete().Where(obproxygroup.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ObProxyGroupDeleteOne{builder}
+}
+
+// Query returns a query builder for ObProxyGroup.
+func (c *ObProxyGroupClient) Query() *ObProxyGroupQuery {
+	return &ObProxyGroupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeObProxyGroup},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ObProxyGroup entity by its id.
+func (c *ObProxyGroupClient) Get(ctx context.Context, id int) (*ObProxyGroup, error) {
+	return c.Query().Where(obproxygroup.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ObProxyGroupClient) GetX(ctx context.Context, id int) *ObProxyGroup {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ObProxyGroupClient) Hooks() []Hook {
+	return c.hooks.ObProxyGroup
+}
+
+// Interceptors returns the client interceptors.
+func (c *ObProxyGroupClient) Interceptors() []Interceptor {
+	return c.inters.ObProxyGroup
+}
+
+func (c *ObProxyGroupClient) mutate(ctx context.Context, m *ObProxyGroupMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ObProxyGroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ObProxyGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ObProxyGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ObProxyGroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ObProxyGroup mutation op: %q", m.Op())
+	}
+}
+
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
+	config
+}
+
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookdelivery.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDelivery = append(c.inters.WebhookDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookDelivery entity.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookDeliveryClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryCreate, int)) *WebhookDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookDeliveryCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDeliveryClient) UpdateOne(wd *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(wd))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDeliveryClient) UpdateOneID(id int) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookDeliveryClient) DeleteOne(wd *WebhookDelivery) *WebhookDeliveryDeleteOne {
+	return c.DeleteOneID(wd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookDeliveryClient) DeleteOneID(id int) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id int) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id int) *WebhookDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	return c.hooks.WebhookDelivery
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDelivery
+}
+
+func (c *WebhookDeliveryClient) mutate(ctx context.Context, m *WebhookDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebhookDelivery mutation op: %q", m.Op())
+	}
+}
+
+// hooks and interceptors per client, for fast access.
+type (
+	hooks struct {
+		AuditLog, ObCluster, ObClusterGroup, ObProxy, ObProxyGroup,
+		WebhookDelivery []ent.Hook
+	}
+	inters struct {
+		AuditLog, ObCluster, ObClusterGroup, ObProxy, ObProxyGroup,
+		WebhookDelivery []ent.Interceptor
+	}
+)
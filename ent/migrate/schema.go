@@ -1,4 +1,4 @@
-// Code generated by entc, DO NOT EDIT.
+// Code generated by ent, DO NOT EDIT.
 
 package migrate
 
@@ -8,15 +8,64 @@ import (
 )
 
 var (
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "namespace", Type: field.TypeString, Default: "default"},
+		{Name: "action", Type: field.TypeString},
+		{Name: "operation", Type: field.TypeString},
+		{Name: "entity", Type: field.TypeString, Default: "ObCluster"},
+		{Name: "entity_name", Type: field.TypeString, Default: ""},
+		{Name: "ob_cluster", Type: field.TypeString},
+		{Name: "ob_cluster_id", Type: field.TypeInt64},
+		{Name: "obproxy_group", Type: field.TypeString, Default: ""},
+		{Name: "remote_ip", Type: field.TypeString, Default: ""},
+		{Name: "principal", Type: field.TypeString, Default: ""},
+		{Name: "user_agent", Type: field.TypeString, Size: 1024, Default: ""},
+		{Name: "trace_id", Type: field.TypeString, Default: ""},
+		{Name: "before_json", Type: field.TypeString, Nullable: true, Size: 65536},
+		{Name: "after_json", Type: field.TypeString, Nullable: true, Size: 65536},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1]},
+			},
+			{
+				Name:    "auditlog_namespace_ob_cluster_ob_cluster_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[2], AuditLogsColumns[7], AuditLogsColumns[8]},
+			},
+			{
+				Name:    "auditlog_namespace_obproxy_group",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[2], AuditLogsColumns[9]},
+			},
+			{
+				Name:    "auditlog_namespace_entity_entity_name",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[2], AuditLogsColumns[5], AuditLogsColumns[6]},
+			},
+		},
+	}
 	// ObClustersColumns holds the columns for the "ob_clusters" table.
 	ObClustersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "create_time", Type: field.TypeTime},
 		{Name: "update_time", Type: field.TypeTime},
+		{Name: "namespace", Type: field.TypeString, Default: "default"},
 		{Name: "name", Type: field.TypeString},
 		{Name: "ob_cluster_id", Type: field.TypeInt64},
 		{Name: "type", Type: field.TypeString},
 		{Name: "rootservice_json", Type: field.TypeString, Size: 65536},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 	}
 	// ObClustersTable holds the schema information for the "ob_clusters" table.
 	ObClustersTable = &schema.Table{
@@ -30,15 +79,150 @@ var (
 				Columns: []*schema.Column{ObClustersColumns[2]},
 			},
 			{
-				Name:    "obcluster_name_ob_cluster_id",
+				Name:    "obcluster_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{ObClustersColumns[8]},
+			},
+			{
+				Name:    "obcluster_namespace_name_ob_cluster_id",
+				Unique:  true,
+				Columns: []*schema.Column{ObClustersColumns[3], ObClustersColumns[4], ObClustersColumns[5]},
+			},
+		},
+	}
+	// ObClusterGroupsColumns holds the columns for the "ob_cluster_groups" table.
+	ObClusterGroupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "namespace", Type: field.TypeString, Default: "default"},
+		{Name: "name", Type: field.TypeString},
+		{Name: "primary_cluster_id", Type: field.TypeInt64, Default: 0},
+	}
+	// ObClusterGroupsTable holds the schema information for the "ob_cluster_groups" table.
+	ObClusterGroupsTable = &schema.Table{
+		Name:       "ob_cluster_groups",
+		Columns:    ObClusterGroupsColumns,
+		PrimaryKey: []*schema.Column{ObClusterGroupsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "obclustergroup_namespace_name",
+				Unique:  true,
+				Columns: []*schema.Column{ObClusterGroupsColumns[3], ObClusterGroupsColumns[4]},
+			},
+		},
+	}
+	// ObProxiesColumns holds the columns for the "ob_proxies" table.
+	ObProxiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "namespace", Type: field.TypeString, Default: "default"},
+		{Name: "name", Type: field.TypeString},
+		{Name: "address", Type: field.TypeString},
+		{Name: "version", Type: field.TypeString, Default: ""},
+		{Name: "config_version", Type: field.TypeString, Default: ""},
+		{Name: "obproxy_group", Type: field.TypeString, Default: ""},
+		{Name: "service_address", Type: field.TypeString, Default: ""},
+		{Name: "last_seen_time", Type: field.TypeTime},
+	}
+	// ObProxiesTable holds the schema information for the "ob_proxies" table.
+	ObProxiesTable = &schema.Table{
+		Name:       "ob_proxies",
+		Columns:    ObProxiesColumns,
+		PrimaryKey: []*schema.Column{ObProxiesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "obproxy_namespace_name",
+				Unique:  true,
+				Columns: []*schema.Column{ObProxiesColumns[3], ObProxiesColumns[4]},
+			},
+			{
+				Name:    "obproxy_last_seen_time",
+				Unique:  false,
+				Columns: []*schema.Column{ObProxiesColumns[10]},
+			},
+		},
+	}
+	// ObProxyGroupsColumns holds the columns for the "ob_proxy_groups" table.
+	ObProxyGroupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "namespace", Type: field.TypeString, Default: "default"},
+		{Name: "name", Type: field.TypeString},
+		{Name: "ob_clusters", Type: field.TypeJSON, Nullable: true},
+		{Name: "clients", Type: field.TypeJSON, Nullable: true},
+		{Name: "users", Type: field.TypeJSON, Nullable: true},
+		{Name: "meta_ob_cluster", Type: field.TypeString, Default: ""},
+		{Name: "meta_database", Type: field.TypeString, Default: ""},
+		{Name: "meta_user", Type: field.TypeString, Default: ""},
+		{Name: "meta_encrypted_password", Type: field.TypeString, Size: 1024, Default: ""},
+		{Name: "url_template_v1", Type: field.TypeString, Size: 1024, Default: ""},
+		{Name: "url_template_v2", Type: field.TypeString, Size: 1024, Default: ""},
+	}
+	// ObProxyGroupsTable holds the schema information for the "ob_proxy_groups" table.
+	ObProxyGroupsTable = &schema.Table{
+		Name:       "ob_proxy_groups",
+		Columns:    ObProxyGroupsColumns,
+		PrimaryKey: []*schema.Column{ObProxyGroupsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "obproxygroup_namespace_name",
 				Unique:  true,
-				Columns: []*schema.Column{ObClustersColumns[3], ObClustersColumns[4]},
+				Columns: []*schema.Column{ObProxyGroupsColumns[3], ObProxyGroupsColumns[4]},
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "subscriber", Type: field.TypeString},
+		{Name: "url", Type: field.TypeString, Size: 1024},
+		{Name: "event", Type: field.TypeString},
+		{Name: "namespace", Type: field.TypeString, Default: "default"},
+		{Name: "ob_cluster", Type: field.TypeString},
+		{Name: "ob_cluster_id", Type: field.TypeInt64, Default: 0},
+		{Name: "payload", Type: field.TypeString, Size: 65536},
+		{Name: "status", Type: field.TypeString},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_time", Type: field.TypeTime},
+		{Name: "last_status_code", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Size: 1024, Default: ""},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_status_next_attempt_time",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[10], WebhookDeliveriesColumns[12]},
+			},
+			{
+				Name:    "webhookdelivery_namespace_subscriber",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[6], WebhookDeliveriesColumns[3]},
+			},
+			{
+				Name:    "webhookdelivery_update_time",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[2]},
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AuditLogsTable,
 		ObClustersTable,
+		ObClusterGroupsTable,
+		ObProxiesTable,
+		ObProxyGroupsTable,
+		WebhookDeliveriesTable,
 	}
 )
 
@@ -1,4 +1,4 @@
-// Code generated by entc, DO NOT EDIT.
+// Code generated by ent, DO NOT EDIT.
 
 package ent
 
@@ -9,10 +9,15 @@ import (
 	"sync"
 	"time"
 
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/oceanbase/configserver/ent/auditlog"
 	"github.com/oceanbase/configserver/ent/obcluster"
+	"github.com/oceanbase/configserver/ent/obclustergroup"
+	"github.com/oceanbase/configserver/ent/obproxy"
+	"github.com/oceanbase/configserver/ent/obproxygroup"
 	"github.com/oceanbase/configserver/ent/predicate"
-
-	"entgo.io/ent"
+	"github.com/oceanbase/configserver/ent/webhookdelivery"
 )
 
 const (
@@ -24,39 +29,53 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeObCluster = "ObCluster"
+	TypeAuditLog        = "AuditLog"
+	TypeObCluster       = "ObCluster"
+	TypeObClusterGroup  = "ObClusterGroup"
+	TypeObProxy         = "ObProxy"
+	TypeObProxyGroup    = "ObProxyGroup"
+	TypeWebhookDelivery = "WebhookDelivery"
 )
 
-// ObClusterMutation represents an operation that mutates the ObCluster nodes in the graph.
-type ObClusterMutation struct {
+// AuditLogMutation represents an operation that mutates the AuditLog nodes in the graph.
+type AuditLogMutation struct {
 	config
 	op               Op
 	typ              string
 	id               *int
 	create_time      *time.Time
-	update_time      *time.Time
-	name             *string
+	namespace        *string
+	action           *string
+	operation        *string
+	entity           *string
+	entity_name      *string
+	ob_cluster       *string
 	ob_cluster_id    *int64
 	addob_cluster_id *int64
-	_type            *string
-	rootservice_json *string
+	obproxy_group    *string
+	remote_ip        *string
+	principal        *string
+	user_agent       *string
+	trace_id         *string
+	before_json      *string
+	after_json       *string
 	clearedFields    map[string]struct{}
 	done             bool
-	oldValue         func(context.Context) (*ObCluster, error)
-	predicates       []predicate.ObCluster
+	oldValue         func(context.Context) (*AuditLog, error)
+	predicates       []predicate.AuditLog
 }
 
-var _ ent.Mutation = (*ObClusterMutation)(nil)
+var _ ent.Mutation = (*AuditLogMutation)(nil)
 
-// obclusterOption allows management of the mutation configuration using functional options.
-type obclusterOption func(*ObClusterMutation)
+// auditlogOption allows management of the mutation configuration using functional options.
+type auditlogOption func(*AuditLogMutation)
 
-// newObClusterMutation creates new mutation for the ObCluster entity.
-func newObClusterMutation(c config, op Op, opts ...obclusterOption) *ObClusterMutation {
-	m := &ObClusterMutation{
+// newAuditLogMutation creates new mutation for the AuditLog entity.
+func newAuditLogMutation(c config, op Op, opts ...auditlogOption) *AuditLogMutation {
+	m := &AuditLogMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeObCluster,
+		typ:           TypeAuditLog,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -65,20 +84,20 @@ func newObClusterMutation(c config, op Op, opts ...obclusterOption) *ObClusterMu
 	return m
 }
 
-// withObClusterID sets the ID field of the mutation.
-func withObClusterID(id int) obclusterOption {
-	return func(m *ObClusterMutation) {
+// withAuditLogID sets the ID field of the mutation.
+func withAuditLogID(id int) auditlogOption {
+	return func(m *AuditLogMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ObCluster
+			value *AuditLog
 		)
-		m.oldValue = func(ctx context.Context) (*ObCluster, error) {
+		m.oldValue = func(ctx context.Context) (*AuditLog, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ObCluster.Get(ctx, id)
+					value, err = m.Client().AuditLog.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -87,10 +106,10 @@ func withObClusterID(id int) obclusterOption {
 	}
 }
 
-// withObCluster sets the old ObCluster of the mutation.
-func withObCluster(node *ObCluster) obclusterOption {
-	return func(m *ObClusterMutation) {
-		m.oldValue = func(context.Context) (*ObCluster, error) {
+// withAuditLog sets the old AuditLog of the mutation.
+func withAuditLog(node *AuditLog) auditlogOption {
+	return func(m *AuditLogMutation) {
+		m.oldValue = func(context.Context) (*AuditLog, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -99,7 +118,7 @@ func withObCluster(node *ObCluster) obclusterOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ObClusterMutation) Client() *Client {
+func (m AuditLogMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -107,7 +126,7 @@ func (m ObClusterMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ObClusterMutation) Tx() (*Tx, error) {
+func (m AuditLogMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -118,7 +137,7 @@ func (m ObClusterMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ObClusterMutation) ID() (id int, exists bool) {
+func (m *AuditLogMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -129,7 +148,7 @@ func (m *ObClusterMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ObClusterMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AuditLogMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -138,19 +157,19 @@ func (m *ObClusterMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ObCluster.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuditLog.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *ObClusterMutation) SetCreateTime(t time.Time) {
+func (m *AuditLogMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *ObClusterMutation) CreateTime() (r time.Time, exists bool) {
+func (m *AuditLogMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -158,10 +177,10 @@ func (m *ObClusterMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the ObCluster entity.
-// If the ObCluster object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ObClusterMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *AuditLogMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -176,247 +195,5011 @@ func (m *ObClusterMutation) OldCreateTime(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *ObClusterMutation) ResetCreateTime() {
+func (m *AuditLogMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (m *ObClusterMutation) SetUpdateTime(t time.Time) {
-	m.update_time = &t
+// SetNamespace sets the "namespace" field.
+func (m *AuditLogMutation) SetNamespace(s string) {
+	m.namespace = &s
 }
 
-// UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *ObClusterMutation) UpdateTime() (r time.Time, exists bool) {
-	v := m.update_time
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *AuditLogMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the ObCluster entity.
-// If the ObCluster object wasn't provided to the builder, the object is fetched from the database.
+// OldNamespace returns the old "namespace" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ObClusterMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *AuditLogMutation) OldNamespace(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *AuditLogMutation) ResetNamespace() {
+	m.namespace = nil
+}
+
+// SetAction sets the "action" field.
+func (m *AuditLogMutation) SetAction(s string) {
+	m.action = &s
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *AuditLogMutation) Action() (r string, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldAction(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *AuditLogMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetOperation sets the "operation" field.
+func (m *AuditLogMutation) SetOperation(s string) {
+	m.operation = &s
+}
+
+// Operation returns the value of the "operation" field in the mutation.
+func (m *AuditLogMutation) Operation() (r string, exists bool) {
+	v := m.operation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOperation returns the old "operation" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldOperation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOperation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOperation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOperation: %w", err)
+	}
+	return oldValue.Operation, nil
+}
+
+// ResetOperation resets all changes to the "operation" field.
+func (m *AuditLogMutation) ResetOperation() {
+	m.operation = nil
+}
+
+// SetEntity sets the "entity" field.
+func (m *AuditLogMutation) SetEntity(s string) {
+	m.entity = &s
+}
+
+// Entity returns the value of the "entity" field in the mutation.
+func (m *AuditLogMutation) Entity() (r string, exists bool) {
+	v := m.entity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntity returns the old "entity" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldEntity(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntity: %w", err)
+	}
+	return oldValue.Entity, nil
+}
+
+// ResetEntity resets all changes to the "entity" field.
+func (m *AuditLogMutation) ResetEntity() {
+	m.entity = nil
+}
+
+// SetEntityName sets the "entity_name" field.
+func (m *AuditLogMutation) SetEntityName(s string) {
+	m.entity_name = &s
+}
+
+// EntityName returns the value of the "entity_name" field in the mutation.
+func (m *AuditLogMutation) EntityName() (r string, exists bool) {
+	v := m.entity_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntityName returns the old "entity_name" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldEntityName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntityName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntityName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntityName: %w", err)
+	}
+	return oldValue.EntityName, nil
+}
+
+// ResetEntityName resets all changes to the "entity_name" field.
+func (m *AuditLogMutation) ResetEntityName() {
+	m.entity_name = nil
+}
+
+// SetObCluster sets the "ob_cluster" field.
+func (m *AuditLogMutation) SetObCluster(s string) {
+	m.ob_cluster = &s
+}
+
+// ObCluster returns the value of the "ob_cluster" field in the mutation.
+func (m *AuditLogMutation) ObCluster() (r string, exists bool) {
+	v := m.ob_cluster
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldObCluster returns the old "ob_cluster" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldObCluster(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldObCluster is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldObCluster requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldObCluster: %w", err)
+	}
+	return oldValue.ObCluster, nil
+}
+
+// ResetObCluster resets all changes to the "ob_cluster" field.
+func (m *AuditLogMutation) ResetObCluster() {
+	m.ob_cluster = nil
+}
+
+// SetObClusterID sets the "ob_cluster_id" field.
+func (m *AuditLogMutation) SetObClusterID(i int64) {
+	m.ob_cluster_id = &i
+	m.addob_cluster_id = nil
+}
+
+// ObClusterID returns the value of the "ob_cluster_id" field in the mutation.
+func (m *AuditLogMutation) ObClusterID() (r int64, exists bool) {
+	v := m.ob_cluster_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldObClusterID returns the old "ob_cluster_id" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldObClusterID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldObClusterID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldObClusterID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldObClusterID: %w", err)
+	}
+	return oldValue.ObClusterID, nil
+}
+
+// AddObClusterID adds i to the "ob_cluster_id" field.
+func (m *AuditLogMutation) AddObClusterID(i int64) {
+	if m.addob_cluster_id != nil {
+		*m.addob_cluster_id += i
+	} else {
+		m.addob_cluster_id = &i
+	}
+}
+
+// AddedObClusterID returns the value that was added to the "ob_cluster_id" field in this mutation.
+func (m *AuditLogMutation) AddedObClusterID() (r int64, exists bool) {
+	v := m.addob_cluster_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetObClusterID resets all changes to the "ob_cluster_id" field.
+func (m *AuditLogMutation) ResetObClusterID() {
+	m.ob_cluster_id = nil
+	m.addob_cluster_id = nil
+}
+
+// SetObproxyGroup sets the "obproxy_group" field.
+func (m *AuditLogMutation) SetObproxyGroup(s string) {
+	m.obproxy_group = &s
+}
+
+// ObproxyGroup returns the value of the "obproxy_group" field in the mutation.
+func (m *AuditLogMutation) ObproxyGroup() (r string, exists bool) {
+	v := m.obproxy_group
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldObproxyGroup returns the old "obproxy_group" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldObproxyGroup(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldObproxyGroup is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldObproxyGroup requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldObproxyGroup: %w", err)
+	}
+	return oldValue.ObproxyGroup, nil
+}
+
+// ResetObproxyGroup resets all changes to the "obproxy_group" field.
+func (m *AuditLogMutation) ResetObproxyGroup() {
+	m.obproxy_group = nil
+}
+
+// SetRemoteIP sets the "remote_ip" field.
+func (m *AuditLogMutation) SetRemoteIP(s string) {
+	m.remote_ip = &s
+}
+
+// RemoteIP returns the value of the "remote_ip" field in the mutation.
+func (m *AuditLogMutation) RemoteIP() (r string, exists bool) {
+	v := m.remote_ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRemoteIP returns the old "remote_ip" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldRemoteIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRemoteIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRemoteIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRemoteIP: %w", err)
+	}
+	return oldValue.RemoteIP, nil
+}
+
+// ResetRemoteIP resets all changes to the "remote_ip" field.
+func (m *AuditLogMutation) ResetRemoteIP() {
+	m.remote_ip = nil
+}
+
+// SetPrincipal sets the "principal" field.
+func (m *AuditLogMutation) SetPrincipal(s string) {
+	m.principal = &s
+}
+
+// Principal returns the value of the "principal" field in the mutation.
+func (m *AuditLogMutation) Principal() (r string, exists bool) {
+	v := m.principal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrincipal returns the old "principal" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldPrincipal(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrincipal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrincipal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrincipal: %w", err)
+	}
+	return oldValue.Principal, nil
+}
+
+// ResetPrincipal resets all changes to the "principal" field.
+func (m *AuditLogMutation) ResetPrincipal() {
+	m.principal = nil
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (m *AuditLogMutation) SetUserAgent(s string) {
+	m.user_agent = &s
+}
+
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *AuditLogMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserAgent returns the old "user_agent" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldUserAgent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+	}
+	return oldValue.UserAgent, nil
+}
+
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *AuditLogMutation) ResetUserAgent() {
+	m.user_agent = nil
+}
+
+// SetTraceID sets the "trace_id" field.
+func (m *AuditLogMutation) SetTraceID(s string) {
+	m.trace_id = &s
+}
+
+// TraceID returns the value of the "trace_id" field in the mutation.
+func (m *AuditLogMutation) TraceID() (r string, exists bool) {
+	v := m.trace_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTraceID returns the old "trace_id" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldTraceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTraceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTraceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTraceID: %w", err)
+	}
+	return oldValue.TraceID, nil
+}
+
+// ResetTraceID resets all changes to the "trace_id" field.
+func (m *AuditLogMutation) ResetTraceID() {
+	m.trace_id = nil
+}
+
+// SetBeforeJSON sets the "before_json" field.
+func (m *AuditLogMutation) SetBeforeJSON(s string) {
+	m.before_json = &s
+}
+
+// BeforeJSON returns the value of the "before_json" field in the mutation.
+func (m *AuditLogMutation) BeforeJSON() (r string, exists bool) {
+	v := m.before_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBeforeJSON returns the old "before_json" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldBeforeJSON(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBeforeJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBeforeJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBeforeJSON: %w", err)
+	}
+	return oldValue.BeforeJSON, nil
+}
+
+// ClearBeforeJSON clears the value of the "before_json" field.
+func (m *AuditLogMutation) ClearBeforeJSON() {
+	m.before_json = nil
+	m.clearedFields[auditlog.FieldBeforeJSON] = struct{}{}
+}
+
+// BeforeJSONCleared returns if the "before_json" field was cleared in this mutation.
+func (m *AuditLogMutation) BeforeJSONCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldBeforeJSON]
+	return ok
+}
+
+// ResetBeforeJSON resets all changes to the "before_json" field.
+func (m *AuditLogMutation) ResetBeforeJSON() {
+	m.before_json = nil
+	delete(m.clearedFields, auditlog.FieldBeforeJSON)
+}
+
+// SetAfterJSON sets the "after_json" field.
+func (m *AuditLogMutation) SetAfterJSON(s string) {
+	m.after_json = &s
+}
+
+// AfterJSON returns the value of the "after_json" field in the mutation.
+func (m *AuditLogMutation) AfterJSON() (r string, exists bool) {
+	v := m.after_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAfterJSON returns the old "after_json" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldAfterJSON(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAfterJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAfterJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAfterJSON: %w", err)
+	}
+	return oldValue.AfterJSON, nil
+}
+
+// ClearAfterJSON clears the value of the "after_json" field.
+func (m *AuditLogMutation) ClearAfterJSON() {
+	m.after_json = nil
+	m.clearedFields[auditlog.FieldAfterJSON] = struct{}{}
+}
+
+// AfterJSONCleared returns if the "after_json" field was cleared in this mutation.
+func (m *AuditLogMutation) AfterJSONCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldAfterJSON]
+	return ok
+}
+
+// ResetAfterJSON resets all changes to the "after_json" field.
+func (m *AuditLogMutation) ResetAfterJSON() {
+	m.after_json = nil
+	delete(m.clearedFields, auditlog.FieldAfterJSON)
+}
+
+// Where appends a list predicates to the AuditLogMutation builder.
+func (m *AuditLogMutation) Where(ps ...predicate.AuditLog) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AuditLogMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AuditLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditLog, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AuditLogMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AuditLogMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AuditLog).
+func (m *AuditLogMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuditLogMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.create_time != nil {
+		fields = append(fields, auditlog.FieldCreateTime)
+	}
+	if m.namespace != nil {
+		fields = append(fields, auditlog.FieldNamespace)
+	}
+	if m.action != nil {
+		fields = append(fields, auditlog.FieldAction)
+	}
+	if m.operation != nil {
+		fields = append(fields, auditlog.FieldOperation)
+	}
+	if m.entity != nil {
+		fields = append(fields, auditlog.FieldEntity)
+	}
+	if m.entity_name != nil {
+		fields = append(fields, auditlog.FieldEntityName)
+	}
+	if m.ob_cluster != nil {
+		fields = append(fields, auditlog.FieldObCluster)
+	}
+	if m.ob_cluster_id != nil {
+		fields = append(fields, auditlog.FieldObClusterID)
+	}
+	if m.obproxy_group != nil {
+		fields = append(fields, auditlog.FieldObproxyGroup)
+	}
+	if m.remote_ip != nil {
+		fields = append(fields, auditlog.FieldRemoteIP)
+	}
+	if m.principal != nil {
+		fields = append(fields, auditlog.FieldPrincipal)
+	}
+	if m.user_agent != nil {
+		fields = append(fields, auditlog.FieldUserAgent)
+	}
+	if m.trace_id != nil {
+		fields = append(fields, auditlog.FieldTraceID)
+	}
+	if m.before_json != nil {
+		fields = append(fields, auditlog.FieldBeforeJSON)
+	}
+	if m.after_json != nil {
+		fields = append(fields, auditlog.FieldAfterJSON)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuditLogMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case auditlog.FieldCreateTime:
+		return m.CreateTime()
+	case auditlog.FieldNamespace:
+		return m.Namespace()
+	case auditlog.FieldAction:
+		return m.Action()
+	case auditlog.FieldOperation:
+		return m.Operation()
+	case auditlog.FieldEntity:
+		return m.Entity()
+	case auditlog.FieldEntityName:
+		return m.EntityName()
+	case auditlog.FieldObCluster:
+		return m.ObCluster()
+	case auditlog.FieldObClusterID:
+		return m.ObClusterID()
+	case auditlog.FieldObproxyGroup:
+		return m.ObproxyGroup()
+	case auditlog.FieldRemoteIP:
+		return m.RemoteIP()
+	case auditlog.FieldPrincipal:
+		return m.Principal()
+	case auditlog.FieldUserAgent:
+		return m.UserAgent()
+	case auditlog.FieldTraceID:
+		return m.TraceID()
+	case auditlog.FieldBeforeJSON:
+		return m.BeforeJSON()
+	case auditlog.FieldAfterJSON:
+		return m.AfterJSON()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuditLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case auditlog.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case auditlog.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case auditlog.FieldAction:
+		return m.OldAction(ctx)
+	case auditlog.FieldOperation:
+		return m.OldOperation(ctx)
+	case auditlog.FieldEntity:
+		return m.OldEntity(ctx)
+	case auditlog.FieldEntityName:
+		return m.OldEntityName(ctx)
+	case auditlog.FieldObCluster:
+		return m.OldObCluster(ctx)
+	case auditlog.FieldObClusterID:
+		return m.OldObClusterID(ctx)
+	case auditlog.FieldObproxyGroup:
+		return m.OldObproxyGroup(ctx)
+	case auditlog.FieldRemoteIP:
+		return m.OldRemoteIP(ctx)
+	case auditlog.FieldPrincipal:
+		return m.OldPrincipal(ctx)
+	case auditlog.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case auditlog.FieldTraceID:
+		return m.OldTraceID(ctx)
+	case auditlog.FieldBeforeJSON:
+		return m.OldBeforeJSON(ctx)
+	case auditlog.FieldAfterJSON:
+		return m.OldAfterJSON(ctx)
+	}
+	return nil, fmt.Errorf("unknown AuditLog field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditLogMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case auditlog.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case auditlog.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case auditlog.FieldAction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case auditlog.FieldOperation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOperation(v)
+		return nil
+	case auditlog.FieldEntity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntity(v)
+		return nil
+	case auditlog.FieldEntityName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntityName(v)
+		return nil
+	case auditlog.FieldObCluster:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetObCluster(v)
+		return nil
+	case auditlog.FieldObClusterID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetObClusterID(v)
+		return nil
+	case auditlog.FieldObproxyGroup:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetObproxyGroup(v)
+		return nil
+	case auditlog.FieldRemoteIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemoteIP(v)
+		return nil
+	case auditlog.FieldPrincipal:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrincipal(v)
+		return nil
+	case auditlog.FieldUserAgent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAgent(v)
+		return nil
+	case auditlog.FieldTraceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTraceID(v)
+		return nil
+	case auditlog.FieldBeforeJSON:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBeforeJSON(v)
+		return nil
+	case auditlog.FieldAfterJSON:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAfterJSON(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuditLogMutation) AddedFields() []string {
+	var fields []string
+	if m.addob_cluster_id != nil {
+		fields = append(fields, auditlog.FieldObClusterID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuditLogMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case auditlog.FieldObClusterID:
+		return m.AddedObClusterID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditLogMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case auditlog.FieldObClusterID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddObClusterID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuditLogMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(auditlog.FieldBeforeJSON) {
+		fields = append(fields, auditlog.FieldBeforeJSON)
+	}
+	if m.FieldCleared(auditlog.FieldAfterJSON) {
+		fields = append(fields, auditlog.FieldAfterJSON)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuditLogMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AuditLogMutation) ClearField(name string) error {
+	switch name {
+	case auditlog.FieldBeforeJSON:
+		m.ClearBeforeJSON()
+		return nil
+	case auditlog.FieldAfterJSON:
+		m.ClearAfterJSON()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AuditLogMutation) ResetField(name string) error {
+	switch name {
+	case auditlog.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case auditlog.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case auditlog.FieldAction:
+		m.ResetAction()
+		return nil
+	case auditlog.FieldOperation:
+		m.ResetOperation()
+		return nil
+	case auditlog.FieldEntity:
+		m.ResetEntity()
+		return nil
+	case auditlog.FieldEntityName:
+		m.ResetEntityName()
+		return nil
+	case auditlog.FieldObCluster:
+		m.ResetObCluster()
+		return nil
+	case auditlog.FieldObClusterID:
+		m.ResetObClusterID()
+		return nil
+	case auditlog.FieldObproxyGroup:
+		m.ResetObproxyGroup()
+		return nil
+	case auditlog.FieldRemoteIP:
+		m.ResetRemoteIP()
+		return nil
+	case auditlog.FieldPrincipal:
+		m.ResetPrincipal()
+		return nil
+	case auditlog.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	case auditlog.FieldTraceID:
+		m.ResetTraceID()
+		return nil
+	case auditlog.FieldBeforeJSON:
+		m.ResetBeforeJSON()
+		return nil
+	case auditlog.FieldAfterJSON:
+		m.ResetAfterJSON()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AuditLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AuditLogMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AuditLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AuditLogMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AuditLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AuditLogMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AuditLogMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditLog unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuditLogMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditLog edge %s", name)
+}
+
+// ObClusterMutation represents an operation that mutates the ObCluster nodes in the graph.
+type ObClusterMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	create_time      *time.Time
+	update_time      *time.Time
+	namespace        *string
+	name             *string
+	ob_cluster_id    *int64
+	addob_cluster_id *int64
+	_type            *string
+	rootservice_json *string
+	deleted_at       *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*ObCluster, error)
+	predicates       []predicate.ObCluster
+}
+
+var _ ent.Mutation = (*ObClusterMutation)(nil)
+
+// obclusterOption allows management of the mutation configuration using functional options.
+type obclusterOption func(*ObClusterMutation)
+
+// newObClusterMutation creates new mutation for the ObCluster entity.
+func newObClusterMutation(c config, op Op, opts ...obclusterOption) *ObClusterMutation {
+	m := &ObClusterMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeObCluster,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withObClusterID sets the ID field of the mutation.
+func withObClusterID(id int) obclusterOption {
+	return func(m *ObClusterMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ObCluster
+		)
+		m.oldValue = func(ctx context.Context) (*ObCluster, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ObCluster.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withObCluster sets the old ObCluster of the mutation.
+func withObCluster(node *ObCluster) obclusterOption {
+	return func(m *ObClusterMutation) {
+		m.oldValue = func(context.Context) (*ObCluster, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ObClusterMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ObClusterMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ObClusterMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ObClusterMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ObCluster.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ObClusterMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ObClusterMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ObCluster entity.
+// If the ObCluster object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObClusterMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ObClusterMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ObClusterMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ObClusterMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ObCluster entity.
+// If the ObCluster object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObClusterMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ObClusterMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *ObClusterMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *ObClusterMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the ObCluster entity.
+// If the ObCluster object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObClusterMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *ObClusterMutation) ResetNamespace() {
+	m.namespace = nil
+}
+
+// SetName sets the "name" field.
+func (m *ObClusterMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ObClusterMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ObCluster entity.
+// If the ObCluster object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObClusterMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ObClusterMutation) ResetName() {
+	m.name = nil
+}
+
+// SetObClusterID sets the "ob_cluster_id" field.
+func (m *ObClusterMutation) SetObClusterID(i int64) {
+	m.ob_cluster_id = &i
+	m.addob_cluster_id = nil
+}
+
+// ObClusterID returns the value of the "ob_cluster_id" field in the mutation.
+func (m *ObClusterMutation) ObClusterID() (r int64, exists bool) {
+	v := m.ob_cluster_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldObClusterID returns the old "ob_cluster_id" field's value of the ObCluster entity.
+// If the ObCluster object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObClusterMutation) OldObClusterID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldObClusterID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldObClusterID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldObClusterID: %w", err)
+	}
+	return oldValue.ObClusterID, nil
+}
+
+// AddObClusterID adds i to the "ob_cluster_id" field.
+func (m *ObClusterMutation) AddObClusterID(i int64) {
+	if m.addob_cluster_id != nil {
+		*m.addob_cluster_id += i
+	} else {
+		m.addob_cluster_id = &i
+	}
+}
+
+// AddedObClusterID returns the value that was added to the "ob_cluster_id" field in this mutation.
+func (m *ObClusterMutation) AddedObClusterID() (r int64, exists bool) {
+	v := m.addob_cluster_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetObClusterID resets all changes to the "ob_cluster_id" field.
+func (m *ObClusterMutation) ResetObClusterID() {
+	m.ob_cluster_id = nil
+	m.addob_cluster_id = nil
+}
+
+// SetType sets the "type" field.
+func (m *ObClusterMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *ObClusterMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the ObCluster entity.
+// If the ObCluster object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObClusterMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *ObClusterMutation) ResetType() {
+	m._type = nil
+}
+
+// SetRootserviceJSON sets the "rootservice_json" field.
+func (m *ObClusterMutation) SetRootserviceJSON(s string) {
+	m.rootservice_json = &s
+}
+
+// RootserviceJSON returns the value of the "rootservice_json" field in the mutation.
+func (m *ObClusterMutation) RootserviceJSON() (r string, exists bool) {
+	v := m.rootservice_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRootserviceJSON returns the old "rootservice_json" field's value of the ObCluster entity.
+// If the ObCluster object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObClusterMutation) OldRootserviceJSON(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRootserviceJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRootserviceJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRootserviceJSON: %w", err)
+	}
+	return oldValue.RootserviceJSON, nil
+}
+
+// ResetRootserviceJSON resets all changes to the "rootservice_json" field.
+func (m *ObClusterMutation) ResetRootserviceJSON() {
+	m.rootservice_json = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *ObClusterMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *ObClusterMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the ObCluster entity.
+// If the ObCluster object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObClusterMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *ObClusterMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[obcluster.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *ObClusterMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[obcluster.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *ObClusterMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, obcluster.FieldDeletedAt)
+}
+
+// Where appends a list predicates to the ObClusterMutation builder.
+func (m *ObClusterMutation) Where(ps ...predicate.ObCluster) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ObClusterMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ObClusterMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ObCluster, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ObClusterMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ObClusterMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ObCluster).
+func (m *ObClusterMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ObClusterMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.create_time != nil {
+		fields = append(fields, obcluster.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, obcluster.FieldUpdateTime)
+	}
+	if m.namespace != nil {
+		fields = append(fields, obcluster.FieldNamespace)
+	}
+	if m.name != nil {
+		fields = append(fields, obcluster.FieldName)
+	}
+	if m.ob_cluster_id != nil {
+		fields = append(fields, obcluster.FieldObClusterID)
+	}
+	if m._type != nil {
+		fields = append(fields, obcluster.FieldType)
+	}
+	if m.rootservice_json != nil {
+		fields = append(fields, obcluster.FieldRootserviceJSON)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, obcluster.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ObClusterMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case obcluster.FieldCreateTime:
+		return m.CreateTime()
+	case obcluster.FieldUpdateTime:
+		return m.UpdateTime()
+	case obcluster.FieldNamespace:
+		return m.Namespace()
+	case obcluster.FieldName:
+		return m.Name()
+	case obcluster.FieldObClusterID:
+		return m.ObClusterID()
+	case obcluster.FieldType:
+		return m.GetType()
+	case obcluster.FieldRootserviceJSON:
+		return m.RootserviceJSON()
+	case obcluster.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ObClusterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case obcluster.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case obcluster.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case obcluster.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case obcluster.FieldName:
+		return m.OldName(ctx)
+	case obcluster.FieldObClusterID:
+		return m.OldObClusterID(ctx)
+	case obcluster.FieldType:
+		return m.OldType(ctx)
+	case obcluster.FieldRootserviceJSON:
+		return m.OldRootserviceJSON(ctx)
+	case obcluster.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ObCluster field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ObClusterMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case obcluster.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case obcluster.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case obcluster.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case obcluster.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case obcluster.FieldObClusterID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetObClusterID(v)
+		return nil
+	case obcluster.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case obcluster.FieldRootserviceJSON:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRootserviceJSON(v)
+		return nil
+	case obcluster.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ObCluster field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ObClusterMutation) AddedFields() []string {
+	var fields []string
+	if m.addob_cluster_id != nil {
+		fields = append(fields, obcluster.FieldObClusterID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ObClusterMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case obcluster.FieldObClusterID:
+		return m.AddedObClusterID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ObClusterMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case obcluster.FieldObClusterID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddObClusterID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ObCluster numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ObClusterMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(obcluster.FieldDeletedAt) {
+		fields = append(fields, obcluster.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ObClusterMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ObClusterMutation) ClearField(name string) error {
+	switch name {
+	case obcluster.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ObCluster nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ObClusterMutation) ResetField(name string) error {
+	switch name {
+	case obcluster.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case obcluster.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case obcluster.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case obcluster.FieldName:
+		m.ResetName()
+		return nil
+	case obcluster.FieldObClusterID:
+		m.ResetObClusterID()
+		return nil
+	case obcluster.FieldType:
+		m.ResetType()
+		return nil
+	case obcluster.FieldRootserviceJSON:
+		m.ResetRootserviceJSON()
+		return nil
+	case obcluster.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ObCluster field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ObClusterMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ObClusterMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ObClusterMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ObClusterMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ObClusterMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ObClusterMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ObClusterMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ObCluster unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ObClusterMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ObCluster edge %s", name)
+}
+
+// ObClusterGroupMutation represents an operation that mutates the ObClusterGroup nodes in the graph.
+type ObClusterGroupMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	create_time           *time.Time
+	update_time           *time.Time
+	namespace             *string
+	name                  *string
+	primary_cluster_id    *int64
+	addprimary_cluster_id *int64
+	clearedFields         map[string]struct{}
+	done                  bool
+	oldValue              func(context.Context) (*ObClusterGroup, error)
+	predicates            []predicate.ObClusterGroup
+}
+
+var _ ent.Mutation = (*ObClusterGroupMutation)(nil)
+
+// obclustergroupOption allows management of the mutation configuration using functional options.
+type obclustergroupOption func(*ObClusterGroupMutation)
+
+// newObClusterGroupMutation creates new mutation for the ObClusterGroup entity.
+func newObClusterGroupMutation(c config, op Op, opts ...obclustergroupOption) *ObClusterGroupMutation {
+	m := &ObClusterGroupMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeObClusterGroup,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withObClusterGroupID sets the ID field of the mutation.
+func withObClusterGroupID(id int) obclustergroupOption {
+	return func(m *ObClusterGroupMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ObClusterGroup
+		)
+		m.oldValue = func(ctx context.Context) (*ObClusterGroup, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ObClusterGroup.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withObClusterGroup sets the old ObClusterGroup of the mutation.
+func withObClusterGroup(node *ObClusterGroup) obclustergroupOption {
+	return func(m *ObClusterGroupMutation) {
+		m.oldValue = func(context.Context) (*ObClusterGroup, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ObClusterGroupMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ObClusterGroupMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ObClusterGroupMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ObClusterGroupMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ObClusterGroup.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ObClusterGroupMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ObClusterGroupMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ObClusterGroup entity.
+// If the ObClusterGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObClusterGroupMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ObClusterGroupMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ObClusterGroupMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ObClusterGroupMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ObClusterGroup entity.
+// If the ObClusterGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObClusterGroupMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ObClusterGroupMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *ObClusterGroupMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *ObClusterGroupMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the ObClusterGroup entity.
+// If the ObClusterGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObClusterGroupMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *ObClusterGroupMutation) ResetNamespace() {
+	m.namespace = nil
+}
+
+// SetName sets the "name" field.
+func (m *ObClusterGroupMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ObClusterGroupMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ObClusterGroup entity.
+// If the ObClusterGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObClusterGroupMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ObClusterGroupMutation) ResetName() {
+	m.name = nil
+}
+
+// SetPrimaryClusterID sets the "primary_cluster_id" field.
+func (m *ObClusterGroupMutation) SetPrimaryClusterID(i int64) {
+	m.primary_cluster_id = &i
+	m.addprimary_cluster_id = nil
+}
+
+// PrimaryClusterID returns the value of the "primary_cluster_id" field in the mutation.
+func (m *ObClusterGroupMutation) PrimaryClusterID() (r int64, exists bool) {
+	v := m.primary_cluster_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrimaryClusterID returns the old "primary_cluster_id" field's value of the ObClusterGroup entity.
+// If the ObClusterGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObClusterGroupMutation) OldPrimaryClusterID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrimaryClusterID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrimaryClusterID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrimaryClusterID: %w", err)
+	}
+	return oldValue.PrimaryClusterID, nil
+}
+
+// AddPrimaryClusterID adds i to the "primary_cluster_id" field.
+func (m *ObClusterGroupMutation) AddPrimaryClusterID(i int64) {
+	if m.addprimary_cluster_id != nil {
+		*m.addprimary_cluster_id += i
+	} else {
+		m.addprimary_cluster_id = &i
+	}
+}
+
+// AddedPrimaryClusterID returns the value that was added to the "primary_cluster_id" field in this mutation.
+func (m *ObClusterGroupMutation) AddedPrimaryClusterID() (r int64, exists bool) {
+	v := m.addprimary_cluster_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPrimaryClusterID resets all changes to the "primary_cluster_id" field.
+func (m *ObClusterGroupMutation) ResetPrimaryClusterID() {
+	m.primary_cluster_id = nil
+	m.addprimary_cluster_id = nil
+}
+
+// Where appends a list predicates to the ObClusterGroupMutation builder.
+func (m *ObClusterGroupMutation) Where(ps ...predicate.ObClusterGroup) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ObClusterGroupMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ObClusterGroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ObClusterGroup, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ObClusterGroupMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ObClusterGroupMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ObClusterGroup).
+func (m *ObClusterGroupMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ObClusterGroupMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.create_time != nil {
+		fields = append(fields, obclustergroup.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, obclustergroup.FieldUpdateTime)
+	}
+	if m.namespace != nil {
+		fields = append(fields, obclustergroup.FieldNamespace)
+	}
+	if m.name != nil {
+		fields = append(fields, obclustergroup.FieldName)
+	}
+	if m.primary_cluster_id != nil {
+		fields = append(fields, obclustergroup.FieldPrimaryClusterID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ObClusterGroupMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case obclustergroup.FieldCreateTime:
+		return m.CreateTime()
+	case obclustergroup.FieldUpdateTime:
+		return m.UpdateTime()
+	case obclustergroup.FieldNamespace:
+		return m.Namespace()
+	case obclustergroup.FieldName:
+		return m.Name()
+	case obclustergroup.FieldPrimaryClusterID:
+		return m.PrimaryClusterID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ObClusterGroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case obclustergroup.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case obclustergroup.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case obclustergroup.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case obclustergroup.FieldName:
+		return m.OldName(ctx)
+	case obclustergroup.FieldPrimaryClusterID:
+		return m.OldPrimaryClusterID(ctx)
+	}
+	return nil, fmt.Errorf("unknown ObClusterGroup field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ObClusterGroupMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case obclustergroup.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case obclustergroup.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case obclustergroup.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case obclustergroup.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case obclustergroup.FieldPrimaryClusterID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrimaryClusterID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ObClusterGroup field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ObClusterGroupMutation) AddedFields() []string {
+	var fields []string
+	if m.addprimary_cluster_id != nil {
+		fields = append(fields, obclustergroup.FieldPrimaryClusterID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ObClusterGroupMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case obclustergroup.FieldPrimaryClusterID:
+		return m.AddedPrimaryClusterID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ObClusterGroupMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case obclustergroup.FieldPrimaryClusterID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPrimaryClusterID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ObClusterGroup numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ObClusterGroupMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ObClusterGroupMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ObClusterGroupMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ObClusterGroup nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ObClusterGroupMutation) ResetField(name string) error {
+	switch name {
+	case obclustergroup.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case obclustergroup.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case obclustergroup.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case obclustergroup.FieldName:
+		m.ResetName()
+		return nil
+	case obclustergroup.FieldPrimaryClusterID:
+		m.ResetPrimaryClusterID()
+		return nil
+	}
+	return fmt.Errorf("unknown ObClusterGroup field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ObClusterGroupMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ObClusterGroupMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ObClusterGroupMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ObClusterGroupMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ObClusterGroupMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ObClusterGroupMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ObClusterGroupMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ObClusterGroup unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ObClusterGroupMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ObClusterGroup edge %s", name)
+}
+
+// ObProxyMutation represents an operation that mutates the ObProxy nodes in the graph.
+type ObProxyMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	create_time     *time.Time
+	update_time     *time.Time
+	namespace       *string
+	name            *string
+	address         *string
+	version         *string
+	config_version  *string
+	obproxy_group   *string
+	service_address *string
+	last_seen_time  *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*ObProxy, error)
+	predicates      []predicate.ObProxy
+}
+
+var _ ent.Mutation = (*ObProxyMutation)(nil)
+
+// obproxyOption allows management of the mutation configuration using functional options.
+type obproxyOption func(*ObProxyMutation)
+
+// newObProxyMutation creates new mutation for the ObProxy entity.
+func newObProxyMutation(c config, op Op, opts ...obproxyOption) *ObProxyMutation {
+	m := &ObProxyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeObProxy,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withObProxyID sets the ID field of the mutation.
+func withObProxyID(id int) obproxyOption {
+	return func(m *ObProxyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ObProxy
+		)
+		m.oldValue = func(ctx context.Context) (*ObProxy, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ObProxy.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withObProxy sets the old ObProxy of the mutation.
+func withObProxy(node *ObProxy) obproxyOption {
+	return func(m *ObProxyMutation) {
+		m.oldValue = func(context.Context) (*ObProxy, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ObProxyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ObProxyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ObProxyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ObProxyMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ObProxy.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ObProxyMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ObProxyMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ObProxy entity.
+// If the ObProxy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObProxyMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ObProxyMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ObProxyMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ObProxyMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ObProxy entity.
+// If the ObProxy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObProxyMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ObProxyMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *ObProxyMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *ObProxyMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the ObProxy entity.
+// If the ObProxy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObProxyMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *ObProxyMutation) ResetNamespace() {
+	m.namespace = nil
+}
+
+// SetName sets the "name" field.
+func (m *ObProxyMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ObProxyMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ObProxy entity.
+// If the ObProxy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObProxyMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ObProxyMutation) ResetName() {
+	m.name = nil
+}
+
+// SetAddress sets the "address" field.
+func (m *ObProxyMutation) SetAddress(s string) {
+	m.address = &s
+}
+
+// Address returns the value of the "address" field in the mutation.
+func (m *ObProxyMutation) Address() (r string, exists bool) {
+	v := m.address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddress returns the old "address" field's value of the ObProxy entity.
+// If the ObProxy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObProxyMutation) OldAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
+	}
+	return oldValue.Address, nil
+}
+
+// ResetAddress resets all changes to the "address" field.
+func (m *ObProxyMutation) ResetAddress() {
+	m.address = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *ObProxyMutation) SetVersion(s string) {
+	m.version = &s
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *ObProxyMutation) Version() (r string, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the ObProxy entity.
+// If the ObProxy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObProxyMutation) OldVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *ObProxyMutation) ResetVersion() {
+	m.version = nil
+}
+
+// SetConfigVersion sets the "config_version" field.
+func (m *ObProxyMutation) SetConfigVersion(s string) {
+	m.config_version = &s
+}
+
+// ConfigVersion returns the value of the "config_version" field in the mutation.
+func (m *ObProxyMutation) ConfigVersion() (r string, exists bool) {
+	v := m.config_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConfigVersion returns the old "config_version" field's value of the ObProxy entity.
+// If the ObProxy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObProxyMutation) OldConfigVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConfigVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConfigVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConfigVersion: %w", err)
+	}
+	return oldValue.ConfigVersion, nil
+}
+
+// ResetConfigVersion resets all changes to the "config_version" field.
+func (m *ObProxyMutation) ResetConfigVersion() {
+	m.config_version = nil
+}
+
+// SetObproxyGroup sets the "obproxy_group" field.
+func (m *ObProxyMutation) SetObproxyGroup(s string) {
+	m.obproxy_group = &s
+}
+
+// ObproxyGroup returns the value of the "obproxy_group" field in the mutation.
+func (m *ObProxyMutation) ObproxyGroup() (r string, exists bool) {
+	v := m.obproxy_group
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldObproxyGroup returns the old "obproxy_group" field's value of the ObProxy entity.
+// If the ObProxy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObProxyMutation) OldObproxyGroup(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldObproxyGroup is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldObproxyGroup requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldObproxyGroup: %w", err)
+	}
+	return oldValue.ObproxyGroup, nil
+}
+
+// ResetObproxyGroup resets all changes to the "obproxy_group" field.
+func (m *ObProxyMutation) ResetObproxyGroup() {
+	m.obproxy_group = nil
+}
+
+// SetServiceAddress sets the "service_address" field.
+func (m *ObProxyMutation) SetServiceAddress(s string) {
+	m.service_address = &s
+}
+
+// ServiceAddress returns the value of the "service_address" field in the mutation.
+func (m *ObProxyMutation) ServiceAddress() (r string, exists bool) {
+	v := m.service_address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldServiceAddress returns the old "service_address" field's value of the ObProxy entity.
+// If the ObProxy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObProxyMutation) OldServiceAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldServiceAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldServiceAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldServiceAddress: %w", err)
+	}
+	return oldValue.ServiceAddress, nil
+}
+
+// ResetServiceAddress resets all changes to the "service_address" field.
+func (m *ObProxyMutation) ResetServiceAddress() {
+	m.service_address = nil
+}
+
+// SetLastSeenTime sets the "last_seen_time" field.
+func (m *ObProxyMutation) SetLastSeenTime(t time.Time) {
+	m.last_seen_time = &t
+}
+
+// LastSeenTime returns the value of the "last_seen_time" field in the mutation.
+func (m *ObProxyMutation) LastSeenTime() (r time.Time, exists bool) {
+	v := m.last_seen_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSeenTime returns the old "last_seen_time" field's value of the ObProxy entity.
+// If the ObProxy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObProxyMutation) OldLastSeenTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeenTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeenTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeenTime: %w", err)
+	}
+	return oldValue.LastSeenTime, nil
+}
+
+// ResetLastSeenTime resets all changes to the "last_seen_time" field.
+func (m *ObProxyMutation) ResetLastSeenTime() {
+	m.last_seen_time = nil
+}
+
+// Where appends a list predicates to the ObProxyMutation builder.
+func (m *ObProxyMutation) Where(ps ...predicate.ObProxy) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ObProxyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ObProxyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ObProxy, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ObProxyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ObProxyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ObProxy).
+func (m *ObProxyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ObProxyMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.create_time != nil {
+		fields = append(fields, obproxy.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, obproxy.FieldUpdateTime)
+	}
+	if m.namespace != nil {
+		fields = append(fields, obproxy.FieldNamespace)
+	}
+	if m.name != nil {
+		fields = append(fields, obproxy.FieldName)
+	}
+	if m.address != nil {
+		fields = append(fields, obproxy.FieldAddress)
+	}
+	if m.version != nil {
+		fields = append(fields, obproxy.FieldVersion)
+	}
+	if m.config_version != nil {
+		fields = append(fields, obproxy.FieldConfigVersion)
+	}
+	if m.obproxy_group != nil {
+		fields = append(fields, obproxy.FieldObproxyGroup)
+	}
+	if m.service_address != nil {
+		fields = append(fields, obproxy.FieldServiceAddress)
+	}
+	if m.last_seen_time != nil {
+		fields = append(fields, obproxy.FieldLastSeenTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ObProxyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case obproxy.FieldCreateTime:
+		return m.CreateTime()
+	case obproxy.FieldUpdateTime:
+		return m.UpdateTime()
+	case obproxy.FieldNamespace:
+		return m.Namespace()
+	case obproxy.FieldName:
+		return m.Name()
+	case obproxy.FieldAddress:
+		return m.Address()
+	case obproxy.FieldVersion:
+		return m.Version()
+	case obproxy.FieldConfigVersion:
+		return m.ConfigVersion()
+	case obproxy.FieldObproxyGroup:
+		return m.ObproxyGroup()
+	case obproxy.FieldServiceAddress:
+		return m.ServiceAddress()
+	case obproxy.FieldLastSeenTime:
+		return m.LastSeenTime()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ObProxyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case obproxy.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case obproxy.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case obproxy.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case obproxy.FieldName:
+		return m.OldName(ctx)
+	case obproxy.FieldAddress:
+		return m.OldAddress(ctx)
+	case obproxy.FieldVersion:
+		return m.OldVersion(ctx)
+	case obproxy.FieldConfigVersion:
+		return m.OldConfigVersion(ctx)
+	case obproxy.FieldObproxyGroup:
+		return m.OldObproxyGroup(ctx)
+	case obproxy.FieldServiceAddress:
+		return m.OldServiceAddress(ctx)
+	case obproxy.FieldLastSeenTime:
+		return m.OldLastSeenTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown ObProxy field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ObProxyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case obproxy.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case obproxy.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case obproxy.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case obproxy.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case obproxy.FieldAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddress(v)
+		return nil
+	case obproxy.FieldVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case obproxy.FieldConfigVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConfigVersion(v)
+		return nil
+	case obproxy.FieldObproxyGroup:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetObproxyGroup(v)
+		return nil
+	case obproxy.FieldServiceAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetServiceAddress(v)
+		return nil
+	case obproxy.FieldLastSeenTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSeenTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ObProxy field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ObProxyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ObProxyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ObProxyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ObProxy numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ObProxyMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ObProxyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ObProxyMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ObProxy nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ObProxyMutation) ResetField(name string) error {
+	switch name {
+	case obproxy.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case obproxy.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case obproxy.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case obproxy.FieldName:
+		m.ResetName()
+		return nil
+	case obproxy.FieldAddress:
+		m.ResetAddress()
+		return nil
+	case obproxy.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case obproxy.FieldConfigVersion:
+		m.ResetConfigVersion()
+		return nil
+	case obproxy.FieldObproxyGroup:
+		m.ResetObproxyGroup()
+		return nil
+	case obproxy.FieldServiceAddress:
+		m.ResetServiceAddress()
+		return nil
+	case obproxy.FieldLastSeenTime:
+		m.ResetLastSeenTime()
+		return nil
+	}
+	return fmt.Errorf("unknown ObProxy field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ObProxyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ObProxyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ObProxyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ObProxyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ObProxyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ObProxyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ObProxyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ObProxy unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ObProxyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ObProxy edge %s", name)
+}
+
+// ObProxyGroupMutation represents an operation that mutates the ObProxyGroup nodes in the graph.
+type ObProxyGroupMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int
+	create_time             *time.Time
+	update_time             *time.Time
+	namespace               *string
+	name                    *string
+	ob_clusters             *[]string
+	appendob_clusters       []string
+	clients                 *[]string
+	appendclients           []string
+	users                   *[]string
+	appendusers             []string
+	meta_ob_cluster         *string
+	meta_database           *string
+	meta_user               *string
+	meta_encrypted_password *string
+	url_template_v1         *string
+	url_template_v2         *string
+	clearedFields           map[string]struct{}
+	done                    bool
+	oldValue                func(context.Context) (*ObProxyGroup, error)
+	predicates              []predicate.ObProxyGroup
+}
+
+var _ ent.Mutation = (*ObProxyGroupMutation)(nil)
+
+// obproxygroupOption allows management of the mutation configuration using functional options.
+type obproxygroupOption func(*ObProxyGroupMutation)
+
+// newObProxyGroupMutation creates new mutation for the ObProxyGroup entity.
+func newObProxyGroupMutation(c config, op Op, opts ...obproxygroupOption) *ObProxyGroupMutation {
+	m := &ObProxyGroupMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeObProxyGroup,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withObProxyGroupID sets the ID field of the mutation.
+func withObProxyGroupID(id int) obproxygroupOption {
+	return func(m *ObProxyGroupMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ObProxyGroup
+		)
+		m.oldValue = func(ctx context.Context) (*ObProxyGroup, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ObProxyGroup.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withObProxyGroup sets the old ObProxyGroup of the mutation.
+func withObProxyGroup(node *ObProxyGroup) obproxygroupOption {
+	return func(m *ObProxyGroupMutation) {
+		m.oldValue = func(context.Context) (*ObProxyGroup, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ObProxyGroupMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ObProxyGroupMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ObProxyGroupMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ObProxyGroupMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ObProxyGroup.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ObProxyGroupMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ObProxyGroupMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ObProxyGroup entity.
+// If the ObProxyGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObProxyGroupMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ObProxyGroupMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ObProxyGroupMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ObProxyGroupMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ObProxyGroup entity.
+// If the ObProxyGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObProxyGroupMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ObProxyGroupMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *ObProxyGroupMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *ObProxyGroupMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the ObProxyGroup entity.
+// If the ObProxyGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObProxyGroupMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *ObProxyGroupMutation) ResetNamespace() {
+	m.namespace = nil
+}
+
+// SetName sets the "name" field.
+func (m *ObProxyGroupMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ObProxyGroupMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ObProxyGroup entity.
+// If the ObProxyGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObProxyGroupMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ObProxyGroupMutation) ResetName() {
+	m.name = nil
+}
+
+// SetObClusters sets the "ob_clusters" field.
+func (m *ObProxyGroupMutation) SetObClusters(s []string) {
+	m.ob_clusters = &s
+	m.appendob_clusters = nil
+}
+
+// ObClusters returns the value of the "ob_clusters" field in the mutation.
+func (m *ObProxyGroupMutation) ObClusters() (r []string, exists bool) {
+	v := m.ob_clusters
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldObClusters returns the old "ob_clusters" field's value of the ObProxyGroup entity.
+// If the ObProxyGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObProxyGroupMutation) OldObClusters(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldObClusters is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldObClusters requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldObClusters: %w", err)
+	}
+	return oldValue.ObClusters, nil
+}
+
+// AppendObClusters adds s to the "ob_clusters" field.
+func (m *ObProxyGroupMutation) AppendObClusters(s []string) {
+	m.appendob_clusters = append(m.appendob_clusters, s...)
+}
+
+// AppendedObClusters returns the list of values that were appended to the "ob_clusters" field in this mutation.
+func (m *ObProxyGroupMutation) AppendedObClusters() ([]string, bool) {
+	if len(m.appendob_clusters) == 0 {
+		return nil, false
+	}
+	return m.appendob_clusters, true
+}
+
+// ClearObClusters clears the value of the "ob_clusters" field.
+func (m *ObProxyGroupMutation) ClearObClusters() {
+	m.ob_clusters = nil
+	m.appendob_clusters = nil
+	m.clearedFields[obproxygroup.FieldObClusters] = struct{}{}
+}
+
+// ObClustersCleared returns if the "ob_clusters" field was cleared in this mutation.
+func (m *ObProxyGroupMutation) ObClustersCleared() bool {
+	_, ok := m.clearedFields[obproxygroup.FieldObClusters]
+	return ok
+}
+
+// ResetObClusters resets all changes to the "ob_clusters" field.
+func (m *ObProxyGroupMutation) ResetObClusters() {
+	m.ob_clusters = nil
+	m.appendob_clusters = nil
+	delete(m.clearedFields, obproxygroup.FieldObClusters)
+}
+
+// SetClients sets the "clients" field.
+func (m *ObProxyGroupMutation) SetClients(s []string) {
+	m.clients = &s
+	m.appendclients = nil
+}
+
+// Clients returns the value of the "clients" field in the mutation.
+func (m *ObProxyGroupMutation) Clients() (r []string, exists bool) {
+	v := m.clients
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClients returns the old "clients" field's value of the ObProxyGroup entity.
+// If the ObProxyGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObProxyGroupMutation) OldClients(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClients is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClients requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClients: %w", err)
+	}
+	return oldValue.Clients, nil
+}
+
+// AppendClients adds s to the "clients" field.
+func (m *ObProxyGroupMutation) AppendClients(s []string) {
+	m.appendclients = append(m.appendclients, s...)
+}
+
+// AppendedClients returns the list of values that were appended to the "clients" field in this mutation.
+func (m *ObProxyGroupMutation) AppendedClients() ([]string, bool) {
+	if len(m.appendclients) == 0 {
+		return nil, false
+	}
+	return m.appendclients, true
+}
+
+// ClearClients clears the value of the "clients" field.
+func (m *ObProxyGroupMutation) ClearClients() {
+	m.clients = nil
+	m.appendclients = nil
+	m.clearedFields[obproxygroup.FieldClients] = struct{}{}
+}
+
+// ClientsCleared returns if the "clients" field was cleared in this mutation.
+func (m *ObProxyGroupMutation) ClientsCleared() bool {
+	_, ok := m.clearedFields[obproxygroup.FieldClients]
+	return ok
+}
+
+// ResetClients resets all changes to the "clients" field.
+func (m *ObProxyGroupMutation) ResetClients() {
+	m.clients = nil
+	m.appendclients = nil
+	delete(m.clearedFields, obproxygroup.FieldClients)
+}
+
+// SetUsers sets the "users" field.
+func (m *ObProxyGroupMutation) SetUsers(s []string) {
+	m.users = &s
+	m.appendusers = nil
+}
+
+// Users returns the value of the "users" field in the mutation.
+func (m *ObProxyGroupMutation) Users() (r []string, exists bool) {
+	v := m.users
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsers returns the old "users" field's value of the ObProxyGroup entity.
+// If the ObProxyGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObProxyGroupMutation) OldUsers(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsers: %w", err)
+	}
+	return oldValue.Users, nil
+}
+
+// AppendUsers adds s to the "users" field.
+func (m *ObProxyGroupMutation) AppendUsers(s []string) {
+	m.appendusers = append(m.appendusers, s...)
+}
+
+// AppendedUsers returns the list of values that were appended to the "users" field in this mutation.
+func (m *ObProxyGroupMutation) AppendedUsers() ([]string, bool) {
+	if len(m.appendusers) == 0 {
+		return nil, false
+	}
+	return m.appendusers, true
+}
+
+// ClearUsers clears the value of the "users" field.
+func (m *ObProxyGroupMutation) ClearUsers() {
+	m.users = nil
+	m.appendusers = nil
+	m.clearedFields[obproxygroup.FieldUsers] = struct{}{}
+}
+
+// UsersCleared returns if the "users" field was cleared in this mutation.
+func (m *ObProxyGroupMutation) UsersCleared() bool {
+	_, ok := m.clearedFields[obproxygroup.FieldUsers]
+	return ok
+}
+
+// ResetUsers resets all changes to the "users" field.
+func (m *ObProxyGroupMutation) ResetUsers() {
+	m.users = nil
+	m.appendusers = nil
+	delete(m.clearedFields, obproxygroup.FieldUsers)
+}
+
+// SetMetaObCluster sets the "meta_ob_cluster" field.
+func (m *ObProxyGroupMutation) SetMetaObCluster(s string) {
+	m.meta_ob_cluster = &s
+}
+
+// MetaObCluster returns the value of the "meta_ob_cluster" field in the mutation.
+func (m *ObProxyGroupMutation) MetaObCluster() (r string, exists bool) {
+	v := m.meta_ob_cluster
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetaObCluster returns the old "meta_ob_cluster" field's value of the ObProxyGroup entity.
+// If the ObProxyGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObProxyGroupMutation) OldMetaObCluster(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetaObCluster is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetaObCluster requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetaObCluster: %w", err)
+	}
+	return oldValue.MetaObCluster, nil
+}
+
+// ResetMetaObCluster resets all changes to the "meta_ob_cluster" field.
+func (m *ObProxyGroupMutation) ResetMetaObCluster() {
+	m.meta_ob_cluster = nil
+}
+
+// SetMetaDatabase sets the "meta_database" field.
+func (m *ObProxyGroupMutation) SetMetaDatabase(s string) {
+	m.meta_database = &s
+}
+
+// MetaDatabase returns the value of the "meta_database" field in the mutation.
+func (m *ObProxyGroupMutation) MetaDatabase() (r string, exists bool) {
+	v := m.meta_database
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetaDatabase returns the old "meta_database" field's value of the ObProxyGroup entity.
+// If the ObProxyGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObProxyGroupMutation) OldMetaDatabase(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetaDatabase is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetaDatabase requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetaDatabase: %w", err)
+	}
+	return oldValue.MetaDatabase, nil
+}
+
+// ResetMetaDatabase resets all changes to the "meta_database" field.
+func (m *ObProxyGroupMutation) ResetMetaDatabase() {
+	m.meta_database = nil
+}
+
+// SetMetaUser sets the "meta_user" field.
+func (m *ObProxyGroupMutation) SetMetaUser(s string) {
+	m.meta_user = &s
+}
+
+// MetaUser returns the value of the "meta_user" field in the mutation.
+func (m *ObProxyGroupMutation) MetaUser() (r string, exists bool) {
+	v := m.meta_user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetaUser returns the old "meta_user" field's value of the ObProxyGroup entity.
+// If the ObProxyGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObProxyGroupMutation) OldMetaUser(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetaUser is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetaUser requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetaUser: %w", err)
+	}
+	return oldValue.MetaUser, nil
+}
+
+// ResetMetaUser resets all changes to the "meta_user" field.
+func (m *ObProxyGroupMutation) ResetMetaUser() {
+	m.meta_user = nil
+}
+
+// SetMetaEncryptedPassword sets the "meta_encrypted_password" field.
+func (m *ObProxyGroupMutation) SetMetaEncryptedPassword(s string) {
+	m.meta_encrypted_password = &s
+}
+
+// MetaEncryptedPassword returns the value of the "meta_encrypted_password" field in the mutation.
+func (m *ObProxyGroupMutation) MetaEncryptedPassword() (r string, exists bool) {
+	v := m.meta_encrypted_password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetaEncryptedPassword returns the old "meta_encrypted_password" field's value of the ObProxyGroup entity.
+// If the ObProxyGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObProxyGroupMutation) OldMetaEncryptedPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetaEncryptedPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetaEncryptedPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetaEncryptedPassword: %w", err)
+	}
+	return oldValue.MetaEncryptedPassword, nil
+}
+
+// ResetMetaEncryptedPassword resets all changes to the "meta_encrypted_password" field.
+func (m *ObProxyGroupMutation) ResetMetaEncryptedPassword() {
+	m.meta_encrypted_password = nil
+}
+
+// SetURLTemplateV1 sets the "url_template_v1" field.
+func (m *ObProxyGroupMutation) SetURLTemplateV1(s string) {
+	m.url_template_v1 = &s
+}
+
+// URLTemplateV1 returns the value of the "url_template_v1" field in the mutation.
+func (m *ObProxyGroupMutation) URLTemplateV1() (r string, exists bool) {
+	v := m.url_template_v1
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURLTemplateV1 returns the old "url_template_v1" field's value of the ObProxyGroup entity.
+// If the ObProxyGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObProxyGroupMutation) OldURLTemplateV1(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURLTemplateV1 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURLTemplateV1 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURLTemplateV1: %w", err)
+	}
+	return oldValue.URLTemplateV1, nil
+}
+
+// ResetURLTemplateV1 resets all changes to the "url_template_v1" field.
+func (m *ObProxyGroupMutation) ResetURLTemplateV1() {
+	m.url_template_v1 = nil
+}
+
+// SetURLTemplateV2 sets the "url_template_v2" field.
+func (m *ObProxyGroupMutation) SetURLTemplateV2(s string) {
+	m.url_template_v2 = &s
+}
+
+// URLTemplateV2 returns the value of the "url_template_v2" field in the mutation.
+func (m *ObProxyGroupMutation) URLTemplateV2() (r string, exists bool) {
+	v := m.url_template_v2
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURLTemplateV2 returns the old "url_template_v2" field's value of the ObProxyGroup entity.
+// If the ObProxyGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObProxyGroupMutation) OldURLTemplateV2(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURLTemplateV2 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURLTemplateV2 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURLTemplateV2: %w", err)
+	}
+	return oldValue.URLTemplateV2, nil
+}
+
+// ResetURLTemplateV2 resets all changes to the "url_template_v2" field.
+func (m *ObProxyGroupMutation) ResetURLTemplateV2() {
+	m.url_template_v2 = nil
+}
+
+// Where appends a list predicates to the ObProxyGroupMutation builder.
+func (m *ObProxyGroupMutation) Where(ps ...predicate.ObProxyGroup) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ObProxyGroupMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ObProxyGroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ObProxyGroup, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ObProxyGroupMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ObProxyGroupMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ObProxyGroup).
+func (m *ObProxyGroupMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ObProxyGroupMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.create_time != nil {
+		fields = append(fields, obproxygroup.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, obproxygroup.FieldUpdateTime)
+	}
+	if m.namespace != nil {
+		fields = append(fields, obproxygroup.FieldNamespace)
+	}
+	if m.name != nil {
+		fields = append(fields, obproxygroup.FieldName)
+	}
+	if m.ob_clusters != nil {
+		fields = append(fields, obproxygroup.FieldObClusters)
+	}
+	if m.clients != nil {
+		fields = append(fields, obproxygroup.FieldClients)
+	}
+	if m.users != nil {
+		fields = append(fields, obproxygroup.FieldUsers)
+	}
+	if m.meta_ob_cluster != nil {
+		fields = append(fields, obproxygroup.FieldMetaObCluster)
+	}
+	if m.meta_database != nil {
+		fields = append(fields, obproxygroup.FieldMetaDatabase)
+	}
+	if m.meta_user != nil {
+		fields = append(fields, obproxygroup.FieldMetaUser)
+	}
+	if m.meta_encrypted_password != nil {
+		fields = append(fields, obproxygroup.FieldMetaEncryptedPassword)
+	}
+	if m.url_template_v1 != nil {
+		fields = append(fields, obproxygroup.FieldURLTemplateV1)
+	}
+	if m.url_template_v2 != nil {
+		fields = append(fields, obproxygroup.FieldURLTemplateV2)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ObProxyGroupMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case obproxygroup.FieldCreateTime:
+		return m.CreateTime()
+	case obproxygroup.FieldUpdateTime:
+		return m.UpdateTime()
+	case obproxygroup.FieldNamespace:
+		return m.Namespace()
+	case obproxygroup.FieldName:
+		return m.Name()
+	case obproxygroup.FieldObClusters:
+		return m.ObClusters()
+	case obproxygroup.FieldClients:
+		return m.Clients()
+	case obproxygroup.FieldUsers:
+		return m.Users()
+	case obproxygroup.FieldMetaObCluster:
+		return m.MetaObCluster()
+	case obproxygroup.FieldMetaDatabase:
+		return m.MetaDatabase()
+	case obproxygroup.FieldMetaUser:
+		return m.MetaUser()
+	case obproxygroup.FieldMetaEncryptedPassword:
+		return m.MetaEncryptedPassword()
+	case obproxygroup.FieldURLTemplateV1:
+		return m.URLTemplateV1()
+	case obproxygroup.FieldURLTemplateV2:
+		return m.URLTemplateV2()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ObProxyGroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case obproxygroup.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case obproxygroup.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case obproxygroup.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case obproxygroup.FieldName:
+		return m.OldName(ctx)
+	case obproxygroup.FieldObClusters:
+		return m.OldObClusters(ctx)
+	case obproxygroup.FieldClients:
+		return m.OldClients(ctx)
+	case obproxygroup.FieldUsers:
+		return m.OldUsers(ctx)
+	case obproxygroup.FieldMetaObCluster:
+		return m.OldMetaObCluster(ctx)
+	case obproxygroup.FieldMetaDatabase:
+		return m.OldMetaDatabase(ctx)
+	case obproxygroup.FieldMetaUser:
+		return m.OldMetaUser(ctx)
+	case obproxygroup.FieldMetaEncryptedPassword:
+		return m.OldMetaEncryptedPassword(ctx)
+	case obproxygroup.FieldURLTemplateV1:
+		return m.OldURLTemplateV1(ctx)
+	case obproxygroup.FieldURLTemplateV2:
+		return m.OldURLTemplateV2(ctx)
+	}
+	return nil, fmt.Errorf("unknown ObProxyGroup field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ObProxyGroupMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case obproxygroup.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case obproxygroup.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case obproxygroup.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case obproxygroup.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case obproxygroup.FieldObClusters:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetObClusters(v)
+		return nil
+	case obproxygroup.FieldClients:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClients(v)
+		return nil
+	case obproxygroup.FieldUsers:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsers(v)
+		return nil
+	case obproxygroup.FieldMetaObCluster:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetaObCluster(v)
+		return nil
+	case obproxygroup.FieldMetaDatabase:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetaDatabase(v)
+		return nil
+	case obproxygroup.FieldMetaUser:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetaUser(v)
+		return nil
+	case obproxygroup.FieldMetaEncryptedPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetaEncryptedPassword(v)
+		return nil
+	case obproxygroup.FieldURLTemplateV1:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURLTemplateV1(v)
+		return nil
+	case obproxygroup.FieldURLTemplateV2:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURLTemplateV2(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ObProxyGroup field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ObProxyGroupMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ObProxyGroupMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ObProxyGroupMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ObProxyGroup numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ObProxyGroupMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(obproxygroup.FieldObClusters) {
+		fields = append(fields, obproxygroup.FieldObClusters)
+	}
+	if m.FieldCleared(obproxygroup.FieldClients) {
+		fields = append(fields, obproxygroup.FieldClients)
+	}
+	if m.FieldCleared(obproxygroup.FieldUsers) {
+		fields = append(fields, obproxygroup.FieldUsers)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ObProxyGroupMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ObProxyGroupMutation) ClearField(name string) error {
+	switch name {
+	case obproxygroup.FieldObClusters:
+		m.ClearObClusters()
+		return nil
+	case obproxygroup.FieldClients:
+		m.ClearClients()
+		return nil
+	case obproxygroup.FieldUsers:
+		m.ClearUsers()
+		return nil
+	}
+	return fmt.Errorf("unknown ObProxyGroup nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ObProxyGroupMutation) ResetField(name string) error {
+	switch name {
+	case obproxygroup.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case obproxygroup.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case obproxygroup.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case obproxygroup.FieldName:
+		m.ResetName()
+		return nil
+	case obproxygroup.FieldObClusters:
+		m.ResetObClusters()
+		return nil
+	case obproxygroup.FieldClients:
+		m.ResetClients()
+		return nil
+	case obproxygroup.FieldUsers:
+		m.ResetUsers()
+		return nil
+	case obproxygroup.FieldMetaObCluster:
+		m.ResetMetaObCluster()
+		return nil
+	case obproxygroup.FieldMetaDatabase:
+		m.ResetMetaDatabase()
+		return nil
+	case obproxygroup.FieldMetaUser:
+		m.ResetMetaUser()
+		return nil
+	case obproxygroup.FieldMetaEncryptedPassword:
+		m.ResetMetaEncryptedPassword()
+		return nil
+	case obproxygroup.FieldURLTemplateV1:
+		m.ResetURLTemplateV1()
+		return nil
+	case obproxygroup.FieldURLTemplateV2:
+		m.ResetURLTemplateV2()
+		return nil
+	}
+	return fmt.Errorf("unknown ObProxyGroup field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ObProxyGroupMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ObProxyGroupMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ObProxyGroupMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ObProxyGroupMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ObProxyGroupMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ObProxyGroupMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ObProxyGroupMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ObProxyGroup unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ObProxyGroupMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ObProxyGroup edge %s", name)
+}
+
+// WebhookDeliveryMutation represents an operation that mutates the WebhookDelivery nodes in the graph.
+type WebhookDeliveryMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	create_time         *time.Time
+	update_time         *time.Time
+	subscriber          *string
+	url                 *string
+	event               *string
+	namespace           *string
+	ob_cluster          *string
+	ob_cluster_id       *int64
+	addob_cluster_id    *int64
+	payload             *string
+	status              *string
+	attempts            *int
+	addattempts         *int
+	next_attempt_time   *time.Time
+	last_status_code    *int
+	addlast_status_code *int
+	last_error          *string
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*WebhookDelivery, error)
+	predicates          []predicate.WebhookDelivery
+}
+
+var _ ent.Mutation = (*WebhookDeliveryMutation)(nil)
+
+// webhookdeliveryOption allows management of the mutation configuration using functional options.
+type webhookdeliveryOption func(*WebhookDeliveryMutation)
+
+// newWebhookDeliveryMutation creates new mutation for the WebhookDelivery entity.
+func newWebhookDeliveryMutation(c config, op Op, opts ...webhookdeliveryOption) *WebhookDeliveryMutation {
+	m := &WebhookDeliveryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWebhookDelivery,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWebhookDeliveryID sets the ID field of the mutation.
+func withWebhookDeliveryID(id int) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WebhookDelivery
+		)
+		m.oldValue = func(ctx context.Context) (*WebhookDelivery, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WebhookDelivery.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWebhookDelivery sets the old WebhookDelivery of the mutation.
+func withWebhookDelivery(node *WebhookDelivery) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		m.oldValue = func(context.Context) (*WebhookDelivery, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WebhookDeliveryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WebhookDeliveryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WebhookDeliveryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WebhookDeliveryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WebhookDelivery.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *WebhookDeliveryMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *WebhookDeliveryMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *WebhookDeliveryMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *WebhookDeliveryMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *WebhookDeliveryMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *WebhookDeliveryMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetSubscriber sets the "subscriber" field.
+func (m *WebhookDeliveryMutation) SetSubscriber(s string) {
+	m.subscriber = &s
+}
+
+// Subscriber returns the value of the "subscriber" field in the mutation.
+func (m *WebhookDeliveryMutation) Subscriber() (r string, exists bool) {
+	v := m.subscriber
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubscriber returns the old "subscriber" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldSubscriber(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubscriber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubscriber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubscriber: %w", err)
+	}
+	return oldValue.Subscriber, nil
+}
+
+// ResetSubscriber resets all changes to the "subscriber" field.
+func (m *WebhookDeliveryMutation) ResetSubscriber() {
+	m.subscriber = nil
+}
+
+// SetURL sets the "url" field.
+func (m *WebhookDeliveryMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *WebhookDeliveryMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *WebhookDeliveryMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetEvent sets the "event" field.
+func (m *WebhookDeliveryMutation) SetEvent(s string) {
+	m.event = &s
+}
+
+// Event returns the value of the "event" field in the mutation.
+func (m *WebhookDeliveryMutation) Event() (r string, exists bool) {
+	v := m.event
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvent returns the old "event" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldEvent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvent: %w", err)
+	}
+	return oldValue.Event, nil
+}
+
+// ResetEvent resets all changes to the "event" field.
+func (m *WebhookDeliveryMutation) ResetEvent() {
+	m.event = nil
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *WebhookDeliveryMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *WebhookDeliveryMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *WebhookDeliveryMutation) ResetNamespace() {
+	m.namespace = nil
+}
+
+// SetObCluster sets the "ob_cluster" field.
+func (m *WebhookDeliveryMutation) SetObCluster(s string) {
+	m.ob_cluster = &s
+}
+
+// ObCluster returns the value of the "ob_cluster" field in the mutation.
+func (m *WebhookDeliveryMutation) ObCluster() (r string, exists bool) {
+	v := m.ob_cluster
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldObCluster returns the old "ob_cluster" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldObCluster(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldObCluster is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldObCluster requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldObCluster: %w", err)
+	}
+	return oldValue.ObCluster, nil
+}
+
+// ResetObCluster resets all changes to the "ob_cluster" field.
+func (m *WebhookDeliveryMutation) ResetObCluster() {
+	m.ob_cluster = nil
+}
+
+// SetObClusterID sets the "ob_cluster_id" field.
+func (m *WebhookDeliveryMutation) SetObClusterID(i int64) {
+	m.ob_cluster_id = &i
+	m.addob_cluster_id = nil
+}
+
+// ObClusterID returns the value of the "ob_cluster_id" field in the mutation.
+func (m *WebhookDeliveryMutation) ObClusterID() (r int64, exists bool) {
+	v := m.ob_cluster_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldObClusterID returns the old "ob_cluster_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldObClusterID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldObClusterID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldObClusterID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldObClusterID: %w", err)
+	}
+	return oldValue.ObClusterID, nil
+}
+
+// AddObClusterID adds i to the "ob_cluster_id" field.
+func (m *WebhookDeliveryMutation) AddObClusterID(i int64) {
+	if m.addob_cluster_id != nil {
+		*m.addob_cluster_id += i
+	} else {
+		m.addob_cluster_id = &i
+	}
+}
+
+// AddedObClusterID returns the value that was added to the "ob_cluster_id" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedObClusterID() (r int64, exists bool) {
+	v := m.addob_cluster_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetObClusterID resets all changes to the "ob_cluster_id" field.
+func (m *WebhookDeliveryMutation) ResetObClusterID() {
+	m.ob_cluster_id = nil
+	m.addob_cluster_id = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *WebhookDeliveryMutation) SetPayload(s string) {
+	m.payload = &s
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *WebhookDeliveryMutation) Payload() (r string, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldPayload(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
 	}
-	return oldValue.UpdateTime, nil
+	return oldValue.Payload, nil
 }
 
-// ResetUpdateTime resets all changes to the "update_time" field.
-func (m *ObClusterMutation) ResetUpdateTime() {
-	m.update_time = nil
+// ResetPayload resets all changes to the "payload" field.
+func (m *WebhookDeliveryMutation) ResetPayload() {
+	m.payload = nil
 }
 
-// SetName sets the "name" field.
-func (m *ObClusterMutation) SetName(s string) {
-	m.name = &s
+// SetStatus sets the "status" field.
+func (m *WebhookDeliveryMutation) SetStatus(s string) {
+	m.status = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ObClusterMutation) Name() (r string, exists bool) {
-	v := m.name
+// Status returns the value of the "status" field in the mutation.
+func (m *WebhookDeliveryMutation) Status() (r string, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the ObCluster entity.
-// If the ObCluster object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ObClusterMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Status, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *ObClusterMutation) ResetName() {
-	m.name = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *WebhookDeliveryMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetObClusterID sets the "ob_cluster_id" field.
-func (m *ObClusterMutation) SetObClusterID(i int64) {
-	m.ob_cluster_id = &i
-	m.addob_cluster_id = nil
+// SetAttempts sets the "attempts" field.
+func (m *WebhookDeliveryMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// ObClusterID returns the value of the "ob_cluster_id" field in the mutation.
-func (m *ObClusterMutation) ObClusterID() (r int64, exists bool) {
-	v := m.ob_cluster_id
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *WebhookDeliveryMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldObClusterID returns the old "ob_cluster_id" field's value of the ObCluster entity.
-// If the ObCluster object wasn't provided to the builder, the object is fetched from the database.
+// OldAttempts returns the old "attempts" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ObClusterMutation) OldObClusterID(ctx context.Context) (v int64, err error) {
+func (m *WebhookDeliveryMutation) OldAttempts(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldObClusterID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldObClusterID requires an ID field in the mutation")
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldObClusterID: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
 	}
-	return oldValue.ObClusterID, nil
+	return oldValue.Attempts, nil
 }
 
-// AddObClusterID adds i to the "ob_cluster_id" field.
-func (m *ObClusterMutation) AddObClusterID(i int64) {
-	if m.addob_cluster_id != nil {
-		*m.addob_cluster_id += i
+// AddAttempts adds i to the "attempts" field.
+func (m *WebhookDeliveryMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
 	} else {
-		m.addob_cluster_id = &i
+		m.addattempts = &i
 	}
 }
 
-// AddedObClusterID returns the value that was added to the "ob_cluster_id" field in this mutation.
-func (m *ObClusterMutation) AddedObClusterID() (r int64, exists bool) {
-	v := m.addob_cluster_id
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetObClusterID resets all changes to the "ob_cluster_id" field.
-func (m *ObClusterMutation) ResetObClusterID() {
-	m.ob_cluster_id = nil
-	m.addob_cluster_id = nil
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *WebhookDeliveryMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
 }
 
-// SetType sets the "type" field.
-func (m *ObClusterMutation) SetType(s string) {
-	m._type = &s
+// SetNextAttemptTime sets the "next_attempt_time" field.
+func (m *WebhookDeliveryMutation) SetNextAttemptTime(t time.Time) {
+	m.next_attempt_time = &t
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *ObClusterMutation) GetType() (r string, exists bool) {
-	v := m._type
+// NextAttemptTime returns the value of the "next_attempt_time" field in the mutation.
+func (m *WebhookDeliveryMutation) NextAttemptTime() (r time.Time, exists bool) {
+	v := m.next_attempt_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the ObCluster entity.
-// If the ObCluster object wasn't provided to the builder, the object is fetched from the database.
+// OldNextAttemptTime returns the old "next_attempt_time" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ObClusterMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldNextAttemptTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldNextAttemptTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldNextAttemptTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldNextAttemptTime: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.NextAttemptTime, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *ObClusterMutation) ResetType() {
-	m._type = nil
+// ResetNextAttemptTime resets all changes to the "next_attempt_time" field.
+func (m *WebhookDeliveryMutation) ResetNextAttemptTime() {
+	m.next_attempt_time = nil
 }
 
-// SetRootserviceJSON sets the "rootservice_json" field.
-func (m *ObClusterMutation) SetRootserviceJSON(s string) {
-	m.rootservice_json = &s
+// SetLastStatusCode sets the "last_status_code" field.
+func (m *WebhookDeliveryMutation) SetLastStatusCode(i int) {
+	m.last_status_code = &i
+	m.addlast_status_code = nil
 }
 
-// RootserviceJSON returns the value of the "rootservice_json" field in the mutation.
-func (m *ObClusterMutation) RootserviceJSON() (r string, exists bool) {
-	v := m.rootservice_json
+// LastStatusCode returns the value of the "last_status_code" field in the mutation.
+func (m *WebhookDeliveryMutation) LastStatusCode() (r int, exists bool) {
+	v := m.last_status_code
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRootserviceJSON returns the old "rootservice_json" field's value of the ObCluster entity.
-// If the ObCluster object wasn't provided to the builder, the object is fetched from the database.
+// OldLastStatusCode returns the old "last_status_code" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ObClusterMutation) OldRootserviceJSON(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldLastStatusCode(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRootserviceJSON is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastStatusCode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRootserviceJSON requires an ID field in the mutation")
+		return v, errors.New("OldLastStatusCode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRootserviceJSON: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastStatusCode: %w", err)
 	}
-	return oldValue.RootserviceJSON, nil
+	return oldValue.LastStatusCode, nil
 }
 
-// ResetRootserviceJSON resets all changes to the "rootservice_json" field.
-func (m *ObClusterMutation) ResetRootserviceJSON() {
-	m.rootservice_json = nil
+// AddLastStatusCode adds i to the "last_status_code" field.
+func (m *WebhookDeliveryMutation) AddLastStatusCode(i int) {
+	if m.addlast_status_code != nil {
+		*m.addlast_status_code += i
+	} else {
+		m.addlast_status_code = &i
+	}
 }
 
-// Where appends a list predicates to the ObClusterMutation builder.
-func (m *ObClusterMutation) Where(ps ...predicate.ObCluster) {
+// AddedLastStatusCode returns the value that was added to the "last_status_code" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedLastStatusCode() (r int, exists bool) {
+	v := m.addlast_status_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLastStatusCode resets all changes to the "last_status_code" field.
+func (m *WebhookDeliveryMutation) ResetLastStatusCode() {
+	m.last_status_code = nil
+	m.addlast_status_code = nil
+}
+
+// SetLastError sets the "last_error" field.
+func (m *WebhookDeliveryMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *WebhookDeliveryMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *WebhookDeliveryMutation) ResetLastError() {
+	m.last_error = nil
+}
+
+// Where appends a list predicates to the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Where(ps ...predicate.WebhookDelivery) {
 	m.predicates = append(m.predicates, ps...)
 }
 
+// WhereP appends storage-level predicates to the WebhookDeliveryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *WebhookDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookDelivery, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
 // Op returns the operation name.
-func (m *ObClusterMutation) Op() Op {
+func (m *WebhookDeliveryMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (ObCluster).
-func (m *ObClusterMutation) Type() string {
+// SetOp allows setting the mutation operation.
+func (m *WebhookDeliveryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (WebhookDelivery).
+func (m *WebhookDeliveryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ObClusterMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *WebhookDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 14)
 	if m.create_time != nil {
-		fields = append(fields, obcluster.FieldCreateTime)
+		fields = append(fields, webhookdelivery.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, obcluster.FieldUpdateTime)
+		fields = append(fields, webhookdelivery.FieldUpdateTime)
 	}
-	if m.name != nil {
-		fields = append(fields, obcluster.FieldName)
+	if m.subscriber != nil {
+		fields = append(fields, webhookdelivery.FieldSubscriber)
+	}
+	if m.url != nil {
+		fields = append(fields, webhookdelivery.FieldURL)
+	}
+	if m.event != nil {
+		fields = append(fields, webhookdelivery.FieldEvent)
+	}
+	if m.namespace != nil {
+		fields = append(fields, webhookdelivery.FieldNamespace)
+	}
+	if m.ob_cluster != nil {
+		fields = append(fields, webhookdelivery.FieldObCluster)
 	}
 	if m.ob_cluster_id != nil {
-		fields = append(fields, obcluster.FieldObClusterID)
+		fields = append(fields, webhookdelivery.FieldObClusterID)
 	}
-	if m._type != nil {
-		fields = append(fields, obcluster.FieldType)
+	if m.payload != nil {
+		fields = append(fields, webhookdelivery.FieldPayload)
 	}
-	if m.rootservice_json != nil {
-		fields = append(fields, obcluster.FieldRootserviceJSON)
+	if m.status != nil {
+		fields = append(fields, webhookdelivery.FieldStatus)
+	}
+	if m.attempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.next_attempt_time != nil {
+		fields = append(fields, webhookdelivery.FieldNextAttemptTime)
+	}
+	if m.last_status_code != nil {
+		fields = append(fields, webhookdelivery.FieldLastStatusCode)
+	}
+	if m.last_error != nil {
+		fields = append(fields, webhookdelivery.FieldLastError)
 	}
 	return fields
 }
@@ -424,20 +5207,36 @@ func (m *ObClusterMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ObClusterMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case obcluster.FieldCreateTime:
+	case webhookdelivery.FieldCreateTime:
 		return m.CreateTime()
-	case obcluster.FieldUpdateTime:
+	case webhookdelivery.FieldUpdateTime:
 		return m.UpdateTime()
-	case obcluster.FieldName:
-		return m.Name()
-	case obcluster.FieldObClusterID:
+	case webhookdelivery.FieldSubscriber:
+		return m.Subscriber()
+	case webhookdelivery.FieldURL:
+		return m.URL()
+	case webhookdelivery.FieldEvent:
+		return m.Event()
+	case webhookdelivery.FieldNamespace:
+		return m.Namespace()
+	case webhookdelivery.FieldObCluster:
+		return m.ObCluster()
+	case webhookdelivery.FieldObClusterID:
 		return m.ObClusterID()
-	case obcluster.FieldType:
-		return m.GetType()
-	case obcluster.FieldRootserviceJSON:
-		return m.RootserviceJSON()
+	case webhookdelivery.FieldPayload:
+		return m.Payload()
+	case webhookdelivery.FieldStatus:
+		return m.Status()
+	case webhookdelivery.FieldAttempts:
+		return m.Attempts()
+	case webhookdelivery.FieldNextAttemptTime:
+		return m.NextAttemptTime()
+	case webhookdelivery.FieldLastStatusCode:
+		return m.LastStatusCode()
+	case webhookdelivery.FieldLastError:
+		return m.LastError()
 	}
 	return nil, false
 }
@@ -445,81 +5244,159 @@ func (m *ObClusterMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ObClusterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case obcluster.FieldCreateTime:
+	case webhookdelivery.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case obcluster.FieldUpdateTime:
+	case webhookdelivery.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case obcluster.FieldName:
-		return m.OldName(ctx)
-	case obcluster.FieldObClusterID:
+	case webhookdelivery.FieldSubscriber:
+		return m.OldSubscriber(ctx)
+	case webhookdelivery.FieldURL:
+		return m.OldURL(ctx)
+	case webhookdelivery.FieldEvent:
+		return m.OldEvent(ctx)
+	case webhookdelivery.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case webhookdelivery.FieldObCluster:
+		return m.OldObCluster(ctx)
+	case webhookdelivery.FieldObClusterID:
 		return m.OldObClusterID(ctx)
-	case obcluster.FieldType:
-		return m.OldType(ctx)
-	case obcluster.FieldRootserviceJSON:
-		return m.OldRootserviceJSON(ctx)
+	case webhookdelivery.FieldPayload:
+		return m.OldPayload(ctx)
+	case webhookdelivery.FieldStatus:
+		return m.OldStatus(ctx)
+	case webhookdelivery.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case webhookdelivery.FieldNextAttemptTime:
+		return m.OldNextAttemptTime(ctx)
+	case webhookdelivery.FieldLastStatusCode:
+		return m.OldLastStatusCode(ctx)
+	case webhookdelivery.FieldLastError:
+		return m.OldLastError(ctx)
 	}
-	return nil, fmt.Errorf("unknown ObCluster field %s", name)
+	return nil, fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ObClusterMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case obcluster.FieldCreateTime:
+	case webhookdelivery.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case obcluster.FieldUpdateTime:
+	case webhookdelivery.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case obcluster.FieldName:
+	case webhookdelivery.FieldSubscriber:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetSubscriber(v)
 		return nil
-	case obcluster.FieldObClusterID:
+	case webhookdelivery.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case webhookdelivery.FieldEvent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvent(v)
+		return nil
+	case webhookdelivery.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case webhookdelivery.FieldObCluster:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetObCluster(v)
+		return nil
+	case webhookdelivery.FieldObClusterID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetObClusterID(v)
 		return nil
-	case obcluster.FieldType:
+	case webhookdelivery.FieldPayload:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetPayload(v)
 		return nil
-	case obcluster.FieldRootserviceJSON:
+	case webhookdelivery.FieldStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRootserviceJSON(v)
+		m.SetStatus(v)
+		return nil
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case webhookdelivery.FieldNextAttemptTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptTime(v)
+		return nil
+	case webhookdelivery.FieldLastStatusCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastStatusCode(v)
+		return nil
+	case webhookdelivery.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ObCluster field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ObClusterMutation) AddedFields() []string {
+func (m *WebhookDeliveryMutation) AddedFields() []string {
 	var fields []string
 	if m.addob_cluster_id != nil {
-		fields = append(fields, obcluster.FieldObClusterID)
+		fields = append(fields, webhookdelivery.FieldObClusterID)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.addlast_status_code != nil {
+		fields = append(fields, webhookdelivery.FieldLastStatusCode)
 	}
 	return fields
 }
@@ -527,10 +5404,14 @@ func (m *ObClusterMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ObClusterMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case obcluster.FieldObClusterID:
+	case webhookdelivery.FieldObClusterID:
 		return m.AddedObClusterID()
+	case webhookdelivery.FieldAttempts:
+		return m.AddedAttempts()
+	case webhookdelivery.FieldLastStatusCode:
+		return m.AddedLastStatusCode()
 	}
 	return nil, false
 }
@@ -538,108 +5419,146 @@ func (m *ObClusterMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ObClusterMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case obcluster.FieldObClusterID:
+	case webhookdelivery.FieldObClusterID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddObClusterID(v)
 		return nil
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case webhookdelivery.FieldLastStatusCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastStatusCode(v)
+		return nil
 	}
-	return fmt.Errorf("unknown ObCluster numeric field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ObClusterMutation) ClearedFields() []string {
+func (m *WebhookDeliveryMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ObClusterMutation) FieldCleared(name string) bool {
+func (m *WebhookDeliveryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ObClusterMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown ObCluster nullable field %s", name)
+func (m *WebhookDeliveryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown WebhookDelivery nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ObClusterMutation) ResetField(name string) error {
+func (m *WebhookDeliveryMutation) ResetField(name string) error {
 	switch name {
-	case obcluster.FieldCreateTime:
+	case webhookdelivery.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case obcluster.FieldUpdateTime:
+	case webhookdelivery.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case obcluster.FieldName:
-		m.ResetName()
+	case webhookdelivery.FieldSubscriber:
+		m.ResetSubscriber()
 		return nil
-	case obcluster.FieldObClusterID:
+	case webhookdelivery.FieldURL:
+		m.ResetURL()
+		return nil
+	case webhookdelivery.FieldEvent:
+		m.ResetEvent()
+		return nil
+	case webhookdelivery.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case webhookdelivery.FieldObCluster:
+		m.ResetObCluster()
+		return nil
+	case webhookdelivery.FieldObClusterID:
 		m.ResetObClusterID()
 		return nil
-	case obcluster.FieldType:
-		m.ResetType()
+	case webhookdelivery.FieldPayload:
+		m.ResetPayload()
 		return nil
-	case obcluster.FieldRootserviceJSON:
-		m.ResetRootserviceJSON()
+	case webhookdelivery.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case webhookdelivery.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case webhookdelivery.FieldNextAttemptTime:
+		m.ResetNextAttemptTime()
+		return nil
+	case webhookdelivery.FieldLastStatusCode:
+		m.ResetLastStatusCode()
+		return nil
+	case webhookdelivery.FieldLastError:
+		m.ResetLastError()
 		return nil
 	}
-	return fmt.Errorf("unknown ObCluster field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ObClusterMutation) AddedEdges() []string {
+func (m *WebhookDeliveryMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ObClusterMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ObClusterMutation) RemovedEdges() []string {
+func (m *WebhookDeliveryMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ObClusterMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ObClusterMutation) ClearedEdges() []string {
+func (m *WebhookDeliveryMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ObClusterMutation) EdgeCleared(name string) bool {
+func (m *WebhookDeliveryMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ObClusterMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ObCluster unique edge %s", name)
+func (m *WebhookDeliveryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown WebhookDelivery unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ObClusterMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ObCluster edge %s", name)
+func (m *WebhookDeliveryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown WebhookDelivery edge %s", name)
 }
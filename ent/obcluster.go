@@ -1,4 +1,4 @@
-// Code generated by entc, DO NOT EDIT.
+// Code generated by ent, DO NOT EDIT.
 
 package ent
 
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/oceanbase/configserver/ent/obcluster"
 )
@@ -20,6 +21,8 @@ type ObCluster struct {
 	CreateTime time.Time `json:"create_time,omitempty"`
 	// UpdateTime holds the value of the "update_time" field.
 	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Namespace holds the value of the "namespace" field.
+	Namespace string `json:"namespace,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
 	// ObClusterID holds the value of the "ob_cluster_id" field.
@@ -28,21 +31,24 @@ type ObCluster struct {
 	Type string `json:"type,omitempty"`
 	// RootserviceJSON holds the value of the "rootservice_json" field.
 	RootserviceJSON string `json:"rootservice_json,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt    *time.Time `json:"deleted_at,omitempty"`
+	selectValues sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
-func (*ObCluster) scanValues(columns []string) ([]interface{}, error) {
-	values := make([]interface{}, len(columns))
+func (*ObCluster) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
 		case obcluster.FieldID, obcluster.FieldObClusterID:
 			values[i] = new(sql.NullInt64)
-		case obcluster.FieldName, obcluster.FieldType, obcluster.FieldRootserviceJSON:
+		case obcluster.FieldNamespace, obcluster.FieldName, obcluster.FieldType, obcluster.FieldRootserviceJSON:
 			values[i] = new(sql.NullString)
-		case obcluster.FieldCreateTime, obcluster.FieldUpdateTime:
+		case obcluster.FieldCreateTime, obcluster.FieldUpdateTime, obcluster.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		default:
-			return nil, fmt.Errorf("unexpected column %q for type ObCluster", columns[i])
+			values[i] = new(sql.UnknownType)
 		}
 	}
 	return values, nil
@@ -50,7 +56,7 @@ func (*ObCluster) scanValues(columns []string) ([]interface{}, error) {
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
 // to the ObCluster fields.
-func (oc *ObCluster) assignValues(columns []string, values []interface{}) error {
+func (oc *ObCluster) assignValues(columns []string, values []any) error {
 	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
@@ -74,6 +80,12 @@ func (oc *ObCluster) assignValues(columns []string, values []interface{}) error
 			} else if value.Valid {
 				oc.UpdateTime = value.Time
 			}
+		case obcluster.FieldNamespace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace", values[i])
+			} else if value.Valid {
+				oc.Namespace = value.String
+			}
 		case obcluster.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -98,26 +110,41 @@ func (oc *ObCluster) assignValues(columns []string, values []interface{}) error
 			} else if value.Valid {
 				oc.RootserviceJSON = value.String
 			}
+		case obcluster.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				oc.DeletedAt = new(time.Time)
+				*oc.DeletedAt = value.Time
+			}
+		default:
+			oc.selectValues.Set(columns[i], values[i])
 		}
 	}
 	return nil
 }
 
+// Value returns the ent.Value that was dynamically selected and assigned to the ObCluster.
+// This includes values selected through modifiers, order, etc.
+func (oc *ObCluster) Value(name string) (ent.Value, error) {
+	return oc.selectValues.Get(name)
+}
+
 // Update returns a builder for updating this ObCluster.
 // Note that you need to call ObCluster.Unwrap() before calling this method if this ObCluster
 // was returned from a transaction, and the transaction was committed or rolled back.
 func (oc *ObCluster) Update() *ObClusterUpdateOne {
-	return (&ObClusterClient{config: oc.config}).UpdateOne(oc)
+	return NewObClusterClient(oc.config).UpdateOne(oc)
 }
 
 // Unwrap unwraps the ObCluster entity that was returned from a transaction after it was closed,
 // so that all future queries will be executed through the driver which created the transaction.
 func (oc *ObCluster) Unwrap() *ObCluster {
-	tx, ok := oc.config.driver.(*txDriver)
+	_tx, ok := oc.config.driver.(*txDriver)
 	if !ok {
 		panic("ent: ObCluster is not a transactional entity")
 	}
-	oc.config.driver = tx.drv
+	oc.config.driver = _tx.drv
 	return oc
 }
 
@@ -125,28 +152,35 @@ func (oc *ObCluster) Unwrap() *ObCluster {
 func (oc *ObCluster) String() string {
 	var builder strings.Builder
 	builder.WriteString("ObCluster(")
-	builder.WriteString(fmt.Sprintf("id=%v", oc.ID))
-	builder.WriteString(", create_time=")
+	builder.WriteString(fmt.Sprintf("id=%v, ", oc.ID))
+	builder.WriteString("create_time=")
 	builder.WriteString(oc.CreateTime.Format(time.ANSIC))
-	builder.WriteString(", update_time=")
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
 	builder.WriteString(oc.UpdateTime.Format(time.ANSIC))
-	builder.WriteString(", name=")
+	builder.WriteString(", ")
+	builder.WriteString("namespace=")
+	builder.WriteString(oc.Namespace)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
 	builder.WriteString(oc.Name)
-	builder.WriteString(", ob_cluster_id=")
+	builder.WriteString(", ")
+	builder.WriteString("ob_cluster_id=")
 	builder.WriteString(fmt.Sprintf("%v", oc.ObClusterID))
-	builder.WriteString(", type=")
+	builder.WriteString(", ")
+	builder.WriteString("type=")
 	builder.WriteString(oc.Type)
-	builder.WriteString(", rootservice_json=")
+	builder.WriteString(", ")
+	builder.WriteString("rootservice_json=")
 	builder.WriteString(oc.RootserviceJSON)
+	builder.WriteString(", ")
+	if v := oc.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
 
 // ObClusters is a parsable slice of ObCluster.
 type ObClusters []*ObCluster
-
-func (oc ObClusters) config(cfg config) {
-	for _i := range oc {
-		oc[_i].config = cfg
-	}
-}
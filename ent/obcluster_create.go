@@ -1,4 +1,4 @@
-// Code generated by entc, DO NOT EDIT.
+// Code generated by ent, DO NOT EDIT.
 
 package ent
 
@@ -50,6 +50,20 @@ func (occ *ObClusterCreate) SetNillableUpdateTime(t *time.Time) *ObClusterCreate
 	return occ
 }
 
+// SetNamespace sets the "namespace" field.
+func (occ *ObClusterCreate) SetNamespace(s string) *ObClusterCreate {
+	occ.mutation.SetNamespace(s)
+	return occ
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (occ *ObClusterCreate) SetNillableNamespace(s *string) *ObClusterCreate {
+	if s != nil {
+		occ.SetNamespace(*s)
+	}
+	return occ
+}
+
 // SetName sets the "name" field.
 func (occ *ObClusterCreate) SetName(s string) *ObClusterCreate {
 	occ.mutation.SetName(s)
@@ -74,6 +88,20 @@ func (occ *ObClusterCreate) SetRootserviceJSON(s string) *ObClusterCreate {
 	return occ
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (occ *ObClusterCreate) SetDeletedAt(t time.Time) *ObClusterCreate {
+	occ.mutation.SetDeletedAt(t)
+	return occ
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (occ *ObClusterCreate) SetNillableDeletedAt(t *time.Time) *ObClusterCreate {
+	if t != nil {
+		occ.SetDeletedAt(*t)
+	}
+	return occ
+}
+
 // Mutation returns the ObClusterMutation object of the builder.
 func (occ *ObClusterCreate) Mutation() *ObClusterMutation {
 	return occ.mutation
@@ -81,44 +109,8 @@ func (occ *ObClusterCreate) Mutation() *ObClusterMutation {
 
 // Save creates the ObCluster in the database.
 func (occ *ObClusterCreate) Save(ctx context.Context) (*ObCluster, error) {
-	var (
-		err  error
-		node *ObCluster
-	)
 	occ.defaults()
-	if len(occ.hooks) == 0 {
-		if err = occ.check(); err != nil {
-			return nil, err
-		}
-		node, err = occ.sqlSave(ctx)
-	} else {
-		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-			mutation, ok := m.(*ObClusterMutation)
-			if !ok {
-				return nil, fmt.Errorf("unexpected mutation type %T", m)
-			}
-			if err = occ.check(); err != nil {
-				return nil, err
-			}
-			occ.mutation = mutation
-			if node, err = occ.sqlSave(ctx); err != nil {
-				return nil, err
-			}
-			mutation.id = &node.ID
-			mutation.done = true
-			return node, err
-		})
-		for i := len(occ.hooks) - 1; i >= 0; i-- {
-			if occ.hooks[i] == nil {
-				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
-			}
-			mut = occ.hooks[i](mut)
-		}
-		if _, err := mut.Mutate(ctx, occ.mutation); err != nil {
-			return nil, err
-		}
-	}
-	return node, err
+	return withHooks(ctx, occ.sqlSave, occ.mutation, occ.hooks)
 }
 
 // SaveX calls Save and panics if Save returns an error.
@@ -153,6 +145,10 @@ func (occ *ObClusterCreate) defaults() {
 		v := obcluster.DefaultUpdateTime()
 		occ.mutation.SetUpdateTime(v)
 	}
+	if _, ok := occ.mutation.Namespace(); !ok {
+		v := obcluster.DefaultNamespace
+		occ.mutation.SetNamespace(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -163,6 +159,9 @@ func (occ *ObClusterCreate) check() error {
 	if _, ok := occ.mutation.UpdateTime(); !ok {
 		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "ObCluster.update_time"`)}
 	}
+	if _, ok := occ.mutation.Namespace(); !ok {
+		return &ValidationError{Name: "namespace", err: errors.New(`ent: missing required field "ObCluster.namespace"`)}
+	}
 	if _, ok := occ.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ObCluster.name"`)}
 	}
@@ -184,78 +183,61 @@ func (occ *ObClusterCreate) check() error {
 }
 
 func (occ *ObClusterCreate) sqlSave(ctx context.Context) (*ObCluster, error) {
+	if err := occ.check(); err != nil {
+		return nil, err
+	}
 	_node, _spec := occ.createSpec()
 	if err := sqlgraph.CreateNode(ctx, occ.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{err.Error(), err}
+			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
 		return nil, err
 	}
 	id := _spec.ID.Value.(int64)
 	_node.ID = int(id)
+	occ.mutation.id = &_node.ID
+	occ.mutation.done = true
 	return _node, nil
 }
 
 func (occ *ObClusterCreate) createSpec() (*ObCluster, *sqlgraph.CreateSpec) {
 	var (
 		_node = &ObCluster{config: occ.config}
-		_spec = &sqlgraph.CreateSpec{
-			Table: obcluster.Table,
-			ID: &sqlgraph.FieldSpec{
-				Type:   field.TypeInt,
-				Column: obcluster.FieldID,
-			},
-		}
+		_spec = sqlgraph.NewCreateSpec(obcluster.Table, sqlgraph.NewFieldSpec(obcluster.FieldID, field.TypeInt))
 	)
 	_spec.OnConflict = occ.conflict
 	if value, ok := occ.mutation.CreateTime(); ok {
-		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
-			Type:   field.TypeTime,
-			Value:  value,
-			Column: obcluster.FieldCreateTime,
-		})
+		_spec.SetField(obcluster.FieldCreateTime, field.TypeTime, value)
 		_node.CreateTime = value
 	}
 	if value, ok := occ.mutation.UpdateTime(); ok {
-		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
-			Type:   field.TypeTime,
-			Value:  value,
-			Column: obcluster.FieldUpdateTime,
-		})
+		_spec.SetField(obcluster.FieldUpdateTime, field.TypeTime, value)
 		_node.UpdateTime = value
 	}
+	if value, ok := occ.mutation.Namespace(); ok {
+		_spec.SetField(obcluster.FieldNamespace, field.TypeString, value)
+		_node.Namespace = value
+	}
 	if value, ok := occ.mutation.Name(); ok {
-		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
-			Type:   field.TypeString,
-			Value:  value,
-			Column: obcluster.FieldName,
-		})
+		_spec.SetField(obcluster.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
 	if value, ok := occ.mutation.ObClusterID(); ok {
-		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt64,
-			Value:  value,
-			Column: obcluster.FieldObClusterID,
-		})
+		_spec.SetField(obcluster.FieldObClusterID, field.TypeInt64, value)
 		_node.ObClusterID = value
 	}
 	if value, ok := occ.mutation.GetType(); ok {
-		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
-			Type:   field.TypeString,
-			Value:  value,
-			Column: obcluster.FieldType,
-		})
+		_spec.SetField(obcluster.FieldType, field.TypeString, value)
 		_node.Type = value
 	}
 	if value, ok := occ.mutation.RootserviceJSON(); ok {
-		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
-			Type:   field.TypeString,
-			Value:  value,
-			Column: obcluster.FieldRootserviceJSON,
-		})
+		_spec.SetField(obcluster.FieldRootserviceJSON, field.TypeString, value)
 		_node.RootserviceJSON = value
 	}
+	if value, ok := occ.mutation.DeletedAt(); ok {
+		_spec.SetField(obcluster.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	return _node, _spec
 }
 
@@ -275,7 +257,6 @@ func (occ *ObClusterCreate) createSpec() (*ObCluster, *sqlgraph.CreateSpec) {
 //			SetCreateTime(v+v).
 //		}).
 //		Exec(ctx)
-//
 func (occ *ObClusterCreate) OnConflict(opts ...sql.ConflictOption) *ObClusterUpsertOne {
 	occ.conflict = opts
 	return &ObClusterUpsertOne{
@@ -289,7 +270,6 @@ func (occ *ObClusterCreate) OnConflict(opts ...sql.ConflictOption) *ObClusterUps
 //	client.ObCluster.Create().
 //		OnConflict(sql.ConflictColumns(columns...)).
 //		Exec(ctx)
-//
 func (occ *ObClusterCreate) OnConflictColumns(columns ...string) *ObClusterUpsertOne {
 	occ.conflict = append(occ.conflict, sql.ConflictColumns(columns...))
 	return &ObClusterUpsertOne{
@@ -334,6 +314,18 @@ func (u *ObClusterUpsert) UpdateUpdateTime() *ObClusterUpsert {
 	return u
 }
 
+// SetNamespace sets the "namespace" field.
+func (u *ObClusterUpsert) SetNamespace(v string) *ObClusterUpsert {
+	u.Set(obcluster.FieldNamespace, v)
+	return u
+}
+
+// UpdateNamespace sets the "namespace" field to the value that was provided on create.
+func (u *ObClusterUpsert) UpdateNamespace() *ObClusterUpsert {
+	u.SetExcluded(obcluster.FieldNamespace)
+	return u
+}
+
 // SetName sets the "name" field.
 func (u *ObClusterUpsert) SetName(v string) *ObClusterUpsert {
 	u.Set(obcluster.FieldName, v)
@@ -388,6 +380,24 @@ func (u *ObClusterUpsert) UpdateRootserviceJSON() *ObClusterUpsert {
 	return u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ObClusterUpsert) SetDeletedAt(v time.Time) *ObClusterUpsert {
+	u.Set(obcluster.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ObClusterUpsert) UpdateDeletedAt() *ObClusterUpsert {
+	u.SetExcluded(obcluster.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ObClusterUpsert) ClearDeletedAt() *ObClusterUpsert {
+	u.SetNull(obcluster.FieldDeletedAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -396,7 +406,6 @@ func (u *ObClusterUpsert) UpdateRootserviceJSON() *ObClusterUpsert {
 //			sql.ResolveWithNewValues(),
 //		).
 //		Exec(ctx)
-//
 func (u *ObClusterUpsertOne) UpdateNewValues() *ObClusterUpsertOne {
 	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
 	return u
@@ -405,10 +414,9 @@ func (u *ObClusterUpsertOne) UpdateNewValues() *ObClusterUpsertOne {
 // Ignore sets each column to itself in case of conflict.
 // Using this option is equivalent to using:
 //
-//  client.ObCluster.Create().
-//      OnConflict(sql.ResolveWithIgnore()).
-//      Exec(ctx)
-//
+//	client.ObCluster.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
 func (u *ObClusterUpsertOne) Ignore() *ObClusterUpsertOne {
 	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
 	return u
@@ -458,6 +466,20 @@ func (u *ObClusterUpsertOne) UpdateUpdateTime() *ObClusterUpsertOne {
 	})
 }
 
+// SetNamespace sets the "namespace" field.
+func (u *ObClusterUpsertOne) SetNamespace(v string) *ObClusterUpsertOne {
+	return u.Update(func(s *ObClusterUpsert) {
+		s.SetNamespace(v)
+	})
+}
+
+// UpdateNamespace sets the "namespace" field to the value that was provided on create.
+func (u *ObClusterUpsertOne) UpdateNamespace() *ObClusterUpsertOne {
+	return u.Update(func(s *ObClusterUpsert) {
+		s.UpdateNamespace()
+	})
+}
+
 // SetName sets the "name" field.
 func (u *ObClusterUpsertOne) SetName(v string) *ObClusterUpsertOne {
 	return u.Update(func(s *ObClusterUpsert) {
@@ -521,6 +543,27 @@ func (u *ObClusterUpsertOne) UpdateRootserviceJSON() *ObClusterUpsertOne {
 	})
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ObClusterUpsertOne) SetDeletedAt(v time.Time) *ObClusterUpsertOne {
+	return u.Update(func(s *ObClusterUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ObClusterUpsertOne) UpdateDeletedAt() *ObClusterUpsertOne {
+	return u.Update(func(s *ObClusterUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ObClusterUpsertOne) ClearDeletedAt() *ObClusterUpsertOne {
+	return u.Update(func(s *ObClusterUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *ObClusterUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -557,12 +600,16 @@ func (u *ObClusterUpsertOne) IDX(ctx context.Context) int {
 // ObClusterCreateBulk is the builder for creating many ObCluster entities in bulk.
 type ObClusterCreateBulk struct {
 	config
+	err      error
 	builders []*ObClusterCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the ObCluster entities in the database.
 func (occb *ObClusterCreateBulk) Save(ctx context.Context) ([]*ObCluster, error) {
+	if occb.err != nil {
+		return nil, occb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(occb.builders))
 	nodes := make([]*ObCluster, len(occb.builders))
 	mutators := make([]Mutator, len(occb.builders))
@@ -579,8 +626,8 @@ func (occb *ObClusterCreateBulk) Save(ctx context.Context) ([]*ObCluster, error)
 					return nil, err
 				}
 				builder.mutation = mutation
-				nodes[i], specs[i] = builder.createSpec()
 				var err error
+				nodes[i], specs[i] = builder.createSpec()
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, occb.builders[i+1].mutation)
 				} else {
@@ -589,7 +636,7 @@ func (occb *ObClusterCreateBulk) Save(ctx context.Context) ([]*ObCluster, error)
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, occb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{err.Error(), err}
+							err = &ConstraintError{msg: err.Error(), wrap: err}
 						}
 					}
 				}
@@ -597,11 +644,11 @@ func (occb *ObClusterCreateBulk) Save(ctx context.Context) ([]*ObCluster, error)
 					return nil, err
 				}
 				mutation.id = &nodes[i].ID
-				mutation.done = true
 				if specs[i].ID.Value != nil {
 					id := specs[i].ID.Value.(int64)
 					nodes[i].ID = int(id)
 				}
+				mutation.done = true
 				return nodes[i], nil
 			})
 			for i := len(builder.hooks) - 1; i >= 0; i-- {
@@ -655,7 +702,6 @@ func (occb *ObClusterCreateBulk) ExecX(ctx context.Context) {
 //			SetCreateTime(v+v).
 //		}).
 //		Exec(ctx)
-//
 func (occb *ObClusterCreateBulk) OnConflict(opts ...sql.ConflictOption) *ObClusterUpsertBulk {
 	occb.conflict = opts
 	return &ObClusterUpsertBulk{
@@ -669,7 +715,6 @@ func (occb *ObClusterCreateBulk) OnConflict(opts ...sql.ConflictOption) *ObClust
 //	client.ObCluster.Create().
 //		OnConflict(sql.ConflictColumns(columns...)).
 //		Exec(ctx)
-//
 func (occb *ObClusterCreateBulk) OnConflictColumns(columns ...string) *ObClusterUpsertBulk {
 	occb.conflict = append(occb.conflict, sql.ConflictColumns(columns...))
 	return &ObClusterUpsertBulk{
@@ -691,7 +736,6 @@ type ObClusterUpsertBulk struct {
 //			sql.ResolveWithNewValues(),
 //		).
 //		Exec(ctx)
-//
 func (u *ObClusterUpsertBulk) UpdateNewValues() *ObClusterUpsertBulk {
 	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
 	return u
@@ -703,7 +747,6 @@ func (u *ObClusterUpsertBulk) UpdateNewValues() *ObClusterUpsertBulk {
 //	client.ObCluster.Create().
 //		OnConflict(sql.ResolveWithIgnore()).
 //		Exec(ctx)
-//
 func (u *ObClusterUpsertBulk) Ignore() *ObClusterUpsertBulk {
 	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
 	return u
@@ -753,6 +796,20 @@ func (u *ObClusterUpsertBulk) UpdateUpdateTime() *ObClusterUpsertBulk {
 	})
 }
 
+// SetNamespace sets the "namespace" field.
+func (u *ObClusterUpsertBulk) SetNamespace(v string) *ObClusterUpsertBulk {
+	return u.Update(func(s *ObClusterUpsert) {
+		s.SetNamespace(v)
+	})
+}
+
+// UpdateNamespace sets the "namespace" field to the value that was provided on create.
+func (u *ObClusterUpsertBulk) UpdateNamespace() *ObClusterUpsertBulk {
+	return u.Update(func(s *ObClusterUpsert) {
+		s.UpdateNamespace()
+	})
+}
+
 // SetName sets the "name" field.
 func (u *ObClusterUpsertBulk) SetName(v string) *ObClusterUpsertBulk {
 	return u.Update(func(s *ObClusterUpsert) {
@@ -816,8 +873,32 @@ func (u *ObClusterUpsertBulk) UpdateRootserviceJSON() *ObClusterUpsertBulk {
 	})
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ObClusterUpsertBulk) SetDeletedAt(v time.Time) *ObClusterUpsertBulk {
+	return u.Update(func(s *ObClusterUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ObClusterUpsertBulk) UpdateDeletedAt() *ObClusterUpsertBulk {
+	return u.Update(func(s *ObClusterUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ObClusterUpsertBulk) ClearDeletedAt() *ObClusterUpsertBulk {
+	return u.Update(func(s *ObClusterUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *ObClusterUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
 	for i, b := range u.create.builders {
 		if len(b.conflict) != 0 {
 			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ObClusterCreateBulk instead", i)
@@ -1,10 +1,9 @@
-// Code generated by entc, DO NOT EDIT.
+// Code generated by ent, DO NOT EDIT.
 
 package ent
 
 import (
 	"context"
-	"fmt"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -28,34 +27,7 @@ func (ocd *ObClusterDelete) Where(ps ...predicate.ObCluster) *ObClusterDelete {
 
 // Exec executes the deletion query and returns how many vertices were deleted.
 func (ocd *ObClusterDelete) Exec(ctx context.Context) (int, error) {
-	var (
-		err      error
-		affected int
-	)
-	if len(ocd.hooks) == 0 {
-		affected, err = ocd.sqlExec(ctx)
-	} else {
-		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-			mutation, ok := m.(*ObClusterMutation)
-			if !ok {
-				return nil, fmt.Errorf("unexpected mutation type %T", m)
-			}
-			ocd.mutation = mutation
-			affected, err = ocd.sqlExec(ctx)
-			mutation.done = true
-			return affected, err
-		})
-		for i := len(ocd.hooks) - 1; i >= 0; i-- {
-			if ocd.hooks[i] == nil {
-				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
-			}
-			mut = ocd.hooks[i](mut)
-		}
-		if _, err := mut.Mutate(ctx, ocd.mutation); err != nil {
-			return 0, err
-		}
-	}
-	return affected, err
+	return withHooks(ctx, ocd.sqlExec, ocd.mutation, ocd.hooks)
 }
 
 // ExecX is like Exec, but panics if an error occurs.
@@ -68,15 +40,7 @@ func (ocd *ObClusterDelete) ExecX(ctx context.Context) int {
 }
 
 func (ocd *ObClusterDelete) sqlExec(ctx context.Context) (int, error) {
-	_spec := &sqlgraph.DeleteSpec{
-		Node: &sqlgraph.NodeSpec{
-			Table: obcluster.Table,
-			ID: &sqlgraph.FieldSpec{
-				Type:   field.TypeInt,
-				Column: obcluster.FieldID,
-			},
-		},
-	}
+	_spec := sqlgraph.NewDeleteSpec(obcluster.Table, sqlgraph.NewFieldSpec(obcluster.FieldID, field.TypeInt))
 	if ps := ocd.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -84,7 +48,12 @@ func (ocd *ObClusterDelete) sqlExec(ctx context.Context) (int, error) {
 			}
 		}
 	}
-	return sqlgraph.DeleteNodes(ctx, ocd.driver, _spec)
+	affected, err := sqlgraph.DeleteNodes(ctx, ocd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ocd.mutation.done = true
+	return affected, err
 }
 
 // ObClusterDeleteOne is the builder for deleting a single ObCluster entity.
@@ -92,6 +61,12 @@ type ObClusterDeleteOne struct {
 	ocd *ObClusterDelete
 }
 
+// Where appends a list predicates to the ObClusterDelete builder.
+func (ocdo *ObClusterDeleteOne) Where(ps ...predicate.ObCluster) *ObClusterDeleteOne {
+	ocdo.ocd.mutation.Where(ps...)
+	return ocdo
+}
+
 // Exec executes the deletion query.
 func (ocdo *ObClusterDeleteOne) Exec(ctx context.Context) error {
 	n, err := ocdo.ocd.Exec(ctx)
@@ -107,5 +82,7 @@ func (ocdo *ObClusterDeleteOne) Exec(ctx context.Context) error {
 
 // ExecX is like Exec, but panics if an error occurs.
 func (ocdo *ObClusterDeleteOne) ExecX(ctx context.Context) {
-	ocdo.ocd.ExecX(ctx)
+	if err := ocdo.Exec(ctx); err != nil {
+		panic(err)
+	}
 }
@@ -1,13 +1,13 @@
-// Code generated by entc, DO NOT EDIT.
+// Code generated by ent, DO NOT EDIT.
 
 package ent
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -18,11 +18,9 @@ import (
 // ObClusterQuery is the builder for querying ObCluster entities.
 type ObClusterQuery struct {
 	config
-	limit      *int
-	offset     *int
-	unique     *bool
-	order      []OrderFunc
-	fields     []string
+	ctx        *QueryContext
+	order      []obcluster.OrderOption
+	inters     []Interceptor
 	predicates []predicate.ObCluster
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -35,27 +33,27 @@ func (ocq *ObClusterQuery) Where(ps ...predicate.ObCluster) *ObClusterQuery {
 	return ocq
 }
 
-// Limit adds a limit step to the query.
+// Limit the number of records to be returned by this query.
 func (ocq *ObClusterQuery) Limit(limit int) *ObClusterQuery {
-	ocq.limit = &limit
+	ocq.ctx.Limit = &limit
 	return ocq
 }
 
-// Offset adds an offset step to the query.
+// Offset to start from.
 func (ocq *ObClusterQuery) Offset(offset int) *ObClusterQuery {
-	ocq.offset = &offset
+	ocq.ctx.Offset = &offset
 	return ocq
 }
 
 // Unique configures the query builder to filter duplicate records on query.
 // By default, unique is set to true, and can be disabled using this method.
 func (ocq *ObClusterQuery) Unique(unique bool) *ObClusterQuery {
-	ocq.unique = &unique
+	ocq.ctx.Unique = &unique
 	return ocq
 }
 
-// Order adds an order step to the query.
-func (ocq *ObClusterQuery) Order(o ...OrderFunc) *ObClusterQuery {
+// Order specifies how the records should be ordered.
+func (ocq *ObClusterQuery) Order(o ...obcluster.OrderOption) *ObClusterQuery {
 	ocq.order = append(ocq.order, o...)
 	return ocq
 }
@@ -63,7 +61,7 @@ func (ocq *ObClusterQuery) Order(o ...OrderFunc) *ObClusterQuery {
 // First returns the first ObCluster entity from the query.
 // Returns a *NotFoundError when no ObCluster was found.
 func (ocq *ObClusterQuery) First(ctx context.Context) (*ObCluster, error) {
-	nodes, err := ocq.Limit(1).All(ctx)
+	nodes, err := ocq.Limit(1).All(setContextOp(ctx, ocq.ctx, ent.OpQueryFirst))
 	if err != nil {
 		return nil, err
 	}
@@ -86,7 +84,7 @@ func (ocq *ObClusterQuery) FirstX(ctx context.Context) *ObCluster {
 // Returns a *NotFoundError when no ObCluster ID was found.
 func (ocq *ObClusterQuery) FirstID(ctx context.Context) (id int, err error) {
 	var ids []int
-	if ids, err = ocq.Limit(1).IDs(ctx); err != nil {
+	if ids, err = ocq.Limit(1).IDs(setContextOp(ctx, ocq.ctx, ent.OpQueryFirstID)); err != nil {
 		return
 	}
 	if len(ids) == 0 {
@@ -109,7 +107,7 @@ func (ocq *ObClusterQuery) FirstIDX(ctx context.Context) int {
 // Returns a *NotSingularError when more than one ObCluster entity is found.
 // Returns a *NotFoundError when no ObCluster entities are found.
 func (ocq *ObClusterQuery) Only(ctx context.Context) (*ObCluster, error) {
-	nodes, err := ocq.Limit(2).All(ctx)
+	nodes, err := ocq.Limit(2).All(setContextOp(ctx, ocq.ctx, ent.OpQueryOnly))
 	if err != nil {
 		return nil, err
 	}
@@ -137,7 +135,7 @@ func (ocq *ObClusterQuery) OnlyX(ctx context.Context) *ObCluster {
 // Returns a *NotFoundError when no entities are found.
 func (ocq *ObClusterQuery) OnlyID(ctx context.Context) (id int, err error) {
 	var ids []int
-	if ids, err = ocq.Limit(2).IDs(ctx); err != nil {
+	if ids, err = ocq.Limit(2).IDs(setContextOp(ctx, ocq.ctx, ent.OpQueryOnlyID)); err != nil {
 		return
 	}
 	switch len(ids) {
@@ -162,10 +160,12 @@ func (ocq *ObClusterQuery) OnlyIDX(ctx context.Context) int {
 
 // All executes the query and returns a list of ObClusters.
 func (ocq *ObClusterQuery) All(ctx context.Context) ([]*ObCluster, error) {
+	ctx = setContextOp(ctx, ocq.ctx, ent.OpQueryAll)
 	if err := ocq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return ocq.sqlAll(ctx)
+	qr := querierAll[[]*ObCluster, *ObClusterQuery]()
+	return withInterceptors[[]*ObCluster](ctx, ocq, qr, ocq.inters)
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -178,9 +178,12 @@ func (ocq *ObClusterQuery) AllX(ctx context.Context) []*ObCluster {
 }
 
 // IDs executes the query and returns a list of ObCluster IDs.
-func (ocq *ObClusterQuery) IDs(ctx context.Context) ([]int, error) {
-	var ids []int
-	if err := ocq.Select(obcluster.FieldID).Scan(ctx, &ids); err != nil {
+func (ocq *ObClusterQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ocq.ctx.Unique == nil && ocq.path != nil {
+		ocq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ocq.ctx, ent.OpQueryIDs)
+	if err = ocq.Select(obcluster.FieldID).Scan(ctx, &ids); err != nil {
 		return nil, err
 	}
 	return ids, nil
@@ -197,10 +200,11 @@ func (ocq *ObClusterQuery) IDsX(ctx context.Context) []int {
 
 // Count returns the count of the given query.
 func (ocq *ObClusterQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ocq.ctx, ent.OpQueryCount)
 	if err := ocq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return ocq.sqlCount(ctx)
+	return withInterceptors[int](ctx, ocq, querierCount[*ObClusterQuery](), ocq.inters)
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -214,10 +218,15 @@ func (ocq *ObClusterQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (ocq *ObClusterQuery) Exist(ctx context.Context) (bool, error) {
-	if err := ocq.prepareQuery(ctx); err != nil {
-		return false, err
+	ctx = setContextOp(ctx, ocq.ctx, ent.OpQueryExist)
+	switch _, err := ocq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
 	}
-	return ocq.sqlExist(ctx)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -237,14 +246,13 @@ func (ocq *ObClusterQuery) Clone() *ObClusterQuery {
 	}
 	return &ObClusterQuery{
 		config:     ocq.config,
-		limit:      ocq.limit,
-		offset:     ocq.offset,
-		order:      append([]OrderFunc{}, ocq.order...),
+		ctx:        ocq.ctx.Clone(),
+		order:      append([]obcluster.OrderOption{}, ocq.order...),
+		inters:     append([]Interceptor{}, ocq.inters...),
 		predicates: append([]predicate.ObCluster{}, ocq.predicates...),
 		// clone intermediate query.
-		sql:    ocq.sql.Clone(),
-		path:   ocq.path,
-		unique: ocq.unique,
+		sql:  ocq.sql.Clone(),
+		path: ocq.path,
 	}
 }
 
@@ -262,17 +270,13 @@ func (ocq *ObClusterQuery) Clone() *ObClusterQuery {
 //		GroupBy(obcluster.FieldCreateTime).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
-//
 func (ocq *ObClusterQuery) GroupBy(field string, fields ...string) *ObClusterGroupBy {
-	group := &ObClusterGroupBy{config: ocq.config}
-	group.fields = append([]string{field}, fields...)
-	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
-		if err := ocq.prepareQuery(ctx); err != nil {
-			return nil, err
-		}
-		return ocq.sqlQuery(ctx), nil
-	}
-	return group
+	ocq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ObClusterGroupBy{build: ocq}
+	grbuild.flds = &ocq.ctx.Fields
+	grbuild.label = obcluster.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
 }
 
 // Select allows the selection one or more fields/columns for the given query,
@@ -287,14 +291,31 @@ func (ocq *ObClusterQuery) GroupBy(field string, fields ...string) *ObClusterGro
 //	client.ObCluster.Query().
 //		Select(obcluster.FieldCreateTime).
 //		Scan(ctx, &v)
-//
 func (ocq *ObClusterQuery) Select(fields ...string) *ObClusterSelect {
-	ocq.fields = append(ocq.fields, fields...)
-	return &ObClusterSelect{ObClusterQuery: ocq}
+	ocq.ctx.Fields = append(ocq.ctx.Fields, fields...)
+	sbuild := &ObClusterSelect{ObClusterQuery: ocq}
+	sbuild.label = obcluster.Label
+	sbuild.flds, sbuild.scan = &ocq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ObClusterSelect configured with the given aggregations.
+func (ocq *ObClusterQuery) Aggregate(fns ...AggregateFunc) *ObClusterSelect {
+	return ocq.Select().Aggregate(fns...)
 }
 
 func (ocq *ObClusterQuery) prepareQuery(ctx context.Context) error {
-	for _, f := range ocq.fields {
+	for _, inter := range ocq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ocq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ocq.ctx.Fields {
 		if !obcluster.ValidColumn(f) {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
@@ -309,23 +330,22 @@ func (ocq *ObClusterQuery) prepareQuery(ctx context.Context) error {
 	return nil
 }
 
-func (ocq *ObClusterQuery) sqlAll(ctx context.Context) ([]*ObCluster, error) {
+func (ocq *ObClusterQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ObCluster, error) {
 	var (
 		nodes = []*ObCluster{}
 		_spec = ocq.querySpec()
 	)
-	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ObCluster).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
 		node := &ObCluster{config: ocq.config}
 		nodes = append(nodes, node)
-		return node.scanValues(columns)
-	}
-	_spec.Assign = func(columns []string, values []interface{}) error {
-		if len(nodes) == 0 {
-			return fmt.Errorf("ent: Assign called without calling ScanValues")
-		}
-		node := nodes[len(nodes)-1]
 		return node.assignValues(columns, values)
 	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
 	if err := sqlgraph.QueryNodes(ctx, ocq.driver, _spec); err != nil {
 		return nil, err
 	}
@@ -337,38 +357,22 @@ func (ocq *ObClusterQuery) sqlAll(ctx context.Context) ([]*ObCluster, error) {
 
 func (ocq *ObClusterQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := ocq.querySpec()
-	_spec.Node.Columns = ocq.fields
-	if len(ocq.fields) > 0 {
-		_spec.Unique = ocq.unique != nil && *ocq.unique
+	_spec.Node.Columns = ocq.ctx.Fields
+	if len(ocq.ctx.Fields) > 0 {
+		_spec.Unique = ocq.ctx.Unique != nil && *ocq.ctx.Unique
 	}
 	return sqlgraph.CountNodes(ctx, ocq.driver, _spec)
 }
 
-func (ocq *ObClusterQuery) sqlExist(ctx context.Context) (bool, error) {
-	n, err := ocq.sqlCount(ctx)
-	if err != nil {
-		return false, fmt.Errorf("ent: check existence: %w", err)
-	}
-	return n > 0, nil
-}
-
 func (ocq *ObClusterQuery) querySpec() *sqlgraph.QuerySpec {
-	_spec := &sqlgraph.QuerySpec{
-		Node: &sqlgraph.NodeSpec{
-			Table:   obcluster.Table,
-			Columns: obcluster.Columns,
-			ID: &sqlgraph.FieldSpec{
-				Type:   field.TypeInt,
-				Column: obcluster.FieldID,
-			},
-		},
-		From:   ocq.sql,
-		Unique: true,
-	}
-	if unique := ocq.unique; unique != nil {
+	_spec := sqlgraph.NewQuerySpec(obcluster.Table, obcluster.Columns, sqlgraph.NewFieldSpec(obcluster.FieldID, field.TypeInt))
+	_spec.From = ocq.sql
+	if unique := ocq.ctx.Unique; unique != nil {
 		_spec.Unique = *unique
+	} else if ocq.path != nil {
+		_spec.Unique = true
 	}
-	if fields := ocq.fields; len(fields) > 0 {
+	if fields := ocq.ctx.Fields; len(fields) > 0 {
 		_spec.Node.Columns = make([]string, 0, len(fields))
 		_spec.Node.Columns = append(_spec.Node.Columns, obcluster.FieldID)
 		for i := range fields {
@@ -384,10 +388,10 @@ func (ocq *ObClusterQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
-	if limit := ocq.limit; limit != nil {
+	if limit := ocq.ctx.Limit; limit != nil {
 		_spec.Limit = *limit
 	}
-	if offset := ocq.offset; offset != nil {
+	if offset := ocq.ctx.Offset; offset != nil {
 		_spec.Offset = *offset
 	}
 	if ps := ocq.order; len(ps) > 0 {
@@ -403,7 +407,7 @@ func (ocq *ObClusterQuery) querySpec() *sqlgraph.QuerySpec {
 func (ocq *ObClusterQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	builder := sql.Dialect(ocq.driver.Dialect())
 	t1 := builder.Table(obcluster.Table)
-	columns := ocq.fields
+	columns := ocq.ctx.Fields
 	if len(columns) == 0 {
 		columns = obcluster.Columns
 	}
@@ -412,7 +416,7 @@ func (ocq *ObClusterQuery) sqlQuery(ctx context.Context) *sql.Selector {
 		selector = ocq.sql
 		selector.Select(selector.Columns(columns...)...)
 	}
-	if ocq.unique != nil && *ocq.unique {
+	if ocq.ctx.Unique != nil && *ocq.ctx.Unique {
 		selector.Distinct()
 	}
 	for _, p := range ocq.predicates {
@@ -421,12 +425,12 @@ func (ocq *ObClusterQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	for _, p := range ocq.order {
 		p(selector)
 	}
-	if offset := ocq.offset; offset != nil {
+	if offset := ocq.ctx.Offset; offset != nil {
 		// limit is mandatory for offset clause. We start
 		// with default value, and override it below if needed.
 		selector.Offset(*offset).Limit(math.MaxInt32)
 	}
-	if limit := ocq.limit; limit != nil {
+	if limit := ocq.ctx.Limit; limit != nil {
 		selector.Limit(*limit)
 	}
 	return selector
@@ -434,12 +438,8 @@ func (ocq *ObClusterQuery) sqlQuery(ctx context.Context) *sql.Selector {
 
 // ObClusterGroupBy is the group-by builder for ObCluster entities.
 type ObClusterGroupBy struct {
-	config
-	fields []string
-	fns    []AggregateFunc
-	// intermediate query (i.e. traversal path).
-	sql  *sql.Selector
-	path func(context.Context) (*sql.Selector, error)
+	selector
+	build *ObClusterQuery
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -448,471 +448,77 @@ func (ocgb *ObClusterGroupBy) Aggregate(fns ...AggregateFunc) *ObClusterGroupBy
 	return ocgb
 }
 
-// Scan applies the group-by query and scans the result into the given value.
-func (ocgb *ObClusterGroupBy) Scan(ctx context.Context, v interface{}) error {
-	query, err := ocgb.path(ctx)
-	if err != nil {
+// Scan applies the selector query and scans the result into the given value.
+func (ocgb *ObClusterGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ocgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ocgb.build.prepareQuery(ctx); err != nil {
 		return err
 	}
-	ocgb.sql = query
-	return ocgb.sqlScan(ctx, v)
-}
-
-// ScanX is like Scan, but panics if an error occurs.
-func (ocgb *ObClusterGroupBy) ScanX(ctx context.Context, v interface{}) {
-	if err := ocgb.Scan(ctx, v); err != nil {
-		panic(err)
-	}
-}
-
-// Strings returns list of strings from group-by.
-// It is only allowed when executing a group-by query with one field.
-func (ocgb *ObClusterGroupBy) Strings(ctx context.Context) ([]string, error) {
-	if len(ocgb.fields) > 1 {
-		return nil, errors.New("ent: ObClusterGroupBy.Strings is not achievable when grouping more than 1 field")
-	}
-	var v []string
-	if err := ocgb.Scan(ctx, &v); err != nil {
-		return nil, err
-	}
-	return v, nil
-}
-
-// StringsX is like Strings, but panics if an error occurs.
-func (ocgb *ObClusterGroupBy) StringsX(ctx context.Context) []string {
-	v, err := ocgb.Strings(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// String returns a single string from a group-by query.
-// It is only allowed when executing a group-by query with one field.
-func (ocgb *ObClusterGroupBy) String(ctx context.Context) (_ string, err error) {
-	var v []string
-	if v, err = ocgb.Strings(ctx); err != nil {
-		return
-	}
-	switch len(v) {
-	case 1:
-		return v[0], nil
-	case 0:
-		err = &NotFoundError{obcluster.Label}
-	default:
-		err = fmt.Errorf("ent: ObClusterGroupBy.Strings returned %d results when one was expected", len(v))
-	}
-	return
-}
-
-// StringX is like String, but panics if an error occurs.
-func (ocgb *ObClusterGroupBy) StringX(ctx context.Context) string {
-	v, err := ocgb.String(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Ints returns list of ints from group-by.
-// It is only allowed when executing a group-by query with one field.
-func (ocgb *ObClusterGroupBy) Ints(ctx context.Context) ([]int, error) {
-	if len(ocgb.fields) > 1 {
-		return nil, errors.New("ent: ObClusterGroupBy.Ints is not achievable when grouping more than 1 field")
-	}
-	var v []int
-	if err := ocgb.Scan(ctx, &v); err != nil {
-		return nil, err
-	}
-	return v, nil
-}
-
-// IntsX is like Ints, but panics if an error occurs.
-func (ocgb *ObClusterGroupBy) IntsX(ctx context.Context) []int {
-	v, err := ocgb.Ints(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
+	return scanWithInterceptors[*ObClusterQuery, *ObClusterGroupBy](ctx, ocgb.build, ocgb, ocgb.build.inters, v)
 }
 
-// Int returns a single int from a group-by query.
-// It is only allowed when executing a group-by query with one field.
-func (ocgb *ObClusterGroupBy) Int(ctx context.Context) (_ int, err error) {
-	var v []int
-	if v, err = ocgb.Ints(ctx); err != nil {
-		return
-	}
-	switch len(v) {
-	case 1:
-		return v[0], nil
-	case 0:
-		err = &NotFoundError{obcluster.Label}
-	default:
-		err = fmt.Errorf("ent: ObClusterGroupBy.Ints returned %d results when one was expected", len(v))
-	}
-	return
-}
-
-// IntX is like Int, but panics if an error occurs.
-func (ocgb *ObClusterGroupBy) IntX(ctx context.Context) int {
-	v, err := ocgb.Int(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Float64s returns list of float64s from group-by.
-// It is only allowed when executing a group-by query with one field.
-func (ocgb *ObClusterGroupBy) Float64s(ctx context.Context) ([]float64, error) {
-	if len(ocgb.fields) > 1 {
-		return nil, errors.New("ent: ObClusterGroupBy.Float64s is not achievable when grouping more than 1 field")
-	}
-	var v []float64
-	if err := ocgb.Scan(ctx, &v); err != nil {
-		return nil, err
-	}
-	return v, nil
-}
-
-// Float64sX is like Float64s, but panics if an error occurs.
-func (ocgb *ObClusterGroupBy) Float64sX(ctx context.Context) []float64 {
-	v, err := ocgb.Float64s(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Float64 returns a single float64 from a group-by query.
-// It is only allowed when executing a group-by query with one field.
-func (ocgb *ObClusterGroupBy) Float64(ctx context.Context) (_ float64, err error) {
-	var v []float64
-	if v, err = ocgb.Float64s(ctx); err != nil {
-		return
-	}
-	switch len(v) {
-	case 1:
-		return v[0], nil
-	case 0:
-		err = &NotFoundError{obcluster.Label}
-	default:
-		err = fmt.Errorf("ent: ObClusterGroupBy.Float64s returned %d results when one was expected", len(v))
-	}
-	return
-}
-
-// Float64X is like Float64, but panics if an error occurs.
-func (ocgb *ObClusterGroupBy) Float64X(ctx context.Context) float64 {
-	v, err := ocgb.Float64(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Bools returns list of bools from group-by.
-// It is only allowed when executing a group-by query with one field.
-func (ocgb *ObClusterGroupBy) Bools(ctx context.Context) ([]bool, error) {
-	if len(ocgb.fields) > 1 {
-		return nil, errors.New("ent: ObClusterGroupBy.Bools is not achievable when grouping more than 1 field")
-	}
-	var v []bool
-	if err := ocgb.Scan(ctx, &v); err != nil {
-		return nil, err
-	}
-	return v, nil
-}
-
-// BoolsX is like Bools, but panics if an error occurs.
-func (ocgb *ObClusterGroupBy) BoolsX(ctx context.Context) []bool {
-	v, err := ocgb.Bools(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Bool returns a single bool from a group-by query.
-// It is only allowed when executing a group-by query with one field.
-func (ocgb *ObClusterGroupBy) Bool(ctx context.Context) (_ bool, err error) {
-	var v []bool
-	if v, err = ocgb.Bools(ctx); err != nil {
-		return
-	}
-	switch len(v) {
-	case 1:
-		return v[0], nil
-	case 0:
-		err = &NotFoundError{obcluster.Label}
-	default:
-		err = fmt.Errorf("ent: ObClusterGroupBy.Bools returned %d results when one was expected", len(v))
-	}
-	return
-}
-
-// BoolX is like Bool, but panics if an error occurs.
-func (ocgb *ObClusterGroupBy) BoolX(ctx context.Context) bool {
-	v, err := ocgb.Bool(ctx)
-	if err != nil {
-		panic(err)
+func (ocgb *ObClusterGroupBy) sqlScan(ctx context.Context, root *ObClusterQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ocgb.fns))
+	for _, fn := range ocgb.fns {
+		aggregation = append(aggregation, fn(selector))
 	}
-	return v
-}
-
-func (ocgb *ObClusterGroupBy) sqlScan(ctx context.Context, v interface{}) error {
-	for _, f := range ocgb.fields {
-		if !obcluster.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ocgb.flds)+len(ocgb.fns))
+		for _, f := range *ocgb.flds {
+			columns = append(columns, selector.C(f))
 		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
 	}
-	selector := ocgb.sqlQuery()
+	selector.GroupBy(selector.Columns(*ocgb.flds...)...)
 	if err := selector.Err(); err != nil {
 		return err
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := ocgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := ocgb.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
 
-func (ocgb *ObClusterGroupBy) sqlQuery() *sql.Selector {
-	selector := ocgb.sql.Select()
-	aggregation := make([]string, 0, len(ocgb.fns))
-	for _, fn := range ocgb.fns {
-		aggregation = append(aggregation, fn(selector))
-	}
-	// If no columns were selected in a custom aggregation function, the default
-	// selection is the fields used for "group-by", and the aggregation functions.
-	if len(selector.SelectedColumns()) == 0 {
-		columns := make([]string, 0, len(ocgb.fields)+len(ocgb.fns))
-		for _, f := range ocgb.fields {
-			columns = append(columns, selector.C(f))
-		}
-		columns = append(columns, aggregation...)
-		selector.Select(columns...)
-	}
-	return selector.GroupBy(selector.Columns(ocgb.fields...)...)
-}
-
 // ObClusterSelect is the builder for selecting fields of ObCluster entities.
 type ObClusterSelect struct {
 	*ObClusterQuery
-	// intermediate query (i.e. traversal path).
-	sql *sql.Selector
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ocs *ObClusterSelect) Aggregate(fns ...AggregateFunc) *ObClusterSelect {
+	ocs.fns = append(ocs.fns, fns...)
+	return ocs
 }
 
 // Scan applies the selector query and scans the result into the given value.
-func (ocs *ObClusterSelect) Scan(ctx context.Context, v interface{}) error {
+func (ocs *ObClusterSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ocs.ctx, ent.OpQuerySelect)
 	if err := ocs.prepareQuery(ctx); err != nil {
 		return err
 	}
-	ocs.sql = ocs.ObClusterQuery.sqlQuery(ctx)
-	return ocs.sqlScan(ctx, v)
-}
-
-// ScanX is like Scan, but panics if an error occurs.
-func (ocs *ObClusterSelect) ScanX(ctx context.Context, v interface{}) {
-	if err := ocs.Scan(ctx, v); err != nil {
-		panic(err)
-	}
-}
-
-// Strings returns list of strings from a selector. It is only allowed when selecting one field.
-func (ocs *ObClusterSelect) Strings(ctx context.Context) ([]string, error) {
-	if len(ocs.fields) > 1 {
-		return nil, errors.New("ent: ObClusterSelect.Strings is not achievable when selecting more than 1 field")
-	}
-	var v []string
-	if err := ocs.Scan(ctx, &v); err != nil {
-		return nil, err
-	}
-	return v, nil
-}
-
-// StringsX is like Strings, but panics if an error occurs.
-func (ocs *ObClusterSelect) StringsX(ctx context.Context) []string {
-	v, err := ocs.Strings(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// String returns a single string from a selector. It is only allowed when selecting one field.
-func (ocs *ObClusterSelect) String(ctx context.Context) (_ string, err error) {
-	var v []string
-	if v, err = ocs.Strings(ctx); err != nil {
-		return
-	}
-	switch len(v) {
-	case 1:
-		return v[0], nil
-	case 0:
-		err = &NotFoundError{obcluster.Label}
-	default:
-		err = fmt.Errorf("ent: ObClusterSelect.Strings returned %d results when one was expected", len(v))
-	}
-	return
-}
-
-// StringX is like String, but panics if an error occurs.
-func (ocs *ObClusterSelect) StringX(ctx context.Context) string {
-	v, err := ocs.String(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Ints returns list of ints from a selector. It is only allowed when selecting one field.
-func (ocs *ObClusterSelect) Ints(ctx context.Context) ([]int, error) {
-	if len(ocs.fields) > 1 {
-		return nil, errors.New("ent: ObClusterSelect.Ints is not achievable when selecting more than 1 field")
-	}
-	var v []int
-	if err := ocs.Scan(ctx, &v); err != nil {
-		return nil, err
-	}
-	return v, nil
-}
-
-// IntsX is like Ints, but panics if an error occurs.
-func (ocs *ObClusterSelect) IntsX(ctx context.Context) []int {
-	v, err := ocs.Ints(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
+	return scanWithInterceptors[*ObClusterQuery, *ObClusterSelect](ctx, ocs.ObClusterQuery, ocs, ocs.inters, v)
 }
 
-// Int returns a single int from a selector. It is only allowed when selecting one field.
-func (ocs *ObClusterSelect) Int(ctx context.Context) (_ int, err error) {
-	var v []int
-	if v, err = ocs.Ints(ctx); err != nil {
-		return
-	}
-	switch len(v) {
-	case 1:
-		return v[0], nil
-	case 0:
-		err = &NotFoundError{obcluster.Label}
-	default:
-		err = fmt.Errorf("ent: ObClusterSelect.Ints returned %d results when one was expected", len(v))
-	}
-	return
-}
-
-// IntX is like Int, but panics if an error occurs.
-func (ocs *ObClusterSelect) IntX(ctx context.Context) int {
-	v, err := ocs.Int(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
-func (ocs *ObClusterSelect) Float64s(ctx context.Context) ([]float64, error) {
-	if len(ocs.fields) > 1 {
-		return nil, errors.New("ent: ObClusterSelect.Float64s is not achievable when selecting more than 1 field")
-	}
-	var v []float64
-	if err := ocs.Scan(ctx, &v); err != nil {
-		return nil, err
-	}
-	return v, nil
-}
-
-// Float64sX is like Float64s, but panics if an error occurs.
-func (ocs *ObClusterSelect) Float64sX(ctx context.Context) []float64 {
-	v, err := ocs.Float64s(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
-func (ocs *ObClusterSelect) Float64(ctx context.Context) (_ float64, err error) {
-	var v []float64
-	if v, err = ocs.Float64s(ctx); err != nil {
-		return
-	}
-	switch len(v) {
-	case 1:
-		return v[0], nil
-	case 0:
-		err = &NotFoundError{obcluster.Label}
-	default:
-		err = fmt.Errorf("ent: ObClusterSelect.Float64s returned %d results when one was expected", len(v))
-	}
-	return
-}
-
-// Float64X is like Float64, but panics if an error occurs.
-func (ocs *ObClusterSelect) Float64X(ctx context.Context) float64 {
-	v, err := ocs.Float64(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Bools returns list of bools from a selector. It is only allowed when selecting one field.
-func (ocs *ObClusterSelect) Bools(ctx context.Context) ([]bool, error) {
-	if len(ocs.fields) > 1 {
-		return nil, errors.New("ent: ObClusterSelect.Bools is not achievable when selecting more than 1 field")
-	}
-	var v []bool
-	if err := ocs.Scan(ctx, &v); err != nil {
-		return nil, err
-	}
-	return v, nil
-}
-
-// BoolsX is like Bools, but panics if an error occurs.
-func (ocs *ObClusterSelect) BoolsX(ctx context.Context) []bool {
-	v, err := ocs.Bools(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Bool returns a single bool from a selector. It is only allowed when selecting one field.
-func (ocs *ObClusterSelect) Bool(ctx context.Context) (_ bool, err error) {
-	var v []bool
-	if v, err = ocs.Bools(ctx); err != nil {
-		return
-	}
-	switch len(v) {
-	case 1:
-		return v[0], nil
-	case 0:
-		err = &NotFoundError{obcluster.Label}
-	default:
-		err = fmt.Errorf("ent: ObClusterSelect.Bools returned %d results when one was expected", len(v))
+func (ocs *ObClusterSelect) sqlScan(ctx context.Context, root *ObClusterQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ocs.fns))
+	for _, fn := range ocs.fns {
+		aggregation = append(aggregation, fn(selector))
 	}
-	return
-}
-
-// BoolX is like Bool, but panics if an error occurs.
-func (ocs *ObClusterSelect) BoolX(ctx context.Context) bool {
-	v, err := ocs.Bool(ctx)
-	if err != nil {
-		panic(err)
+	switch n := len(*ocs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
 	}
-	return v
-}
-
-func (ocs *ObClusterSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
-	query, args := ocs.sql.Query()
+	query, args := selector.Query()
 	if err := ocs.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -1,4 +1,4 @@
-// Code generated by entc, DO NOT EDIT.
+// Code generated by ent, DO NOT EDIT.
 
 package ent
 
@@ -48,12 +48,34 @@ func (ocu *ObClusterUpdate) SetUpdateTime(t time.Time) *ObClusterUpdate {
 	return ocu
 }
 
+// SetNamespace sets the "namespace" field.
+func (ocu *ObClusterUpdate) SetNamespace(s string) *ObClusterUpdate {
+	ocu.mutation.SetNamespace(s)
+	return ocu
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (ocu *ObClusterUpdate) SetNillableNamespace(s *string) *ObClusterUpdate {
+	if s != nil {
+		ocu.SetNamespace(*s)
+	}
+	return ocu
+}
+
 // SetName sets the "name" field.
 func (ocu *ObClusterUpdate) SetName(s string) *ObClusterUpdate {
 	ocu.mutation.SetName(s)
 	return ocu
 }
 
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ocu *ObClusterUpdate) SetNillableName(s *string) *ObClusterUpdate {
+	if s != nil {
+		ocu.SetName(*s)
+	}
+	return ocu
+}
+
 // SetObClusterID sets the "ob_cluster_id" field.
 func (ocu *ObClusterUpdate) SetObClusterID(i int64) *ObClusterUpdate {
 	ocu.mutation.ResetObClusterID()
@@ -61,6 +83,14 @@ func (ocu *ObClusterUpdate) SetObClusterID(i int64) *ObClusterUpdate {
 	return ocu
 }
 
+// SetNillableObClusterID sets the "ob_cluster_id" field if the given value is not nil.
+func (ocu *ObClusterUpdate) SetNillableObClusterID(i *int64) *ObClusterUpdate {
+	if i != nil {
+		ocu.SetObClusterID(*i)
+	}
+	return ocu
+}
+
 // AddObClusterID adds i to the "ob_cluster_id" field.
 func (ocu *ObClusterUpdate) AddObClusterID(i int64) *ObClusterUpdate {
 	ocu.mutation.AddObClusterID(i)
@@ -73,12 +103,48 @@ func (ocu *ObClusterUpdate) SetType(s string) *ObClusterUpdate {
 	return ocu
 }
 
+// SetNillableType sets the "type" field if the given value is not nil.
+func (ocu *ObClusterUpdate) SetNillableType(s *string) *ObClusterUpdate {
+	if s != nil {
+		ocu.SetType(*s)
+	}
+	return ocu
+}
+
 // SetRootserviceJSON sets the "rootservice_json" field.
 func (ocu *ObClusterUpdate) SetRootserviceJSON(s string) *ObClusterUpdate {
 	ocu.mutation.SetRootserviceJSON(s)
 	return ocu
 }
 
+// SetNillableRootserviceJSON sets the "rootservice_json" field if the given value is not nil.
+func (ocu *ObClusterUpdate) SetNillableRootserviceJSON(s *string) *ObClusterUpdate {
+	if s != nil {
+		ocu.SetRootserviceJSON(*s)
+	}
+	return ocu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ocu *ObClusterUpdate) SetDeletedAt(t time.Time) *ObClusterUpdate {
+	ocu.mutation.SetDeletedAt(t)
+	return ocu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ocu *ObClusterUpdate) SetNillableDeletedAt(t *time.Time) *ObClusterUpdate {
+	if t != nil {
+		ocu.SetDeletedAt(*t)
+	}
+	return ocu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ocu *ObClusterUpdate) ClearDeletedAt() *ObClusterUpdate {
+	ocu.mutation.ClearDeletedAt()
+	return ocu
+}
+
 // Mutation returns the ObClusterMutation object of the builder.
 func (ocu *ObClusterUpdate) Mutation() *ObClusterMutation {
 	return ocu.mutation
@@ -86,41 +152,8 @@ func (ocu *ObClusterUpdate) Mutation() *ObClusterMutation {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ocu *ObClusterUpdate) Save(ctx context.Context) (int, error) {
-	var (
-		err      error
-		affected int
-	)
 	ocu.defaults()
-	if len(ocu.hooks) == 0 {
-		if err = ocu.check(); err != nil {
-			return 0, err
-		}
-		affected, err = ocu.sqlSave(ctx)
-	} else {
-		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-			mutation, ok := m.(*ObClusterMutation)
-			if !ok {
-				return nil, fmt.Errorf("unexpected mutation type %T", m)
-			}
-			if err = ocu.check(); err != nil {
-				return 0, err
-			}
-			ocu.mutation = mutation
-			affected, err = ocu.sqlSave(ctx)
-			mutation.done = true
-			return affected, err
-		})
-		for i := len(ocu.hooks) - 1; i >= 0; i-- {
-			if ocu.hooks[i] == nil {
-				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
-			}
-			mut = ocu.hooks[i](mut)
-		}
-		if _, err := mut.Mutate(ctx, ocu.mutation); err != nil {
-			return 0, err
-		}
-	}
-	return affected, err
+	return withHooks(ctx, ocu.sqlSave, ocu.mutation, ocu.hooks)
 }
 
 // SaveX is like Save, but panics if an error occurs.
@@ -164,16 +197,10 @@ func (ocu *ObClusterUpdate) check() error {
 }
 
 func (ocu *ObClusterUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	_spec := &sqlgraph.UpdateSpec{
-		Node: &sqlgraph.NodeSpec{
-			Table:   obcluster.Table,
-			Columns: obcluster.Columns,
-			ID: &sqlgraph.FieldSpec{
-				Type:   field.TypeInt,
-				Column: obcluster.FieldID,
-			},
-		},
+	if err := ocu.check(); err != nil {
+		return n, err
 	}
+	_spec := sqlgraph.NewUpdateSpec(obcluster.Table, obcluster.Columns, sqlgraph.NewFieldSpec(obcluster.FieldID, field.TypeInt))
 	if ps := ocu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -182,62 +209,44 @@ func (ocu *ObClusterUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 	}
 	if value, ok := ocu.mutation.CreateTime(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeTime,
-			Value:  value,
-			Column: obcluster.FieldCreateTime,
-		})
+		_spec.SetField(obcluster.FieldCreateTime, field.TypeTime, value)
 	}
 	if value, ok := ocu.mutation.UpdateTime(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeTime,
-			Value:  value,
-			Column: obcluster.FieldUpdateTime,
-		})
+		_spec.SetField(obcluster.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := ocu.mutation.Namespace(); ok {
+		_spec.SetField(obcluster.FieldNamespace, field.TypeString, value)
 	}
 	if value, ok := ocu.mutation.Name(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeString,
-			Value:  value,
-			Column: obcluster.FieldName,
-		})
+		_spec.SetField(obcluster.FieldName, field.TypeString, value)
 	}
 	if value, ok := ocu.mutation.ObClusterID(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt64,
-			Value:  value,
-			Column: obcluster.FieldObClusterID,
-		})
+		_spec.SetField(obcluster.FieldObClusterID, field.TypeInt64, value)
 	}
 	if value, ok := ocu.mutation.AddedObClusterID(); ok {
-		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt64,
-			Value:  value,
-			Column: obcluster.FieldObClusterID,
-		})
+		_spec.AddField(obcluster.FieldObClusterID, field.TypeInt64, value)
 	}
 	if value, ok := ocu.mutation.GetType(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeString,
-			Value:  value,
-			Column: obcluster.FieldType,
-		})
+		_spec.SetField(obcluster.FieldType, field.TypeString, value)
 	}
 	if value, ok := ocu.mutation.RootserviceJSON(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeString,
-			Value:  value,
-			Column: obcluster.FieldRootserviceJSON,
-		})
+		_spec.SetField(obcluster.FieldRootserviceJSON, field.TypeString, value)
+	}
+	if value, ok := ocu.mutation.DeletedAt(); ok {
+		_spec.SetField(obcluster.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ocu.mutation.DeletedAtCleared() {
+		_spec.ClearField(obcluster.FieldDeletedAt, field.TypeTime)
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ocu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{obcluster.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{err.Error(), err}
+			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
 		return 0, err
 	}
+	ocu.mutation.done = true
 	return n, nil
 }
 
@@ -269,12 +278,34 @@ func (ocuo *ObClusterUpdateOne) SetUpdateTime(t time.Time) *ObClusterUpdateOne {
 	return ocuo
 }
 
+// SetNamespace sets the "namespace" field.
+func (ocuo *ObClusterUpdateOne) SetNamespace(s string) *ObClusterUpdateOne {
+	ocuo.mutation.SetNamespace(s)
+	return ocuo
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (ocuo *ObClusterUpdateOne) SetNillableNamespace(s *string) *ObClusterUpdateOne {
+	if s != nil {
+		ocuo.SetNamespace(*s)
+	}
+	return ocuo
+}
+
 // SetName sets the "name" field.
 func (ocuo *ObClusterUpdateOne) SetName(s string) *ObClusterUpdateOne {
 	ocuo.mutation.SetName(s)
 	return ocuo
 }
 
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ocuo *ObClusterUpdateOne) SetNillableName(s *string) *ObClusterUpdateOne {
+	if s != nil {
+		ocuo.SetName(*s)
+	}
+	return ocuo
+}
+
 // SetObClusterID sets the "ob_cluster_id" field.
 func (ocuo *ObClusterUpdateOne) SetObClusterID(i int64) *ObClusterUpdateOne {
 	ocuo.mutation.ResetObClusterID()
@@ -282,6 +313,14 @@ func (ocuo *ObClusterUpdateOne) SetObClusterID(i int64) *ObClusterUpdateOne {
 	return ocuo
 }
 
+// SetNillableObClusterID sets the "ob_cluster_id" field if the given value is not nil.
+func (ocuo *ObClusterUpdateOne) SetNillableObClusterID(i *int64) *ObClusterUpdateOne {
+	if i != nil {
+		ocuo.SetObClusterID(*i)
+	}
+	return ocuo
+}
+
 // AddObClusterID adds i to the "ob_cluster_id" field.
 func (ocuo *ObClusterUpdateOne) AddObClusterID(i int64) *ObClusterUpdateOne {
 	ocuo.mutation.AddObClusterID(i)
@@ -294,17 +333,59 @@ func (ocuo *ObClusterUpdateOne) SetType(s string) *ObClusterUpdateOne {
 	return ocuo
 }
 
+// SetNillableType sets the "type" field if the given value is not nil.
+func (ocuo *ObClusterUpdateOne) SetNillableType(s *string) *ObClusterUpdateOne {
+	if s != nil {
+		ocuo.SetType(*s)
+	}
+	return ocuo
+}
+
 // SetRootserviceJSON sets the "rootservice_json" field.
 func (ocuo *ObClusterUpdateOne) SetRootserviceJSON(s string) *ObClusterUpdateOne {
 	ocuo.mutation.SetRootserviceJSON(s)
 	return ocuo
 }
 
+// SetNillableRootserviceJSON sets the "rootservice_json" field if the given value is not nil.
+func (ocuo *ObClusterUpdateOne) SetNillableRootserviceJSON(s *string) *ObClusterUpdateOne {
+	if s != nil {
+		ocuo.SetRootserviceJSON(*s)
+	}
+	return ocuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ocuo *ObClusterUpdateOne) SetDeletedAt(t time.Time) *ObClusterUpdateOne {
+	ocuo.mutation.SetDeletedAt(t)
+	return ocuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ocuo *ObClusterUpdateOne) SetNillableDeletedAt(t *time.Time) *ObClusterUpdateOne {
+	if t != nil {
+		ocuo.SetDeletedAt(*t)
+	}
+	return ocuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ocuo *ObClusterUpdateOne) ClearDeletedAt() *ObClusterUpdateOne {
+	ocuo.mutation.ClearDeletedAt()
+	return ocuo
+}
+
 // Mutation returns the ObClusterMutation object of the builder.
 func (ocuo *ObClusterUpdateOne) Mutation() *ObClusterMutation {
 	return ocuo.mutation
 }
 
+// Where appends a list predicates to the ObClusterUpdate builder.
+func (ocuo *ObClusterUpdateOne) Where(ps ...predicate.ObCluster) *ObClusterUpdateOne {
+	ocuo.mutation.Where(ps...)
+	return ocuo
+}
+
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
 func (ocuo *ObClusterUpdateOne) Select(field string, fields ...string) *ObClusterUpdateOne {
@@ -314,41 +395,8 @@ func (ocuo *ObClusterUpdateOne) Select(field string, fields ...string) *ObCluste
 
 // Save executes the query and returns the updated ObCluster entity.
 func (ocuo *ObClusterUpdateOne) Save(ctx context.Context) (*ObCluster, error) {
-	var (
-		err  error
-		node *ObCluster
-	)
 	ocuo.defaults()
-	if len(ocuo.hooks) == 0 {
-		if err = ocuo.check(); err != nil {
-			return nil, err
-		}
-		node, err = ocuo.sqlSave(ctx)
-	} else {
-		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-			mutation, ok := m.(*ObClusterMutation)
-			if !ok {
-				return nil, fmt.Errorf("unexpected mutation type %T", m)
-			}
-			if err = ocuo.check(); err != nil {
-				return nil, err
-			}
-			ocuo.mutation = mutation
-			node, err = ocuo.sqlSave(ctx)
-			mutation.done = true
-			return node, err
-		})
-		for i := len(ocuo.hooks) - 1; i >= 0; i-- {
-			if ocuo.hooks[i] == nil {
-				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
-			}
-			mut = ocuo.hooks[i](mut)
-		}
-		if _, err := mut.Mutate(ctx, ocuo.mutation); err != nil {
-			return nil, err
-		}
-	}
-	return node, err
+	return withHooks(ctx, ocuo.sqlSave, ocuo.mutation, ocuo.hooks)
 }
 
 // SaveX is like Save, but panics if an error occurs.
@@ -392,16 +440,10 @@ func (ocuo *ObClusterUpdateOne) check() error {
 }
 
 func (ocuo *ObClusterUpdateOne) sqlSave(ctx context.Context) (_node *ObCluster, err error) {
-	_spec := &sqlgraph.UpdateSpec{
-		Node: &sqlgraph.NodeSpec{
-			Table:   obcluster.Table,
-			Columns: obcluster.Columns,
-			ID: &sqlgraph.FieldSpec{
-				Type:   field.TypeInt,
-				Column: obcluster.FieldID,
-			},
-		},
+	if err := ocuo.check(); err != nil {
+		return _node, err
 	}
+	_spec := sqlgraph.NewUpdateSpec(obcluster.Table, obcluster.Columns, sqlgraph.NewFieldSpec(obcluster.FieldID, field.TypeInt))
 	id, ok := ocuo.mutation.ID()
 	if !ok {
 		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ObCluster.id" for update`)}
@@ -427,53 +469,34 @@ func (ocuo *ObClusterUpdateOne) sqlSave(ctx context.Context) (_node *ObCluster,
 		}
 	}
 	if value, ok := ocuo.mutation.CreateTime(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeTime,
-			Value:  value,
-			Column: obcluster.FieldCreateTime,
-		})
+		_spec.SetField(obcluster.FieldCreateTime, field.TypeTime, value)
 	}
 	if value, ok := ocuo.mutation.UpdateTime(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeTime,
-			Value:  value,
-			Column: obcluster.FieldUpdateTime,
-		})
+		_spec.SetField(obcluster.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := ocuo.mutation.Namespace(); ok {
+		_spec.SetField(obcluster.FieldNamespace, field.TypeString, value)
 	}
 	if value, ok := ocuo.mutation.Name(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeString,
-			Value:  value,
-			Column: obcluster.FieldName,
-		})
+		_spec.SetField(obcluster.FieldName, field.TypeString, value)
 	}
 	if value, ok := ocuo.mutation.ObClusterID(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt64,
-			Value:  value,
-			Column: obcluster.FieldObClusterID,
-		})
+		_spec.SetField(obcluster.FieldObClusterID, field.TypeInt64, value)
 	}
 	if value, ok := ocuo.mutation.AddedObClusterID(); ok {
-		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt64,
-			Value:  value,
-			Column: obcluster.FieldObClusterID,
-		})
+		_spec.AddField(obcluster.FieldObClusterID, field.TypeInt64, value)
 	}
 	if value, ok := ocuo.mutation.GetType(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeString,
-			Value:  value,
-			Column: obcluster.FieldType,
-		})
+		_spec.SetField(obcluster.FieldType, field.TypeString, value)
 	}
 	if value, ok := ocuo.mutation.RootserviceJSON(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeString,
-			Value:  value,
-			Column: obcluster.FieldRootserviceJSON,
-		})
+		_spec.SetField(obcluster.FieldRootserviceJSON, field.TypeString, value)
+	}
+	if value, ok := ocuo.mutation.DeletedAt(); ok {
+		_spec.SetField(obcluster.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ocuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(obcluster.FieldDeletedAt, field.TypeTime)
 	}
 	_node = &ObCluster{config: ocuo.config}
 	_spec.Assign = _node.assignValues
@@ -482,9 +505,10 @@ func (ocuo *ObClusterUpdateOne) sqlSave(ctx context.Context) (_node *ObCluster,
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{obcluster.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{err.Error(), err}
+			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
 		return nil, err
 	}
+	ocuo.mutation.done = true
 	return _node, nil
 }
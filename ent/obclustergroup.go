@@ -0,0 +1,150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/oceanbase/configserver/ent/obclustergroup"
+)
+
+// ObClusterGroup is the model entity for the ObClusterGroup schema.
+type ObClusterGroup struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Namespace holds the value of the "namespace" field.
+	Namespace string `json:"namespace,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// PrimaryClusterID holds the value of the "primary_cluster_id" field.
+	PrimaryClusterID int64 `json:"primary_cluster_id,omitempty"`
+	selectValues     sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ObClusterGroup) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case obclustergroup.FieldID, obclustergroup.FieldPrimaryClusterID:
+			values[i] = new(sql.NullInt64)
+		case obclustergroup.FieldNamespace, obclustergroup.FieldName:
+			values[i] = new(sql.NullString)
+		case obclustergroup.FieldCreateTime, obclustergroup.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ObClusterGroup fields.
+func (ocg *ObClusterGroup) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case obclustergroup.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ocg.ID = int(value.Int64)
+		case obclustergroup.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				ocg.CreateTime = value.Time
+			}
+		case obclustergroup.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				ocg.UpdateTime = value.Time
+			}
+		case obclustergroup.FieldNamespace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace", values[i])
+			} else if value.Valid {
+				ocg.Namespace = value.String
+			}
+		case obclustergroup.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ocg.Name = value.String
+			}
+		case obclustergroup.FieldPrimaryClusterID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field primary_cluster_id", values[i])
+			} else if value.Valid {
+				ocg.PrimaryClusterID = value.Int64
+			}
+		default:
+			ocg.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ObClusterGroup.
+// This includes values selected through modifiers, order, etc.
+func (ocg *ObClusterGroup) Value(name string) (ent.Value, error) {
+	return ocg.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ObClusterGroup.
+// Note that you need to call ObClusterGroup.Unwrap() before calling this method if this ObClusterGroup
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ocg *ObClusterGroup) Update() *ObClusterGroupUpdateOne {
+	return NewObClusterGroupClient(ocg.config).UpdateOne(ocg)
+}
+
+// Unwrap unwraps the ObClusterGroup entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ocg *ObClusterGroup) Unwrap() *ObClusterGroup {
+	_tx, ok := ocg.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ObClusterGroup is not a transactional entity")
+	}
+	ocg.config.driver = _tx.drv
+	return ocg
+}
+
+// String implements the fmt.Stringer.
+func (ocg *ObClusterGroup) String() string {
+	var builder strings.Builder
+	builder.WriteString("ObClusterGroup(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ocg.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(ocg.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(ocg.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("namespace=")
+	builder.WriteString(ocg.Namespace)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(ocg.Name)
+	builder.WriteString(", ")
+	builder.WriteString("primary_cluster_id=")
+	builder.WriteString(fmt.Sprintf("%v", ocg.PrimaryClusterID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ObClusterGroups is a parsable slice of ObClusterGroup.
+type ObClusterGroups []*ObClusterGroup
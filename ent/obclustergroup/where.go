@@ -0,0 +1,345 @@
+// Code generated by ent, DO NOT EDIT.
+
+package obclustergroup
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/oceanbase/configserver/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// Namespace applies equality check predicate on the "namespace" field. It's identical to NamespaceEQ.
+func Namespace(v string) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldEQ(FieldNamespace, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldEQ(FieldName, v))
+}
+
+// PrimaryClusterID applies equality check predicate on the "primary_cluster_id" field. It's identical to PrimaryClusterIDEQ.
+func PrimaryClusterID(v int64) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldEQ(FieldPrimaryClusterID, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// NamespaceEQ applies the EQ predicate on the "namespace" field.
+func NamespaceEQ(v string) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldEQ(FieldNamespace, v))
+}
+
+// NamespaceNEQ applies the NEQ predicate on the "namespace" field.
+func NamespaceNEQ(v string) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldNEQ(FieldNamespace, v))
+}
+
+// NamespaceIn applies the In predicate on the "namespace" field.
+func NamespaceIn(vs ...string) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldIn(FieldNamespace, vs...))
+}
+
+// NamespaceNotIn applies the NotIn predicate on the "namespace" field.
+func NamespaceNotIn(vs ...string) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldNotIn(FieldNamespace, vs...))
+}
+
+// NamespaceGT applies the GT predicate on the "namespace" field.
+func NamespaceGT(v string) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldGT(FieldNamespace, v))
+}
+
+// NamespaceGTE applies the GTE predicate on the "namespace" field.
+func NamespaceGTE(v string) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldGTE(FieldNamespace, v))
+}
+
+// NamespaceLT applies the LT predicate on the "namespace" field.
+func NamespaceLT(v string) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldLT(FieldNamespace, v))
+}
+
+// NamespaceLTE applies the LTE predicate on the "namespace" field.
+func NamespaceLTE(v string) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldLTE(FieldNamespace, v))
+}
+
+// NamespaceContains applies the Contains predicate on the "namespace" field.
+func NamespaceContains(v string) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldContains(FieldNamespace, v))
+}
+
+// NamespaceHasPrefix applies the HasPrefix predicate on the "namespace" field.
+func NamespaceHasPrefix(v string) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldHasPrefix(FieldNamespace, v))
+}
+
+// NamespaceHasSuffix applies the HasSuffix predicate on the "namespace" field.
+func NamespaceHasSuffix(v string) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldHasSuffix(FieldNamespace, v))
+}
+
+// NamespaceEqualFold applies the EqualFold predicate on the "namespace" field.
+func NamespaceEqualFold(v string) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldEqualFold(FieldNamespace, v))
+}
+
+// NamespaceContainsFold applies the ContainsFold predicate on the "namespace" field.
+func NamespaceContainsFold(v string) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldContainsFold(FieldNamespace, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldContainsFold(FieldName, v))
+}
+
+// PrimaryClusterIDEQ applies the EQ predicate on the "primary_cluster_id" field.
+func PrimaryClusterIDEQ(v int64) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldEQ(FieldPrimaryClusterID, v))
+}
+
+// PrimaryClusterIDNEQ applies the NEQ predicate on the "primary_cluster_id" field.
+func PrimaryClusterIDNEQ(v int64) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldNEQ(FieldPrimaryClusterID, v))
+}
+
+// PrimaryClusterIDIn applies the In predicate on the "primary_cluster_id" field.
+func PrimaryClusterIDIn(vs ...int64) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldIn(FieldPrimaryClusterID, vs...))
+}
+
+// PrimaryClusterIDNotIn applies the NotIn predicate on the "primary_cluster_id" field.
+func PrimaryClusterIDNotIn(vs ...int64) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldNotIn(FieldPrimaryClusterID, vs...))
+}
+
+// PrimaryClusterIDGT applies the GT predicate on the "primary_cluster_id" field.
+func PrimaryClusterIDGT(v int64) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldGT(FieldPrimaryClusterID, v))
+}
+
+// PrimaryClusterIDGTE applies the GTE predicate on the "primary_cluster_id" field.
+func PrimaryClusterIDGTE(v int64) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldGTE(FieldPrimaryClusterID, v))
+}
+
+// PrimaryClusterIDLT applies the LT predicate on the "primary_cluster_id" field.
+func PrimaryClusterIDLT(v int64) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldLT(FieldPrimaryClusterID, v))
+}
+
+// PrimaryClusterIDLTE applies the LTE predicate on the "primary_cluster_id" field.
+func PrimaryClusterIDLTE(v int64) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.FieldLTE(FieldPrimaryClusterID, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ObClusterGroup) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ObClusterGroup) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ObClusterGroup) predicate.ObClusterGroup {
+	return predicate.ObClusterGroup(sql.NotPredicates(p))
+}
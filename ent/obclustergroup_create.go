@@ -0,0 +1,741 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/oceanbase/configserver/ent/obclustergroup"
+)
+
+// ObClusterGroupCreate is the builder for creating a ObClusterGroup entity.
+type ObClusterGroupCreate struct {
+	config
+	mutation *ObClusterGroupMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (ocgc *ObClusterGroupCreate) SetCreateTime(t time.Time) *ObClusterGroupCreate {
+	ocgc.mutation.SetCreateTime(t)
+	return ocgc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (ocgc *ObClusterGroupCreate) SetNillableCreateTime(t *time.Time) *ObClusterGroupCreate {
+	if t != nil {
+		ocgc.SetCreateTime(*t)
+	}
+	return ocgc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (ocgc *ObClusterGroupCreate) SetUpdateTime(t time.Time) *ObClusterGroupCreate {
+	ocgc.mutation.SetUpdateTime(t)
+	return ocgc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (ocgc *ObClusterGroupCreate) SetNillableUpdateTime(t *time.Time) *ObClusterGroupCreate {
+	if t != nil {
+		ocgc.SetUpdateTime(*t)
+	}
+	return ocgc
+}
+
+// SetNamespace sets the "namespace" field.
+func (ocgc *ObClusterGroupCreate) SetNamespace(s string) *ObClusterGroupCreate {
+	ocgc.mutation.SetNamespace(s)
+	return ocgc
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (ocgc *ObClusterGroupCreate) SetNillableNamespace(s *string) *ObClusterGroupCreate {
+	if s != nil {
+		ocgc.SetNamespace(*s)
+	}
+	return ocgc
+}
+
+// SetName sets the "name" field.
+func (ocgc *ObClusterGroupCreate) SetName(s string) *ObClusterGroupCreate {
+	ocgc.mutation.SetName(s)
+	return ocgc
+}
+
+// SetPrimaryClusterID sets the "primary_cluster_id" field.
+func (ocgc *ObClusterGroupCreate) SetPrimaryClusterID(i int64) *ObClusterGroupCreate {
+	ocgc.mutation.SetPrimaryClusterID(i)
+	return ocgc
+}
+
+// SetNillablePrimaryClusterID sets the "primary_cluster_id" field if the given value is not nil.
+func (ocgc *ObClusterGroupCreate) SetNillablePrimaryClusterID(i *int64) *ObClusterGroupCreate {
+	if i != nil {
+		ocgc.SetPrimaryClusterID(*i)
+	}
+	return ocgc
+}
+
+// Mutation returns the ObClusterGroupMutation object of the builder.
+func (ocgc *ObClusterGroupCreate) Mutation() *ObClusterGroupMutation {
+	return ocgc.mutation
+}
+
+// Save creates the ObClusterGroup in the database.
+func (ocgc *ObClusterGroupCreate) Save(ctx context.Context) (*ObClusterGroup, error) {
+	ocgc.defaults()
+	return withHooks(ctx, ocgc.sqlSave, ocgc.mutation, ocgc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ocgc *ObClusterGroupCreate) SaveX(ctx context.Context) *ObClusterGroup {
+	v, err := ocgc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ocgc *ObClusterGroupCreate) Exec(ctx context.Context) error {
+	_, err := ocgc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocgc *ObClusterGroupCreate) ExecX(ctx context.Context) {
+	if err := ocgc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ocgc *ObClusterGroupCreate) defaults() {
+	if _, ok := ocgc.mutation.CreateTime(); !ok {
+		v := obclustergroup.DefaultCreateTime()
+		ocgc.mutation.SetCreateTime(v)
+	}
+	if _, ok := ocgc.mutation.UpdateTime(); !ok {
+		v := obclustergroup.DefaultUpdateTime()
+		ocgc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := ocgc.mutation.Namespace(); !ok {
+		v := obclustergroup.DefaultNamespace
+		ocgc.mutation.SetNamespace(v)
+	}
+	if _, ok := ocgc.mutation.PrimaryClusterID(); !ok {
+		v := obclustergroup.DefaultPrimaryClusterID
+		ocgc.mutation.SetPrimaryClusterID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ocgc *ObClusterGroupCreate) check() error {
+	if _, ok := ocgc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "ObClusterGroup.create_time"`)}
+	}
+	if _, ok := ocgc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "ObClusterGroup.update_time"`)}
+	}
+	if _, ok := ocgc.mutation.Namespace(); !ok {
+		return &ValidationError{Name: "namespace", err: errors.New(`ent: missing required field "ObClusterGroup.namespace"`)}
+	}
+	if _, ok := ocgc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ObClusterGroup.name"`)}
+	}
+	if _, ok := ocgc.mutation.PrimaryClusterID(); !ok {
+		return &ValidationError{Name: "primary_cluster_id", err: errors.New(`ent: missing required field "ObClusterGroup.primary_cluster_id"`)}
+	}
+	return nil
+}
+
+func (ocgc *ObClusterGroupCreate) sqlSave(ctx context.Context) (*ObClusterGroup, error) {
+	if err := ocgc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ocgc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ocgc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ocgc.mutation.id = &_node.ID
+	ocgc.mutation.done = true
+	return _node, nil
+}
+
+func (ocgc *ObClusterGroupCreate) createSpec() (*ObClusterGroup, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ObClusterGroup{config: ocgc.config}
+		_spec = sqlgraph.NewCreateSpec(obclustergroup.Table, sqlgraph.NewFieldSpec(obclustergroup.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = ocgc.conflict
+	if value, ok := ocgc.mutation.CreateTime(); ok {
+		_spec.SetField(obclustergroup.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := ocgc.mutation.UpdateTime(); ok {
+		_spec.SetField(obclustergroup.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := ocgc.mutation.Namespace(); ok {
+		_spec.SetField(obclustergroup.FieldNamespace, field.TypeString, value)
+		_node.Namespace = value
+	}
+	if value, ok := ocgc.mutation.Name(); ok {
+		_spec.SetField(obclustergroup.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := ocgc.mutation.PrimaryClusterID(); ok {
+		_spec.SetField(obclustergroup.FieldPrimaryClusterID, field.TypeInt64, value)
+		_node.PrimaryClusterID = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ObClusterGroup.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ObClusterGroupUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (ocgc *ObClusterGroupCreate) OnConflict(opts ...sql.ConflictOption) *ObClusterGroupUpsertOne {
+	ocgc.conflict = opts
+	return &ObClusterGroupUpsertOne{
+		create: ocgc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ObClusterGroup.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ocgc *ObClusterGroupCreate) OnConflictColumns(columns ...string) *ObClusterGroupUpsertOne {
+	ocgc.conflict = append(ocgc.conflict, sql.ConflictColumns(columns...))
+	return &ObClusterGroupUpsertOne{
+		create: ocgc,
+	}
+}
+
+type (
+	// ObClusterGroupUpsertOne is the builder for "upsert"-ing
+	//  one ObClusterGroup node.
+	ObClusterGroupUpsertOne struct {
+		create *ObClusterGroupCreate
+	}
+
+	// ObClusterGroupUpsert is the "OnConflict" setter.
+	ObClusterGroupUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateTime sets the "create_time" field.
+func (u *ObClusterGroupUpsert) SetCreateTime(v time.Time) *ObClusterGroupUpsert {
+	u.Set(obclustergroup.FieldCreateTime, v)
+	return u
+}
+
+// UpdateCreateTime sets the "create_time" field to the value that was provided on create.
+func (u *ObClusterGroupUpsert) UpdateCreateTime() *ObClusterGroupUpsert {
+	u.SetExcluded(obclustergroup.FieldCreateTime)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ObClusterGroupUpsert) SetUpdateTime(v time.Time) *ObClusterGroupUpsert {
+	u.Set(obclustergroup.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ObClusterGroupUpsert) UpdateUpdateTime() *ObClusterGroupUpsert {
+	u.SetExcluded(obclustergroup.FieldUpdateTime)
+	return u
+}
+
+// SetNamespace sets the "namespace" field.
+func (u *ObClusterGroupUpsert) SetNamespace(v string) *ObClusterGroupUpsert {
+	u.Set(obclustergroup.FieldNamespace, v)
+	return u
+}
+
+// UpdateNamespace sets the "namespace" field to the value that was provided on create.
+func (u *ObClusterGroupUpsert) UpdateNamespace() *ObClusterGroupUpsert {
+	u.SetExcluded(obclustergroup.FieldNamespace)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ObClusterGroupUpsert) SetName(v string) *ObClusterGroupUpsert {
+	u.Set(obclustergroup.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ObClusterGroupUpsert) UpdateName() *ObClusterGroupUpsert {
+	u.SetExcluded(obclustergroup.FieldName)
+	return u
+}
+
+// SetPrimaryClusterID sets the "primary_cluster_id" field.
+func (u *ObClusterGroupUpsert) SetPrimaryClusterID(v int64) *ObClusterGroupUpsert {
+	u.Set(obclustergroup.FieldPrimaryClusterID, v)
+	return u
+}
+
+// UpdatePrimaryClusterID sets the "primary_cluster_id" field to the value that was provided on create.
+func (u *ObClusterGroupUpsert) UpdatePrimaryClusterID() *ObClusterGroupUpsert {
+	u.SetExcluded(obclustergroup.FieldPrimaryClusterID)
+	return u
+}
+
+// AddPrimaryClusterID adds v to the "primary_cluster_id" field.
+func (u *ObClusterGroupUpsert) AddPrimaryClusterID(v int64) *ObClusterGroupUpsert {
+	u.Add(obclustergroup.FieldPrimaryClusterID, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ObClusterGroup.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ObClusterGroupUpsertOne) UpdateNewValues() *ObClusterGroupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ObClusterGroup.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ObClusterGroupUpsertOne) Ignore() *ObClusterGroupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ObClusterGroupUpsertOne) DoNothing() *ObClusterGroupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ObClusterGroupCreate.OnConflict
+// documentation for more info.
+func (u *ObClusterGroupUpsertOne) Update(set func(*ObClusterGroupUpsert)) *ObClusterGroupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ObClusterGroupUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateTime sets the "create_time" field.
+func (u *ObClusterGroupUpsertOne) SetCreateTime(v time.Time) *ObClusterGroupUpsertOne {
+	return u.Update(func(s *ObClusterGroupUpsert) {
+		s.SetCreateTime(v)
+	})
+}
+
+// UpdateCreateTime sets the "create_time" field to the value that was provided on create.
+func (u *ObClusterGroupUpsertOne) UpdateCreateTime() *ObClusterGroupUpsertOne {
+	return u.Update(func(s *ObClusterGroupUpsert) {
+		s.UpdateCreateTime()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ObClusterGroupUpsertOne) SetUpdateTime(v time.Time) *ObClusterGroupUpsertOne {
+	return u.Update(func(s *ObClusterGroupUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ObClusterGroupUpsertOne) UpdateUpdateTime() *ObClusterGroupUpsertOne {
+	return u.Update(func(s *ObClusterGroupUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetNamespace sets the "namespace" field.
+func (u *ObClusterGroupUpsertOne) SetNamespace(v string) *ObClusterGroupUpsertOne {
+	return u.Update(func(s *ObClusterGroupUpsert) {
+		s.SetNamespace(v)
+	})
+}
+
+// UpdateNamespace sets the "namespace" field to the value that was provided on create.
+func (u *ObClusterGroupUpsertOne) UpdateNamespace() *ObClusterGroupUpsertOne {
+	return u.Update(func(s *ObClusterGroupUpsert) {
+		s.UpdateNamespace()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ObClusterGroupUpsertOne) SetName(v string) *ObClusterGroupUpsertOne {
+	return u.Update(func(s *ObClusterGroupUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ObClusterGroupUpsertOne) UpdateName() *ObClusterGroupUpsertOne {
+	return u.Update(func(s *ObClusterGroupUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetPrimaryClusterID sets the "primary_cluster_id" field.
+func (u *ObClusterGroupUpsertOne) SetPrimaryClusterID(v int64) *ObClusterGroupUpsertOne {
+	return u.Update(func(s *ObClusterGroupUpsert) {
+		s.SetPrimaryClusterID(v)
+	})
+}
+
+// AddPrimaryClusterID adds v to the "primary_cluster_id" field.
+func (u *ObClusterGroupUpsertOne) AddPrimaryClusterID(v int64) *ObClusterGroupUpsertOne {
+	return u.Update(func(s *ObClusterGroupUpsert) {
+		s.AddPrimaryClusterID(v)
+	})
+}
+
+// UpdatePrimaryClusterID sets the "primary_cluster_id" field to the value that was provided on create.
+func (u *ObClusterGroupUpsertOne) UpdatePrimaryClusterID() *ObClusterGroupUpsertOne {
+	return u.Update(func(s *ObClusterGroupUpsert) {
+		s.UpdatePrimaryClusterID()
+	})
+}
+
+// Exec executes the query.
+func (u *ObClusterGroupUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ObClusterGroupCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ObClusterGroupUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ObClusterGroupUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ObClusterGroupUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ObClusterGroupCreateBulk is the builder for creating many ObClusterGroup entities in bulk.
+type ObClusterGroupCreateBulk struct {
+	config
+	err      error
+	builders []*ObClusterGroupCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ObClusterGroup entities in the database.
+func (ocgcb *ObClusterGroupCreateBulk) Save(ctx context.Context) ([]*ObClusterGroup, error) {
+	if ocgcb.err != nil {
+		return nil, ocgcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ocgcb.builders))
+	nodes := make([]*ObClusterGroup, len(ocgcb.builders))
+	mutators := make([]Mutator, len(ocgcb.builders))
+	for i := range ocgcb.builders {
+		func(i int, root context.Context) {
+			builder := ocgcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ObClusterGroupMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ocgcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ocgcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ocgcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ocgcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocgcb *ObClusterGroupCreateBulk) SaveX(ctx context.Context) []*ObClusterGroup {
+	v, err := ocgcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ocgcb *ObClusterGroupCreateBulk) Exec(ctx context.Context) error {
+	_, err := ocgcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocgcb *ObClusterGroupCreateBulk) ExecX(ctx context.Context) {
+	if err := ocgcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ObClusterGroup.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ObClusterGroupUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (ocgcb *ObClusterGroupCreateBulk) OnConflict(opts ...sql.ConflictOption) *ObClusterGroupUpsertBulk {
+	ocgcb.conflict = opts
+	return &ObClusterGroupUpsertBulk{
+		create: ocgcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ObClusterGroup.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ocgcb *ObClusterGroupCreateBulk) OnConflictColumns(columns ...string) *ObClusterGroupUpsertBulk {
+	ocgcb.conflict = append(ocgcb.conflict, sql.ConflictColumns(columns...))
+	return &ObClusterGroupUpsertBulk{
+		create: ocgcb,
+	}
+}
+
+// ObClusterGroupUpsertBulk is the builder for "upsert"-ing
+// a bulk of ObClusterGroup nodes.
+type ObClusterGroupUpsertBulk struct {
+	create *ObClusterGroupCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ObClusterGroup.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ObClusterGroupUpsertBulk) UpdateNewValues() *ObClusterGroupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ObClusterGroup.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ObClusterGroupUpsertBulk) Ignore() *ObClusterGroupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ObClusterGroupUpsertBulk) DoNothing() *ObClusterGroupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ObClusterGroupCreateBulk.OnConflict
+// documentation for more info.
+func (u *ObClusterGroupUpsertBulk) Update(set func(*ObClusterGroupUpsert)) *ObClusterGroupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ObClusterGroupUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateTime sets the "create_time" field.
+func (u *ObClusterGroupUpsertBulk) SetCreateTime(v time.Time) *ObClusterGroupUpsertBulk {
+	return u.Update(func(s *ObClusterGroupUpsert) {
+		s.SetCreateTime(v)
+	})
+}
+
+// UpdateCreateTime sets the "create_time" field to the value that was provided on create.
+func (u *ObClusterGroupUpsertBulk) UpdateCreateTime() *ObClusterGroupUpsertBulk {
+	return u.Update(func(s *ObClusterGroupUpsert) {
+		s.UpdateCreateTime()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ObClusterGroupUpsertBulk) SetUpdateTime(v time.Time) *ObClusterGroupUpsertBulk {
+	return u.Update(func(s *ObClusterGroupUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ObClusterGroupUpsertBulk) UpdateUpdateTime() *ObClusterGroupUpsertBulk {
+	return u.Update(func(s *ObClusterGroupUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetNamespace sets the "namespace" field.
+func (u *ObClusterGroupUpsertBulk) SetNamespace(v string) *ObClusterGroupUpsertBulk {
+	return u.Update(func(s *ObClusterGroupUpsert) {
+		s.SetNamespace(v)
+	})
+}
+
+// UpdateNamespace sets the "namespace" field to the value that was provided on create.
+func (u *ObClusterGroupUpsertBulk) UpdateNamespace() *ObClusterGroupUpsertBulk {
+	return u.Update(func(s *ObClusterGroupUpsert) {
+		s.UpdateNamespace()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ObClusterGroupUpsertBulk) SetName(v string) *ObClusterGroupUpsertBulk {
+	return u.Update(func(s *ObClusterGroupUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ObClusterGroupUpsertBulk) UpdateName() *ObClusterGroupUpsertBulk {
+	return u.Update(func(s *ObClusterGroupUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetPrimaryClusterID sets the "primary_cluster_id" field.
+func (u *ObClusterGroupUpsertBulk) SetPrimaryClusterID(v int64) *ObClusterGroupUpsertBulk {
+	return u.Update(func(s *ObClusterGroupUpsert) {
+		s.SetPrimaryClusterID(v)
+	})
+}
+
+// AddPrimaryClusterID adds v to the "primary_cluster_id" field.
+func (u *ObClusterGroupUpsertBulk) AddPrimaryClusterID(v int64) *ObClusterGroupUpsertBulk {
+	return u.Update(func(s *ObClusterGroupUpsert) {
+		s.AddPrimaryClusterID(v)
+	})
+}
+
+// UpdatePrimaryClusterID sets the "primary_cluster_id" field to the value that was provided on create.
+func (u *ObClusterGroupUpsertBulk) UpdatePrimaryClusterID() *ObClusterGroupUpsertBulk {
+	return u.Update(func(s *ObClusterGroupUpsert) {
+		s.UpdatePrimaryClusterID()
+	})
+}
+
+// Exec executes the query.
+func (u *ObClusterGroupUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ObClusterGroupCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ObClusterGroupCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ObClusterGroupUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/oceanbase/configserver/ent/obclustergroup"
+	"github.com/oceanbase/configserver/ent/predicate"
+)
+
+// ObClusterGroupDelete is the builder for deleting a ObClusterGroup entity.
+type ObClusterGroupDelete struct {
+	config
+	hooks    []Hook
+	mutation *ObClusterGroupMutation
+}
+
+// Where appends a list predicates to the ObClusterGroupDelete builder.
+func (ocgd *ObClusterGroupDelete) Where(ps ...predicate.ObClusterGroup) *ObClusterGroupDelete {
+	ocgd.mutation.Where(ps...)
+	return ocgd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ocgd *ObClusterGroupDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ocgd.sqlExec, ocgd.mutation, ocgd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocgd *ObClusterGroupDelete) ExecX(ctx context.Context) int {
+	n, err := ocgd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ocgd *ObClusterGroupDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(obclustergroup.Table, sqlgraph.NewFieldSpec(obclustergroup.FieldID, field.TypeInt))
+	if ps := ocgd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ocgd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ocgd.mutation.done = true
+	return affected, err
+}
+
+// ObClusterGroupDeleteOne is the builder for deleting a single ObClusterGroup entity.
+type ObClusterGroupDeleteOne struct {
+	ocgd *ObClusterGroupDelete
+}
+
+// Where appends a list predicates to the ObClusterGroupDelete builder.
+func (ocgdo *ObClusterGroupDeleteOne) Where(ps ...predicate.ObClusterGroup) *ObClusterGroupDeleteOne {
+	ocgdo.ocgd.mutation.Where(ps...)
+	return ocgdo
+}
+
+// Exec executes the deletion query.
+func (ocgdo *ObClusterGroupDeleteOne) Exec(ctx context.Context) error {
+	n, err := ocgdo.ocgd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{obclustergroup.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocgdo *ObClusterGroupDeleteOne) ExecX(ctx context.Context) {
+	if err := ocgdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/oceanbase/configserver/ent/obclustergroup"
+	"github.com/oceanbase/configserver/ent/predicate"
+)
+
+// ObClusterGroupQuery is the builder for querying ObClusterGroup entities.
+type ObClusterGroupQuery struct {
+	config
+	ctx        *QueryContext
+	order      []obclustergroup.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ObClusterGroup
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ObClusterGroupQuery builder.
+func (ocgq *ObClusterGroupQuery) Where(ps ...predicate.ObClusterGroup) *ObClusterGroupQuery {
+	ocgq.predicates = append(ocgq.predicates, ps...)
+	return ocgq
+}
+
+// Limit the number of records to be returned by this query.
+func (ocgq *ObClusterGroupQuery) Limit(limit int) *ObClusterGroupQuery {
+	ocgq.ctx.Limit = &limit
+	return ocgq
+}
+
+// Offset to start from.
+func (ocgq *ObClusterGroupQuery) Offset(offset int) *ObClusterGroupQuery {
+	ocgq.ctx.Offset = &offset
+	return ocgq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ocgq *ObClusterGroupQuery) Unique(unique bool) *ObClusterGroupQuery {
+	ocgq.ctx.Unique = &unique
+	return ocgq
+}
+
+// Order specifies how the records should be ordered.
+func (ocgq *ObClusterGroupQuery) Order(o ...obclustergroup.OrderOption) *ObClusterGroupQuery {
+	ocgq.order = append(ocgq.order, o...)
+	return ocgq
+}
+
+// First returns the first ObClusterGroup entity from the query.
+// Returns a *NotFoundError when no ObClusterGroup was found.
+func (ocgq *ObClusterGroupQuery) First(ctx context.Context) (*ObClusterGroup, error) {
+	nodes, err := ocgq.Limit(1).All(setContextOp(ctx, ocgq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{obclustergroup.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ocgq *ObClusterGroupQuery) FirstX(ctx context.Context) *ObClusterGroup {
+	node, err := ocgq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ObClusterGroup ID from the query.
+// Returns a *NotFoundError when no ObClusterGroup ID was found.
+func (ocgq *ObClusterGroupQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ocgq.Limit(1).IDs(setContextOp(ctx, ocgq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{obclustergroup.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ocgq *ObClusterGroupQuery) FirstIDX(ctx context.Context) int {
+	id, err := ocgq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ObClusterGroup entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ObClusterGroup entity is found.
+// Returns a *NotFoundError when no ObClusterGroup entities are found.
+func (ocgq *ObClusterGroupQuery) Only(ctx context.Context) (*ObClusterGroup, error) {
+	nodes, err := ocgq.Limit(2).All(setContextOp(ctx, ocgq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{obclustergroup.Label}
+	default:
+		return nil, &NotSingularError{obclustergroup.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ocgq *ObClusterGroupQuery) OnlyX(ctx context.Context) *ObClusterGroup {
+	node, err := ocgq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ObClusterGroup ID in the query.
+// Returns a *NotSingularError when more than one ObClusterGroup ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ocgq *ObClusterGroupQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ocgq.Limit(2).IDs(setContextOp(ctx, ocgq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{obclustergroup.Label}
+	default:
+		err = &NotSingularError{obclustergroup.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ocgq *ObClusterGroupQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ocgq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ObClusterGroups.
+func (ocgq *ObClusterGroupQuery) All(ctx context.Context) ([]*ObClusterGroup, error) {
+	ctx = setContextOp(ctx, ocgq.ctx, ent.OpQueryAll)
+	if err := ocgq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ObClusterGroup, *ObClusterGroupQuery]()
+	return withInterceptors[[]*ObClusterGroup](ctx, ocgq, qr, ocgq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ocgq *ObClusterGroupQuery) AllX(ctx context.Context) []*ObClusterGroup {
+	nodes, err := ocgq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ObClusterGroup IDs.
+func (ocgq *ObClusterGroupQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ocgq.ctx.Unique == nil && ocgq.path != nil {
+		ocgq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ocgq.ctx, ent.OpQueryIDs)
+	if err = ocgq.Select(obclustergroup.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ocgq *ObClusterGroupQuery) IDsX(ctx context.Context) []int {
+	ids, err := ocgq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ocgq *ObClusterGroupQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ocgq.ctx, ent.OpQueryCount)
+	if err := ocgq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ocgq, querierCount[*ObClusterGroupQuery](), ocgq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ocgq *ObClusterGroupQuery) CountX(ctx context.Context) int {
+	count, err := ocgq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ocgq *ObClusterGroupQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ocgq.ctx, ent.OpQueryExist)
+	switch _, err := ocgq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ocgq *ObClusterGroupQuery) ExistX(ctx context.Context) bool {
+	exist, err := ocgq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ObClusterGroupQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ocgq *ObClusterGroupQuery) Clone() *ObClusterGroupQuery {
+	if ocgq == nil {
+		return nil
+	}
+	return &ObClusterGroupQuery{
+		config:     ocgq.config,
+		ctx:        ocgq.ctx.Clone(),
+		order:      append([]obclustergroup.OrderOption{}, ocgq.order...),
+		inters:     append([]Interceptor{}, ocgq.inters...),
+		predicates: append([]predicate.ObClusterGroup{}, ocgq.predicates...),
+		// clone intermediate query.
+		sql:  ocgq.sql.Clone(),
+		path: ocgq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ObClusterGroup.Query().
+//		GroupBy(obclustergroup.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ocgq *ObClusterGroupQuery) GroupBy(field string, fields ...string) *ObClusterGroupGroupBy {
+	ocgq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ObClusterGroupGroupBy{build: ocgq}
+	grbuild.flds = &ocgq.ctx.Fields
+	grbuild.label = obclustergroup.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.ObClusterGroup.Query().
+//		Select(obclustergroup.FieldCreateTime).
+//		Scan(ctx, &v)
+func (ocgq *ObClusterGroupQuery) Select(fields ...string) *ObClusterGroupSelect {
+	ocgq.ctx.Fields = append(ocgq.ctx.Fields, fields...)
+	sbuild := &ObClusterGroupSelect{ObClusterGroupQuery: ocgq}
+	sbuild.label = obclustergroup.Label
+	sbuild.flds, sbuild.scan = &ocgq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ObClusterGroupSelect configured with the given aggregations.
+func (ocgq *ObClusterGroupQuery) Aggregate(fns ...AggregateFunc) *ObClusterGroupSelect {
+	return ocgq.Select().Aggregate(fns...)
+}
+
+func (ocgq *ObClusterGroupQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ocgq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ocgq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ocgq.ctx.Fields {
+		if !obclustergroup.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ocgq.path != nil {
+		prev, err := ocgq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ocgq.sql = prev
+	}
+	return nil
+}
+
+func (ocgq *ObClusterGroupQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ObClusterGroup, error) {
+	var (
+		nodes = []*ObClusterGroup{}
+		_spec = ocgq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ObClusterGroup).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ObClusterGroup{config: ocgq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ocgq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ocgq *ObClusterGroupQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ocgq.querySpec()
+	_spec.Node.Columns = ocgq.ctx.Fields
+	if len(ocgq.ctx.Fields) > 0 {
+		_spec.Unique = ocgq.ctx.Unique != nil && *ocgq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ocgq.driver, _spec)
+}
+
+func (ocgq *ObClusterGroupQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(obclustergroup.Table, obclustergroup.Columns, sqlgraph.NewFieldSpec(obclustergroup.FieldID, field.TypeInt))
+	_spec.From = ocgq.sql
+	if unique := ocgq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ocgq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ocgq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, obclustergroup.FieldID)
+		for i := range fields {
+			if fields[i] != obclustergroup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ocgq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ocgq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ocgq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ocgq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ocgq *ObClusterGroupQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ocgq.driver.Dialect())
+	t1 := builder.Table(obclustergroup.Table)
+	columns := ocgq.ctx.Fields
+	if len(columns) == 0 {
+		columns = obclustergroup.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ocgq.sql != nil {
+		selector = ocgq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ocgq.ctx.Unique != nil && *ocgq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ocgq.predicates {
+		p(selector)
+	}
+	for _, p := range ocgq.order {
+		p(selector)
+	}
+	if offset := ocgq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ocgq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ObClusterGroupGroupBy is the group-by builder for ObClusterGroup entities.
+type ObClusterGroupGroupBy struct {
+	selector
+	build *ObClusterGroupQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ocggb *ObClusterGroupGroupBy) Aggregate(fns ...AggregateFunc) *ObClusterGroupGroupBy {
+	ocggb.fns = append(ocggb.fns, fns...)
+	return ocggb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ocggb *ObClusterGroupGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ocggb.build.ctx, ent.OpQueryGroupBy)
+	if err := ocggb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ObClusterGroupQuery, *ObClusterGroupGroupBy](ctx, ocggb.build, ocggb, ocggb.build.inters, v)
+}
+
+func (ocggb *ObClusterGroupGroupBy) sqlScan(ctx context.Context, root *ObClusterGroupQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ocggb.fns))
+	for _, fn := range ocggb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ocggb.flds)+len(ocggb.fns))
+		for _, f := range *ocggb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ocggb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ocggb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ObClusterGroupSelect is the builder for selecting fields of ObClusterGroup entities.
+type ObClusterGroupSelect struct {
+	*ObClusterGroupQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ocgs *ObClusterGroupSelect) Aggregate(fns ...AggregateFunc) *ObClusterGroupSelect {
+	ocgs.fns = append(ocgs.fns, fns...)
+	return ocgs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ocgs *ObClusterGroupSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ocgs.ctx, ent.OpQuerySelect)
+	if err := ocgs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ObClusterGroupQuery, *ObClusterGroupSelect](ctx, ocgs.ObClusterGroupQuery, ocgs, ocgs.inters, v)
+}
+
+func (ocgs *ObClusterGroupSelect) sqlScan(ctx context.Context, root *ObClusterGroupQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ocgs.fns))
+	for _, fn := range ocgs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ocgs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ocgs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,368 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/oceanbase/configserver/ent/obclustergroup"
+	"github.com/oceanbase/configserver/ent/predicate"
+)
+
+// ObClusterGroupUpdate is the builder for updating ObClusterGroup entities.
+type ObClusterGroupUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ObClusterGroupMutation
+}
+
+// Where appends a list predicates to the ObClusterGroupUpdate builder.
+func (ocgu *ObClusterGroupUpdate) Where(ps ...predicate.ObClusterGroup) *ObClusterGroupUpdate {
+	ocgu.mutation.Where(ps...)
+	return ocgu
+}
+
+// SetCreateTime sets the "create_time" field.
+func (ocgu *ObClusterGroupUpdate) SetCreateTime(t time.Time) *ObClusterGroupUpdate {
+	ocgu.mutation.SetCreateTime(t)
+	return ocgu
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (ocgu *ObClusterGroupUpdate) SetNillableCreateTime(t *time.Time) *ObClusterGroupUpdate {
+	if t != nil {
+		ocgu.SetCreateTime(*t)
+	}
+	return ocgu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (ocgu *ObClusterGroupUpdate) SetUpdateTime(t time.Time) *ObClusterGroupUpdate {
+	ocgu.mutation.SetUpdateTime(t)
+	return ocgu
+}
+
+// SetNamespace sets the "namespace" field.
+func (ocgu *ObClusterGroupUpdate) SetNamespace(s string) *ObClusterGroupUpdate {
+	ocgu.mutation.SetNamespace(s)
+	return ocgu
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (ocgu *ObClusterGroupUpdate) SetNillableNamespace(s *string) *ObClusterGroupUpdate {
+	if s != nil {
+		ocgu.SetNamespace(*s)
+	}
+	return ocgu
+}
+
+// SetName sets the "name" field.
+func (ocgu *ObClusterGroupUpdate) SetName(s string) *ObClusterGroupUpdate {
+	ocgu.mutation.SetName(s)
+	return ocgu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ocgu *ObClusterGroupUpdate) SetNillableName(s *string) *ObClusterGroupUpdate {
+	if s != nil {
+		ocgu.SetName(*s)
+	}
+	return ocgu
+}
+
+// SetPrimaryClusterID sets the "primary_cluster_id" field.
+func (ocgu *ObClusterGroupUpdate) SetPrimaryClusterID(i int64) *ObClusterGroupUpdate {
+	ocgu.mutation.ResetPrimaryClusterID()
+	ocgu.mutation.SetPrimaryClusterID(i)
+	return ocgu
+}
+
+// SetNillablePrimaryClusterID sets the "primary_cluster_id" field if the given value is not nil.
+func (ocgu *ObClusterGroupUpdate) SetNillablePrimaryClusterID(i *int64) *ObClusterGroupUpdate {
+	if i != nil {
+		ocgu.SetPrimaryClusterID(*i)
+	}
+	return ocgu
+}
+
+// AddPrimaryClusterID adds i to the "primary_cluster_id" field.
+func (ocgu *ObClusterGroupUpdate) AddPrimaryClusterID(i int64) *ObClusterGroupUpdate {
+	ocgu.mutation.AddPrimaryClusterID(i)
+	return ocgu
+}
+
+// Mutation returns the ObClusterGroupMutation object of the builder.
+func (ocgu *ObClusterGroupUpdate) Mutation() *ObClusterGroupMutation {
+	return ocgu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ocgu *ObClusterGroupUpdate) Save(ctx context.Context) (int, error) {
+	ocgu.defaults()
+	return withHooks(ctx, ocgu.sqlSave, ocgu.mutation, ocgu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocgu *ObClusterGroupUpdate) SaveX(ctx context.Context) int {
+	affected, err := ocgu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ocgu *ObClusterGroupUpdate) Exec(ctx context.Context) error {
+	_, err := ocgu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocgu *ObClusterGroupUpdate) ExecX(ctx context.Context) {
+	if err := ocgu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ocgu *ObClusterGroupUpdate) defaults() {
+	if _, ok := ocgu.mutation.UpdateTime(); !ok {
+		v := obclustergroup.UpdateDefaultUpdateTime()
+		ocgu.mutation.SetUpdateTime(v)
+	}
+}
+
+func (ocgu *ObClusterGroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(obclustergroup.Table, obclustergroup.Columns, sqlgraph.NewFieldSpec(obclustergroup.FieldID, field.TypeInt))
+	if ps := ocgu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ocgu.mutation.CreateTime(); ok {
+		_spec.SetField(obclustergroup.FieldCreateTime, field.TypeTime, value)
+	}
+	if value, ok := ocgu.mutation.UpdateTime(); ok {
+		_spec.SetField(obclustergroup.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := ocgu.mutation.Namespace(); ok {
+		_spec.SetField(obclustergroup.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := ocgu.mutation.Name(); ok {
+		_spec.SetField(obclustergroup.FieldName, field.TypeString, value)
+	}
+	if value, ok := ocgu.mutation.PrimaryClusterID(); ok {
+		_spec.SetField(obclustergroup.FieldPrimaryClusterID, field.TypeInt64, value)
+	}
+	if value, ok := ocgu.mutation.AddedPrimaryClusterID(); ok {
+		_spec.AddField(obclustergroup.FieldPrimaryClusterID, field.TypeInt64, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ocgu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{obclustergroup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ocgu.mutation.done = true
+	return n, nil
+}
+
+// ObClusterGroupUpdateOne is the builder for updating a single ObClusterGroup entity.
+type ObClusterGroupUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ObClusterGroupMutation
+}
+
+// SetCreateTime sets the "create_time" field.
+func (ocguo *ObClusterGroupUpdateOne) SetCreateTime(t time.Time) *ObClusterGroupUpdateOne {
+	ocguo.mutation.SetCreateTime(t)
+	return ocguo
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (ocguo *ObClusterGroupUpdateOne) SetNillableCreateTime(t *time.Time) *ObClusterGroupUpdateOne {
+	if t != nil {
+		ocguo.SetCreateTime(*t)
+	}
+	return ocguo
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (ocguo *ObClusterGroupUpdateOne) SetUpdateTime(t time.Time) *ObClusterGroupUpdateOne {
+	ocguo.mutation.SetUpdateTime(t)
+	return ocguo
+}
+
+// SetNamespace sets the "namespace" field.
+func (ocguo *ObClusterGroupUpdateOne) SetNamespace(s string) *ObClusterGroupUpdateOne {
+	ocguo.mutation.SetNamespace(s)
+	return ocguo
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (ocguo *ObClusterGroupUpdateOne) SetNillableNamespace(s *string) *ObClusterGroupUpdateOne {
+	if s != nil {
+		ocguo.SetNamespace(*s)
+	}
+	return ocguo
+}
+
+// SetName sets the "name" field.
+func (ocguo *ObClusterGroupUpdateOne) SetName(s string) *ObClusterGroupUpdateOne {
+	ocguo.mutation.SetName(s)
+	return ocguo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ocguo *ObClusterGroupUpdateOne) SetNillableName(s *string) *ObClusterGroupUpdateOne {
+	if s != nil {
+		ocguo.SetName(*s)
+	}
+	return ocguo
+}
+
+// SetPrimaryClusterID sets the "primary_cluster_id" field.
+func (ocguo *ObClusterGroupUpdateOne) SetPrimaryClusterID(i int64) *ObClusterGroupUpdateOne {
+	ocguo.mutation.ResetPrimaryClusterID()
+	ocguo.mutation.SetPrimaryClusterID(i)
+	return ocguo
+}
+
+// SetNillablePrimaryClusterID sets the "primary_cluster_id" field if the given value is not nil.
+func (ocguo *ObClusterGroupUpdateOne) SetNillablePrimaryClusterID(i *int64) *ObClusterGroupUpdateOne {
+	if i != nil {
+		ocguo.SetPrimaryClusterID(*i)
+	}
+	return ocguo
+}
+
+// AddPrimaryClusterID adds i to the "primary_cluster_id" field.
+func (ocguo *ObClusterGroupUpdateOne) AddPrimaryClusterID(i int64) *ObClusterGroupUpdateOne {
+	ocguo.mutation.AddPrimaryClusterID(i)
+	return ocguo
+}
+
+// Mutation returns the ObClusterGroupMutation object of the builder.
+func (ocguo *ObClusterGroupUpdateOne) Mutation() *ObClusterGroupMutation {
+	return ocguo.mutation
+}
+
+// Where appends a list predicates to the ObClusterGroupUpdate builder.
+func (ocguo *ObClusterGroupUpdateOne) Where(ps ...predicate.ObClusterGroup) *ObClusterGroupUpdateOne {
+	ocguo.mutation.Where(ps...)
+	return ocguo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ocguo *ObClusterGroupUpdateOne) Select(field string, fields ...string) *ObClusterGroupUpdateOne {
+	ocguo.fields = append([]string{field}, fields...)
+	return ocguo
+}
+
+// Save executes the query and returns the updated ObClusterGroup entity.
+func (ocguo *ObClusterGroupUpdateOne) Save(ctx context.Context) (*ObClusterGroup, error) {
+	ocguo.defaults()
+	return withHooks(ctx, ocguo.sqlSave, ocguo.mutation, ocguo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocguo *ObClusterGroupUpdateOne) SaveX(ctx context.Context) *ObClusterGroup {
+	node, err := ocguo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ocguo *ObClusterGroupUpdateOne) Exec(ctx context.Context) error {
+	_, err := ocguo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocguo *ObClusterGroupUpdateOne) ExecX(ctx context.Context) {
+	if err := ocguo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ocguo *ObClusterGroupUpdateOne) defaults() {
+	if _, ok := ocguo.mutation.UpdateTime(); !ok {
+		v := obclustergroup.UpdateDefaultUpdateTime()
+		ocguo.mutation.SetUpdateTime(v)
+	}
+}
+
+func (ocguo *ObClusterGroupUpdateOne) sqlSave(ctx context.Context) (_node *ObClusterGroup, err error) {
+	_spec := sqlgraph.NewUpdateSpec(obclustergroup.Table, obclustergroup.Columns, sqlgraph.NewFieldSpec(obclustergroup.FieldID, field.TypeInt))
+	id, ok := ocguo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ObClusterGroup.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ocguo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, obclustergroup.FieldID)
+		for _, f := range fields {
+			if !obclustergroup.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != obclustergroup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ocguo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ocguo.mutation.CreateTime(); ok {
+		_spec.SetField(obclustergroup.FieldCreateTime, field.TypeTime, value)
+	}
+	if value, ok := ocguo.mutation.UpdateTime(); ok {
+		_spec.SetField(obclustergroup.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := ocguo.mutation.Namespace(); ok {
+		_spec.SetField(obclustergroup.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := ocguo.mutation.Name(); ok {
+		_spec.SetField(obclustergroup.FieldName, field.TypeString, value)
+	}
+	if value, ok := ocguo.mutation.PrimaryClusterID(); ok {
+		_spec.SetField(obclustergroup.FieldPrimaryClusterID, field.TypeInt64, value)
+	}
+	if value, ok := ocguo.mutation.AddedPrimaryClusterID(); ok {
+		_spec.AddField(obclustergroup.FieldPrimaryClusterID, field.TypeInt64, value)
+	}
+	_node = &ObClusterGroup{config: ocguo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ocguo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{obclustergroup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ocguo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/oceanbase/configserver/ent/obproxy"
+)
+
+// ObProxy is the model entity for the ObProxy schema.
+type ObProxy struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Namespace holds the value of the "namespace" field.
+	Namespace string `json:"namespace,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Address holds the value of the "address" field.
+	Address string `json:"address,omitempty"`
+	// Version holds the value of the "version" field.
+	Version string `json:"version,omitempty"`
+	// ConfigVersion holds the value of the "config_version" field.
+	ConfigVersion string `json:"config_version,omitempty"`
+	// ObproxyGroup holds the value of the "obproxy_group" field.
+	ObproxyGroup string `json:"obproxy_group,omitempty"`
+	// ServiceAddress holds the value of the "service_address" field.
+	ServiceAddress string `json:"service_address,omitempty"`
+	// LastSeenTime holds the value of the "last_seen_time" field.
+	LastSeenTime time.Time `json:"last_seen_time,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ObProxy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case obproxy.FieldID:
+			values[i] = new(sql.NullInt64)
+		case obproxy.FieldNamespace, obproxy.FieldName, obproxy.FieldAddress, obproxy.FieldVersion, obproxy.FieldConfigVersion, obproxy.FieldObproxyGroup, obproxy.FieldServiceAddress:
+			values[i] = new(sql.NullString)
+		case obproxy.FieldCreateTime, obproxy.FieldUpdateTime, obproxy.FieldLastSeenTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ObProxy fields.
+func (op *ObProxy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case obproxy.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			op.ID = int(value.Int64)
+		case obproxy.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				op.CreateTime = value.Time
+			}
+		case obproxy.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				op.UpdateTime = value.Time
+			}
+		case obproxy.FieldNamespace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace", values[i])
+			} else if value.Valid {
+				op.Namespace = value.String
+			}
+		case obproxy.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				op.Name = value.String
+			}
+		case obproxy.FieldAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value.Valid {
+				op.Address = value.String
+			}
+		case obproxy.FieldVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				op.Version = value.String
+			}
+		case obproxy.FieldConfigVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field config_version", values[i])
+			} else if value.Valid {
+				op.ConfigVersion = value.String
+			}
+		case obproxy.FieldObproxyGroup:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field obproxy_group", values[i])
+			} else if value.Valid {
+				op.ObproxyGroup = value.String
+			}
+		case obproxy.FieldServiceAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field service_address", values[i])
+			} else if value.Valid {
+				op.ServiceAddress = value.String
+			}
+		case obproxy.FieldLastSeenTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_time", values[i])
+			} else if value.Valid {
+				op.LastSeenTime = value.Time
+			}
+		default:
+			op.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ObProxy.
+// This includes values selected through modifiers, order, etc.
+func (op *ObProxy) Value(name string) (ent.Value, error) {
+	return op.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ObProxy.
+// Note that you need to call ObProxy.Unwrap() before calling this method if this ObProxy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (op *ObProxy) Update() *ObProxyUpdateOne {
+	return NewObProxyClient(op.config).UpdateOne(op)
+}
+
+// Unwrap unwraps the ObProxy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (op *ObProxy) Unwrap() *ObProxy {
+	_tx, ok := op.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ObProxy is not a transactional entity")
+	}
+	op.config.driver = _tx.drv
+	return op
+}
+
+// String implements the fmt.Stringer.
+func (op *ObProxy) String() string {
+	var builder strings.Builder
+	builder.WriteString("ObProxy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", op.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(op.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(op.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("namespace=")
+	builder.WriteString(op.Namespace)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(op.Name)
+	builder.WriteString(", ")
+	builder.WriteString("address=")
+	builder.WriteString(op.Address)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(op.Version)
+	builder.WriteString(", ")
+	builder.WriteString("config_version=")
+	builder.WriteString(op.ConfigVersion)
+	builder.WriteString(", ")
+	builder.WriteString("obproxy_group=")
+	builder.WriteString(op.ObproxyGroup)
+	builder.WriteString(", ")
+	builder.WriteString("service_address=")
+	builder.WriteString(op.ServiceAddress)
+	builder.WriteString(", ")
+	builder.WriteString("last_seen_time=")
+	builder.WriteString(op.LastSeenTime.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ObProxies is a parsable slice of ObProxy.
+type ObProxies []*ObProxy
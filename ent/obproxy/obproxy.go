@@ -0,0 +1,142 @@
+// Code generated by ent, DO NOT EDIT.
+
+package obproxy
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the obproxy type in the database.
+	Label = "ob_proxy"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldNamespace holds the string denoting the namespace field in the database.
+	FieldNamespace = "namespace"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldAddress holds the string denoting the address field in the database.
+	FieldAddress = "address"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldConfigVersion holds the string denoting the config_version field in the database.
+	FieldConfigVersion = "config_version"
+	// FieldObproxyGroup holds the string denoting the obproxy_group field in the database.
+	FieldObproxyGroup = "obproxy_group"
+	// FieldServiceAddress holds the string denoting the service_address field in the database.
+	FieldServiceAddress = "service_address"
+	// FieldLastSeenTime holds the string denoting the last_seen_time field in the database.
+	FieldLastSeenTime = "last_seen_time"
+	// Table holds the table name of the obproxy in the database.
+	Table = "ob_proxies"
+)
+
+// Columns holds all SQL columns for obproxy fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldNamespace,
+	FieldName,
+	FieldAddress,
+	FieldVersion,
+	FieldConfigVersion,
+	FieldObproxyGroup,
+	FieldServiceAddress,
+	FieldLastSeenTime,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// DefaultNamespace holds the default value on creation for the "namespace" field.
+	DefaultNamespace string
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion string
+	// DefaultConfigVersion holds the default value on creation for the "config_version" field.
+	DefaultConfigVersion string
+	// DefaultObproxyGroup holds the default value on creation for the "obproxy_group" field.
+	DefaultObproxyGroup string
+	// DefaultServiceAddress holds the default value on creation for the "service_address" field.
+	DefaultServiceAddress string
+	// DefaultLastSeenTime holds the default value on creation for the "last_seen_time" field.
+	DefaultLastSeenTime func() time.Time
+)
+
+// OrderOption defines the ordering options for the ObProxy queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByNamespace orders the results by the namespace field.
+func ByNamespace(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNamespace, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByAddress orders the results by the address field.
+func ByAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddress, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByConfigVersion orders the results by the config_version field.
+func ByConfigVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConfigVersion, opts...).ToFunc()
+}
+
+// ByObproxyGroup orders the results by the obproxy_group field.
+func ByObproxyGroup(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldObproxyGroup, opts...).ToFunc()
+}
+
+// ByServiceAddress orders the results by the service_address field.
+func ByServiceAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldServiceAddress, opts...).ToFunc()
+}
+
+// ByLastSeenTime orders the results by the last_seen_time field.
+func ByLastSeenTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSeenTime, opts...).ToFunc()
+}
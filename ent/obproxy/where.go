@@ -0,0 +1,695 @@
+// Code generated by ent, DO NOT EDIT.
+
+package obproxy
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/oceanbase/configserver/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// Namespace applies equality check predicate on the "namespace" field. It's identical to NamespaceEQ.
+func Namespace(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldEQ(FieldNamespace, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldEQ(FieldName, v))
+}
+
+// Address applies equality check predicate on the "address" field. It's identical to AddressEQ.
+func Address(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldEQ(FieldAddress, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldEQ(FieldVersion, v))
+}
+
+// ConfigVersion applies equality check predicate on the "config_version" field. It's identical to ConfigVersionEQ.
+func ConfigVersion(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldEQ(FieldConfigVersion, v))
+}
+
+// ObproxyGroup applies equality check predicate on the "obproxy_group" field. It's identical to ObproxyGroupEQ.
+func ObproxyGroup(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldEQ(FieldObproxyGroup, v))
+}
+
+// ServiceAddress applies equality check predicate on the "service_address" field. It's identical to ServiceAddressEQ.
+func ServiceAddress(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldEQ(FieldServiceAddress, v))
+}
+
+// LastSeenTime applies equality check predicate on the "last_seen_time" field. It's identical to LastSeenTimeEQ.
+func LastSeenTime(v time.Time) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldEQ(FieldLastSeenTime, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// NamespaceEQ applies the EQ predicate on the "namespace" field.
+func NamespaceEQ(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldEQ(FieldNamespace, v))
+}
+
+// NamespaceNEQ applies the NEQ predicate on the "namespace" field.
+func NamespaceNEQ(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldNEQ(FieldNamespace, v))
+}
+
+// NamespaceIn applies the In predicate on the "namespace" field.
+func NamespaceIn(vs ...string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldIn(FieldNamespace, vs...))
+}
+
+// NamespaceNotIn applies the NotIn predicate on the "namespace" field.
+func NamespaceNotIn(vs ...string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldNotIn(FieldNamespace, vs...))
+}
+
+// NamespaceGT applies the GT predicate on the "namespace" field.
+func NamespaceGT(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldGT(FieldNamespace, v))
+}
+
+// NamespaceGTE applies the GTE predicate on the "namespace" field.
+func NamespaceGTE(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldGTE(FieldNamespace, v))
+}
+
+// NamespaceLT applies the LT predicate on the "namespace" field.
+func NamespaceLT(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldLT(FieldNamespace, v))
+}
+
+// NamespaceLTE applies the LTE predicate on the "namespace" field.
+func NamespaceLTE(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldLTE(FieldNamespace, v))
+}
+
+// NamespaceContains applies the Contains predicate on the "namespace" field.
+func NamespaceContains(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldContains(FieldNamespace, v))
+}
+
+// NamespaceHasPrefix applies the HasPrefix predicate on the "namespace" field.
+func NamespaceHasPrefix(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldHasPrefix(FieldNamespace, v))
+}
+
+// NamespaceHasSuffix applies the HasSuffix predicate on the "namespace" field.
+func NamespaceHasSuffix(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldHasSuffix(FieldNamespace, v))
+}
+
+// NamespaceEqualFold applies the EqualFold predicate on the "namespace" field.
+func NamespaceEqualFold(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldEqualFold(FieldNamespace, v))
+}
+
+// NamespaceContainsFold applies the ContainsFold predicate on the "namespace" field.
+func NamespaceContainsFold(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldContainsFold(FieldNamespace, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldContainsFold(FieldName, v))
+}
+
+// AddressEQ applies the EQ predicate on the "address" field.
+func AddressEQ(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldEQ(FieldAddress, v))
+}
+
+// AddressNEQ applies the NEQ predicate on the "address" field.
+func AddressNEQ(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldNEQ(FieldAddress, v))
+}
+
+// AddressIn applies the In predicate on the "address" field.
+func AddressIn(vs ...string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldIn(FieldAddress, vs...))
+}
+
+// AddressNotIn applies the NotIn predicate on the "address" field.
+func AddressNotIn(vs ...string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldNotIn(FieldAddress, vs...))
+}
+
+// AddressGT applies the GT predicate on the "address" field.
+func AddressGT(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldGT(FieldAddress, v))
+}
+
+// AddressGTE applies the GTE predicate on the "address" field.
+func AddressGTE(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldGTE(FieldAddress, v))
+}
+
+// AddressLT applies the LT predicate on the "address" field.
+func AddressLT(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldLT(FieldAddress, v))
+}
+
+// AddressLTE applies the LTE predicate on the "address" field.
+func AddressLTE(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldLTE(FieldAddress, v))
+}
+
+// AddressContains applies the Contains predicate on the "address" field.
+func AddressContains(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldContains(FieldAddress, v))
+}
+
+// AddressHasPrefix applies the HasPrefix predicate on the "address" field.
+func AddressHasPrefix(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldHasPrefix(FieldAddress, v))
+}
+
+// AddressHasSuffix applies the HasSuffix predicate on the "address" field.
+func AddressHasSuffix(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldHasSuffix(FieldAddress, v))
+}
+
+// AddressEqualFold applies the EqualFold predicate on the "address" field.
+func AddressEqualFold(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldEqualFold(FieldAddress, v))
+}
+
+// AddressContainsFold applies the ContainsFold predicate on the "address" field.
+func AddressContainsFold(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldContainsFold(FieldAddress, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldLTE(FieldVersion, v))
+}
+
+// VersionContains applies the Contains predicate on the "version" field.
+func VersionContains(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldContains(FieldVersion, v))
+}
+
+// VersionHasPrefix applies the HasPrefix predicate on the "version" field.
+func VersionHasPrefix(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldHasPrefix(FieldVersion, v))
+}
+
+// VersionHasSuffix applies the HasSuffix predicate on the "version" field.
+func VersionHasSuffix(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldHasSuffix(FieldVersion, v))
+}
+
+// VersionEqualFold applies the EqualFold predicate on the "version" field.
+func VersionEqualFold(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldEqualFold(FieldVersion, v))
+}
+
+// VersionContainsFold applies the ContainsFold predicate on the "version" field.
+func VersionContainsFold(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldContainsFold(FieldVersion, v))
+}
+
+// ConfigVersionEQ applies the EQ predicate on the "config_version" field.
+func ConfigVersionEQ(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldEQ(FieldConfigVersion, v))
+}
+
+// ConfigVersionNEQ applies the NEQ predicate on the "config_version" field.
+func ConfigVersionNEQ(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldNEQ(FieldConfigVersion, v))
+}
+
+// ConfigVersionIn applies the In predicate on the "config_version" field.
+func ConfigVersionIn(vs ...string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldIn(FieldConfigVersion, vs...))
+}
+
+// ConfigVersionNotIn applies the NotIn predicate on the "config_version" field.
+func ConfigVersionNotIn(vs ...string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldNotIn(FieldConfigVersion, vs...))
+}
+
+// ConfigVersionGT applies the GT predicate on the "config_version" field.
+func ConfigVersionGT(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldGT(FieldConfigVersion, v))
+}
+
+// ConfigVersionGTE applies the GTE predicate on the "config_version" field.
+func ConfigVersionGTE(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldGTE(FieldConfigVersion, v))
+}
+
+// ConfigVersionLT applies the LT predicate on the "config_version" field.
+func ConfigVersionLT(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldLT(FieldConfigVersion, v))
+}
+
+// ConfigVersionLTE applies the LTE predicate on the "config_version" field.
+func ConfigVersionLTE(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldLTE(FieldConfigVersion, v))
+}
+
+// ConfigVersionContains applies the Contains predicate on the "config_version" field.
+func ConfigVersionContains(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldContains(FieldConfigVersion, v))
+}
+
+// ConfigVersionHasPrefix applies the HasPrefix predicate on the "config_version" field.
+func ConfigVersionHasPrefix(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldHasPrefix(FieldConfigVersion, v))
+}
+
+// ConfigVersionHasSuffix applies the HasSuffix predicate on the "config_version" field.
+func ConfigVersionHasSuffix(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldHasSuffix(FieldConfigVersion, v))
+}
+
+// ConfigVersionEqualFold applies the EqualFold predicate on the "config_version" field.
+func ConfigVersionEqualFold(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldEqualFold(FieldConfigVersion, v))
+}
+
+// ConfigVersionContainsFold applies the ContainsFold predicate on the "config_version" field.
+func ConfigVersionContainsFold(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldContainsFold(FieldConfigVersion, v))
+}
+
+// ObproxyGroupEQ applies the EQ predicate on the "obproxy_group" field.
+func ObproxyGroupEQ(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldEQ(FieldObproxyGroup, v))
+}
+
+// ObproxyGroupNEQ applies the NEQ predicate on the "obproxy_group" field.
+func ObproxyGroupNEQ(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldNEQ(FieldObproxyGroup, v))
+}
+
+// ObproxyGroupIn applies the In predicate on the "obproxy_group" field.
+func ObproxyGroupIn(vs ...string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldIn(FieldObproxyGroup, vs...))
+}
+
+// ObproxyGroupNotIn applies the NotIn predicate on the "obproxy_group" field.
+func ObproxyGroupNotIn(vs ...string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldNotIn(FieldObproxyGroup, vs...))
+}
+
+// ObproxyGroupGT applies the GT predicate on the "obproxy_group" field.
+func ObproxyGroupGT(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldGT(FieldObproxyGroup, v))
+}
+
+// ObproxyGroupGTE applies the GTE predicate on the "obproxy_group" field.
+func ObproxyGroupGTE(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldGTE(FieldObproxyGroup, v))
+}
+
+// ObproxyGroupLT applies the LT predicate on the "obproxy_group" field.
+func ObproxyGroupLT(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldLT(FieldObproxyGroup, v))
+}
+
+// ObproxyGroupLTE applies the LTE predicate on the "obproxy_group" field.
+func ObproxyGroupLTE(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldLTE(FieldObproxyGroup, v))
+}
+
+// ObproxyGroupContains applies the Contains predicate on the "obproxy_group" field.
+func ObproxyGroupContains(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldContains(FieldObproxyGroup, v))
+}
+
+// ObproxyGroupHasPrefix applies the HasPrefix predicate on the "obproxy_group" field.
+func ObproxyGroupHasPrefix(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldHasPrefix(FieldObproxyGroup, v))
+}
+
+// ObproxyGroupHasSuffix applies the HasSuffix predicate on the "obproxy_group" field.
+func ObproxyGroupHasSuffix(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldHasSuffix(FieldObproxyGroup, v))
+}
+
+// ObproxyGroupEqualFold applies the EqualFold predicate on the "obproxy_group" field.
+func ObproxyGroupEqualFold(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldEqualFold(FieldObproxyGroup, v))
+}
+
+// ObproxyGroupContainsFold applies the ContainsFold predicate on the "obproxy_group" field.
+func ObproxyGroupContainsFold(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldContainsFold(FieldObproxyGroup, v))
+}
+
+// ServiceAddressEQ applies the EQ predicate on the "service_address" field.
+func ServiceAddressEQ(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldEQ(FieldServiceAddress, v))
+}
+
+// ServiceAddressNEQ applies the NEQ predicate on the "service_address" field.
+func ServiceAddressNEQ(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldNEQ(FieldServiceAddress, v))
+}
+
+// ServiceAddressIn applies the In predicate on the "service_address" field.
+func ServiceAddressIn(vs ...string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldIn(FieldServiceAddress, vs...))
+}
+
+// ServiceAddressNotIn applies the NotIn predicate on the "service_address" field.
+func ServiceAddressNotIn(vs ...string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldNotIn(FieldServiceAddress, vs...))
+}
+
+// ServiceAddressGT applies the GT predicate on the "service_address" field.
+func ServiceAddressGT(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldGT(FieldServiceAddress, v))
+}
+
+// ServiceAddressGTE applies the GTE predicate on the "service_address" field.
+func ServiceAddressGTE(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldGTE(FieldServiceAddress, v))
+}
+
+// ServiceAddressLT applies the LT predicate on the "service_address" field.
+func ServiceAddressLT(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldLT(FieldServiceAddress, v))
+}
+
+// ServiceAddressLTE applies the LTE predicate on the "service_address" field.
+func ServiceAddressLTE(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldLTE(FieldServiceAddress, v))
+}
+
+// ServiceAddressContains applies the Contains predicate on the "service_address" field.
+func ServiceAddressContains(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldContains(FieldServiceAddress, v))
+}
+
+// ServiceAddressHasPrefix applies the HasPrefix predicate on the "service_address" field.
+func ServiceAddressHasPrefix(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldHasPrefix(FieldServiceAddress, v))
+}
+
+// ServiceAddressHasSuffix applies the HasSuffix predicate on the "service_address" field.
+func ServiceAddressHasSuffix(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldHasSuffix(FieldServiceAddress, v))
+}
+
+// ServiceAddressEqualFold applies the EqualFold predicate on the "service_address" field.
+func ServiceAddressEqualFold(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldEqualFold(FieldServiceAddress, v))
+}
+
+// ServiceAddressContainsFold applies the ContainsFold predicate on the "service_address" field.
+func ServiceAddressContainsFold(v string) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldContainsFold(FieldServiceAddress, v))
+}
+
+// LastSeenTimeEQ applies the EQ predicate on the "last_seen_time" field.
+func LastSeenTimeEQ(v time.Time) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldEQ(FieldLastSeenTime, v))
+}
+
+// LastSeenTimeNEQ applies the NEQ predicate on the "last_seen_time" field.
+func LastSeenTimeNEQ(v time.Time) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldNEQ(FieldLastSeenTime, v))
+}
+
+// LastSeenTimeIn applies the In predicate on the "last_seen_time" field.
+func LastSeenTimeIn(vs ...time.Time) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldIn(FieldLastSeenTime, vs...))
+}
+
+// LastSeenTimeNotIn applies the NotIn predicate on the "last_seen_time" field.
+func LastSeenTimeNotIn(vs ...time.Time) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldNotIn(FieldLastSeenTime, vs...))
+}
+
+// LastSeenTimeGT applies the GT predicate on the "last_seen_time" field.
+func LastSeenTimeGT(v time.Time) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldGT(FieldLastSeenTime, v))
+}
+
+// LastSeenTimeGTE applies the GTE predicate on the "last_seen_time" field.
+func LastSeenTimeGTE(v time.Time) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldGTE(FieldLastSeenTime, v))
+}
+
+// LastSeenTimeLT applies the LT predicate on the "last_seen_time" field.
+func LastSeenTimeLT(v time.Time) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldLT(FieldLastSeenTime, v))
+}
+
+// LastSeenTimeLTE applies the LTE predicate on the "last_seen_time" field.
+func LastSeenTimeLTE(v time.Time) predicate.ObProxy {
+	return predicate.ObProxy(sql.FieldLTE(FieldLastSeenTime, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ObProxy) predicate.ObProxy {
+	return predicate.ObProxy(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ObProxy) predicate.ObProxy {
+	return predicate.ObProxy(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ObProxy) predicate.ObProxy {
+	return predicate.ObProxy(sql.NotPredicates(p))
+}
@@ -0,0 +1,1034 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/oceanbase/configserver/ent/obproxy"
+)
+
+// ObProxyCreate is the builder for creating a ObProxy entity.
+type ObProxyCreate struct {
+	config
+	mutation *ObProxyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (opc *ObProxyCreate) SetCreateTime(t time.Time) *ObProxyCreate {
+	opc.mutation.SetCreateTime(t)
+	return opc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (opc *ObProxyCreate) SetNillableCreateTime(t *time.Time) *ObProxyCreate {
+	if t != nil {
+		opc.SetCreateTime(*t)
+	}
+	return opc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (opc *ObProxyCreate) SetUpdateTime(t time.Time) *ObProxyCreate {
+	opc.mutation.SetUpdateTime(t)
+	return opc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (opc *ObProxyCreate) SetNillableUpdateTime(t *time.Time) *ObProxyCreate {
+	if t != nil {
+		opc.SetUpdateTime(*t)
+	}
+	return opc
+}
+
+// SetNamespace sets the "namespace" field.
+func (opc *ObProxyCreate) SetNamespace(s string) *ObProxyCreate {
+	opc.mutation.SetNamespace(s)
+	return opc
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (opc *ObProxyCreate) SetNillableNamespace(s *string) *ObProxyCreate {
+	if s != nil {
+		opc.SetNamespace(*s)
+	}
+	return opc
+}
+
+// SetName sets the "name" field.
+func (opc *ObProxyCreate) SetName(s string) *ObProxyCreate {
+	opc.mutation.SetName(s)
+	return opc
+}
+
+// SetAddress sets the "address" field.
+func (opc *ObProxyCreate) SetAddress(s string) *ObProxyCreate {
+	opc.mutation.SetAddress(s)
+	return opc
+}
+
+// SetVersion sets the "version" field.
+func (opc *ObProxyCreate) SetVersion(s string) *ObProxyCreate {
+	opc.mutation.SetVersion(s)
+	return opc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (opc *ObProxyCreate) SetNillableVersion(s *string) *ObProxyCreate {
+	if s != nil {
+		opc.SetVersion(*s)
+	}
+	return opc
+}
+
+// SetConfigVersion sets the "config_version" field.
+func (opc *ObProxyCreate) SetConfigVersion(s string) *ObProxyCreate {
+	opc.mutation.SetConfigVersion(s)
+	return opc
+}
+
+// SetNillableConfigVersion sets the "config_version" field if the given value is not nil.
+func (opc *ObProxyCreate) SetNillableConfigVersion(s *string) *ObProxyCreate {
+	if s != nil {
+		opc.SetConfigVersion(*s)
+	}
+	return opc
+}
+
+// SetObproxyGroup sets the "obproxy_group" field.
+func (opc *ObProxyCreate) SetObproxyGroup(s string) *ObProxyCreate {
+	opc.mutation.SetObproxyGroup(s)
+	return opc
+}
+
+// SetNillableObproxyGroup sets the "obproxy_group" field if the given value is not nil.
+func (opc *ObProxyCreate) SetNillableObproxyGroup(s *string) *ObProxyCreate {
+	if s != nil {
+		opc.SetObproxyGroup(*s)
+	}
+	return opc
+}
+
+// SetServiceAddress sets the "service_address" field.
+func (opc *ObProxyCreate) SetServiceAddress(s string) *ObProxyCreate {
+	opc.mutation.SetServiceAddress(s)
+	return opc
+}
+
+// SetNillableServiceAddress sets the "service_address" field if the given value is not nil.
+func (opc *ObProxyCreate) SetNillableServiceAddress(s *string) *ObProxyCreate {
+	if s != nil {
+		opc.SetServiceAddress(*s)
+	}
+	return opc
+}
+
+// SetLastSeenTime sets the "last_seen_time" field.
+func (opc *ObProxyCreate) SetLastSeenTime(t time.Time) *ObProxyCreate {
+	opc.mutation.SetLastSeenTime(t)
+	return opc
+}
+
+// SetNillableLastSeenTime sets the "last_seen_time" field if the given value is not nil.
+func (opc *ObProxyCreate) SetNillableLastSeenTime(t *time.Time) *ObProxyCreate {
+	if t != nil {
+		opc.SetLastSeenTime(*t)
+	}
+	return opc
+}
+
+// Mutation returns the ObProxyMutation object of the builder.
+func (opc *ObProxyCreate) Mutation() *ObProxyMutation {
+	return opc.mutation
+}
+
+// Save creates the ObProxy in the database.
+func (opc *ObProxyCreate) Save(ctx context.Context) (*ObProxy, error) {
+	opc.defaults()
+	return withHooks(ctx, opc.sqlSave, opc.mutation, opc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (opc *ObProxyCreate) SaveX(ctx context.Context) *ObProxy {
+	v, err := opc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (opc *ObProxyCreate) Exec(ctx context.Context) error {
+	_, err := opc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (opc *ObProxyCreate) ExecX(ctx context.Context) {
+	if err := opc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (opc *ObProxyCreate) defaults() {
+	if _, ok := opc.mutation.CreateTime(); !ok {
+		v := obproxy.DefaultCreateTime()
+		opc.mutation.SetCreateTime(v)
+	}
+	if _, ok := opc.mutation.UpdateTime(); !ok {
+		v := obproxy.DefaultUpdateTime()
+		opc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := opc.mutation.Namespace(); !ok {
+		v := obproxy.DefaultNamespace
+		opc.mutation.SetNamespace(v)
+	}
+	if _, ok := opc.mutation.Version(); !ok {
+		v := obproxy.DefaultVersion
+		opc.mutation.SetVersion(v)
+	}
+	if _, ok := opc.mutation.ConfigVersion(); !ok {
+		v := obproxy.DefaultConfigVersion
+		opc.mutation.SetConfigVersion(v)
+	}
+	if _, ok := opc.mutation.ObproxyGroup(); !ok {
+		v := obproxy.DefaultObproxyGroup
+		opc.mutation.SetObproxyGroup(v)
+	}
+	if _, ok := opc.mutation.ServiceAddress(); !ok {
+		v := obproxy.DefaultServiceAddress
+		opc.mutation.SetServiceAddress(v)
+	}
+	if _, ok := opc.mutation.LastSeenTime(); !ok {
+		v := obproxy.DefaultLastSeenTime()
+		opc.mutation.SetLastSeenTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (opc *ObProxyCreate) check() error {
+	if _, ok := opc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "ObProxy.create_time"`)}
+	}
+	if _, ok := opc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "ObProxy.update_time"`)}
+	}
+	if _, ok := opc.mutation.Namespace(); !ok {
+		return &ValidationError{Name: "namespace", err: errors.New(`ent: missing required field "ObProxy.namespace"`)}
+	}
+	if _, ok := opc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ObProxy.name"`)}
+	}
+	if _, ok := opc.mutation.Address(); !ok {
+		return &ValidationError{Name: "address", err: errors.New(`ent: missing required field "ObProxy.address"`)}
+	}
+	if _, ok := opc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "ObProxy.version"`)}
+	}
+	if _, ok := opc.mutation.ConfigVersion(); !ok {
+		return &ValidationError{Name: "config_version", err: errors.New(`ent: missing required field "ObProxy.config_version"`)}
+	}
+	if _, ok := opc.mutation.ObproxyGroup(); !ok {
+		return &ValidationError{Name: "obproxy_group", err: errors.New(`ent: missing required field "ObProxy.obproxy_group"`)}
+	}
+	if _, ok := opc.mutation.ServiceAddress(); !ok {
+		return &ValidationError{Name: "service_address", err: errors.New(`ent: missing required field "ObProxy.service_address"`)}
+	}
+	if _, ok := opc.mutation.LastSeenTime(); !ok {
+		return &ValidationError{Name: "last_seen_time", err: errors.New(`ent: missing required field "ObProxy.last_seen_time"`)}
+	}
+	return nil
+}
+
+func (opc *ObProxyCreate) sqlSave(ctx context.Context) (*ObProxy, error) {
+	if err := opc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := opc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, opc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	opc.mutation.id = &_node.ID
+	opc.mutation.done = true
+	return _node, nil
+}
+
+func (opc *ObProxyCreate) createSpec() (*ObProxy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ObProxy{config: opc.config}
+		_spec = sqlgraph.NewCreateSpec(obproxy.Table, sqlgraph.NewFieldSpec(obproxy.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = opc.conflict
+	if value, ok := opc.mutation.CreateTime(); ok {
+		_spec.SetField(obproxy.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := opc.mutation.UpdateTime(); ok {
+		_spec.SetField(obproxy.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := opc.mutation.Namespace(); ok {
+		_spec.SetField(obproxy.FieldNamespace, field.TypeString, value)
+		_node.Namespace = value
+	}
+	if value, ok := opc.mutation.Name(); ok {
+		_spec.SetField(obproxy.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := opc.mutation.Address(); ok {
+		_spec.SetField(obproxy.FieldAddress, field.TypeString, value)
+		_node.Address = value
+	}
+	if value, ok := opc.mutation.Version(); ok {
+		_spec.SetField(obproxy.FieldVersion, field.TypeString, value)
+		_node.Version = value
+	}
+	if value, ok := opc.mutation.ConfigVersion(); ok {
+		_spec.SetField(obproxy.FieldConfigVersion, field.TypeString, value)
+		_node.ConfigVersion = value
+	}
+	if value, ok := opc.mutation.ObproxyGroup(); ok {
+		_spec.SetField(obproxy.FieldObproxyGroup, field.TypeString, value)
+		_node.ObproxyGroup = value
+	}
+	if value, ok := opc.mutation.ServiceAddress(); ok {
+		_spec.SetField(obproxy.FieldServiceAddress, field.TypeString, value)
+		_node.ServiceAddress = value
+	}
+	if value, ok := opc.mutation.LastSeenTime(); ok {
+		_spec.SetField(obproxy.FieldLastSeenTime, field.TypeTime, value)
+		_node.LastSeenTime = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ObProxy.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ObProxyUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (opc *ObProxyCreate) OnConflict(opts ...sql.ConflictOption) *ObProxyUpsertOne {
+	opc.conflict = opts
+	return &ObProxyUpsertOne{
+		create: opc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ObProxy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (opc *ObProxyCreate) OnConflictColumns(columns ...string) *ObProxyUpsertOne {
+	opc.conflict = append(opc.conflict, sql.ConflictColumns(columns...))
+	return &ObProxyUpsertOne{
+		create: opc,
+	}
+}
+
+type (
+	// ObProxyUpsertOne is the builder for "upsert"-ing
+	//  one ObProxy node.
+	ObProxyUpsertOne struct {
+		create *ObProxyCreate
+	}
+
+	// ObProxyUpsert is the "OnConflict" setter.
+	ObProxyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateTime sets the "create_time" field.
+func (u *ObProxyUpsert) SetCreateTime(v time.Time) *ObProxyUpsert {
+	u.Set(obproxy.FieldCreateTime, v)
+	return u
+}
+
+// UpdateCreateTime sets the "create_time" field to the value that was provided on create.
+func (u *ObProxyUpsert) UpdateCreateTime() *ObProxyUpsert {
+	u.SetExcluded(obproxy.FieldCreateTime)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ObProxyUpsert) SetUpdateTime(v time.Time) *ObProxyUpsert {
+	u.Set(obproxy.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ObProxyUpsert) UpdateUpdateTime() *ObProxyUpsert {
+	u.SetExcluded(obproxy.FieldUpdateTime)
+	return u
+}
+
+// SetNamespace sets the "namespace" field.
+func (u *ObProxyUpsert) SetNamespace(v string) *ObProxyUpsert {
+	u.Set(obproxy.FieldNamespace, v)
+	return u
+}
+
+// UpdateNamespace sets the "namespace" field to the value that was provided on create.
+func (u *ObProxyUpsert) UpdateNamespace() *ObProxyUpsert {
+	u.SetExcluded(obproxy.FieldNamespace)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ObProxyUpsert) SetName(v string) *ObProxyUpsert {
+	u.Set(obproxy.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ObProxyUpsert) UpdateName() *ObProxyUpsert {
+	u.SetExcluded(obproxy.FieldName)
+	return u
+}
+
+// SetAddress sets the "address" field.
+func (u *ObProxyUpsert) SetAddress(v string) *ObProxyUpsert {
+	u.Set(obproxy.FieldAddress, v)
+	return u
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *ObProxyUpsert) UpdateAddress() *ObProxyUpsert {
+	u.SetExcluded(obproxy.FieldAddress)
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *ObProxyUpsert) SetVersion(v string) *ObProxyUpsert {
+	u.Set(obproxy.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *ObProxyUpsert) UpdateVersion() *ObProxyUpsert {
+	u.SetExcluded(obproxy.FieldVersion)
+	return u
+}
+
+// SetConfigVersion sets the "config_version" field.
+func (u *ObProxyUpsert) SetConfigVersion(v string) *ObProxyUpsert {
+	u.Set(obproxy.FieldConfigVersion, v)
+	return u
+}
+
+// UpdateConfigVersion sets the "config_version" field to the value that was provided on create.
+func (u *ObProxyUpsert) UpdateConfigVersion() *ObProxyUpsert {
+	u.SetExcluded(obproxy.FieldConfigVersion)
+	return u
+}
+
+// SetObproxyGroup sets the "obproxy_group" field.
+func (u *ObProxyUpsert) SetObproxyGroup(v string) *ObProxyUpsert {
+	u.Set(obproxy.FieldObproxyGroup, v)
+	return u
+}
+
+// UpdateObproxyGroup sets the "obproxy_group" field to the value that was provided on create.
+func (u *ObProxyUpsert) UpdateObproxyGroup() *ObProxyUpsert {
+	u.SetExcluded(obproxy.FieldObproxyGroup)
+	return u
+}
+
+// SetServiceAddress sets the "service_address" field.
+func (u *ObProxyUpsert) SetServiceAddress(v string) *ObProxyUpsert {
+	u.Set(obproxy.FieldServiceAddress, v)
+	return u
+}
+
+// UpdateServiceAddress sets the "service_address" field to the value that was provided on create.
+func (u *ObProxyUpsert) UpdateServiceAddress() *ObProxyUpsert {
+	u.SetExcluded(obproxy.FieldServiceAddress)
+	return u
+}
+
+// SetLastSeenTime sets the "last_seen_time" field.
+func (u *ObProxyUpsert) SetLastSeenTime(v time.Time) *ObProxyUpsert {
+	u.Set(obproxy.FieldLastSeenTime, v)
+	return u
+}
+
+// UpdateLastSeenTime sets the "last_seen_time" field to the value that was provided on create.
+func (u *ObProxyUpsert) UpdateLastSeenTime() *ObProxyUpsert {
+	u.SetExcluded(obproxy.FieldLastSeenTime)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ObProxy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ObProxyUpsertOne) UpdateNewValues() *ObProxyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ObProxy.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ObProxyUpsertOne) Ignore() *ObProxyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ObProxyUpsertOne) DoNothing() *ObProxyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ObProxyCreate.OnConflict
+// documentation for more info.
+func (u *ObProxyUpsertOne) Update(set func(*ObProxyUpsert)) *ObProxyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ObProxyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateTime sets the "create_time" field.
+func (u *ObProxyUpsertOne) SetCreateTime(v time.Time) *ObProxyUpsertOne {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.SetCreateTime(v)
+	})
+}
+
+// UpdateCreateTime sets the "create_time" field to the value that was provided on create.
+func (u *ObProxyUpsertOne) UpdateCreateTime() *ObProxyUpsertOne {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.UpdateCreateTime()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ObProxyUpsertOne) SetUpdateTime(v time.Time) *ObProxyUpsertOne {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ObProxyUpsertOne) UpdateUpdateTime() *ObProxyUpsertOne {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetNamespace sets the "namespace" field.
+func (u *ObProxyUpsertOne) SetNamespace(v string) *ObProxyUpsertOne {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.SetNamespace(v)
+	})
+}
+
+// UpdateNamespace sets the "namespace" field to the value that was provided on create.
+func (u *ObProxyUpsertOne) UpdateNamespace() *ObProxyUpsertOne {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.UpdateNamespace()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ObProxyUpsertOne) SetName(v string) *ObProxyUpsertOne {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ObProxyUpsertOne) UpdateName() *ObProxyUpsertOne {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetAddress sets the "address" field.
+func (u *ObProxyUpsertOne) SetAddress(v string) *ObProxyUpsertOne {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *ObProxyUpsertOne) UpdateAddress() *ObProxyUpsertOne {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *ObProxyUpsertOne) SetVersion(v string) *ObProxyUpsertOne {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *ObProxyUpsertOne) UpdateVersion() *ObProxyUpsertOne {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetConfigVersion sets the "config_version" field.
+func (u *ObProxyUpsertOne) SetConfigVersion(v string) *ObProxyUpsertOne {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.SetConfigVersion(v)
+	})
+}
+
+// UpdateConfigVersion sets the "config_version" field to the value that was provided on create.
+func (u *ObProxyUpsertOne) UpdateConfigVersion() *ObProxyUpsertOne {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.UpdateConfigVersion()
+	})
+}
+
+// SetObproxyGroup sets the "obproxy_group" field.
+func (u *ObProxyUpsertOne) SetObproxyGroup(v string) *ObProxyUpsertOne {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.SetObproxyGroup(v)
+	})
+}
+
+// UpdateObproxyGroup sets the "obproxy_group" field to the value that was provided on create.
+func (u *ObProxyUpsertOne) UpdateObproxyGroup() *ObProxyUpsertOne {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.UpdateObproxyGroup()
+	})
+}
+
+// SetServiceAddress sets the "service_address" field.
+func (u *ObProxyUpsertOne) SetServiceAddress(v string) *ObProxyUpsertOne {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.SetServiceAddress(v)
+	})
+}
+
+// UpdateServiceAddress sets the "service_address" field to the value that was provided on create.
+func (u *ObProxyUpsertOne) UpdateServiceAddress() *ObProxyUpsertOne {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.UpdateServiceAddress()
+	})
+}
+
+// SetLastSeenTime sets the "last_seen_time" field.
+func (u *ObProxyUpsertOne) SetLastSeenTime(v time.Time) *ObProxyUpsertOne {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.SetLastSeenTime(v)
+	})
+}
+
+// UpdateLastSeenTime sets the "last_seen_time" field to the value that was provided on create.
+func (u *ObProxyUpsertOne) UpdateLastSeenTime() *ObProxyUpsertOne {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.UpdateLastSeenTime()
+	})
+}
+
+// Exec executes the query.
+func (u *ObProxyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ObProxyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ObProxyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ObProxyUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ObProxyUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ObProxyCreateBulk is the builder for creating many ObProxy entities in bulk.
+type ObProxyCreateBulk struct {
+	config
+	err      error
+	builders []*ObProxyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ObProxy entities in the database.
+func (opcb *ObProxyCreateBulk) Save(ctx context.Context) ([]*ObProxy, error) {
+	if opcb.err != nil {
+		return nil, opcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(opcb.builders))
+	nodes := make([]*ObProxy, len(opcb.builders))
+	mutators := make([]Mutator, len(opcb.builders))
+	for i := range opcb.builders {
+		func(i int, root context.Context) {
+			builder := opcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ObProxyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, opcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = opcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, opcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, opcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (opcb *ObProxyCreateBulk) SaveX(ctx context.Context) []*ObProxy {
+	v, err := opcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (opcb *ObProxyCreateBulk) Exec(ctx context.Context) error {
+	_, err := opcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (opcb *ObProxyCreateBulk) ExecX(ctx context.Context) {
+	if err := opcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ObProxy.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ObProxyUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (opcb *ObProxyCreateBulk) OnConflict(opts ...sql.ConflictOption) *ObProxyUpsertBulk {
+	opcb.conflict = opts
+	return &ObProxyUpsertBulk{
+		create: opcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ObProxy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (opcb *ObProxyCreateBulk) OnConflictColumns(columns ...string) *ObProxyUpsertBulk {
+	opcb.conflict = append(opcb.conflict, sql.ConflictColumns(columns...))
+	return &ObProxyUpsertBulk{
+		create: opcb,
+	}
+}
+
+// ObProxyUpsertBulk is the builder for "upsert"-ing
+// a bulk of ObProxy nodes.
+type ObProxyUpsertBulk struct {
+	create *ObProxyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ObProxy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ObProxyUpsertBulk) UpdateNewValues() *ObProxyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ObProxy.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ObProxyUpsertBulk) Ignore() *ObProxyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ObProxyUpsertBulk) DoNothing() *ObProxyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ObProxyCreateBulk.OnConflict
+// documentation for more info.
+func (u *ObProxyUpsertBulk) Update(set func(*ObProxyUpsert)) *ObProxyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ObProxyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateTime sets the "create_time" field.
+func (u *ObProxyUpsertBulk) SetCreateTime(v time.Time) *ObProxyUpsertBulk {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.SetCreateTime(v)
+	})
+}
+
+// UpdateCreateTime sets the "create_time" field to the value that was provided on create.
+func (u *ObProxyUpsertBulk) UpdateCreateTime() *ObProxyUpsertBulk {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.UpdateCreateTime()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ObProxyUpsertBulk) SetUpdateTime(v time.Time) *ObProxyUpsertBulk {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ObProxyUpsertBulk) UpdateUpdateTime() *ObProxyUpsertBulk {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetNamespace sets the "namespace" field.
+func (u *ObProxyUpsertBulk) SetNamespace(v string) *ObProxyUpsertBulk {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.SetNamespace(v)
+	})
+}
+
+// UpdateNamespace sets the "namespace" field to the value that was provided on create.
+func (u *ObProxyUpsertBulk) UpdateNamespace() *ObProxyUpsertBulk {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.UpdateNamespace()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ObProxyUpsertBulk) SetName(v string) *ObProxyUpsertBulk {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ObProxyUpsertBulk) UpdateName() *ObProxyUpsertBulk {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetAddress sets the "address" field.
+func (u *ObProxyUpsertBulk) SetAddress(v string) *ObProxyUpsertBulk {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *ObProxyUpsertBulk) UpdateAddress() *ObProxyUpsertBulk {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *ObProxyUpsertBulk) SetVersion(v string) *ObProxyUpsertBulk {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *ObProxyUpsertBulk) UpdateVersion() *ObProxyUpsertBulk {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetConfigVersion sets the "config_version" field.
+func (u *ObProxyUpsertBulk) SetConfigVersion(v string) *ObProxyUpsertBulk {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.SetConfigVersion(v)
+	})
+}
+
+// UpdateConfigVersion sets the "config_version" field to the value that was provided on create.
+func (u *ObProxyUpsertBulk) UpdateConfigVersion() *ObProxyUpsertBulk {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.UpdateConfigVersion()
+	})
+}
+
+// SetObproxyGroup sets the "obproxy_group" field.
+func (u *ObProxyUpsertBulk) SetObproxyGroup(v string) *ObProxyUpsertBulk {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.SetObproxyGroup(v)
+	})
+}
+
+// UpdateObproxyGroup sets the "obproxy_group" field to the value that was provided on create.
+func (u *ObProxyUpsertBulk) UpdateObproxyGroup() *ObProxyUpsertBulk {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.UpdateObproxyGroup()
+	})
+}
+
+// SetServiceAddress sets the "service_address" field.
+func (u *ObProxyUpsertBulk) SetServiceAddress(v string) *ObProxyUpsertBulk {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.SetServiceAddress(v)
+	})
+}
+
+// UpdateServiceAddress sets the "service_address" field to the value that was provided on create.
+func (u *ObProxyUpsertBulk) UpdateServiceAddress() *ObProxyUpsertBulk {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.UpdateServiceAddress()
+	})
+}
+
+// SetLastSeenTime sets the "last_seen_time" field.
+func (u *ObProxyUpsertBulk) SetLastSeenTime(v time.Time) *ObProxyUpsertBulk {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.SetLastSeenTime(v)
+	})
+}
+
+// UpdateLastSeenTime sets the "last_seen_time" field to the value that was provided on create.
+func (u *ObProxyUpsertBulk) UpdateLastSeenTime() *ObProxyUpsertBulk {
+	return u.Update(func(s *ObProxyUpsert) {
+		s.UpdateLastSeenTime()
+	})
+}
+
+// Exec executes the query.
+func (u *ObProxyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ObProxyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ObProxyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ObProxyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/oceanbase/configserver/ent/obproxy"
+	"github.com/oceanbase/configserver/ent/predicate"
+)
+
+// ObProxyDelete is the builder for deleting a ObProxy entity.
+type ObProxyDelete struct {
+	config
+	hooks    []Hook
+	mutation *ObProxyMutation
+}
+
+// Where appends a list predicates to the ObProxyDelete builder.
+func (opd *ObProxyDelete) Where(ps ...predicate.ObProxy) *ObProxyDelete {
+	opd.mutation.Where(ps...)
+	return opd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (opd *ObProxyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, opd.sqlExec, opd.mutation, opd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (opd *ObProxyDelete) ExecX(ctx context.Context) int {
+	n, err := opd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (opd *ObProxyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(obproxy.Table, sqlgraph.NewFieldSpec(obproxy.FieldID, field.TypeInt))
+	if ps := opd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, opd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	opd.mutation.done = true
+	return affected, err
+}
+
+// ObProxyDeleteOne is the builder for deleting a single ObProxy entity.
+type ObProxyDeleteOne struct {
+	opd *ObProxyDelete
+}
+
+// Where appends a list predicates to the ObProxyDelete builder.
+func (opdo *ObProxyDeleteOne) Where(ps ...predicate.ObProxy) *ObProxyDeleteOne {
+	opdo.opd.mutation.Where(ps...)
+	return opdo
+}
+
+// Exec executes the deletion query.
+func (opdo *ObProxyDeleteOne) Exec(ctx context.Context) error {
+	n, err := opdo.opd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{obproxy.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (opdo *ObProxyDeleteOne) ExecX(ctx context.Context) {
+	if err := opdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
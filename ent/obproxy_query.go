@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/oceanbase/configserver/ent/obproxy"
+	"github.com/oceanbase/configserver/ent/predicate"
+)
+
+// ObProxyQuery is the builder for querying ObProxy entities.
+type ObProxyQuery struct {
+	config
+	ctx        *QueryContext
+	order      []obproxy.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ObProxy
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ObProxyQuery builder.
+func (opq *ObProxyQuery) Where(ps ...predicate.ObProxy) *ObProxyQuery {
+	opq.predicates = append(opq.predicates, ps...)
+	return opq
+}
+
+// Limit the number of records to be returned by this query.
+func (opq *ObProxyQuery) Limit(limit int) *ObProxyQuery {
+	opq.ctx.Limit = &limit
+	return opq
+}
+
+// Offset to start from.
+func (opq *ObProxyQuery) Offset(offset int) *ObProxyQuery {
+	opq.ctx.Offset = &offset
+	return opq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (opq *ObProxyQuery) Unique(unique bool) *ObProxyQuery {
+	opq.ctx.Unique = &unique
+	return opq
+}
+
+// Order specifies how the records should be ordered.
+func (opq *ObProxyQuery) Order(o ...obproxy.OrderOption) *ObProxyQuery {
+	opq.order = append(opq.order, o...)
+	return opq
+}
+
+// First returns the first ObProxy entity from the query.
+// Returns a *NotFoundError when no ObProxy was found.
+func (opq *ObProxyQuery) First(ctx context.Context) (*ObProxy, error) {
+	nodes, err := opq.Limit(1).All(setContextOp(ctx, opq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{obproxy.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (opq *ObProxyQuery) FirstX(ctx context.Context) *ObProxy {
+	node, err := opq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ObProxy ID from the query.
+// Returns a *NotFoundError when no ObProxy ID was found.
+func (opq *ObProxyQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = opq.Limit(1).IDs(setContextOp(ctx, opq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{obproxy.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (opq *ObProxyQuery) FirstIDX(ctx context.Context) int {
+	id, err := opq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ObProxy entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ObProxy entity is found.
+// Returns a *NotFoundError when no ObProxy entities are found.
+func (opq *ObProxyQuery) Only(ctx context.Context) (*ObProxy, error) {
+	nodes, err := opq.Limit(2).All(setContextOp(ctx, opq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{obproxy.Label}
+	default:
+		return nil, &NotSingularError{obproxy.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (opq *ObProxyQuery) OnlyX(ctx context.Context) *ObProxy {
+	node, err := opq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ObProxy ID in the query.
+// Returns a *NotSingularError when more than one ObProxy ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (opq *ObProxyQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = opq.Limit(2).IDs(setContextOp(ctx, opq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{obproxy.Label}
+	default:
+		err = &NotSingularError{obproxy.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (opq *ObProxyQuery) OnlyIDX(ctx context.Context) int {
+	id, err := opq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ObProxies.
+func (opq *ObProxyQuery) All(ctx context.Context) ([]*ObProxy, error) {
+	ctx = setContextOp(ctx, opq.ctx, ent.OpQueryAll)
+	if err := opq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ObProxy, *ObProxyQuery]()
+	return withInterceptors[[]*ObProxy](ctx, opq, qr, opq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (opq *ObProxyQuery) AllX(ctx context.Context) []*ObProxy {
+	nodes, err := opq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ObProxy IDs.
+func (opq *ObProxyQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if opq.ctx.Unique == nil && opq.path != nil {
+		opq.Unique(true)
+	}
+	ctx = setContextOp(ctx, opq.ctx, ent.OpQueryIDs)
+	if err = opq.Select(obproxy.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (opq *ObProxyQuery) IDsX(ctx context.Context) []int {
+	ids, err := opq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (opq *ObProxyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, opq.ctx, ent.OpQueryCount)
+	if err := opq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, opq, querierCount[*ObProxyQuery](), opq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (opq *ObProxyQuery) CountX(ctx context.Context) int {
+	count, err := opq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (opq *ObProxyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, opq.ctx, ent.OpQueryExist)
+	switch _, err := opq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (opq *ObProxyQuery) ExistX(ctx context.Context) bool {
+	exist, err := opq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ObProxyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (opq *ObProxyQuery) Clone() *ObProxyQuery {
+	if opq == nil {
+		return nil
+	}
+	return &ObProxyQuery{
+		config:     opq.config,
+		ctx:        opq.ctx.Clone(),
+		order:      append([]obproxy.OrderOption{}, opq.order...),
+		inters:     append([]Interceptor{}, opq.inters...),
+		predicates: append([]predicate.ObProxy{}, opq.predicates...),
+		// clone intermediate query.
+		sql:  opq.sql.Clone(),
+		path: opq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ObProxy.Query().
+//		GroupBy(obproxy.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (opq *ObProxyQuery) GroupBy(field string, fields ...string) *ObProxyGroupBy {
+	opq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ObProxyGroupBy{build: opq}
+	grbuild.flds = &opq.ctx.Fields
+	grbuild.label = obproxy.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.ObProxy.Query().
+//		Select(obproxy.FieldCreateTime).
+//		Scan(ctx, &v)
+func (opq *ObProxyQuery) Select(fields ...string) *ObProxySelect {
+	opq.ctx.Fields = append(opq.ctx.Fields, fields...)
+	sbuild := &ObProxySelect{ObProxyQuery: opq}
+	sbuild.label = obproxy.Label
+	sbuild.flds, sbuild.scan = &opq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ObProxySelect configured with the given aggregations.
+func (opq *ObProxyQuery) Aggregate(fns ...AggregateFunc) *ObProxySelect {
+	return opq.Select().Aggregate(fns...)
+}
+
+func (opq *ObProxyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range opq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, opq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range opq.ctx.Fields {
+		if !obproxy.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if opq.path != nil {
+		prev, err := opq.path(ctx)
+		if err != nil {
+			return err
+		}
+		opq.sql = prev
+	}
+	return nil
+}
+
+func (opq *ObProxyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ObProxy, error) {
+	var (
+		nodes = []*ObProxy{}
+		_spec = opq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ObProxy).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ObProxy{config: opq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, opq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (opq *ObProxyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := opq.querySpec()
+	_spec.Node.Columns = opq.ctx.Fields
+	if len(opq.ctx.Fields) > 0 {
+		_spec.Unique = opq.ctx.Unique != nil && *opq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, opq.driver, _spec)
+}
+
+func (opq *ObProxyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(obproxy.Table, obproxy.Columns, sqlgraph.NewFieldSpec(obproxy.FieldID, field.TypeInt))
+	_spec.From = opq.sql
+	if unique := opq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if opq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := opq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, obproxy.FieldID)
+		for i := range fields {
+			if fields[i] != obproxy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := opq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := opq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := opq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := opq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (opq *ObProxyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(opq.driver.Dialect())
+	t1 := builder.Table(obproxy.Table)
+	columns := opq.ctx.Fields
+	if len(columns) == 0 {
+		columns = obproxy.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if opq.sql != nil {
+		selector = opq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if opq.ctx.Unique != nil && *opq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range opq.predicates {
+		p(selector)
+	}
+	for _, p := range opq.order {
+		p(selector)
+	}
+	if offset := opq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := opq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ObProxyGroupBy is the group-by builder for ObProxy entities.
+type ObProxyGroupBy struct {
+	selector
+	build *ObProxyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (opgb *ObProxyGroupBy) Aggregate(fns ...AggregateFunc) *ObProxyGroupBy {
+	opgb.fns = append(opgb.fns, fns...)
+	return opgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (opgb *ObProxyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, opgb.build.ctx, ent.OpQueryGroupBy)
+	if err := opgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ObProxyQuery, *ObProxyGroupBy](ctx, opgb.build, opgb, opgb.build.inters, v)
+}
+
+func (opgb *ObProxyGroupBy) sqlScan(ctx context.Context, root *ObProxyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(opgb.fns))
+	for _, fn := range opgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*opgb.flds)+len(opgb.fns))
+		for _, f := range *opgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*opgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := opgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ObProxySelect is the builder for selecting fields of ObProxy entities.
+type ObProxySelect struct {
+	*ObProxyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ops *ObProxySelect) Aggregate(fns ...AggregateFunc) *ObProxySelect {
+	ops.fns = append(ops.fns, fns...)
+	return ops
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ops *ObProxySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ops.ctx, ent.OpQuerySelect)
+	if err := ops.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ObProxyQuery, *ObProxySelect](ctx, ops.ObProxyQuery, ops, ops.inters, v)
+}
+
+func (ops *ObProxySelect) sqlScan(ctx context.Context, root *ObProxyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ops.fns))
+	for _, fn := range ops.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ops.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ops.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
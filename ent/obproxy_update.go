@@ -0,0 +1,518 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/oceanbase/configserver/ent/obproxy"
+	"github.com/oceanbase/configserver/ent/predicate"
+)
+
+// ObProxyUpdate is the builder for updating ObProxy entities.
+type ObProxyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ObProxyMutation
+}
+
+// Where appends a list predicates to the ObProxyUpdate builder.
+func (opu *ObProxyUpdate) Where(ps ...predicate.ObProxy) *ObProxyUpdate {
+	opu.mutation.Where(ps...)
+	return opu
+}
+
+// SetCreateTime sets the "create_time" field.
+func (opu *ObProxyUpdate) SetCreateTime(t time.Time) *ObProxyUpdate {
+	opu.mutation.SetCreateTime(t)
+	return opu
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (opu *ObProxyUpdate) SetNillableCreateTime(t *time.Time) *ObProxyUpdate {
+	if t != nil {
+		opu.SetCreateTime(*t)
+	}
+	return opu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (opu *ObProxyUpdate) SetUpdateTime(t time.Time) *ObProxyUpdate {
+	opu.mutation.SetUpdateTime(t)
+	return opu
+}
+
+// SetNamespace sets the "namespace" field.
+func (opu *ObProxyUpdate) SetNamespace(s string) *ObProxyUpdate {
+	opu.mutation.SetNamespace(s)
+	return opu
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (opu *ObProxyUpdate) SetNillableNamespace(s *string) *ObProxyUpdate {
+	if s != nil {
+		opu.SetNamespace(*s)
+	}
+	return opu
+}
+
+// SetName sets the "name" field.
+func (opu *ObProxyUpdate) SetName(s string) *ObProxyUpdate {
+	opu.mutation.SetName(s)
+	return opu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (opu *ObProxyUpdate) SetNillableName(s *string) *ObProxyUpdate {
+	if s != nil {
+		opu.SetName(*s)
+	}
+	return opu
+}
+
+// SetAddress sets the "address" field.
+func (opu *ObProxyUpdate) SetAddress(s string) *ObProxyUpdate {
+	opu.mutation.SetAddress(s)
+	return opu
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (opu *ObProxyUpdate) SetNillableAddress(s *string) *ObProxyUpdate {
+	if s != nil {
+		opu.SetAddress(*s)
+	}
+	return opu
+}
+
+// SetVersion sets the "version" field.
+func (opu *ObProxyUpdate) SetVersion(s string) *ObProxyUpdate {
+	opu.mutation.SetVersion(s)
+	return opu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (opu *ObProxyUpdate) SetNillableVersion(s *string) *ObProxyUpdate {
+	if s != nil {
+		opu.SetVersion(*s)
+	}
+	return opu
+}
+
+// SetConfigVersion sets the "config_version" field.
+func (opu *ObProxyUpdate) SetConfigVersion(s string) *ObProxyUpdate {
+	opu.mutation.SetConfigVersion(s)
+	return opu
+}
+
+// SetNillableConfigVersion sets the "config_version" field if the given value is not nil.
+func (opu *ObProxyUpdate) SetNillableConfigVersion(s *string) *ObProxyUpdate {
+	if s != nil {
+		opu.SetConfigVersion(*s)
+	}
+	return opu
+}
+
+// SetObproxyGroup sets the "obproxy_group" field.
+func (opu *ObProxyUpdate) SetObproxyGroup(s string) *ObProxyUpdate {
+	opu.mutation.SetObproxyGroup(s)
+	return opu
+}
+
+// SetNillableObproxyGroup sets the "obproxy_group" field if the given value is not nil.
+func (opu *ObProxyUpdate) SetNillableObproxyGroup(s *string) *ObProxyUpdate {
+	if s != nil {
+		opu.SetObproxyGroup(*s)
+	}
+	return opu
+}
+
+// SetServiceAddress sets the "service_address" field.
+func (opu *ObProxyUpdate) SetServiceAddress(s string) *ObProxyUpdate {
+	opu.mutation.SetServiceAddress(s)
+	return opu
+}
+
+// SetNillableServiceAddress sets the "service_address" field if the given value is not nil.
+func (opu *ObProxyUpdate) SetNillableServiceAddress(s *string) *ObProxyUpdate {
+	if s != nil {
+		opu.SetServiceAddress(*s)
+	}
+	return opu
+}
+
+// SetLastSeenTime sets the "last_seen_time" field.
+func (opu *ObProxyUpdate) SetLastSeenTime(t time.Time) *ObProxyUpdate {
+	opu.mutation.SetLastSeenTime(t)
+	return opu
+}
+
+// SetNillableLastSeenTime sets the "last_seen_time" field if the given value is not nil.
+func (opu *ObProxyUpdate) SetNillableLastSeenTime(t *time.Time) *ObProxyUpdate {
+	if t != nil {
+		opu.SetLastSeenTime(*t)
+	}
+	return opu
+}
+
+// Mutation returns the ObProxyMutation object of the builder.
+func (opu *ObProxyUpdate) Mutation() *ObProxyMutation {
+	return opu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (opu *ObProxyUpdate) Save(ctx context.Context) (int, error) {
+	opu.defaults()
+	return withHooks(ctx, opu.sqlSave, opu.mutation, opu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (opu *ObProxyUpdate) SaveX(ctx context.Context) int {
+	affected, err := opu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (opu *ObProxyUpdate) Exec(ctx context.Context) error {
+	_, err := opu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (opu *ObProxyUpdate) ExecX(ctx context.Context) {
+	if err := opu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (opu *ObProxyUpdate) defaults() {
+	if _, ok := opu.mutation.UpdateTime(); !ok {
+		v := obproxy.UpdateDefaultUpdateTime()
+		opu.mutation.SetUpdateTime(v)
+	}
+}
+
+func (opu *ObProxyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(obproxy.Table, obproxy.Columns, sqlgraph.NewFieldSpec(obproxy.FieldID, field.TypeInt))
+	if ps := opu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := opu.mutation.CreateTime(); ok {
+		_spec.SetField(obproxy.FieldCreateTime, field.TypeTime, value)
+	}
+	if value, ok := opu.mutation.UpdateTime(); ok {
+		_spec.SetField(obproxy.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := opu.mutation.Namespace(); ok {
+		_spec.SetField(obproxy.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := opu.mutation.Name(); ok {
+		_spec.SetField(obproxy.FieldName, field.TypeString, value)
+	}
+	if value, ok := opu.mutation.Address(); ok {
+		_spec.SetField(obproxy.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := opu.mutation.Version(); ok {
+		_spec.SetField(obproxy.FieldVersion, field.TypeString, value)
+	}
+	if value, ok := opu.mutation.ConfigVersion(); ok {
+		_spec.SetField(obproxy.FieldConfigVersion, field.TypeString, value)
+	}
+	if value, ok := opu.mutation.ObproxyGroup(); ok {
+		_spec.SetField(obproxy.FieldObproxyGroup, field.TypeString, value)
+	}
+	if value, ok := opu.mutation.ServiceAddress(); ok {
+		_spec.SetField(obproxy.FieldServiceAddress, field.TypeString, value)
+	}
+	if value, ok := opu.mutation.LastSeenTime(); ok {
+		_spec.SetField(obproxy.FieldLastSeenTime, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, opu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{obproxy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	opu.mutation.done = true
+	return n, nil
+}
+
+// ObProxyUpdateOne is the builder for updating a single ObProxy entity.
+type ObProxyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ObProxyMutation
+}
+
+// SetCreateTime sets the "create_time" field.
+func (opuo *ObProxyUpdateOne) SetCreateTime(t time.Time) *ObProxyUpdateOne {
+	opuo.mutation.SetCreateTime(t)
+	return opuo
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (opuo *ObProxyUpdateOne) SetNillableCreateTime(t *time.Time) *ObProxyUpdateOne {
+	if t != nil {
+		opuo.SetCreateTime(*t)
+	}
+	return opuo
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (opuo *ObProxyUpdateOne) SetUpdateTime(t time.Time) *ObProxyUpdateOne {
+	opuo.mutation.SetUpdateTime(t)
+	return opuo
+}
+
+// SetNamespace sets the "namespace" field.
+func (opuo *ObProxyUpdateOne) SetNamespace(s string) *ObProxyUpdateOne {
+	opuo.mutation.SetNamespace(s)
+	return opuo
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (opuo *ObProxyUpdateOne) SetNillableNamespace(s *string) *ObProxyUpdateOne {
+	if s != nil {
+		opuo.SetNamespace(*s)
+	}
+	return opuo
+}
+
+// SetName sets the "name" field.
+func (opuo *ObProxyUpdateOne) SetName(s string) *ObProxyUpdateOne {
+	opuo.mutation.SetName(s)
+	return opuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (opuo *ObProxyUpdateOne) SetNillableName(s *string) *ObProxyUpdateOne {
+	if s != nil {
+		opuo.SetName(*s)
+	}
+	return opuo
+}
+
+// SetAddress sets the "address" field.
+func (opuo *ObProxyUpdateOne) SetAddress(s string) *ObProxyUpdateOne {
+	opuo.mutation.SetAddress(s)
+	return opuo
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (opuo *ObProxyUpdateOne) SetNillableAddress(s *string) *ObProxyUpdateOne {
+	if s != nil {
+		opuo.SetAddress(*s)
+	}
+	return opuo
+}
+
+// SetVersion sets the "version" field.
+func (opuo *ObProxyUpdateOne) SetVersion(s string) *ObProxyUpdateOne {
+	opuo.mutation.SetVersion(s)
+	return opuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (opuo *ObProxyUpdateOne) SetNillableVersion(s *string) *ObProxyUpdateOne {
+	if s != nil {
+		opuo.SetVersion(*s)
+	}
+	return opuo
+}
+
+// SetConfigVersion sets the "config_version" field.
+func (opuo *ObProxyUpdateOne) SetConfigVersion(s string) *ObProxyUpdateOne {
+	opuo.mutation.SetConfigVersion(s)
+	return opuo
+}
+
+// SetNillableConfigVersion sets the "config_version" field if the given value is not nil.
+func (opuo *ObProxyUpdateOne) SetNillableConfigVersion(s *string) *ObProxyUpdateOne {
+	if s != nil {
+		opuo.SetConfigVersion(*s)
+	}
+	return opuo
+}
+
+// SetObproxyGroup sets the "obproxy_group" field.
+func (opuo *ObProxyUpdateOne) SetObproxyGroup(s string) *ObProxyUpdateOne {
+	opuo.mutation.SetObproxyGroup(s)
+	return opuo
+}
+
+// SetNillableObproxyGroup sets the "obproxy_group" field if the given value is not nil.
+func (opuo *ObProxyUpdateOne) SetNillableObproxyGroup(s *string) *ObProxyUpdateOne {
+	if s != nil {
+		opuo.SetObproxyGroup(*s)
+	}
+	return opuo
+}
+
+// SetServiceAddress sets the "service_address" field.
+func (opuo *ObProxyUpdateOne) SetServiceAddress(s string) *ObProxyUpdateOne {
+	opuo.mutation.SetServiceAddress(s)
+	return opuo
+}
+
+// SetNillableServiceAddress sets the "service_address" field if the given value is not nil.
+func (opuo *ObProxyUpdateOne) SetNillableServiceAddress(s *string) *ObProxyUpdateOne {
+	if s != nil {
+		opuo.SetServiceAddress(*s)
+	}
+	return opuo
+}
+
+// SetLastSeenTime sets the "last_seen_time" field.
+func (opuo *ObProxyUpdateOne) SetLastSeenTime(t time.Time) *ObProxyUpdateOne {
+	opuo.mutation.SetLastSeenTime(t)
+	return opuo
+}
+
+// SetNillableLastSeenTime sets the "last_seen_time" field if the given value is not nil.
+func (opuo *ObProxyUpdateOne) SetNillableLastSeenTime(t *time.Time) *ObProxyUpdateOne {
+	if t != nil {
+		opuo.SetLastSeenTime(*t)
+	}
+	return opuo
+}
+
+// Mutation returns the ObProxyMutation object of the builder.
+func (opuo *ObProxyUpdateOne) Mutation() *ObProxyMutation {
+	return opuo.mutation
+}
+
+// Where appends a list predicates to the ObProxyUpdate builder.
+func (opuo *ObProxyUpdateOne) Where(ps ...predicate.ObProxy) *ObProxyUpdateOne {
+	opuo.mutation.Where(ps...)
+	return opuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (opuo *ObProxyUpdateOne) Select(field string, fields ...string) *ObProxyUpdateOne {
+	opuo.fields = append([]string{field}, fields...)
+	return opuo
+}
+
+// Save executes the query and returns the updated ObProxy entity.
+func (opuo *ObProxyUpdateOne) Save(ctx context.Context) (*ObProxy, error) {
+	opuo.defaults()
+	return withHooks(ctx, opuo.sqlSave, opuo.mutation, opuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (opuo *ObProxyUpdateOne) SaveX(ctx context.Context) *ObProxy {
+	node, err := opuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (opuo *ObProxyUpdateOne) Exec(ctx context.Context) error {
+	_, err := opuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (opuo *ObProxyUpdateOne) ExecX(ctx context.Context) {
+	if err := opuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (opuo *ObProxyUpdateOne) defaults() {
+	if _, ok := opuo.mutation.UpdateTime(); !ok {
+		v := obproxy.UpdateDefaultUpdateTime()
+		opuo.mutation.SetUpdateTime(v)
+	}
+}
+
+func (opuo *ObProxyUpdateOne) sqlSave(ctx context.Context) (_node *ObProxy, err error) {
+	_spec := sqlgraph.NewUpdateSpec(obproxy.Table, obproxy.Columns, sqlgraph.NewFieldSpec(obproxy.FieldID, field.TypeInt))
+	id, ok := opuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ObProxy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := opuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, obproxy.FieldID)
+		for _, f := range fields {
+			if !obproxy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != obproxy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := opuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := opuo.mutation.CreateTime(); ok {
+		_spec.SetField(obproxy.FieldCreateTime, field.TypeTime, value)
+	}
+	if value, ok := opuo.mutation.UpdateTime(); ok {
+		_spec.SetField(obproxy.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := opuo.mutation.Namespace(); ok {
+		_spec.SetField(obproxy.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := opuo.mutation.Name(); ok {
+		_spec.SetField(obproxy.FieldName, field.TypeString, value)
+	}
+	if value, ok := opuo.mutation.Address(); ok {
+		_spec.SetField(obproxy.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := opuo.mutation.Version(); ok {
+		_spec.SetField(obproxy.FieldVersion, field.TypeString, value)
+	}
+	if value, ok := opuo.mutation.ConfigVersion(); ok {
+		_spec.SetField(obproxy.FieldConfigVersion, field.TypeString, value)
+	}
+	if value, ok := opuo.mutation.ObproxyGroup(); ok {
+		_spec.SetField(obproxy.FieldObproxyGroup, field.TypeString, value)
+	}
+	if value, ok := opuo.mutation.ServiceAddress(); ok {
+		_spec.SetField(obproxy.FieldServiceAddress, field.TypeString, value)
+	}
+	if value, ok := opuo.mutation.LastSeenTime(); ok {
+		_spec.SetField(obproxy.FieldLastSeenTime, field.TypeTime, value)
+	}
+	_node = &ObProxy{config: opuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, opuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{obproxy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	opuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,247 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/oceanbase/configserver/ent/obproxygroup"
+)
+
+// ObProxyGroup is the model entity for the ObProxyGroup schema.
+type ObProxyGroup struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Namespace holds the value of the "namespace" field.
+	Namespace string `json:"namespace,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// ObClusters holds the value of the "ob_clusters" field.
+	ObClusters []string `json:"ob_clusters,omitempty"`
+	// Clients holds the value of the "clients" field.
+	Clients []string `json:"clients,omitempty"`
+	// Users holds the value of the "users" field.
+	Users []string `json:"users,omitempty"`
+	// MetaObCluster holds the value of the "meta_ob_cluster" field.
+	MetaObCluster string `json:"meta_ob_cluster,omitempty"`
+	// MetaDatabase holds the value of the "meta_database" field.
+	MetaDatabase string `json:"meta_database,omitempty"`
+	// MetaUser holds the value of the "meta_user" field.
+	MetaUser string `json:"meta_user,omitempty"`
+	// MetaEncryptedPassword holds the value of the "meta_encrypted_password" field.
+	MetaEncryptedPassword string `json:"meta_encrypted_password,omitempty"`
+	// URLTemplateV1 holds the value of the "url_template_v1" field.
+	URLTemplateV1 string `json:"url_template_v1,omitempty"`
+	// URLTemplateV2 holds the value of the "url_template_v2" field.
+	URLTemplateV2 string `json:"url_template_v2,omitempty"`
+	selectValues  sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ObProxyGroup) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case obproxygroup.FieldObClusters, obproxygroup.FieldClients, obproxygroup.FieldUsers:
+			values[i] = new([]byte)
+		case obproxygroup.FieldID:
+			values[i] = new(sql.NullInt64)
+		case obproxygroup.FieldNamespace, obproxygroup.FieldName, obproxygroup.FieldMetaObCluster, obproxygroup.FieldMetaDatabase, obproxygroup.FieldMetaUser, obproxygroup.FieldMetaEncryptedPassword, obproxygroup.FieldURLTemplateV1, obproxygroup.FieldURLTemplateV2:
+			values[i] = new(sql.NullString)
+		case obproxygroup.FieldCreateTime, obproxygroup.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ObProxyGroup fields.
+func (opg *ObProxyGroup) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case obproxygroup.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			opg.ID = int(value.Int64)
+		case obproxygroup.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				opg.CreateTime = value.Time
+			}
+		case obproxygroup.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				opg.UpdateTime = value.Time
+			}
+		case obproxygroup.FieldNamespace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace", values[i])
+			} else if value.Valid {
+				opg.Namespace = value.String
+			}
+		case obproxygroup.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				opg.Name = value.String
+			}
+		case obproxygroup.FieldObClusters:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field ob_clusters", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &opg.ObClusters); err != nil {
+					return fmt.Errorf("unmarshal field ob_clusters: %w", err)
+				}
+			}
+		case obproxygroup.FieldClients:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field clients", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &opg.Clients); err != nil {
+					return fmt.Errorf("unmarshal field clients: %w", err)
+				}
+			}
+		case obproxygroup.FieldUsers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field users", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &opg.Users); err != nil {
+					return fmt.Errorf("unmarshal field users: %w", err)
+				}
+			}
+		case obproxygroup.FieldMetaObCluster:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field meta_ob_cluster", values[i])
+			} else if value.Valid {
+				opg.MetaObCluster = value.String
+			}
+		case obproxygroup.FieldMetaDatabase:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field meta_database", values[i])
+			} else if value.Valid {
+				opg.MetaDatabase = value.String
+			}
+		case obproxygroup.FieldMetaUser:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field meta_user", values[i])
+			} else if value.Valid {
+				opg.MetaUser = value.String
+			}
+		case obproxygroup.FieldMetaEncryptedPassword:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field meta_encrypted_password", values[i])
+			} else if value.Valid {
+				opg.MetaEncryptedPassword = value.String
+			}
+		case obproxygroup.FieldURLTemplateV1:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url_template_v1", values[i])
+			} else if value.Valid {
+				opg.URLTemplateV1 = value.String
+			}
+		case obproxygroup.FieldURLTemplateV2:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url_template_v2", values[i])
+			} else if value.Valid {
+				opg.URLTemplateV2 = value.String
+			}
+		default:
+			opg.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ObProxyGroup.
+// This includes values selected through modifiers, order, etc.
+func (opg *ObProxyGroup) Value(name string) (ent.Value, error) {
+	return opg.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ObProxyGroup.
+// Note that you need to call ObProxyGroup.Unwrap() before calling this method if this ObProxyGroup
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (opg *ObProxyGroup) Update() *ObProxyGroupUpdateOne {
+	return NewObProxyGroupClient(opg.config).UpdateOne(opg)
+}
+
+// Unwrap unwraps the ObProxyGroup entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (opg *ObProxyGroup) Unwrap() *ObProxyGroup {
+	_tx, ok := opg.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ObProxyGroup is not a transactional entity")
+	}
+	opg.config.driver = _tx.drv
+	return opg
+}
+
+// String implements the fmt.Stringer.
+func (opg *ObProxyGroup) String() string {
+	var builder strings.Builder
+	builder.WriteString("ObProxyGroup(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", opg.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(opg.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(opg.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("namespace=")
+	builder.WriteString(opg.Namespace)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(opg.Name)
+	builder.WriteString(", ")
+	builder.WriteString("ob_clusters=")
+	builder.WriteString(fmt.Sprintf("%v", opg.ObClusters))
+	builder.WriteString(", ")
+	builder.WriteString("clients=")
+	builder.WriteString(fmt.Sprintf("%v", opg.Clients))
+	builder.WriteString(", ")
+	builder.WriteString("users=")
+	builder.WriteString(fmt.Sprintf("%v", opg.Users))
+	builder.WriteString(", ")
+	builder.WriteString("meta_ob_cluster=")
+	builder.WriteString(opg.MetaObCluster)
+	builder.WriteString(", ")
+	builder.WriteString("meta_database=")
+	builder.WriteString(opg.MetaDatabase)
+	builder.WriteString(", ")
+	builder.WriteString("meta_user=")
+	builder.WriteString(opg.MetaUser)
+	builder.WriteString(", ")
+	builder.WriteString("meta_encrypted_password=")
+	builder.WriteString(opg.MetaEncryptedPassword)
+	builder.WriteString(", ")
+	builder.WriteString("url_template_v1=")
+	builder.WriteString(opg.URLTemplateV1)
+	builder.WriteString(", ")
+	builder.WriteString("url_template_v2=")
+	builder.WriteString(opg.URLTemplateV2)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ObProxyGroups is a parsable slice of ObProxyGroup.
+type ObProxyGroups []*ObProxyGroup
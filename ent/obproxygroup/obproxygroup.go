@@ -0,0 +1,153 @@
+// Code generated by ent, DO NOT EDIT.
+
+package obproxygroup
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the obproxygroup type in the database.
+	Label = "ob_proxy_group"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldNamespace holds the string denoting the namespace field in the database.
+	FieldNamespace = "namespace"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldObClusters holds the string denoting the ob_clusters field in the database.
+	FieldObClusters = "ob_clusters"
+	// FieldClients holds the string denoting the clients field in the database.
+	FieldClients = "clients"
+	// FieldUsers holds the string denoting the users field in the database.
+	FieldUsers = "users"
+	// FieldMetaObCluster holds the string denoting the meta_ob_cluster field in the database.
+	FieldMetaObCluster = "meta_ob_cluster"
+	// FieldMetaDatabase holds the string denoting the meta_database field in the database.
+	FieldMetaDatabase = "meta_database"
+	// FieldMetaUser holds the string denoting the meta_user field in the database.
+	FieldMetaUser = "meta_user"
+	// FieldMetaEncryptedPassword holds the string denoting the meta_encrypted_password field in the database.
+	FieldMetaEncryptedPassword = "meta_encrypted_password"
+	// FieldURLTemplateV1 holds the string denoting the url_template_v1 field in the database.
+	FieldURLTemplateV1 = "url_template_v1"
+	// FieldURLTemplateV2 holds the string denoting the url_template_v2 field in the database.
+	FieldURLTemplateV2 = "url_template_v2"
+	// Table holds the table name of the obproxygroup in the database.
+	Table = "ob_proxy_groups"
+)
+
+// Columns holds all SQL columns for obproxygroup fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldNamespace,
+	FieldName,
+	FieldObClusters,
+	FieldClients,
+	FieldUsers,
+	FieldMetaObCluster,
+	FieldMetaDatabase,
+	FieldMetaUser,
+	FieldMetaEncryptedPassword,
+	FieldURLTemplateV1,
+	FieldURLTemplateV2,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// DefaultNamespace holds the default value on creation for the "namespace" field.
+	DefaultNamespace string
+	// DefaultMetaObCluster holds the default value on creation for the "meta_ob_cluster" field.
+	DefaultMetaObCluster string
+	// DefaultMetaDatabase holds the default value on creation for the "meta_database" field.
+	DefaultMetaDatabase string
+	// DefaultMetaUser holds the default value on creation for the "meta_user" field.
+	DefaultMetaUser string
+	// DefaultMetaEncryptedPassword holds the default value on creation for the "meta_encrypted_password" field.
+	DefaultMetaEncryptedPassword string
+	// DefaultURLTemplateV1 holds the default value on creation for the "url_template_v1" field.
+	DefaultURLTemplateV1 string
+	// DefaultURLTemplateV2 holds the default value on creation for the "url_template_v2" field.
+	DefaultURLTemplateV2 string
+)
+
+// OrderOption defines the ordering options for the ObProxyGroup queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByNamespace orders the results by the namespace field.
+func ByNamespace(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNamespace, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByMetaObCluster orders the results by the meta_ob_cluster field.
+func ByMetaObCluster(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMetaObCluster, opts...).ToFunc()
+}
+
+// ByMetaDatabase orders the results by the meta_database field.
+func ByMetaDatabase(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMetaDatabase, opts...).ToFunc()
+}
+
+// ByMetaUser orders the results by the meta_user field.
+func ByMetaUser(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMetaUser, opts...).ToFunc()
+}
+
+// ByMetaEncryptedPassword orders the results by the meta_encrypted_password field.
+func ByMetaEncryptedPassword(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMetaEncryptedPassword, opts...).ToFunc()
+}
+
+// ByURLTemplateV1 orders the results by the url_template_v1 field.
+func ByURLTemplateV1(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURLTemplateV1, opts...).ToFunc()
+}
+
+// ByURLTemplateV2 orders the results by the url_template_v2 field.
+func ByURLTemplateV2(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURLTemplateV2, opts...).ToFunc()
+}
@@ -0,0 +1,750 @@
+// Code generated by ent, DO NOT EDIT.
+
+package obproxygroup
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/oceanbase/configserver/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// Namespace applies equality check predicate on the "namespace" field. It's identical to NamespaceEQ.
+func Namespace(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldEQ(FieldNamespace, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldEQ(FieldName, v))
+}
+
+// MetaObCluster applies equality check predicate on the "meta_ob_cluster" field. It's identical to MetaObClusterEQ.
+func MetaObCluster(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldEQ(FieldMetaObCluster, v))
+}
+
+// MetaDatabase applies equality check predicate on the "meta_database" field. It's identical to MetaDatabaseEQ.
+func MetaDatabase(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldEQ(FieldMetaDatabase, v))
+}
+
+// MetaUser applies equality check predicate on the "meta_user" field. It's identical to MetaUserEQ.
+func MetaUser(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldEQ(FieldMetaUser, v))
+}
+
+// MetaEncryptedPassword applies equality check predicate on the "meta_encrypted_password" field. It's identical to MetaEncryptedPasswordEQ.
+func MetaEncryptedPassword(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldEQ(FieldMetaEncryptedPassword, v))
+}
+
+// URLTemplateV1 applies equality check predicate on the "url_template_v1" field. It's identical to URLTemplateV1EQ.
+func URLTemplateV1(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldEQ(FieldURLTemplateV1, v))
+}
+
+// URLTemplateV2 applies equality check predicate on the "url_template_v2" field. It's identical to URLTemplateV2EQ.
+func URLTemplateV2(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldEQ(FieldURLTemplateV2, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// NamespaceEQ applies the EQ predicate on the "namespace" field.
+func NamespaceEQ(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldEQ(FieldNamespace, v))
+}
+
+// NamespaceNEQ applies the NEQ predicate on the "namespace" field.
+func NamespaceNEQ(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldNEQ(FieldNamespace, v))
+}
+
+// NamespaceIn applies the In predicate on the "namespace" field.
+func NamespaceIn(vs ...string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldIn(FieldNamespace, vs...))
+}
+
+// NamespaceNotIn applies the NotIn predicate on the "namespace" field.
+func NamespaceNotIn(vs ...string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldNotIn(FieldNamespace, vs...))
+}
+
+// NamespaceGT applies the GT predicate on the "namespace" field.
+func NamespaceGT(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldGT(FieldNamespace, v))
+}
+
+// NamespaceGTE applies the GTE predicate on the "namespace" field.
+func NamespaceGTE(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldGTE(FieldNamespace, v))
+}
+
+// NamespaceLT applies the LT predicate on the "namespace" field.
+func NamespaceLT(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldLT(FieldNamespace, v))
+}
+
+// NamespaceLTE applies the LTE predicate on the "namespace" field.
+func NamespaceLTE(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldLTE(FieldNamespace, v))
+}
+
+// NamespaceContains applies the Contains predicate on the "namespace" field.
+func NamespaceContains(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldContains(FieldNamespace, v))
+}
+
+// NamespaceHasPrefix applies the HasPrefix predicate on the "namespace" field.
+func NamespaceHasPrefix(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldHasPrefix(FieldNamespace, v))
+}
+
+// NamespaceHasSuffix applies the HasSuffix predicate on the "namespace" field.
+func NamespaceHasSuffix(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldHasSuffix(FieldNamespace, v))
+}
+
+// NamespaceEqualFold applies the EqualFold predicate on the "namespace" field.
+func NamespaceEqualFold(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldEqualFold(FieldNamespace, v))
+}
+
+// NamespaceContainsFold applies the ContainsFold predicate on the "namespace" field.
+func NamespaceContainsFold(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldContainsFold(FieldNamespace, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldContainsFold(FieldName, v))
+}
+
+// ObClustersIsNil applies the IsNil predicate on the "ob_clusters" field.
+func ObClustersIsNil() predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldIsNull(FieldObClusters))
+}
+
+// ObClustersNotNil applies the NotNil predicate on the "ob_clusters" field.
+func ObClustersNotNil() predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldNotNull(FieldObClusters))
+}
+
+// ClientsIsNil applies the IsNil predicate on the "clients" field.
+func ClientsIsNil() predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldIsNull(FieldClients))
+}
+
+// ClientsNotNil applies the NotNil predicate on the "clients" field.
+func ClientsNotNil() predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldNotNull(FieldClients))
+}
+
+// UsersIsNil applies the IsNil predicate on the "users" field.
+func UsersIsNil() predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldIsNull(FieldUsers))
+}
+
+// UsersNotNil applies the NotNil predicate on the "users" field.
+func UsersNotNil() predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldNotNull(FieldUsers))
+}
+
+// MetaObClusterEQ applies the EQ predicate on the "meta_ob_cluster" field.
+func MetaObClusterEQ(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldEQ(FieldMetaObCluster, v))
+}
+
+// MetaObClusterNEQ applies the NEQ predicate on the "meta_ob_cluster" field.
+func MetaObClusterNEQ(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldNEQ(FieldMetaObCluster, v))
+}
+
+// MetaObClusterIn applies the In predicate on the "meta_ob_cluster" field.
+func MetaObClusterIn(vs ...string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldIn(FieldMetaObCluster, vs...))
+}
+
+// MetaObClusterNotIn applies the NotIn predicate on the "meta_ob_cluster" field.
+func MetaObClusterNotIn(vs ...string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldNotIn(FieldMetaObCluster, vs...))
+}
+
+// MetaObClusterGT applies the GT predicate on the "meta_ob_cluster" field.
+func MetaObClusterGT(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldGT(FieldMetaObCluster, v))
+}
+
+// MetaObClusterGTE applies the GTE predicate on the "meta_ob_cluster" field.
+func MetaObClusterGTE(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldGTE(FieldMetaObCluster, v))
+}
+
+// MetaObClusterLT applies the LT predicate on the "meta_ob_cluster" field.
+func MetaObClusterLT(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldLT(FieldMetaObCluster, v))
+}
+
+// MetaObClusterLTE applies the LTE predicate on the "meta_ob_cluster" field.
+func MetaObClusterLTE(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldLTE(FieldMetaObCluster, v))
+}
+
+// MetaObClusterContains applies the Contains predicate on the "meta_ob_cluster" field.
+func MetaObClusterContains(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldContains(FieldMetaObCluster, v))
+}
+
+// MetaObClusterHasPrefix applies the HasPrefix predicate on the "meta_ob_cluster" field.
+func MetaObClusterHasPrefix(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldHasPrefix(FieldMetaObCluster, v))
+}
+
+// MetaObClusterHasSuffix applies the HasSuffix predicate on the "meta_ob_cluster" field.
+func MetaObClusterHasSuffix(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldHasSuffix(FieldMetaObCluster, v))
+}
+
+// MetaObClusterEqualFold applies the EqualFold predicate on the "meta_ob_cluster" field.
+func MetaObClusterEqualFold(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldEqualFold(FieldMetaObCluster, v))
+}
+
+// MetaObClusterContainsFold applies the ContainsFold predicate on the "meta_ob_cluster" field.
+func MetaObClusterContainsFold(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldContainsFold(FieldMetaObCluster, v))
+}
+
+// MetaDatabaseEQ applies the EQ predicate on the "meta_database" field.
+func MetaDatabaseEQ(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldEQ(FieldMetaDatabase, v))
+}
+
+// MetaDatabaseNEQ applies the NEQ predicate on the "meta_database" field.
+func MetaDatabaseNEQ(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldNEQ(FieldMetaDatabase, v))
+}
+
+// MetaDatabaseIn applies the In predicate on the "meta_database" field.
+func MetaDatabaseIn(vs ...string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldIn(FieldMetaDatabase, vs...))
+}
+
+// MetaDatabaseNotIn applies the NotIn predicate on the "meta_database" field.
+func MetaDatabaseNotIn(vs ...string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldNotIn(FieldMetaDatabase, vs...))
+}
+
+// MetaDatabaseGT applies the GT predicate on the "meta_database" field.
+func MetaDatabaseGT(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldGT(FieldMetaDatabase, v))
+}
+
+// MetaDatabaseGTE applies the GTE predicate on the "meta_database" field.
+func MetaDatabaseGTE(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldGTE(FieldMetaDatabase, v))
+}
+
+// MetaDatabaseLT applies the LT predicate on the "meta_database" field.
+func MetaDatabaseLT(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldLT(FieldMetaDatabase, v))
+}
+
+// MetaDatabaseLTE applies the LTE predicate on the "meta_database" field.
+func MetaDatabaseLTE(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldLTE(FieldMetaDatabase, v))
+}
+
+// MetaDatabaseContains applies the Contains predicate on the "meta_database" field.
+func MetaDatabaseContains(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldContains(FieldMetaDatabase, v))
+}
+
+// MetaDatabaseHasPrefix applies the HasPrefix predicate on the "meta_database" field.
+func MetaDatabaseHasPrefix(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldHasPrefix(FieldMetaDatabase, v))
+}
+
+// MetaDatabaseHasSuffix applies the HasSuffix predicate on the "meta_database" field.
+func MetaDatabaseHasSuffix(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldHasSuffix(FieldMetaDatabase, v))
+}
+
+// MetaDatabaseEqualFold applies the EqualFold predicate on the "meta_database" field.
+func MetaDatabaseEqualFold(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldEqualFold(FieldMetaDatabase, v))
+}
+
+// MetaDatabaseContainsFold applies the ContainsFold predicate on the "meta_database" field.
+func MetaDatabaseContainsFold(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldContainsFold(FieldMetaDatabase, v))
+}
+
+// MetaUserEQ applies the EQ predicate on the "meta_user" field.
+func MetaUserEQ(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldEQ(FieldMetaUser, v))
+}
+
+// MetaUserNEQ applies the NEQ predicate on the "meta_user" field.
+func MetaUserNEQ(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldNEQ(FieldMetaUser, v))
+}
+
+// MetaUserIn applies the In predicate on the "meta_user" field.
+func MetaUserIn(vs ...string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldIn(FieldMetaUser, vs...))
+}
+
+// MetaUserNotIn applies the NotIn predicate on the "meta_user" field.
+func MetaUserNotIn(vs ...string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldNotIn(FieldMetaUser, vs...))
+}
+
+// MetaUserGT applies the GT predicate on the "meta_user" field.
+func MetaUserGT(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldGT(FieldMetaUser, v))
+}
+
+// MetaUserGTE applies the GTE predicate on the "meta_user" field.
+func MetaUserGTE(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldGTE(FieldMetaUser, v))
+}
+
+// MetaUserLT applies the LT predicate on the "meta_user" field.
+func MetaUserLT(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldLT(FieldMetaUser, v))
+}
+
+// MetaUserLTE applies the LTE predicate on the "meta_user" field.
+func MetaUserLTE(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldLTE(FieldMetaUser, v))
+}
+
+// MetaUserContains applies the Contains predicate on the "meta_user" field.
+func MetaUserContains(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldContains(FieldMetaUser, v))
+}
+
+// MetaUserHasPrefix applies the HasPrefix predicate on the "meta_user" field.
+func MetaUserHasPrefix(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldHasPrefix(FieldMetaUser, v))
+}
+
+// MetaUserHasSuffix applies the HasSuffix predicate on the "meta_user" field.
+func MetaUserHasSuffix(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldHasSuffix(FieldMetaUser, v))
+}
+
+// MetaUserEqualFold applies the EqualFold predicate on the "meta_user" field.
+func MetaUserEqualFold(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldEqualFold(FieldMetaUser, v))
+}
+
+// MetaUserContainsFold applies the ContainsFold predicate on the "meta_user" field.
+func MetaUserContainsFold(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldContainsFold(FieldMetaUser, v))
+}
+
+// MetaEncryptedPasswordEQ applies the EQ predicate on the "meta_encrypted_password" field.
+func MetaEncryptedPasswordEQ(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldEQ(FieldMetaEncryptedPassword, v))
+}
+
+// MetaEncryptedPasswordNEQ applies the NEQ predicate on the "meta_encrypted_password" field.
+func MetaEncryptedPasswordNEQ(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldNEQ(FieldMetaEncryptedPassword, v))
+}
+
+// MetaEncryptedPasswordIn applies the In predicate on the "meta_encrypted_password" field.
+func MetaEncryptedPasswordIn(vs ...string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldIn(FieldMetaEncryptedPassword, vs...))
+}
+
+// MetaEncryptedPasswordNotIn applies the NotIn predicate on the "meta_encrypted_password" field.
+func MetaEncryptedPasswordNotIn(vs ...string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldNotIn(FieldMetaEncryptedPassword, vs...))
+}
+
+// MetaEncryptedPasswordGT applies the GT predicate on the "meta_encrypted_password" field.
+func MetaEncryptedPasswordGT(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldGT(FieldMetaEncryptedPassword, v))
+}
+
+// MetaEncryptedPasswordGTE applies the GTE predicate on the "meta_encrypted_password" field.
+func MetaEncryptedPasswordGTE(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldGTE(FieldMetaEncryptedPassword, v))
+}
+
+// MetaEncryptedPasswordLT applies the LT predicate on the "meta_encrypted_password" field.
+func MetaEncryptedPasswordLT(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldLT(FieldMetaEncryptedPassword, v))
+}
+
+// MetaEncryptedPasswordLTE applies the LTE predicate on the "meta_encrypted_password" field.
+func MetaEncryptedPasswordLTE(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldLTE(FieldMetaEncryptedPassword, v))
+}
+
+// MetaEncryptedPasswordContains applies the Contains predicate on the "meta_encrypted_password" field.
+func MetaEncryptedPasswordContains(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldContains(FieldMetaEncryptedPassword, v))
+}
+
+// MetaEncryptedPasswordHasPrefix applies the HasPrefix predicate on the "meta_encrypted_password" field.
+func MetaEncryptedPasswordHasPrefix(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldHasPrefix(FieldMetaEncryptedPassword, v))
+}
+
+// MetaEncryptedPasswordHasSuffix applies the HasSuffix predicate on the "meta_encrypted_password" field.
+func MetaEncryptedPasswordHasSuffix(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldHasSuffix(FieldMetaEncryptedPassword, v))
+}
+
+// MetaEncryptedPasswordEqualFold applies the EqualFold predicate on the "meta_encrypted_password" field.
+func MetaEncryptedPasswordEqualFold(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldEqualFold(FieldMetaEncryptedPassword, v))
+}
+
+// MetaEncryptedPasswordContainsFold applies the ContainsFold predicate on the "meta_encrypted_password" field.
+func MetaEncryptedPasswordContainsFold(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldContainsFold(FieldMetaEncryptedPassword, v))
+}
+
+// URLTemplateV1EQ applies the EQ predicate on the "url_template_v1" field.
+func URLTemplateV1EQ(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldEQ(FieldURLTemplateV1, v))
+}
+
+// URLTemplateV1NEQ applies the NEQ predicate on the "url_template_v1" field.
+func URLTemplateV1NEQ(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldNEQ(FieldURLTemplateV1, v))
+}
+
+// URLTemplateV1In applies the In predicate on the "url_template_v1" field.
+func URLTemplateV1In(vs ...string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldIn(FieldURLTemplateV1, vs...))
+}
+
+// URLTemplateV1NotIn applies the NotIn predicate on the "url_template_v1" field.
+func URLTemplateV1NotIn(vs ...string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldNotIn(FieldURLTemplateV1, vs...))
+}
+
+// URLTemplateV1GT applies the GT predicate on the "url_template_v1" field.
+func URLTemplateV1GT(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldGT(FieldURLTemplateV1, v))
+}
+
+// URLTemplateV1GTE applies the GTE predicate on the "url_template_v1" field.
+func URLTemplateV1GTE(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldGTE(FieldURLTemplateV1, v))
+}
+
+// URLTemplateV1LT applies the LT predicate on the "url_template_v1" field.
+func URLTemplateV1LT(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldLT(FieldURLTemplateV1, v))
+}
+
+// URLTemplateV1LTE applies the LTE predicate on the "url_template_v1" field.
+func URLTemplateV1LTE(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldLTE(FieldURLTemplateV1, v))
+}
+
+// URLTemplateV1Contains applies the Contains predicate on the "url_template_v1" field.
+func URLTemplateV1Contains(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldContains(FieldURLTemplateV1, v))
+}
+
+// URLTemplateV1HasPrefix applies the HasPrefix predicate on the "url_template_v1" field.
+func URLTemplateV1HasPrefix(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldHasPrefix(FieldURLTemplateV1, v))
+}
+
+// URLTemplateV1HasSuffix applies the HasSuffix predicate on the "url_template_v1" field.
+func URLTemplateV1HasSuffix(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldHasSuffix(FieldURLTemplateV1, v))
+}
+
+// URLTemplateV1EqualFold applies the EqualFold predicate on the "url_template_v1" field.
+func URLTemplateV1EqualFold(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldEqualFold(FieldURLTemplateV1, v))
+}
+
+// URLTemplateV1ContainsFold applies the ContainsFold predicate on the "url_template_v1" field.
+func URLTemplateV1ContainsFold(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldContainsFold(FieldURLTemplateV1, v))
+}
+
+// URLTemplateV2EQ applies the EQ predicate on the "url_template_v2" field.
+func URLTemplateV2EQ(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldEQ(FieldURLTemplateV2, v))
+}
+
+// URLTemplateV2NEQ applies the NEQ predicate on the "url_template_v2" field.
+func URLTemplateV2NEQ(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldNEQ(FieldURLTemplateV2, v))
+}
+
+// URLTemplateV2In applies the In predicate on the "url_template_v2" field.
+func URLTemplateV2In(vs ...string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldIn(FieldURLTemplateV2, vs...))
+}
+
+// URLTemplateV2NotIn applies the NotIn predicate on the "url_template_v2" field.
+func URLTemplateV2NotIn(vs ...string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldNotIn(FieldURLTemplateV2, vs...))
+}
+
+// URLTemplateV2GT applies the GT predicate on the "url_template_v2" field.
+func URLTemplateV2GT(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldGT(FieldURLTemplateV2, v))
+}
+
+// URLTemplateV2GTE applies the GTE predicate on the "url_template_v2" field.
+func URLTemplateV2GTE(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldGTE(FieldURLTemplateV2, v))
+}
+
+// URLTemplateV2LT applies the LT predicate on the "url_template_v2" field.
+func URLTemplateV2LT(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldLT(FieldURLTemplateV2, v))
+}
+
+// URLTemplateV2LTE applies the LTE predicate on the "url_template_v2" field.
+func URLTemplateV2LTE(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldLTE(FieldURLTemplateV2, v))
+}
+
+// URLTemplateV2Contains applies the Contains predicate on the "url_template_v2" field.
+func URLTemplateV2Contains(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldContains(FieldURLTemplateV2, v))
+}
+
+// URLTemplateV2HasPrefix applies the HasPrefix predicate on the "url_template_v2" field.
+func URLTemplateV2HasPrefix(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldHasPrefix(FieldURLTemplateV2, v))
+}
+
+// URLTemplateV2HasSuffix applies the HasSuffix predicate on the "url_template_v2" field.
+func URLTemplateV2HasSuffix(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldHasSuffix(FieldURLTemplateV2, v))
+}
+
+// URLTemplateV2EqualFold applies the EqualFold predicate on the "url_template_v2" field.
+func URLTemplateV2EqualFold(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldEqualFold(FieldURLTemplateV2, v))
+}
+
+// URLTemplateV2ContainsFold applies the ContainsFold predicate on the "url_template_v2" field.
+func URLTemplateV2ContainsFold(v string) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.FieldContainsFold(FieldURLTemplateV2, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ObProxyGroup) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ObProxyGroup) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ObProxyGroup) predicate.ObProxyGroup {
+	return predicate.ObProxyGroup(sql.NotPredicates(p))
+}
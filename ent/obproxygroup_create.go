@@ -0,0 +1,1256 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/oceanbase/configserver/ent/obproxygroup"
+)
+
+// ObProxyGroupCreate is the builder for creating a ObProxyGroup entity.
+type ObProxyGroupCreate struct {
+	config
+	mutation *ObProxyGroupMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (opgc *ObProxyGroupCreate) SetCreateTime(t time.Time) *ObProxyGroupCreate {
+	opgc.mutation.SetCreateTime(t)
+	return opgc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (opgc *ObProxyGroupCreate) SetNillableCreateTime(t *time.Time) *ObProxyGroupCreate {
+	if t != nil {
+		opgc.SetCreateTime(*t)
+	}
+	return opgc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (opgc *ObProxyGroupCreate) SetUpdateTime(t time.Time) *ObProxyGroupCreate {
+	opgc.mutation.SetUpdateTime(t)
+	return opgc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (opgc *ObProxyGroupCreate) SetNillableUpdateTime(t *time.Time) *ObProxyGroupCreate {
+	if t != nil {
+		opgc.SetUpdateTime(*t)
+	}
+	return opgc
+}
+
+// SetNamespace sets the "namespace" field.
+func (opgc *ObProxyGroupCreate) SetNamespace(s string) *ObProxyGroupCreate {
+	opgc.mutation.SetNamespace(s)
+	return opgc
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (opgc *ObProxyGroupCreate) SetNillableNamespace(s *string) *ObProxyGroupCreate {
+	if s != nil {
+		opgc.SetNamespace(*s)
+	}
+	return opgc
+}
+
+// SetName sets the "name" field.
+func (opgc *ObProxyGroupCreate) SetName(s string) *ObProxyGroupCreate {
+	opgc.mutation.SetName(s)
+	return opgc
+}
+
+// SetObClusters sets the "ob_clusters" field.
+func (opgc *ObProxyGroupCreate) SetObClusters(s []string) *ObProxyGroupCreate {
+	opgc.mutation.SetObClusters(s)
+	return opgc
+}
+
+// SetClients sets the "clients" field.
+func (opgc *ObProxyGroupCreate) SetClients(s []string) *ObProxyGroupCreate {
+	opgc.mutation.SetClients(s)
+	return opgc
+}
+
+// SetUsers sets the "users" field.
+func (opgc *ObProxyGroupCreate) SetUsers(s []string) *ObProxyGroupCreate {
+	opgc.mutation.SetUsers(s)
+	return opgc
+}
+
+// SetMetaObCluster sets the "meta_ob_cluster" field.
+func (opgc *ObProxyGroupCreate) SetMetaObCluster(s string) *ObProxyGroupCreate {
+	opgc.mutation.SetMetaObCluster(s)
+	return opgc
+}
+
+// SetNillableMetaObCluster sets the "meta_ob_cluster" field if the given value is not nil.
+func (opgc *ObProxyGroupCreate) SetNillableMetaObCluster(s *string) *ObProxyGroupCreate {
+	if s != nil {
+		opgc.SetMetaObCluster(*s)
+	}
+	return opgc
+}
+
+// SetMetaDatabase sets the "meta_database" field.
+func (opgc *ObProxyGroupCreate) SetMetaDatabase(s string) *ObProxyGroupCreate {
+	opgc.mutation.SetMetaDatabase(s)
+	return opgc
+}
+
+// SetNillableMetaDatabase sets the "meta_database" field if the given value is not nil.
+func (opgc *ObProxyGroupCreate) SetNillableMetaDatabase(s *string) *ObProxyGroupCreate {
+	if s != nil {
+		opgc.SetMetaDatabase(*s)
+	}
+	return opgc
+}
+
+// SetMetaUser sets the "meta_user" field.
+func (opgc *ObProxyGroupCreate) SetMetaUser(s string) *ObProxyGroupCreate {
+	opgc.mutation.SetMetaUser(s)
+	return opgc
+}
+
+// SetNillableMetaUser sets the "meta_user" field if the given value is not nil.
+func (opgc *ObProxyGroupCreate) SetNillableMetaUser(s *string) *ObProxyGroupCreate {
+	if s != nil {
+		opgc.SetMetaUser(*s)
+	}
+	return opgc
+}
+
+// SetMetaEncryptedPassword sets the "meta_encrypted_password" field.
+func (opgc *ObProxyGroupCreate) SetMetaEncryptedPassword(s string) *ObProxyGroupCreate {
+	opgc.mutation.SetMetaEncryptedPassword(s)
+	return opgc
+}
+
+// SetNillableMetaEncryptedPassword sets the "meta_encrypted_password" field if the given value is not nil.
+func (opgc *ObProxyGroupCreate) SetNillableMetaEncryptedPassword(s *string) *ObProxyGroupCreate {
+	if s != nil {
+		opgc.SetMetaEncryptedPassword(*s)
+	}
+	return opgc
+}
+
+// SetURLTemplateV1 sets the "url_template_v1" field.
+func (opgc *ObProxyGroupCreate) SetURLTemplateV1(s string) *ObProxyGroupCreate {
+	opgc.mutation.SetURLTemplateV1(s)
+	return opgc
+}
+
+// SetNillableURLTemplateV1 sets the "url_template_v1" field if the given value is not nil.
+func (opgc *ObProxyGroupCreate) SetNillableURLTemplateV1(s *string) *ObProxyGroupCreate {
+	if s != nil {
+		opgc.SetURLTemplateV1(*s)
+	}
+	return opgc
+}
+
+// SetURLTemplateV2 sets the "url_template_v2" field.
+func (opgc *ObProxyGroupCreate) SetURLTemplateV2(s string) *ObProxyGroupCreate {
+	opgc.mutation.SetURLTemplateV2(s)
+	return opgc
+}
+
+// SetNillableURLTemplateV2 sets the "url_template_v2" field if the given value is not nil.
+func (opgc *ObProxyGroupCreate) SetNillableURLTemplateV2(s *string) *ObProxyGroupCreate {
+	if s != nil {
+		opgc.SetURLTemplateV2(*s)
+	}
+	return opgc
+}
+
+// Mutation returns the ObProxyGroupMutation object of the builder.
+func (opgc *ObProxyGroupCreate) Mutation() *ObProxyGroupMutation {
+	return opgc.mutation
+}
+
+// Save creates the ObProxyGroup in the database.
+func (opgc *ObProxyGroupCreate) Save(ctx context.Context) (*ObProxyGroup, error) {
+	opgc.defaults()
+	return withHooks(ctx, opgc.sqlSave, opgc.mutation, opgc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (opgc *ObProxyGroupCreate) SaveX(ctx context.Context) *ObProxyGroup {
+	v, err := opgc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (opgc *ObProxyGroupCreate) Exec(ctx context.Context) error {
+	_, err := opgc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (opgc *ObProxyGroupCreate) ExecX(ctx context.Context) {
+	if err := opgc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (opgc *ObProxyGroupCreate) defaults() {
+	if _, ok := opgc.mutation.CreateTime(); !ok {
+		v := obproxygroup.DefaultCreateTime()
+		opgc.mutation.SetCreateTime(v)
+	}
+	if _, ok := opgc.mutation.UpdateTime(); !ok {
+		v := obproxygroup.DefaultUpdateTime()
+		opgc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := opgc.mutation.Namespace(); !ok {
+		v := obproxygroup.DefaultNamespace
+		opgc.mutation.SetNamespace(v)
+	}
+	if _, ok := opgc.mutation.MetaObCluster(); !ok {
+		v := obproxygroup.DefaultMetaObCluster
+		opgc.mutation.SetMetaObCluster(v)
+	}
+	if _, ok := opgc.mutation.MetaDatabase(); !ok {
+		v := obproxygroup.DefaultMetaDatabase
+		opgc.mutation.SetMetaDatabase(v)
+	}
+	if _, ok := opgc.mutation.MetaUser(); !ok {
+		v := obproxygroup.DefaultMetaUser
+		opgc.mutation.SetMetaUser(v)
+	}
+	if _, ok := opgc.mutation.MetaEncryptedPassword(); !ok {
+		v := obproxygroup.DefaultMetaEncryptedPassword
+		opgc.mutation.SetMetaEncryptedPassword(v)
+	}
+	if _, ok := opgc.mutation.URLTemplateV1(); !ok {
+		v := obproxygroup.DefaultURLTemplateV1
+		opgc.mutation.SetURLTemplateV1(v)
+	}
+	if _, ok := opgc.mutation.URLTemplateV2(); !ok {
+		v := obproxygroup.DefaultURLTemplateV2
+		opgc.mutation.SetURLTemplateV2(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (opgc *ObProxyGroupCreate) check() error {
+	if _, ok := opgc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "ObProxyGroup.create_time"`)}
+	}
+	if _, ok := opgc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "ObProxyGroup.update_time"`)}
+	}
+	if _, ok := opgc.mutation.Namespace(); !ok {
+		return &ValidationError{Name: "namespace", err: errors.New(`ent: missing required field "ObProxyGroup.namespace"`)}
+	}
+	if _, ok := opgc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ObProxyGroup.name"`)}
+	}
+	if _, ok := opgc.mutation.MetaObCluster(); !ok {
+		return &ValidationError{Name: "meta_ob_cluster", err: errors.New(`ent: missing required field "ObProxyGroup.meta_ob_cluster"`)}
+	}
+	if _, ok := opgc.mutation.MetaDatabase(); !ok {
+		return &ValidationError{Name: "meta_database", err: errors.New(`ent: missing required field "ObProxyGroup.meta_database"`)}
+	}
+	if _, ok := opgc.mutation.MetaUser(); !ok {
+		return &ValidationError{Name: "meta_user", err: errors.New(`ent: missing required field "ObProxyGroup.meta_user"`)}
+	}
+	if _, ok := opgc.mutation.MetaEncryptedPassword(); !ok {
+		return &ValidationError{Name: "meta_encrypted_password", err: errors.New(`ent: missing required field "ObProxyGroup.meta_encrypted_password"`)}
+	}
+	if _, ok := opgc.mutation.URLTemplateV1(); !ok {
+		return &ValidationError{Name: "url_template_v1", err: errors.New(`ent: missing required field "ObProxyGroup.url_template_v1"`)}
+	}
+	if _, ok := opgc.mutation.URLTemplateV2(); !ok {
+		return &ValidationError{Name: "url_template_v2", err: errors.New(`ent: missing required field "ObProxyGroup.url_template_v2"`)}
+	}
+	return nil
+}
+
+func (opgc *ObProxyGroupCreate) sqlSave(ctx context.Context) (*ObProxyGroup, error) {
+	if err := opgc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := opgc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, opgc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	opgc.mutation.id = &_node.ID
+	opgc.mutation.done = true
+	return _node, nil
+}
+
+func (opgc *ObProxyGroupCreate) createSpec() (*ObProxyGroup, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ObProxyGroup{config: opgc.config}
+		_spec = sqlgraph.NewCreateSpec(obproxygroup.Table, sqlgraph.NewFieldSpec(obproxygroup.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = opgc.conflict
+	if value, ok := opgc.mutation.CreateTime(); ok {
+		_spec.SetField(obproxygroup.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := opgc.mutation.UpdateTime(); ok {
+		_spec.SetField(obproxygroup.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := opgc.mutation.Namespace(); ok {
+		_spec.SetField(obproxygroup.FieldNamespace, field.TypeString, value)
+		_node.Namespace = value
+	}
+	if value, ok := opgc.mutation.Name(); ok {
+		_spec.SetField(obproxygroup.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := opgc.mutation.ObClusters(); ok {
+		_spec.SetField(obproxygroup.FieldObClusters, field.TypeJSON, value)
+		_node.ObClusters = value
+	}
+	if value, ok := opgc.mutation.Clients(); ok {
+		_spec.SetField(obproxygroup.FieldClients, field.TypeJSON, value)
+		_node.Clients = value
+	}
+	if value, ok := opgc.mutation.Users(); ok {
+		_spec.SetField(obproxygroup.FieldUsers, field.TypeJSON, value)
+		_node.Users = value
+	}
+	if value, ok := opgc.mutation.MetaObCluster(); ok {
+		_spec.SetField(obproxygroup.FieldMetaObCluster, field.TypeString, value)
+		_node.MetaObCluster = value
+	}
+	if value, ok := opgc.mutation.MetaDatabase(); ok {
+		_spec.SetField(obproxygroup.FieldMetaDatabase, field.TypeString, value)
+		_node.MetaDatabase = value
+	}
+	if value, ok := opgc.mutation.MetaUser(); ok {
+		_spec.SetField(obproxygroup.FieldMetaUser, field.TypeString, value)
+		_node.MetaUser = value
+	}
+	if value, ok := opgc.mutation.MetaEncryptedPassword(); ok {
+		_spec.SetField(obproxygroup.FieldMetaEncryptedPassword, field.TypeString, value)
+		_node.MetaEncryptedPassword = value
+	}
+	if value, ok := opgc.mutation.URLTemplateV1(); ok {
+		_spec.SetField(obproxygroup.FieldURLTemplateV1, field.TypeString, value)
+		_node.URLTemplateV1 = value
+	}
+	if value, ok := opgc.mutation.URLTemplateV2(); ok {
+		_spec.SetField(obproxygroup.FieldURLTemplateV2, field.TypeString, value)
+		_node.URLTemplateV2 = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ObProxyGroup.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ObProxyGroupUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (opgc *ObProxyGroupCreate) OnConflict(opts ...sql.ConflictOption) *ObProxyGroupUpsertOne {
+	opgc.conflict = opts
+	return &ObProxyGroupUpsertOne{
+		create: opgc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ObProxyGroup.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (opgc *ObProxyGroupCreate) OnConflictColumns(columns ...string) *ObProxyGroupUpsertOne {
+	opgc.conflict = append(opgc.conflict, sql.ConflictColumns(columns...))
+	return &ObProxyGroupUpsertOne{
+		create: opgc,
+	}
+}
+
+type (
+	// ObProxyGroupUpsertOne is the builder for "upsert"-ing
+	//  one ObProxyGroup node.
+	ObProxyGroupUpsertOne struct {
+		create *ObProxyGroupCreate
+	}
+
+	// ObProxyGroupUpsert is the "OnConflict" setter.
+	ObProxyGroupUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateTime sets the "create_time" field.
+func (u *ObProxyGroupUpsert) SetCreateTime(v time.Time) *ObProxyGroupUpsert {
+	u.Set(obproxygroup.FieldCreateTime, v)
+	return u
+}
+
+// UpdateCreateTime sets the "create_time" field to the value that was provided on create.
+func (u *ObProxyGroupUpsert) UpdateCreateTime() *ObProxyGroupUpsert {
+	u.SetExcluded(obproxygroup.FieldCreateTime)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ObProxyGroupUpsert) SetUpdateTime(v time.Time) *ObProxyGroupUpsert {
+	u.Set(obproxygroup.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ObProxyGroupUpsert) UpdateUpdateTime() *ObProxyGroupUpsert {
+	u.SetExcluded(obproxygroup.FieldUpdateTime)
+	return u
+}
+
+// SetNamespace sets the "namespace" field.
+func (u *ObProxyGroupUpsert) SetNamespace(v string) *ObProxyGroupUpsert {
+	u.Set(obproxygroup.FieldNamespace, v)
+	return u
+}
+
+// UpdateNamespace sets the "namespace" field to the value that was provided on create.
+func (u *ObProxyGroupUpsert) UpdateNamespace() *ObProxyGroupUpsert {
+	u.SetExcluded(obproxygroup.FieldNamespace)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ObProxyGroupUpsert) SetName(v string) *ObProxyGroupUpsert {
+	u.Set(obproxygroup.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ObProxyGroupUpsert) UpdateName() *ObProxyGroupUpsert {
+	u.SetExcluded(obproxygroup.FieldName)
+	return u
+}
+
+// SetObClusters sets the "ob_clusters" field.
+func (u *ObProxyGroupUpsert) SetObClusters(v []string) *ObProxyGroupUpsert {
+	u.Set(obproxygroup.FieldObClusters, v)
+	return u
+}
+
+// UpdateObClusters sets the "ob_clusters" field to the value that was provided on create.
+func (u *ObProxyGroupUpsert) UpdateObClusters() *ObProxyGroupUpsert {
+	u.SetExcluded(obproxygroup.FieldObClusters)
+	return u
+}
+
+// ClearObClusters clears the value of the "ob_clusters" field.
+func (u *ObProxyGroupUpsert) ClearObClusters() *ObProxyGroupUpsert {
+	u.SetNull(obproxygroup.FieldObClusters)
+	return u
+}
+
+// SetClients sets the "clients" field.
+func (u *ObProxyGroupUpsert) SetClients(v []string) *ObProxyGroupUpsert {
+	u.Set(obproxygroup.FieldClients, v)
+	return u
+}
+
+// UpdateClients sets the "clients" field to the value that was provided on create.
+func (u *ObProxyGroupUpsert) UpdateClients() *ObProxyGroupUpsert {
+	u.SetExcluded(obproxygroup.FieldClients)
+	return u
+}
+
+// ClearClients clears the value of the "clients" field.
+func (u *ObProxyGroupUpsert) ClearClients() *ObProxyGroupUpsert {
+	u.SetNull(obproxygroup.FieldClients)
+	return u
+}
+
+// SetUsers sets the "users" field.
+func (u *ObProxyGroupUpsert) SetUsers(v []string) *ObProxyGroupUpsert {
+	u.Set(obproxygroup.FieldUsers, v)
+	return u
+}
+
+// UpdateUsers sets the "users" field to the value that was provided on create.
+func (u *ObProxyGroupUpsert) UpdateUsers() *ObProxyGroupUpsert {
+	u.SetExcluded(obproxygroup.FieldUsers)
+	return u
+}
+
+// ClearUsers clears the value of the "users" field.
+func (u *ObProxyGroupUpsert) ClearUsers() *ObProxyGroupUpsert {
+	u.SetNull(obproxygroup.FieldUsers)
+	return u
+}
+
+// SetMetaObCluster sets the "meta_ob_cluster" field.
+func (u *ObProxyGroupUpsert) SetMetaObCluster(v string) *ObProxyGroupUpsert {
+	u.Set(obproxygroup.FieldMetaObCluster, v)
+	return u
+}
+
+// UpdateMetaObCluster sets the "meta_ob_cluster" field to the value that was provided on create.
+func (u *ObProxyGroupUpsert) UpdateMetaObCluster() *ObProxyGroupUpsert {
+	u.SetExcluded(obproxygroup.FieldMetaObCluster)
+	return u
+}
+
+// SetMetaDatabase sets the "meta_database" field.
+func (u *ObProxyGroupUpsert) SetMetaDatabase(v string) *ObProxyGroupUpsert {
+	u.Set(obproxygroup.FieldMetaDatabase, v)
+	return u
+}
+
+// UpdateMetaDatabase sets the "meta_database" field to the value that was provided on create.
+func (u *ObProxyGroupUpsert) UpdateMetaDatabase() *ObProxyGroupUpsert {
+	u.SetExcluded(obproxygroup.FieldMetaDatabase)
+	return u
+}
+
+// SetMetaUser sets the "meta_user" field.
+func (u *ObProxyGroupUpsert) SetMetaUser(v string) *ObProxyGroupUpsert {
+	u.Set(obproxygroup.FieldMetaUser, v)
+	return u
+}
+
+// UpdateMetaUser sets the "meta_user" field to the value that was provided on create.
+func (u *ObProxyGroupUpsert) UpdateMetaUser() *ObProxyGroupUpsert {
+	u.SetExcluded(obproxygroup.FieldMetaUser)
+	return u
+}
+
+// SetMetaEncryptedPassword sets the "meta_encrypted_password" field.
+func (u *ObProxyGroupUpsert) SetMetaEncryptedPassword(v string) *ObProxyGroupUpsert {
+	u.Set(obproxygroup.FieldMetaEncryptedPassword, v)
+	return u
+}
+
+// UpdateMetaEncryptedPassword sets the "meta_encrypted_password" field to the value that was provided on create.
+func (u *ObProxyGroupUpsert) UpdateMetaEncryptedPassword() *ObProxyGroupUpsert {
+	u.SetExcluded(obproxygroup.FieldMetaEncryptedPassword)
+	return u
+}
+
+// SetURLTemplateV1 sets the "url_template_v1" field.
+func (u *ObProxyGroupUpsert) SetURLTemplateV1(v string) *ObProxyGroupUpsert {
+	u.Set(obproxygroup.FieldURLTemplateV1, v)
+	return u
+}
+
+// UpdateURLTemplateV1 sets the "url_template_v1" field to the value that was provided on create.
+func (u *ObProxyGroupUpsert) UpdateURLTemplateV1() *ObProxyGroupUpsert {
+	u.SetExcluded(obproxygroup.FieldURLTemplateV1)
+	return u
+}
+
+// SetURLTemplateV2 sets the "url_template_v2" field.
+func (u *ObProxyGroupUpsert) SetURLTemplateV2(v string) *ObProxyGroupUpsert {
+	u.Set(obproxygroup.FieldURLTemplateV2, v)
+	return u
+}
+
+// UpdateURLTemplateV2 sets the "url_template_v2" field to the value that was provided on create.
+func (u *ObProxyGroupUpsert) UpdateURLTemplateV2() *ObProxyGroupUpsert {
+	u.SetExcluded(obproxygroup.FieldURLTemplateV2)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ObProxyGroup.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ObProxyGroupUpsertOne) UpdateNewValues() *ObProxyGroupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ObProxyGroup.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ObProxyGroupUpsertOne) Ignore() *ObProxyGroupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ObProxyGroupUpsertOne) DoNothing() *ObProxyGroupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ObProxyGroupCreate.OnConflict
+// documentation for more info.
+func (u *ObProxyGroupUpsertOne) Update(set func(*ObProxyGroupUpsert)) *ObProxyGroupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ObProxyGroupUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateTime sets the "create_time" field.
+func (u *ObProxyGroupUpsertOne) SetCreateTime(v time.Time) *ObProxyGroupUpsertOne {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.SetCreateTime(v)
+	})
+}
+
+// UpdateCreateTime sets the "create_time" field to the value that was provided on create.
+func (u *ObProxyGroupUpsertOne) UpdateCreateTime() *ObProxyGroupUpsertOne {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.UpdateCreateTime()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ObProxyGroupUpsertOne) SetUpdateTime(v time.Time) *ObProxyGroupUpsertOne {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ObProxyGroupUpsertOne) UpdateUpdateTime() *ObProxyGroupUpsertOne {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetNamespace sets the "namespace" field.
+func (u *ObProxyGroupUpsertOne) SetNamespace(v string) *ObProxyGroupUpsertOne {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.SetNamespace(v)
+	})
+}
+
+// UpdateNamespace sets the "namespace" field to the value that was provided on create.
+func (u *ObProxyGroupUpsertOne) UpdateNamespace() *ObProxyGroupUpsertOne {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.UpdateNamespace()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ObProxyGroupUpsertOne) SetName(v string) *ObProxyGroupUpsertOne {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ObProxyGroupUpsertOne) UpdateName() *ObProxyGroupUpsertOne {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetObClusters sets the "ob_clusters" field.
+func (u *ObProxyGroupUpsertOne) SetObClusters(v []string) *ObProxyGroupUpsertOne {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.SetObClusters(v)
+	})
+}
+
+// UpdateObClusters sets the "ob_clusters" field to the value that was provided on create.
+func (u *ObProxyGroupUpsertOne) UpdateObClusters() *ObProxyGroupUpsertOne {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.UpdateObClusters()
+	})
+}
+
+// ClearObClusters clears the value of the "ob_clusters" field.
+func (u *ObProxyGroupUpsertOne) ClearObClusters() *ObProxyGroupUpsertOne {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.ClearObClusters()
+	})
+}
+
+// SetClients sets the "clients" field.
+func (u *ObProxyGroupUpsertOne) SetClients(v []string) *ObProxyGroupUpsertOne {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.SetClients(v)
+	})
+}
+
+// UpdateClients sets the "clients" field to the value that was provided on create.
+func (u *ObProxyGroupUpsertOne) UpdateClients() *ObProxyGroupUpsertOne {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.UpdateClients()
+	})
+}
+
+// ClearClients clears the value of the "clients" field.
+func (u *ObProxyGroupUpsertOne) ClearClients() *ObProxyGroupUpsertOne {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.ClearClients()
+	})
+}
+
+// SetUsers sets the "users" field.
+func (u *ObProxyGroupUpsertOne) SetUsers(v []string) *ObProxyGroupUpsertOne {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.SetUsers(v)
+	})
+}
+
+// UpdateUsers sets the "users" field to the value that was provided on create.
+func (u *ObProxyGroupUpsertOne) UpdateUsers() *ObProxyGroupUpsertOne {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.UpdateUsers()
+	})
+}
+
+// ClearUsers clears the value of the "users" field.
+func (u *ObProxyGroupUpsertOne) ClearUsers() *ObProxyGroupUpsertOne {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.ClearUsers()
+	})
+}
+
+// SetMetaObCluster sets the "meta_ob_cluster" field.
+func (u *ObProxyGroupUpsertOne) SetMetaObCluster(v string) *ObProxyGroupUpsertOne {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.SetMetaObCluster(v)
+	})
+}
+
+// UpdateMetaObCluster sets the "meta_ob_cluster" field to the value that was provided on create.
+func (u *ObProxyGroupUpsertOne) UpdateMetaObCluster() *ObProxyGroupUpsertOne {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.UpdateMetaObCluster()
+	})
+}
+
+// SetMetaDatabase sets the "meta_database" field.
+func (u *ObProxyGroupUpsertOne) SetMetaDatabase(v string) *ObProxyGroupUpsertOne {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.SetMetaDatabase(v)
+	})
+}
+
+// UpdateMetaDatabase sets the "meta_database" field to the value that was provided on create.
+func (u *ObProxyGroupUpsertOne) UpdateMetaDatabase() *ObProxyGroupUpsertOne {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.UpdateMetaDatabase()
+	})
+}
+
+// SetMetaUser sets the "meta_user" field.
+func (u *ObProxyGroupUpsertOne) SetMetaUser(v string) *ObProxyGroupUpsertOne {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.SetMetaUser(v)
+	})
+}
+
+// UpdateMetaUser sets the "meta_user" field to the value that was provided on create.
+func (u *ObProxyGroupUpsertOne) UpdateMetaUser() *ObProxyGroupUpsertOne {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.UpdateMetaUser()
+	})
+}
+
+// SetMetaEncryptedPassword sets the "meta_encrypted_password" field.
+func (u *ObProxyGroupUpsertOne) SetMetaEncryptedPassword(v string) *ObProxyGroupUpsertOne {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.SetMetaEncryptedPassword(v)
+	})
+}
+
+// UpdateMetaEncryptedPassword sets the "meta_encrypted_password" field to the value that was provided on create.
+func (u *ObProxyGroupUpsertOne) UpdateMetaEncryptedPassword() *ObProxyGroupUpsertOne {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.UpdateMetaEncryptedPassword()
+	})
+}
+
+// SetURLTemplateV1 sets the "url_template_v1" field.
+func (u *ObProxyGroupUpsertOne) SetURLTemplateV1(v string) *ObProxyGroupUpsertOne {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.SetURLTemplateV1(v)
+	})
+}
+
+// UpdateURLTemplateV1 sets the "url_template_v1" field to the value that was provided on create.
+func (u *ObProxyGroupUpsertOne) UpdateURLTemplateV1() *ObProxyGroupUpsertOne {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.UpdateURLTemplateV1()
+	})
+}
+
+// SetURLTemplateV2 sets the "url_template_v2" field.
+func (u *ObProxyGroupUpsertOne) SetURLTemplateV2(v string) *ObProxyGroupUpsertOne {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.SetURLTemplateV2(v)
+	})
+}
+
+// UpdateURLTemplateV2 sets the "url_template_v2" field to the value that was provided on create.
+func (u *ObProxyGroupUpsertOne) UpdateURLTemplateV2() *ObProxyGroupUpsertOne {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.UpdateURLTemplateV2()
+	})
+}
+
+// Exec executes the query.
+func (u *ObProxyGroupUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ObProxyGroupCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ObProxyGroupUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ObProxyGroupUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ObProxyGroupUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ObProxyGroupCreateBulk is the builder for creating many ObProxyGroup entities in bulk.
+type ObProxyGroupCreateBulk struct {
+	config
+	err      error
+	builders []*ObProxyGroupCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ObProxyGroup entities in the database.
+func (opgcb *ObProxyGroupCreateBulk) Save(ctx context.Context) ([]*ObProxyGroup, error) {
+	if opgcb.err != nil {
+		return nil, opgcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(opgcb.builders))
+	nodes := make([]*ObProxyGroup, len(opgcb.builders))
+	mutators := make([]Mutator, len(opgcb.builders))
+	for i := range opgcb.builders {
+		func(i int, root context.Context) {
+			builder := opgcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ObProxyGroupMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, opgcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = opgcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, opgcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, opgcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (opgcb *ObProxyGroupCreateBulk) SaveX(ctx context.Context) []*ObProxyGroup {
+	v, err := opgcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (opgcb *ObProxyGroupCreateBulk) Exec(ctx context.Context) error {
+	_, err := opgcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (opgcb *ObProxyGroupCreateBulk) ExecX(ctx context.Context) {
+	if err := opgcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ObProxyGroup.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ObProxyGroupUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (opgcb *ObProxyGroupCreateBulk) OnConflict(opts ...sql.ConflictOption) *ObProxyGroupUpsertBulk {
+	opgcb.conflict = opts
+	return &ObProxyGroupUpsertBulk{
+		create: opgcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ObProxyGroup.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (opgcb *ObProxyGroupCreateBulk) OnConflictColumns(columns ...string) *ObProxyGroupUpsertBulk {
+	opgcb.conflict = append(opgcb.conflict, sql.ConflictColumns(columns...))
+	return &ObProxyGroupUpsertBulk{
+		create: opgcb,
+	}
+}
+
+// ObProxyGroupUpsertBulk is the builder for "upsert"-ing
+// a bulk of ObProxyGroup nodes.
+type ObProxyGroupUpsertBulk struct {
+	create *ObProxyGroupCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ObProxyGroup.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ObProxyGroupUpsertBulk) UpdateNewValues() *ObProxyGroupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ObProxyGroup.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ObProxyGroupUpsertBulk) Ignore() *ObProxyGroupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ObProxyGroupUpsertBulk) DoNothing() *ObProxyGroupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ObProxyGroupCreateBulk.OnConflict
+// documentation for more info.
+func (u *ObProxyGroupUpsertBulk) Update(set func(*ObProxyGroupUpsert)) *ObProxyGroupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ObProxyGroupUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateTime sets the "create_time" field.
+func (u *ObProxyGroupUpsertBulk) SetCreateTime(v time.Time) *ObProxyGroupUpsertBulk {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.SetCreateTime(v)
+	})
+}
+
+// UpdateCreateTime sets the "create_time" field to the value that was provided on create.
+func (u *ObProxyGroupUpsertBulk) UpdateCreateTime() *ObProxyGroupUpsertBulk {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.UpdateCreateTime()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ObProxyGroupUpsertBulk) SetUpdateTime(v time.Time) *ObProxyGroupUpsertBulk {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ObProxyGroupUpsertBulk) UpdateUpdateTime() *ObProxyGroupUpsertBulk {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetNamespace sets the "namespace" field.
+func (u *ObProxyGroupUpsertBulk) SetNamespace(v string) *ObProxyGroupUpsertBulk {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.SetNamespace(v)
+	})
+}
+
+// UpdateNamespace sets the "namespace" field to the value that was provided on create.
+func (u *ObProxyGroupUpsertBulk) UpdateNamespace() *ObProxyGroupUpsertBulk {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.UpdateNamespace()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ObProxyGroupUpsertBulk) SetName(v string) *ObProxyGroupUpsertBulk {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ObProxyGroupUpsertBulk) UpdateName() *ObProxyGroupUpsertBulk {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetObClusters sets the "ob_clusters" field.
+func (u *ObProxyGroupUpsertBulk) SetObClusters(v []string) *ObProxyGroupUpsertBulk {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.SetObClusters(v)
+	})
+}
+
+// UpdateObClusters sets the "ob_clusters" field to the value that was provided on create.
+func (u *ObProxyGroupUpsertBulk) UpdateObClusters() *ObProxyGroupUpsertBulk {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.UpdateObClusters()
+	})
+}
+
+// ClearObClusters clears the value of the "ob_clusters" field.
+func (u *ObProxyGroupUpsertBulk) ClearObClusters() *ObProxyGroupUpsertBulk {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.ClearObClusters()
+	})
+}
+
+// SetClients sets the "clients" field.
+func (u *ObProxyGroupUpsertBulk) SetClients(v []string) *ObProxyGroupUpsertBulk {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.SetClients(v)
+	})
+}
+
+// UpdateClients sets the "clients" field to the value that was provided on create.
+func (u *ObProxyGroupUpsertBulk) UpdateClients() *ObProxyGroupUpsertBulk {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.UpdateClients()
+	})
+}
+
+// ClearClients clears the value of the "clients" field.
+func (u *ObProxyGroupUpsertBulk) ClearClients() *ObProxyGroupUpsertBulk {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.ClearClients()
+	})
+}
+
+// SetUsers sets the "users" field.
+func (u *ObProxyGroupUpsertBulk) SetUsers(v []string) *ObProxyGroupUpsertBulk {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.SetUsers(v)
+	})
+}
+
+// UpdateUsers sets the "users" field to the value that was provided on create.
+func (u *ObProxyGroupUpsertBulk) UpdateUsers() *ObProxyGroupUpsertBulk {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.UpdateUsers()
+	})
+}
+
+// ClearUsers clears the value of the "users" field.
+func (u *ObProxyGroupUpsertBulk) ClearUsers() *ObProxyGroupUpsertBulk {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.ClearUsers()
+	})
+}
+
+// SetMetaObCluster sets the "meta_ob_cluster" field.
+func (u *ObProxyGroupUpsertBulk) SetMetaObCluster(v string) *ObProxyGroupUpsertBulk {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.SetMetaObCluster(v)
+	})
+}
+
+// UpdateMetaObCluster sets the "meta_ob_cluster" field to the value that was provided on create.
+func (u *ObProxyGroupUpsertBulk) UpdateMetaObCluster() *ObProxyGroupUpsertBulk {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.UpdateMetaObCluster()
+	})
+}
+
+// SetMetaDatabase sets the "meta_database" field.
+func (u *ObProxyGroupUpsertBulk) SetMetaDatabase(v string) *ObProxyGroupUpsertBulk {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.SetMetaDatabase(v)
+	})
+}
+
+// UpdateMetaDatabase sets the "meta_database" field to the value that was provided on create.
+func (u *ObProxyGroupUpsertBulk) UpdateMetaDatabase() *ObProxyGroupUpsertBulk {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.UpdateMetaDatabase()
+	})
+}
+
+// SetMetaUser sets the "meta_user" field.
+func (u *ObProxyGroupUpsertBulk) SetMetaUser(v string) *ObProxyGroupUpsertBulk {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.SetMetaUser(v)
+	})
+}
+
+// UpdateMetaUser sets the "meta_user" field to the value that was provided on create.
+func (u *ObProxyGroupUpsertBulk) UpdateMetaUser() *ObProxyGroupUpsertBulk {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.UpdateMetaUser()
+	})
+}
+
+// SetMetaEncryptedPassword sets the "meta_encrypted_password" field.
+func (u *ObProxyGroupUpsertBulk) SetMetaEncryptedPassword(v string) *ObProxyGroupUpsertBulk {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.SetMetaEncryptedPassword(v)
+	})
+}
+
+// UpdateMetaEncryptedPassword sets the "meta_encrypted_password" field to the value that was provided on create.
+func (u *ObProxyGroupUpsertBulk) UpdateMetaEncryptedPassword() *ObProxyGroupUpsertBulk {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.UpdateMetaEncryptedPassword()
+	})
+}
+
+// SetURLTemplateV1 sets the "url_template_v1" field.
+func (u *ObProxyGroupUpsertBulk) SetURLTemplateV1(v string) *ObProxyGroupUpsertBulk {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.SetURLTemplateV1(v)
+	})
+}
+
+// UpdateURLTemplateV1 sets the "url_template_v1" field to the value that was provided on create.
+func (u *ObProxyGroupUpsertBulk) UpdateURLTemplateV1() *ObProxyGroupUpsertBulk {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.UpdateURLTemplateV1()
+	})
+}
+
+// SetURLTemplateV2 sets the "url_template_v2" field.
+func (u *ObProxyGroupUpsertBulk) SetURLTemplateV2(v string) *ObProxyGroupUpsertBulk {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.SetURLTemplateV2(v)
+	})
+}
+
+// UpdateURLTemplateV2 sets the "url_template_v2" field to the value that was provided on create.
+func (u *ObProxyGroupUpsertBulk) UpdateURLTemplateV2() *ObProxyGroupUpsertBulk {
+	return u.Update(func(s *ObProxyGroupUpsert) {
+		s.UpdateURLTemplateV2()
+	})
+}
+
+// Exec executes the query.
+func (u *ObProxyGroupUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ObProxyGroupCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ObProxyGroupCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ObProxyGroupUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
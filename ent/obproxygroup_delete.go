@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/oceanbase/configserver/ent/obproxygroup"
+	"github.com/oceanbase/configserver/ent/predicate"
+)
+
+// ObProxyGroupDelete is the builder for deleting a ObProxyGroup entity.
+type ObProxyGroupDelete struct {
+	config
+	hooks    []Hook
+	mutation *ObProxyGroupMutation
+}
+
+// Where appends a list predicates to the ObProxyGroupDelete builder.
+func (opgd *ObProxyGroupDelete) Where(ps ...predicate.ObProxyGroup) *ObProxyGroupDelete {
+	opgd.mutation.Where(ps...)
+	return opgd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (opgd *ObProxyGroupDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, opgd.sqlExec, opgd.mutation, opgd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (opgd *ObProxyGroupDelete) ExecX(ctx context.Context) int {
+	n, err := opgd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (opgd *ObProxyGroupDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(obproxygroup.Table, sqlgraph.NewFieldSpec(obproxygroup.FieldID, field.TypeInt))
+	if ps := opgd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, opgd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	opgd.mutation.done = true
+	return affected, err
+}
+
+// ObProxyGroupDeleteOne is the builder for deleting a single ObProxyGroup entity.
+type ObProxyGroupDeleteOne struct {
+	opgd *ObProxyGroupDelete
+}
+
+// Where appends a list predicates to the ObProxyGroupDelete builder.
+func (opgdo *ObProxyGroupDeleteOne) Where(ps ...predicate.ObProxyGroup) *ObProxyGroupDeleteOne {
+	opgdo.opgd.mutation.Where(ps...)
+	return opgdo
+}
+
+// Exec executes the deletion query.
+func (opgdo *ObProxyGroupDeleteOne) Exec(ctx context.Context) error {
+	n, err := opgdo.opgd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{obproxygroup.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (opgdo *ObProxyGroupDeleteOne) ExecX(ctx context.Context) {
+	if err := opgdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/oceanbase/configserver/ent/obproxygroup"
+	"github.com/oceanbase/configserver/ent/predicate"
+)
+
+// ObProxyGroupQuery is the builder for querying ObProxyGroup entities.
+type ObProxyGroupQuery struct {
+	config
+	ctx        *QueryContext
+	order      []obproxygroup.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ObProxyGroup
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ObProxyGroupQuery builder.
+func (opgq *ObProxyGroupQuery) Where(ps ...predicate.ObProxyGroup) *ObProxyGroupQuery {
+	opgq.predicates = append(opgq.predicates, ps...)
+	return opgq
+}
+
+// Limit the number of records to be returned by this query.
+func (opgq *ObProxyGroupQuery) Limit(limit int) *ObProxyGroupQuery {
+	opgq.ctx.Limit = &limit
+	return opgq
+}
+
+// Offset to start from.
+func (opgq *ObProxyGroupQuery) Offset(offset int) *ObProxyGroupQuery {
+	opgq.ctx.Offset = &offset
+	return opgq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (opgq *ObProxyGroupQuery) Unique(unique bool) *ObProxyGroupQuery {
+	opgq.ctx.Unique = &unique
+	return opgq
+}
+
+// Order specifies how the records should be ordered.
+func (opgq *ObProxyGroupQuery) Order(o ...obproxygroup.OrderOption) *ObProxyGroupQuery {
+	opgq.order = append(opgq.order, o...)
+	return opgq
+}
+
+// First returns the first ObProxyGroup entity from the query.
+// Returns a *NotFoundError when no ObProxyGroup was found.
+func (opgq *ObProxyGroupQuery) First(ctx context.Context) (*ObProxyGroup, error) {
+	nodes, err := opgq.Limit(1).All(setContextOp(ctx, opgq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{obproxygroup.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (opgq *ObProxyGroupQuery) FirstX(ctx context.Context) *ObProxyGroup {
+	node, err := opgq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ObProxyGroup ID from the query.
+// Returns a *NotFoundError when no ObProxyGroup ID was found.
+func (opgq *ObProxyGroupQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = opgq.Limit(1).IDs(setContextOp(ctx, opgq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{obproxygroup.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (opgq *ObProxyGroupQuery) FirstIDX(ctx context.Context) int {
+	id, err := opgq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ObProxyGroup entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ObProxyGroup entity is found.
+// Returns a *NotFoundError when no ObProxyGroup entities are found.
+func (opgq *ObProxyGroupQuery) Only(ctx context.Context) (*ObProxyGroup, error) {
+	nodes, err := opgq.Limit(2).All(setContextOp(ctx, opgq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{obproxygroup.Label}
+	default:
+		return nil, &NotSingularError{obproxygroup.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (opgq *ObProxyGroupQuery) OnlyX(ctx context.Context) *ObProxyGroup {
+	node, err := opgq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ObProxyGroup ID in the query.
+// Returns a *NotSingularError when more than one ObProxyGroup ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (opgq *ObProxyGroupQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = opgq.Limit(2).IDs(setContextOp(ctx, opgq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{obproxygroup.Label}
+	default:
+		err = &NotSingularError{obproxygroup.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (opgq *ObProxyGroupQuery) OnlyIDX(ctx context.Context) int {
+	id, err := opgq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ObProxyGroups.
+func (opgq *ObProxyGroupQuery) All(ctx context.Context) ([]*ObProxyGroup, error) {
+	ctx = setContextOp(ctx, opgq.ctx, ent.OpQueryAll)
+	if err := opgq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ObProxyGroup, *ObProxyGroupQuery]()
+	return withInterceptors[[]*ObProxyGroup](ctx, opgq, qr, opgq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (opgq *ObProxyGroupQuery) AllX(ctx context.Context) []*ObProxyGroup {
+	nodes, err := opgq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ObProxyGroup IDs.
+func (opgq *ObProxyGroupQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if opgq.ctx.Unique == nil && opgq.path != nil {
+		opgq.Unique(true)
+	}
+	ctx = setContextOp(ctx, opgq.ctx, ent.OpQueryIDs)
+	if err = opgq.Select(obproxygroup.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (opgq *ObProxyGroupQuery) IDsX(ctx context.Context) []int {
+	ids, err := opgq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (opgq *ObProxyGroupQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, opgq.ctx, ent.OpQueryCount)
+	if err := opgq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, opgq, querierCount[*ObProxyGroupQuery](), opgq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (opgq *ObProxyGroupQuery) CountX(ctx context.Context) int {
+	count, err := opgq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (opgq *ObProxyGroupQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, opgq.ctx, ent.OpQueryExist)
+	switch _, err := opgq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (opgq *ObProxyGroupQuery) ExistX(ctx context.Context) bool {
+	exist, err := opgq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ObProxyGroupQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (opgq *ObProxyGroupQuery) Clone() *ObProxyGroupQuery {
+	if opgq == nil {
+		return nil
+	}
+	return &ObProxyGroupQuery{
+		config:     opgq.config,
+		ctx:        opgq.ctx.Clone(),
+		order:      append([]obproxygroup.OrderOption{}, opgq.order...),
+		inters:     append([]Interceptor{}, opgq.inters...),
+		predicates: append([]predicate.ObProxyGroup{}, opgq.predicates...),
+		// clone intermediate query.
+		sql:  opgq.sql.Clone(),
+		path: opgq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ObProxyGroup.Query().
+//		GroupBy(obproxygroup.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (opgq *ObProxyGroupQuery) GroupBy(field string, fields ...string) *ObProxyGroupGroupBy {
+	opgq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ObProxyGroupGroupBy{build: opgq}
+	grbuild.flds = &opgq.ctx.Fields
+	grbuild.label = obproxygroup.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.ObProxyGroup.Query().
+//		Select(obproxygroup.FieldCreateTime).
+//		Scan(ctx, &v)
+func (opgq *ObProxyGroupQuery) Select(fields ...string) *ObProxyGroupSelect {
+	opgq.ctx.Fields = append(opgq.ctx.Fields, fields...)
+	sbuild := &ObProxyGroupSelect{ObProxyGroupQuery: opgq}
+	sbuild.label = obproxygroup.Label
+	sbuild.flds, sbuild.scan = &opgq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ObProxyGroupSelect configured with the given aggregations.
+func (opgq *ObProxyGroupQuery) Aggregate(fns ...AggregateFunc) *ObProxyGroupSelect {
+	return opgq.Select().Aggregate(fns...)
+}
+
+func (opgq *ObProxyGroupQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range opgq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, opgq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range opgq.ctx.Fields {
+		if !obproxygroup.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if opgq.path != nil {
+		prev, err := opgq.path(ctx)
+		if err != nil {
+			return err
+		}
+		opgq.sql = prev
+	}
+	return nil
+}
+
+func (opgq *ObProxyGroupQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ObProxyGroup, error) {
+	var (
+		nodes = []*ObProxyGroup{}
+		_spec = opgq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ObProxyGroup).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ObProxyGroup{config: opgq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, opgq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (opgq *ObProxyGroupQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := opgq.querySpec()
+	_spec.Node.Columns = opgq.ctx.Fields
+	if len(opgq.ctx.Fields) > 0 {
+		_spec.Unique = opgq.ctx.Unique != nil && *opgq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, opgq.driver, _spec)
+}
+
+func (opgq *ObProxyGroupQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(obproxygroup.Table, obproxygroup.Columns, sqlgraph.NewFieldSpec(obproxygroup.FieldID, field.TypeInt))
+	_spec.From = opgq.sql
+	if unique := opgq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if opgq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := opgq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, obproxygroup.FieldID)
+		for i := range fields {
+			if fields[i] != obproxygroup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := opgq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := opgq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := opgq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := opgq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (opgq *ObProxyGroupQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(opgq.driver.Dialect())
+	t1 := builder.Table(obproxygroup.Table)
+	columns := opgq.ctx.Fields
+	if len(columns) == 0 {
+		columns = obproxygroup.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if opgq.sql != nil {
+		selector = opgq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if opgq.ctx.Unique != nil && *opgq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range opgq.predicates {
+		p(selector)
+	}
+	for _, p := range opgq.order {
+		p(selector)
+	}
+	if offset := opgq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := opgq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ObProxyGroupGroupBy is the group-by builder for ObProxyGroup entities.
+type ObProxyGroupGroupBy struct {
+	selector
+	build *ObProxyGroupQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (opggb *ObProxyGroupGroupBy) Aggregate(fns ...AggregateFunc) *ObProxyGroupGroupBy {
+	opggb.fns = append(opggb.fns, fns...)
+	return opggb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (opggb *ObProxyGroupGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, opggb.build.ctx, ent.OpQueryGroupBy)
+	if err := opggb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ObProxyGroupQuery, *ObProxyGroupGroupBy](ctx, opggb.build, opggb, opggb.build.inters, v)
+}
+
+func (opggb *ObProxyGroupGroupBy) sqlScan(ctx context.Context, root *ObProxyGroupQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(opggb.fns))
+	for _, fn := range opggb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*opggb.flds)+len(opggb.fns))
+		for _, f := range *opggb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*opggb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := opggb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ObProxyGroupSelect is the builder for selecting fields of ObProxyGroup entities.
+type ObProxyGroupSelect struct {
+	*ObProxyGroupQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (opgs *ObProxyGroupSelect) Aggregate(fns ...AggregateFunc) *ObProxyGroupSelect {
+	opgs.fns = append(opgs.fns, fns...)
+	return opgs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (opgs *ObProxyGroupSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, opgs.ctx, ent.OpQuerySelect)
+	if err := opgs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ObProxyGroupQuery, *ObProxyGroupSelect](ctx, opgs.ObProxyGroupQuery, opgs, opgs.inters, v)
+}
+
+func (opgs *ObProxyGroupSelect) sqlScan(ctx context.Context, root *ObProxyGroupQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(opgs.fns))
+	for _, fn := range opgs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*opgs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := opgs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,693 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/oceanbase/configserver/ent/obproxygroup"
+	"github.com/oceanbase/configserver/ent/predicate"
+)
+
+// ObProxyGroupUpdate is the builder for updating ObProxyGroup entities.
+type ObProxyGroupUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ObProxyGroupMutation
+}
+
+// Where appends a list predicates to the ObProxyGroupUpdate builder.
+func (opgu *ObProxyGroupUpdate) Where(ps ...predicate.ObProxyGroup) *ObProxyGroupUpdate {
+	opgu.mutation.Where(ps...)
+	return opgu
+}
+
+// SetCreateTime sets the "create_time" field.
+func (opgu *ObProxyGroupUpdate) SetCreateTime(t time.Time) *ObProxyGroupUpdate {
+	opgu.mutation.SetCreateTime(t)
+	return opgu
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (opgu *ObProxyGroupUpdate) SetNillableCreateTime(t *time.Time) *ObProxyGroupUpdate {
+	if t != nil {
+		opgu.SetCreateTime(*t)
+	}
+	return opgu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (opgu *ObProxyGroupUpdate) SetUpdateTime(t time.Time) *ObProxyGroupUpdate {
+	opgu.mutation.SetUpdateTime(t)
+	return opgu
+}
+
+// SetNamespace sets the "namespace" field.
+func (opgu *ObProxyGroupUpdate) SetNamespace(s string) *ObProxyGroupUpdate {
+	opgu.mutation.SetNamespace(s)
+	return opgu
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (opgu *ObProxyGroupUpdate) SetNillableNamespace(s *string) *ObProxyGroupUpdate {
+	if s != nil {
+		opgu.SetNamespace(*s)
+	}
+	return opgu
+}
+
+// SetName sets the "name" field.
+func (opgu *ObProxyGroupUpdate) SetName(s string) *ObProxyGroupUpdate {
+	opgu.mutation.SetName(s)
+	return opgu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (opgu *ObProxyGroupUpdate) SetNillableName(s *string) *ObProxyGroupUpdate {
+	if s != nil {
+		opgu.SetName(*s)
+	}
+	return opgu
+}
+
+// SetObClusters sets the "ob_clusters" field.
+func (opgu *ObProxyGroupUpdate) SetObClusters(s []string) *ObProxyGroupUpdate {
+	opgu.mutation.SetObClusters(s)
+	return opgu
+}
+
+// AppendObClusters appends s to the "ob_clusters" field.
+func (opgu *ObProxyGroupUpdate) AppendObClusters(s []string) *ObProxyGroupUpdate {
+	opgu.mutation.AppendObClusters(s)
+	return opgu
+}
+
+// ClearObClusters clears the value of the "ob_clusters" field.
+func (opgu *ObProxyGroupUpdate) ClearObClusters() *ObProxyGroupUpdate {
+	opgu.mutation.ClearObClusters()
+	return opgu
+}
+
+// SetClients sets the "clients" field.
+func (opgu *ObProxyGroupUpdate) SetClients(s []string) *ObProxyGroupUpdate {
+	opgu.mutation.SetClients(s)
+	return opgu
+}
+
+// AppendClients appends s to the "clients" field.
+func (opgu *ObProxyGroupUpdate) AppendClients(s []string) *ObProxyGroupUpdate {
+	opgu.mutation.AppendClients(s)
+	return opgu
+}
+
+// ClearClients clears the value of the "clients" field.
+func (opgu *ObProxyGroupUpdate) ClearClients() *ObProxyGroupUpdate {
+	opgu.mutation.ClearClients()
+	return opgu
+}
+
+// SetUsers sets the "users" field.
+func (opgu *ObProxyGroupUpdate) SetUsers(s []string) *ObProxyGroupUpdate {
+	opgu.mutation.SetUsers(s)
+	return opgu
+}
+
+// AppendUsers appends s to the "users" field.
+func (opgu *ObProxyGroupUpdate) AppendUsers(s []string) *ObProxyGroupUpdate {
+	opgu.mutation.AppendUsers(s)
+	return opgu
+}
+
+// ClearUsers clears the value of the "users" field.
+func (opgu *ObProxyGroupUpdate) ClearUsers() *ObProxyGroupUpdate {
+	opgu.mutation.ClearUsers()
+	return opgu
+}
+
+// SetMetaObCluster sets the "meta_ob_cluster" field.
+func (opgu *ObProxyGroupUpdate) SetMetaObCluster(s string) *ObProxyGroupUpdate {
+	opgu.mutation.SetMetaObCluster(s)
+	return opgu
+}
+
+// SetNillableMetaObCluster sets the "meta_ob_cluster" field if the given value is not nil.
+func (opgu *ObProxyGroupUpdate) SetNillableMetaObCluster(s *string) *ObProxyGroupUpdate {
+	if s != nil {
+		opgu.SetMetaObCluster(*s)
+	}
+	return opgu
+}
+
+// SetMetaDatabase sets the "meta_database" field.
+func (opgu *ObProxyGroupUpdate) SetMetaDatabase(s string) *ObProxyGroupUpdate {
+	opgu.mutation.SetMetaDatabase(s)
+	return opgu
+}
+
+// SetNillableMetaDatabase sets the "meta_database" field if the given value is not nil.
+func (opgu *ObProxyGroupUpdate) SetNillableMetaDatabase(s *string) *ObProxyGroupUpdate {
+	if s != nil {
+		opgu.SetMetaDatabase(*s)
+	}
+	return opgu
+}
+
+// SetMetaUser sets the "meta_user" field.
+func (opgu *ObProxyGroupUpdate) SetMetaUser(s string) *ObProxyGroupUpdate {
+	opgu.mutation.SetMetaUser(s)
+	return opgu
+}
+
+// SetNillableMetaUser sets the "meta_user" field if the given value is not nil.
+func (opgu *ObProxyGroupUpdate) SetNillableMetaUser(s *string) *ObProxyGroupUpdate {
+	if s != nil {
+		opgu.SetMetaUser(*s)
+	}
+	return opgu
+}
+
+// SetMetaEncryptedPassword sets the "meta_encrypted_password" field.
+func (opgu *ObProxyGroupUpdate) SetMetaEncryptedPassword(s string) *ObProxyGroupUpdate {
+	opgu.mutation.SetMetaEncryptedPassword(s)
+	return opgu
+}
+
+// SetNillableMetaEncryptedPassword sets the "meta_encrypted_password" field if the given value is not nil.
+func (opgu *ObProxyGroupUpdate) SetNillableMetaEncryptedPassword(s *string) *ObProxyGroupUpdate {
+	if s != nil {
+		opgu.SetMetaEncryptedPassword(*s)
+	}
+	return opgu
+}
+
+// SetURLTemplateV1 sets the "url_template_v1" field.
+func (opgu *ObProxyGroupUpdate) SetURLTemplateV1(s string) *ObProxyGroupUpdate {
+	opgu.mutation.SetURLTemplateV1(s)
+	return opgu
+}
+
+// SetNillableURLTemplateV1 sets the "url_template_v1" field if the given value is not nil.
+func (opgu *ObProxyGroupUpdate) SetNillableURLTemplateV1(s *string) *ObProxyGroupUpdate {
+	if s != nil {
+		opgu.SetURLTemplateV1(*s)
+	}
+	return opgu
+}
+
+// SetURLTemplateV2 sets the "url_template_v2" field.
+func (opgu *ObProxyGroupUpdate) SetURLTemplateV2(s string) *ObProxyGroupUpdate {
+	opgu.mutation.SetURLTemplateV2(s)
+	return opgu
+}
+
+// SetNillableURLTemplateV2 sets the "url_template_v2" field if the given value is not nil.
+func (opgu *ObProxyGroupUpdate) SetNillableURLTemplateV2(s *string) *ObProxyGroupUpdate {
+	if s != nil {
+		opgu.SetURLTemplateV2(*s)
+	}
+	return opgu
+}
+
+// Mutation returns the ObProxyGroupMutation object of the builder.
+func (opgu *ObProxyGroupUpdate) Mutation() *ObProxyGroupMutation {
+	return opgu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (opgu *ObProxyGroupUpdate) Save(ctx context.Context) (int, error) {
+	opgu.defaults()
+	return withHooks(ctx, opgu.sqlSave, opgu.mutation, opgu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (opgu *ObProxyGroupUpdate) SaveX(ctx context.Context) int {
+	affected, err := opgu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (opgu *ObProxyGroupUpdate) Exec(ctx context.Context) error {
+	_, err := opgu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (opgu *ObProxyGroupUpdate) ExecX(ctx context.Context) {
+	if err := opgu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (opgu *ObProxyGroupUpdate) defaults() {
+	if _, ok := opgu.mutation.UpdateTime(); !ok {
+		v := obproxygroup.UpdateDefaultUpdateTime()
+		opgu.mutation.SetUpdateTime(v)
+	}
+}
+
+func (opgu *ObProxyGroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(obproxygroup.Table, obproxygroup.Columns, sqlgraph.NewFieldSpec(obproxygroup.FieldID, field.TypeInt))
+	if ps := opgu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := opgu.mutation.CreateTime(); ok {
+		_spec.SetField(obproxygroup.FieldCreateTime, field.TypeTime, value)
+	}
+	if value, ok := opgu.mutation.UpdateTime(); ok {
+		_spec.SetField(obproxygroup.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := opgu.mutation.Namespace(); ok {
+		_spec.SetField(obproxygroup.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := opgu.mutation.Name(); ok {
+		_spec.SetField(obproxygroup.FieldName, field.TypeString, value)
+	}
+	if value, ok := opgu.mutation.ObClusters(); ok {
+		_spec.SetField(obproxygroup.FieldObClusters, field.TypeJSON, value)
+	}
+	if value, ok := opgu.mutation.AppendedObClusters(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, obproxygroup.FieldObClusters, value)
+		})
+	}
+	if opgu.mutation.ObClustersCleared() {
+		_spec.ClearField(obproxygroup.FieldObClusters, field.TypeJSON)
+	}
+	if value, ok := opgu.mutation.Clients(); ok {
+		_spec.SetField(obproxygroup.FieldClients, field.TypeJSON, value)
+	}
+	if value, ok := opgu.mutation.AppendedClients(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, obproxygroup.FieldClients, value)
+		})
+	}
+	if opgu.mutation.ClientsCleared() {
+		_spec.ClearField(obproxygroup.FieldClients, field.TypeJSON)
+	}
+	if value, ok := opgu.mutation.Users(); ok {
+		_spec.SetField(obproxygroup.FieldUsers, field.TypeJSON, value)
+	}
+	if value, ok := opgu.mutation.AppendedUsers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, obproxygroup.FieldUsers, value)
+		})
+	}
+	if opgu.mutation.UsersCleared() {
+		_spec.ClearField(obproxygroup.FieldUsers, field.TypeJSON)
+	}
+	if value, ok := opgu.mutation.MetaObCluster(); ok {
+		_spec.SetField(obproxygroup.FieldMetaObCluster, field.TypeString, value)
+	}
+	if value, ok := opgu.mutation.MetaDatabase(); ok {
+		_spec.SetField(obproxygroup.FieldMetaDatabase, field.TypeString, value)
+	}
+	if value, ok := opgu.mutation.MetaUser(); ok {
+		_spec.SetField(obproxygroup.FieldMetaUser, field.TypeString, value)
+	}
+	if value, ok := opgu.mutation.MetaEncryptedPassword(); ok {
+		_spec.SetField(obproxygroup.FieldMetaEncryptedPassword, field.TypeString, value)
+	}
+	if value, ok := opgu.mutation.URLTemplateV1(); ok {
+		_spec.SetField(obproxygroup.FieldURLTemplateV1, field.TypeString, value)
+	}
+	if value, ok := opgu.mutation.URLTemplateV2(); ok {
+		_spec.SetField(obproxygroup.FieldURLTemplateV2, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, opgu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{obproxygroup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	opgu.mutation.done = true
+	return n, nil
+}
+
+// ObProxyGroupUpdateOne is the builder for updating a single ObProxyGroup entity.
+type ObProxyGroupUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ObProxyGroupMutation
+}
+
+// SetCreateTime sets the "create_time" field.
+func (opguo *ObProxyGroupUpdateOne) SetCreateTime(t time.Time) *ObProxyGroupUpdateOne {
+	opguo.mutation.SetCreateTime(t)
+	return opguo
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (opguo *ObProxyGroupUpdateOne) SetNillableCreateTime(t *time.Time) *ObProxyGroupUpdateOne {
+	if t != nil {
+		opguo.SetCreateTime(*t)
+	}
+	return opguo
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (opguo *ObProxyGroupUpdateOne) SetUpdateTime(t time.Time) *ObProxyGroupUpdateOne {
+	opguo.mutation.SetUpdateTime(t)
+	return opguo
+}
+
+// SetNamespace sets the "namespace" field.
+func (opguo *ObProxyGroupUpdateOne) SetNamespace(s string) *ObProxyGroupUpdateOne {
+	opguo.mutation.SetNamespace(s)
+	return opguo
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (opguo *ObProxyGroupUpdateOne) SetNillableNamespace(s *string) *ObProxyGroupUpdateOne {
+	if s != nil {
+		opguo.SetNamespace(*s)
+	}
+	return opguo
+}
+
+// SetName sets the "name" field.
+func (opguo *ObProxyGroupUpdateOne) SetName(s string) *ObProxyGroupUpdateOne {
+	opguo.mutation.SetName(s)
+	return opguo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (opguo *ObProxyGroupUpdateOne) SetNillableName(s *string) *ObProxyGroupUpdateOne {
+	if s != nil {
+		opguo.SetName(*s)
+	}
+	return opguo
+}
+
+// SetObClusters sets the "ob_clusters" field.
+func (opguo *ObProxyGroupUpdateOne) SetObClusters(s []string) *ObProxyGroupUpdateOne {
+	opguo.mutation.SetObClusters(s)
+	return opguo
+}
+
+// AppendObClusters appends s to the "ob_clusters" field.
+func (opguo *ObProxyGroupUpdateOne) AppendObClusters(s []string) *ObProxyGroupUpdateOne {
+	opguo.mutation.AppendObClusters(s)
+	return opguo
+}
+
+// ClearObClusters clears the value of the "ob_clusters" field.
+func (opguo *ObProxyGroupUpdateOne) ClearObClusters() *ObProxyGroupUpdateOne {
+	opguo.mutation.ClearObClusters()
+	return opguo
+}
+
+// SetClients sets the "clients" field.
+func (opguo *ObProxyGroupUpdateOne) SetClients(s []string) *ObProxyGroupUpdateOne {
+	opguo.mutation.SetClients(s)
+	return opguo
+}
+
+// AppendClients appends s to the "clients" field.
+func (opguo *ObProxyGroupUpdateOne) AppendClients(s []string) *ObProxyGroupUpdateOne {
+	opguo.mutation.AppendClients(s)
+	return opguo
+}
+
+// ClearClients clears the value of the "clients" field.
+func (opguo *ObProxyGroupUpdateOne) ClearClients() *ObProxyGroupUpdateOne {
+	opguo.mutation.ClearClients()
+	return opguo
+}
+
+// SetUsers sets the "users" field.
+func (opguo *ObProxyGroupUpdateOne) SetUsers(s []string) *ObProxyGroupUpdateOne {
+	opguo.mutation.SetUsers(s)
+	return opguo
+}
+
+// AppendUsers appends s to the "users" field.
+func (opguo *ObProxyGroupUpdateOne) AppendUsers(s []string) *ObProxyGroupUpdateOne {
+	opguo.mutation.AppendUsers(s)
+	return opguo
+}
+
+// ClearUsers clears the value of the "users" field.
+func (opguo *ObProxyGroupUpdateOne) ClearUsers() *ObProxyGroupUpdateOne {
+	opguo.mutation.ClearUsers()
+	return opguo
+}
+
+// SetMetaObCluster sets the "meta_ob_cluster" field.
+func (opguo *ObProxyGroupUpdateOne) SetMetaObCluster(s string) *ObProxyGroupUpdateOne {
+	opguo.mutation.SetMetaObCluster(s)
+	return opguo
+}
+
+// SetNillableMetaObCluster sets the "meta_ob_cluster" field if the given value is not nil.
+func (opguo *ObProxyGroupUpdateOne) SetNillableMetaObCluster(s *string) *ObProxyGroupUpdateOne {
+	if s != nil {
+		opguo.SetMetaObCluster(*s)
+	}
+	return opguo
+}
+
+// SetMetaDatabase sets the "meta_database" field.
+func (opguo *ObProxyGroupUpdateOne) SetMetaDatabase(s string) *ObProxyGroupUpdateOne {
+	opguo.mutation.SetMetaDatabase(s)
+	return opguo
+}
+
+// SetNillableMetaDatabase sets the "meta_database" field if the given value is not nil.
+func (opguo *ObProxyGroupUpdateOne) SetNillableMetaDatabase(s *string) *ObProxyGroupUpdateOne {
+	if s != nil {
+		opguo.SetMetaDatabase(*s)
+	}
+	return opguo
+}
+
+// SetMetaUser sets the "meta_user" field.
+func (opguo *ObProxyGroupUpdateOne) SetMetaUser(s string) *ObProxyGroupUpdateOne {
+	opguo.mutation.SetMetaUser(s)
+	return opguo
+}
+
+// SetNillableMetaUser sets the "meta_user" field if the given value is not nil.
+func (opguo *ObProxyGroupUpdateOne) SetNillableMetaUser(s *string) *ObProxyGroupUpdateOne {
+	if s != nil {
+		opguo.SetMetaUser(*s)
+	}
+	return opguo
+}
+
+// SetMetaEncryptedPassword sets the "meta_encrypted_password" field.
+func (opguo *ObProxyGroupUpdateOne) SetMetaEncryptedPassword(s string) *ObProxyGroupUpdateOne {
+	opguo.mutation.SetMetaEncryptedPassword(s)
+	return opguo
+}
+
+// SetNillableMetaEncryptedPassword sets the "meta_encrypted_password" field if the given value is not nil.
+func (opguo *ObProxyGroupUpdateOne) SetNillableMetaEncryptedPassword(s *string) *ObProxyGroupUpdateOne {
+	if s != nil {
+		opguo.SetMetaEncryptedPassword(*s)
+	}
+	return opguo
+}
+
+// SetURLTemplateV1 sets the "url_template_v1" field.
+func (opguo *ObProxyGroupUpdateOne) SetURLTemplateV1(s string) *ObProxyGroupUpdateOne {
+	opguo.mutation.SetURLTemplateV1(s)
+	return opguo
+}
+
+// SetNillableURLTemplateV1 sets the "url_template_v1" field if the given value is not nil.
+func (opguo *ObProxyGroupUpdateOne) SetNillableURLTemplateV1(s *string) *ObProxyGroupUpdateOne {
+	if s != nil {
+		opguo.SetURLTemplateV1(*s)
+	}
+	return opguo
+}
+
+// SetURLTemplateV2 sets the "url_template_v2" field.
+func (opguo *ObProxyGroupUpdateOne) SetURLTemplateV2(s string) *ObProxyGroupUpdateOne {
+	opguo.mutation.SetURLTemplateV2(s)
+	return opguo
+}
+
+// SetNillableURLTemplateV2 sets the "url_template_v2" field if the given value is not nil.
+func (opguo *ObProxyGroupUpdateOne) SetNillableURLTemplateV2(s *string) *ObProxyGroupUpdateOne {
+	if s != nil {
+		opguo.SetURLTemplateV2(*s)
+	}
+	return opguo
+}
+
+// Mutation returns the ObProxyGroupMutation object of the builder.
+func (opguo *ObProxyGroupUpdateOne) Mutation() *ObProxyGroupMutation {
+	return opguo.mutation
+}
+
+// Where appends a list predicates to the ObProxyGroupUpdate builder.
+func (opguo *ObProxyGroupUpdateOne) Where(ps ...predicate.ObProxyGroup) *ObProxyGroupUpdateOne {
+	opguo.mutation.Where(ps...)
+	return opguo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (opguo *ObProxyGroupUpdateOne) Select(field string, fields ...string) *ObProxyGroupUpdateOne {
+	opguo.fields = append([]string{field}, fields...)
+	return opguo
+}
+
+// Save executes the query and returns the updated ObProxyGroup entity.
+func (opguo *ObProxyGroupUpdateOne) Save(ctx context.Context) (*ObProxyGroup, error) {
+	opguo.defaults()
+	return withHooks(ctx, opguo.sqlSave, opguo.mutation, opguo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (opguo *ObProxyGroupUpdateOne) SaveX(ctx context.Context) *ObProxyGroup {
+	node, err := opguo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (opguo *ObProxyGroupUpdateOne) Exec(ctx context.Context) error {
+	_, err := opguo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (opguo *ObProxyGroupUpdateOne) ExecX(ctx context.Context) {
+	if err := opguo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (opguo *ObProxyGroupUpdateOne) defaults() {
+	if _, ok := opguo.mutation.UpdateTime(); !ok {
+		v := obproxygroup.UpdateDefaultUpdateTime()
+		opguo.mutation.SetUpdateTime(v)
+	}
+}
+
+func (opguo *ObProxyGroupUpdateOne) sqlSave(ctx context.Context) (_node *ObProxyGroup, err error) {
+	_spec := sqlgraph.NewUpdateSpec(obproxygroup.Table, obproxygroup.Columns, sqlgraph.NewFieldSpec(obproxygroup.FieldID, field.TypeInt))
+	id, ok := opguo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ObProxyGroup.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := opguo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, obproxygroup.FieldID)
+		for _, f := range fields {
+			if !obproxygroup.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != obproxygroup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := opguo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := opguo.mutation.CreateTime(); ok {
+		_spec.SetField(obproxygroup.FieldCreateTime, field.TypeTime, value)
+	}
+	if value, ok := opguo.mutation.UpdateTime(); ok {
+		_spec.SetField(obproxygroup.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := opguo.mutation.Namespace(); ok {
+		_spec.SetField(obproxygroup.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := opguo.mutation.Name(); ok {
+		_spec.SetField(obproxygroup.FieldName, field.TypeString, value)
+	}
+	if value, ok := opguo.mutation.ObClusters(); ok {
+		_spec.SetField(obproxygroup.FieldObClusters, field.TypeJSON, value)
+	}
+	if value, ok := opguo.mutation.AppendedObClusters(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, obproxygroup.FieldObClusters, value)
+		})
+	}
+	if opguo.mutation.ObClustersCleared() {
+		_spec.ClearField(obproxygroup.FieldObClusters, field.TypeJSON)
+	}
+	if value, ok := opguo.mutation.Clients(); ok {
+		_spec.SetField(obproxygroup.FieldClients, field.TypeJSON, value)
+	}
+	if value, ok := opguo.mutation.AppendedClients(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, obproxygroup.FieldClients, value)
+		})
+	}
+	if opguo.mutation.ClientsCleared() {
+		_spec.ClearField(obproxygroup.FieldClients, field.TypeJSON)
+	}
+	if value, ok := opguo.mutation.Users(); ok {
+		_spec.SetField(obproxygroup.FieldUsers, field.TypeJSON, value)
+	}
+	if value, ok := opguo.mutation.AppendedUsers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, obproxygroup.FieldUsers, value)
+		})
+	}
+	if opguo.mutation.UsersCleared() {
+		_spec.ClearField(obproxygroup.FieldUsers, field.TypeJSON)
+	}
+	if value, ok := opguo.mutation.MetaObCluster(); ok {
+		_spec.SetField(obproxygroup.FieldMetaObCluster, field.TypeString, value)
+	}
+	if value, ok := opguo.mutation.MetaDatabase(); ok {
+		_spec.SetField(obproxygroup.FieldMetaDatabase, field.TypeString, value)
+	}
+	if value, ok := opguo.mutation.MetaUser(); ok {
+		_spec.SetField(obproxygroup.FieldMetaUser, field.TypeString, value)
+	}
+	if value, ok := opguo.mutation.MetaEncryptedPassword(); ok {
+		_spec.SetField(obproxygroup.FieldMetaEncryptedPassword, field.TypeString, value)
+	}
+	if value, ok := opguo.mutation.URLTemplateV1(); ok {
+		_spec.SetField(obproxygroup.FieldURLTemplateV1, field.TypeString, value)
+	}
+	if value, ok := opguo.mutation.URLTemplateV2(); ok {
+		_spec.SetField(obproxygroup.FieldURLTemplateV2, field.TypeString, value)
+	}
+	_node = &ObProxyGroup{config: opguo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, opguo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{obproxygroup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	opguo.mutation.done = true
+	return _node, nil
+}
@@ -1,4 +1,4 @@
-// Code generated by entc, DO NOT EDIT.
+// Code generated by ent, DO NOT EDIT.
 
 package predicate
 
@@ -6,5 +6,20 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
 // ObCluster is the predicate function for obcluster builders.
 type ObCluster func(*sql.Selector)
+
+// ObClusterGroup is the predicate function for obclustergroup builders.
+type ObClusterGroup func(*sql.Selector)
+
+// ObProxy is the predicate function for obproxy builders.
+type ObProxy func(*sql.Selector)
+
+// ObProxyGroup is the predicate function for obproxygroup builders.
+type ObProxyGroup func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
@@ -1,18 +1,61 @@
-// Code generated by entc, DO NOT EDIT.
+// Code generated by ent, DO NOT EDIT.
 
 package ent
 
 import (
 	"time"
 
+	"github.com/oceanbase/configserver/ent/auditlog"
 	"github.com/oceanbase/configserver/ent/obcluster"
+	"github.com/oceanbase/configserver/ent/obclustergroup"
+	"github.com/oceanbase/configserver/ent/obproxy"
+	"github.com/oceanbase/configserver/ent/obproxygroup"
 	"github.com/oceanbase/configserver/ent/schema"
+	"github.com/oceanbase/configserver/ent/webhookdelivery"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescCreateTime is the schema descriptor for create_time field.
+	auditlogDescCreateTime := auditlogFields[0].Descriptor()
+	// auditlog.DefaultCreateTime holds the default value on creation for the create_time field.
+	auditlog.DefaultCreateTime = auditlogDescCreateTime.Default.(func() time.Time)
+	// auditlogDescNamespace is the schema descriptor for namespace field.
+	auditlogDescNamespace := auditlogFields[1].Descriptor()
+	// auditlog.DefaultNamespace holds the default value on creation for the namespace field.
+	auditlog.DefaultNamespace = auditlogDescNamespace.Default.(string)
+	// auditlogDescEntity is the schema descriptor for entity field.
+	auditlogDescEntity := auditlogFields[4].Descriptor()
+	// auditlog.DefaultEntity holds the default value on creation for the entity field.
+	auditlog.DefaultEntity = auditlogDescEntity.Default.(string)
+	// auditlogDescEntityName is the schema descriptor for entity_name field.
+	auditlogDescEntityName := auditlogFields[5].Descriptor()
+	// auditlog.DefaultEntityName holds the default value on creation for the entity_name field.
+	auditlog.DefaultEntityName = auditlogDescEntityName.Default.(string)
+	// auditlogDescObproxyGroup is the schema descriptor for obproxy_group field.
+	auditlogDescObproxyGroup := auditlogFields[8].Descriptor()
+	// auditlog.DefaultObproxyGroup holds the default value on creation for the obproxy_group field.
+	auditlog.DefaultObproxyGroup = auditlogDescObproxyGroup.Default.(string)
+	// auditlogDescRemoteIP is the schema descriptor for remote_ip field.
+	auditlogDescRemoteIP := auditlogFields[9].Descriptor()
+	// auditlog.DefaultRemoteIP holds the default value on creation for the remote_ip field.
+	auditlog.DefaultRemoteIP = auditlogDescRemoteIP.Default.(string)
+	// auditlogDescPrincipal is the schema descriptor for principal field.
+	auditlogDescPrincipal := auditlogFields[10].Descriptor()
+	// auditlog.DefaultPrincipal holds the default value on creation for the principal field.
+	auditlog.DefaultPrincipal = auditlogDescPrincipal.Default.(string)
+	// auditlogDescUserAgent is the schema descriptor for user_agent field.
+	auditlogDescUserAgent := auditlogFields[11].Descriptor()
+	// auditlog.DefaultUserAgent holds the default value on creation for the user_agent field.
+	auditlog.DefaultUserAgent = auditlogDescUserAgent.Default.(string)
+	// auditlogDescTraceID is the schema descriptor for trace_id field.
+	auditlogDescTraceID := auditlogFields[12].Descriptor()
+	// auditlog.DefaultTraceID holds the default value on creation for the trace_id field.
+	auditlog.DefaultTraceID = auditlogDescTraceID.Default.(string)
 	obclusterFields := schema.ObCluster{}.Fields()
 	_ = obclusterFields
 	// obclusterDescCreateTime is the schema descriptor for create_time field.
@@ -25,8 +68,144 @@ func init() {
 	obcluster.DefaultUpdateTime = obclusterDescUpdateTime.Default.(func() time.Time)
 	// obcluster.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
 	obcluster.UpdateDefaultUpdateTime = obclusterDescUpdateTime.UpdateDefault.(func() time.Time)
+	// obclusterDescNamespace is the schema descriptor for namespace field.
+	obclusterDescNamespace := obclusterFields[2].Descriptor()
+	// obcluster.DefaultNamespace holds the default value on creation for the namespace field.
+	obcluster.DefaultNamespace = obclusterDescNamespace.Default.(string)
 	// obclusterDescObClusterID is the schema descriptor for ob_cluster_id field.
-	obclusterDescObClusterID := obclusterFields[3].Descriptor()
+	obclusterDescObClusterID := obclusterFields[4].Descriptor()
 	// obcluster.ObClusterIDValidator is a validator for the "ob_cluster_id" field. It is called by the builders before save.
 	obcluster.ObClusterIDValidator = obclusterDescObClusterID.Validators[0].(func(int64) error)
+	obclustergroupFields := schema.ObClusterGroup{}.Fields()
+	_ = obclustergroupFields
+	// obclustergroupDescCreateTime is the schema descriptor for create_time field.
+	obclustergroupDescCreateTime := obclustergroupFields[0].Descriptor()
+	// obclustergroup.DefaultCreateTime holds the default value on creation for the create_time field.
+	obclustergroup.DefaultCreateTime = obclustergroupDescCreateTime.Default.(func() time.Time)
+	// obclustergroupDescUpdateTime is the schema descriptor for update_time field.
+	obclustergroupDescUpdateTime := obclustergroupFields[1].Descriptor()
+	// obclustergroup.DefaultUpdateTime holds the default value on creation for the update_time field.
+	obclustergroup.DefaultUpdateTime = obclustergroupDescUpdateTime.Default.(func() time.Time)
+	// obclustergroup.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	obclustergroup.UpdateDefaultUpdateTime = obclustergroupDescUpdateTime.UpdateDefault.(func() time.Time)
+	// obclustergroupDescNamespace is the schema descriptor for namespace field.
+	obclustergroupDescNamespace := obclustergroupFields[2].Descriptor()
+	// obclustergroup.DefaultNamespace holds the default value on creation for the namespace field.
+	obclustergroup.DefaultNamespace = obclustergroupDescNamespace.Default.(string)
+	// obclustergroupDescPrimaryClusterID is the schema descriptor for primary_cluster_id field.
+	obclustergroupDescPrimaryClusterID := obclustergroupFields[4].Descriptor()
+	// obclustergroup.DefaultPrimaryClusterID holds the default value on creation for the primary_cluster_id field.
+	obclustergroup.DefaultPrimaryClusterID = obclustergroupDescPrimaryClusterID.Default.(int64)
+	obproxyFields := schema.ObProxy{}.Fields()
+	_ = obproxyFields
+	// obproxyDescCreateTime is the schema descriptor for create_time field.
+	obproxyDescCreateTime := obproxyFields[0].Descriptor()
+	// obproxy.DefaultCreateTime holds the default value on creation for the create_time field.
+	obproxy.DefaultCreateTime = obproxyDescCreateTime.Default.(func() time.Time)
+	// obproxyDescUpdateTime is the schema descriptor for update_time field.
+	obproxyDescUpdateTime := obproxyFields[1].Descriptor()
+	// obproxy.DefaultUpdateTime holds the default value on creation for the update_time field.
+	obproxy.DefaultUpdateTime = obproxyDescUpdateTime.Default.(func() time.Time)
+	// obproxy.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	obproxy.UpdateDefaultUpdateTime = obproxyDescUpdateTime.UpdateDefault.(func() time.Time)
+	// obproxyDescNamespace is the schema descriptor for namespace field.
+	obproxyDescNamespace := obproxyFields[2].Descriptor()
+	// obproxy.DefaultNamespace holds the default value on creation for the namespace field.
+	obproxy.DefaultNamespace = obproxyDescNamespace.Default.(string)
+	// obproxyDescVersion is the schema descriptor for version field.
+	obproxyDescVersion := obproxyFields[5].Descriptor()
+	// obproxy.DefaultVersion holds the default value on creation for the version field.
+	obproxy.DefaultVersion = obproxyDescVersion.Default.(string)
+	// obproxyDescConfigVersion is the schema descriptor for config_version field.
+	obproxyDescConfigVersion := obproxyFields[6].Descriptor()
+	// obproxy.DefaultConfigVersion holds the default value on creation for the config_version field.
+	obproxy.DefaultConfigVersion = obproxyDescConfigVersion.Default.(string)
+	// obproxyDescObproxyGroup is the schema descriptor for obproxy_group field.
+	obproxyDescObproxyGroup := obproxyFields[7].Descriptor()
+	// obproxy.DefaultObproxyGroup holds the default value on creation for the obproxy_group field.
+	obproxy.DefaultObproxyGroup = obproxyDescObproxyGroup.Default.(string)
+	// obproxyDescServiceAddress is the schema descriptor for service_address field.
+	obproxyDescServiceAddress := obproxyFields[8].Descriptor()
+	// obproxy.DefaultServiceAddress holds the default value on creation for the service_address field.
+	obproxy.DefaultServiceAddress = obproxyDescServiceAddress.Default.(string)
+	// obproxyDescLastSeenTime is the schema descriptor for last_seen_time field.
+	obproxyDescLastSeenTime := obproxyFields[9].Descriptor()
+	// obproxy.DefaultLastSeenTime holds the default value on creation for the last_seen_time field.
+	obproxy.DefaultLastSeenTime = obproxyDescLastSeenTime.Default.(func() time.Time)
+	obproxygroupFields := schema.ObProxyGroup{}.Fields()
+	_ = obproxygroupFields
+	// obproxygroupDescCreateTime is the schema descriptor for create_time field.
+	obproxygroupDescCreateTime := obproxygroupFields[0].Descriptor()
+	// obproxygroup.DefaultCreateTime holds the default value on creation for the create_time field.
+	obproxygroup.DefaultCreateTime = obproxygroupDescCreateTime.Default.(func() time.Time)
+	// obproxygroupDescUpdateTime is the schema descriptor for update_time field.
+	obproxygroupDescUpdateTime := obproxygroupFields[1].Descriptor()
+	// obproxygroup.DefaultUpdateTime holds the default value on creation for the update_time field.
+	obproxygroup.DefaultUpdateTime = obproxygroupDescUpdateTime.Default.(func() time.Time)
+	// obproxygroup.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	obproxygroup.UpdateDefaultUpdateTime = obproxygroupDescUpdateTime.UpdateDefault.(func() time.Time)
+	// obproxygroupDescNamespace is the schema descriptor for namespace field.
+	obproxygroupDescNamespace := obproxygroupFields[2].Descriptor()
+	// obproxygroup.DefaultNamespace holds the default value on creation for the namespace field.
+	obproxygroup.DefaultNamespace = obproxygroupDescNamespace.Default.(string)
+	// obproxygroupDescMetaObCluster is the schema descriptor for meta_ob_cluster field.
+	obproxygroupDescMetaObCluster := obproxygroupFields[7].Descriptor()
+	// obproxygroup.DefaultMetaObCluster holds the default value on creation for the meta_ob_cluster field.
+	obproxygroup.DefaultMetaObCluster = obproxygroupDescMetaObCluster.Default.(string)
+	// obproxygroupDescMetaDatabase is the schema descriptor for meta_database field.
+	obproxygroupDescMetaDatabase := obproxygroupFields[8].Descriptor()
+	// obproxygroup.DefaultMetaDatabase holds the default value on creation for the meta_database field.
+	obproxygroup.DefaultMetaDatabase = obproxygroupDescMetaDatabase.Default.(string)
+	// obproxygroupDescMetaUser is the schema descriptor for meta_user field.
+	obproxygroupDescMetaUser := obproxygroupFields[9].Descriptor()
+	// obproxygroup.DefaultMetaUser holds the default value on creation for the meta_user field.
+	obproxygroup.DefaultMetaUser = obproxygroupDescMetaUser.Default.(string)
+	// obproxygroupDescMetaEncryptedPassword is the schema descriptor for meta_encrypted_password field.
+	obproxygroupDescMetaEncryptedPassword := obproxygroupFields[10].Descriptor()
+	// obproxygroup.DefaultMetaEncryptedPassword holds the default value on creation for the meta_encrypted_password field.
+	obproxygroup.DefaultMetaEncryptedPassword = obproxygroupDescMetaEncryptedPassword.Default.(string)
+	// obproxygroupDescURLTemplateV1 is the schema descriptor for url_template_v1 field.
+	obproxygroupDescURLTemplateV1 := obproxygroupFields[11].Descriptor()
+	// obproxygroup.DefaultURLTemplateV1 holds the default value on creation for the url_template_v1 field.
+	obproxygroup.DefaultURLTemplateV1 = obproxygroupDescURLTemplateV1.Default.(string)
+	// obproxygroupDescURLTemplateV2 is the schema descriptor for url_template_v2 field.
+	obproxygroupDescURLTemplateV2 := obproxygroupFields[12].Descriptor()
+	// obproxygroup.DefaultURLTemplateV2 holds the default value on creation for the url_template_v2 field.
+	obproxygroup.DefaultURLTemplateV2 = obproxygroupDescURLTemplateV2.Default.(string)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescCreateTime is the schema descriptor for create_time field.
+	webhookdeliveryDescCreateTime := webhookdeliveryFields[0].Descriptor()
+	// webhookdelivery.DefaultCreateTime holds the default value on creation for the create_time field.
+	webhookdelivery.DefaultCreateTime = webhookdeliveryDescCreateTime.Default.(func() time.Time)
+	// webhookdeliveryDescUpdateTime is the schema descriptor for update_time field.
+	webhookdeliveryDescUpdateTime := webhookdeliveryFields[1].Descriptor()
+	// webhookdelivery.DefaultUpdateTime holds the default value on creation for the update_time field.
+	webhookdelivery.DefaultUpdateTime = webhookdeliveryDescUpdateTime.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	webhookdelivery.UpdateDefaultUpdateTime = webhookdeliveryDescUpdateTime.UpdateDefault.(func() time.Time)
+	// webhookdeliveryDescNamespace is the schema descriptor for namespace field.
+	webhookdeliveryDescNamespace := webhookdeliveryFields[5].Descriptor()
+	// webhookdelivery.DefaultNamespace holds the default value on creation for the namespace field.
+	webhookdelivery.DefaultNamespace = webhookdeliveryDescNamespace.Default.(string)
+	// webhookdeliveryDescObClusterID is the schema descriptor for ob_cluster_id field.
+	webhookdeliveryDescObClusterID := webhookdeliveryFields[7].Descriptor()
+	// webhookdelivery.DefaultObClusterID holds the default value on creation for the ob_cluster_id field.
+	webhookdelivery.DefaultObClusterID = webhookdeliveryDescObClusterID.Default.(int64)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[10].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescNextAttemptTime is the schema descriptor for next_attempt_time field.
+	webhookdeliveryDescNextAttemptTime := webhookdeliveryFields[11].Descriptor()
+	// webhookdelivery.DefaultNextAttemptTime holds the default value on creation for the next_attempt_time field.
+	webhookdelivery.DefaultNextAttemptTime = webhookdeliveryDescNextAttemptTime.Default.(func() time.Time)
+	// webhookdeliveryDescLastStatusCode is the schema descriptor for last_status_code field.
+	webhookdeliveryDescLastStatusCode := webhookdeliveryFields[12].Descriptor()
+	// webhookdelivery.DefaultLastStatusCode holds the default value on creation for the last_status_code field.
+	webhookdelivery.DefaultLastStatusCode = webhookdeliveryDescLastStatusCode.Default.(int)
+	// webhookdeliveryDescLastError is the schema descriptor for last_error field.
+	webhookdeliveryDescLastError := webhookdeliveryFields[13].Descriptor()
+	// webhookdelivery.DefaultLastError holds the default value on creation for the last_error field.
+	webhookdelivery.DefaultLastError = webhookdeliveryDescLastError.Default.(string)
 }
@@ -0,0 +1,78 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity, a record of a change to ObCluster, ObClusterGroup,
+// ObProxyGroup, ObProxy or WebhookDelivery.
+type AuditLog struct {
+	ent.Schema
+}
+
+// Fields of the AuditLog.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("create_time").Default(time.Now),
+		field.String("namespace").Default("default"),
+		field.String("action"),
+		field.String("operation"),
+		// kind of the changed entity, rows recorded before it's introduced are changes of ObCluster
+		field.String("entity").Default("ObCluster"),
+		// name of the changed entity not identified by ob cluster or obproxy group, like name of ObProxy or id of WebhookDelivery
+		field.String("entity_name").Default(""),
+		field.String("ob_cluster"),
+		field.Int64("ob_cluster_id"),
+		// name of the changed obproxy group, or the group of the changed obproxy
+		field.String("obproxy_group").Default(""),
+		field.String("remote_ip").Default(""),
+		field.String("principal").Default(""),
+		field.String("user_agent").Default("").
+			Annotations(entsql.Annotation{
+				Size: 1024,
+			}),
+		field.String("trace_id").Default(""),
+		field.String("before_json").Optional().
+			Annotations(entsql.Annotation{
+				Size: 65536,
+			}),
+		field.String("after_json").Optional().
+			Annotations(entsql.Annotation{
+				Size: 65536,
+			}),
+	}
+}
+
+func (AuditLog) Edges() []ent.Edge {
+	return nil
+}
+
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("create_time"),
+		index.Fields("namespace", "ob_cluster", "ob_cluster_id"),
+		index.Fields("namespace", "obproxy_group"),
+		index.Fields("namespace", "entity", "entity_name"),
+	}
+}
@@ -35,6 +35,7 @@ func (ObCluster) Fields() []ent.Field {
 	return []ent.Field{
 		field.Time("create_time").Default(time.Now),
 		field.Time("update_time").Default(time.Now).UpdateDefault(time.Now),
+		field.String("namespace").Default("default"),
 		field.String("name"),
 		field.Int64("ob_cluster_id").Positive(),
 		field.String("type"),
@@ -42,6 +43,8 @@ func (ObCluster) Fields() []ent.Field {
 			Annotations(entsql.Annotation{
 				Size: 65536,
 			}),
+		// deleted clusters are kept in recycle bin until purged, nil means not deleted
+		field.Time("deleted_at").Optional().Nillable(),
 	}
 }
 
@@ -52,6 +55,7 @@ func (ObCluster) Edges() []ent.Edge {
 func (ObCluster) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("update_time"),
-		index.Fields("name", "ob_cluster_id").Unique(),
+		index.Fields("deleted_at"),
+		index.Fields("namespace", "name", "ob_cluster_id").Unique(),
 	}
 }
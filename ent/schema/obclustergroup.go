@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ObClusterGroup holds the schema definition for the ObClusterGroup entity,
+// a group is formed by all the ob clusters sharing the same name, one primary and the others standby.
+type ObClusterGroup struct {
+	ent.Schema
+}
+
+// Fields of the ObClusterGroup.
+func (ObClusterGroup) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("create_time").Default(time.Now),
+		field.Time("update_time").Default(time.Now).UpdateDefault(time.Now),
+		field.String("namespace").Default("default"),
+		field.String("name"),
+		field.Int64("primary_cluster_id").Default(0),
+	}
+}
+
+func (ObClusterGroup) Edges() []ent.Edge {
+	return nil
+}
+
+func (ObClusterGroup) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("namespace", "name").Unique(),
+	}
+}
@@ -0,0 +1,59 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ObProxy holds the schema definition for the ObProxy entity,
+// an obproxy reported by heartbeat with the config version it runs.
+type ObProxy struct {
+	ent.Schema
+}
+
+// Fields of the ObProxy.
+func (ObProxy) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("create_time").Default(time.Now),
+		field.Time("update_time").Default(time.Now).UpdateDefault(time.Now),
+		field.String("namespace").Default("default"),
+		field.String("name"),
+		field.String("address"),
+		field.String("version").Default(""),
+		field.String("config_version").Default(""),
+		field.String("obproxy_group").Default(""),
+		// address prefix of urls served to the obproxy, config versions differ between vip endpoints
+		field.String("service_address").Default(""),
+		field.Time("last_seen_time").Default(time.Now),
+	}
+}
+
+func (ObProxy) Edges() []ent.Edge {
+	return nil
+}
+
+func (ObProxy) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("namespace", "name").Unique(),
+		index.Fields("last_seen_time"),
+	}
+}
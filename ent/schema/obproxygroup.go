@@ -0,0 +1,70 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ObProxyGroup holds the schema definition for the ObProxyGroup entity,
+// a group of obproxy deployments only seeing the clusters they serve, selected by client ip or auth user.
+type ObProxyGroup struct {
+	ent.Schema
+}
+
+// Fields of the ObProxyGroup.
+func (ObProxyGroup) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("create_time").Default(time.Now),
+		field.Time("update_time").Default(time.Now).UpdateDefault(time.Now),
+		field.String("namespace").Default("default"),
+		field.String("name"),
+		field.Strings("ob_clusters").Optional(),
+		field.Strings("clients").Optional(),
+		field.Strings("users").Optional(),
+		field.String("meta_ob_cluster").Default(""),
+		field.String("meta_database").Default(""),
+		field.String("meta_user").Default(""),
+		field.String("meta_encrypted_password").Default("").
+			Annotations(entsql.Annotation{
+				Size: 1024,
+			}),
+		field.String("url_template_v1").Default("").
+			Annotations(entsql.Annotation{
+				Size: 1024,
+			}),
+		field.String("url_template_v2").Default("").
+			Annotations(entsql.Annotation{
+				Size: 1024,
+			}),
+	}
+}
+
+func (ObProxyGroup) Edges() []ent.Edge {
+	return nil
+}
+
+func (ObProxyGroup) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("namespace", "name").Unique(),
+	}
+}
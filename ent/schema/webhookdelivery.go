@@ -0,0 +1,73 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity,
+// an event to be sent to a subscriber, it's kept as dead letter if all the attempts failed.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("create_time").Default(time.Now),
+		field.Time("update_time").Default(time.Now).UpdateDefault(time.Now),
+		field.String("subscriber"),
+		field.String("url").
+			Annotations(entsql.Annotation{
+				Size: 1024,
+			}),
+		field.String("event"),
+		field.String("namespace").Default("default"),
+		field.String("ob_cluster"),
+		field.Int64("ob_cluster_id").Default(0),
+		field.String("payload").
+			Annotations(entsql.Annotation{
+				Size: 65536,
+			}),
+		field.String("status"),
+		field.Int("attempts").Default(0),
+		field.Time("next_attempt_time").Default(time.Now),
+		field.Int("last_status_code").Default(0),
+		field.String("last_error").Default("").
+			Annotations(entsql.Annotation{
+				Size: 1024,
+			}),
+	}
+}
+
+func (WebhookDelivery) Edges() []ent.Edge {
+	return nil
+}
+
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_time"),
+		index.Fields("namespace", "subscriber"),
+		index.Fields("update_time"),
+	}
+}
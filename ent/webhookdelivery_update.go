@@ -0,0 +1,714 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/oceanbase/configserver/ent/predicate"
+	"github.com/oceanbase/configserver/ent/webhookdelivery"
+)
+
+// WebhookDeliveryUpdate is the builder for updating WebhookDelivery entities.
+type WebhookDeliveryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebhookDeliveryMutation
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (wdu *WebhookDeliveryUpdate) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdate {
+	wdu.mutation.Where(ps...)
+	return wdu
+}
+
+// SetCreateTime sets the "create_time" field.
+func (wdu *WebhookDeliveryUpdate) SetCreateTime(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetCreateTime(t)
+	return wdu
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableCreateTime(t *time.Time) *WebhookDeliveryUpdate {
+	if t != nil {
+		wdu.SetCreateTime(*t)
+	}
+	return wdu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (wdu *WebhookDeliveryUpdate) SetUpdateTime(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetUpdateTime(t)
+	return wdu
+}
+
+// SetSubscriber sets the "subscriber" field.
+func (wdu *WebhookDeliveryUpdate) SetSubscriber(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetSubscriber(s)
+	return wdu
+}
+
+// SetNillableSubscriber sets the "subscriber" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableSubscriber(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetSubscriber(*s)
+	}
+	return wdu
+}
+
+// SetURL sets the "url" field.
+func (wdu *WebhookDeliveryUpdate) SetURL(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetURL(s)
+	return wdu
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableURL(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetURL(*s)
+	}
+	return wdu
+}
+
+// SetEvent sets the "event" field.
+func (wdu *WebhookDeliveryUpdate) SetEvent(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetEvent(s)
+	return wdu
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableEvent(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetEvent(*s)
+	}
+	return wdu
+}
+
+// SetNamespace sets the "namespace" field.
+func (wdu *WebhookDeliveryUpdate) SetNamespace(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetNamespace(s)
+	return wdu
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableNamespace(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetNamespace(*s)
+	}
+	return wdu
+}
+
+// SetObCluster sets the "ob_cluster" field.
+func (wdu *WebhookDeliveryUpdate) SetObCluster(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetObCluster(s)
+	return wdu
+}
+
+// SetNillableObCluster sets the "ob_cluster" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableObCluster(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetObCluster(*s)
+	}
+	return wdu
+}
+
+// SetObClusterID sets the "ob_cluster_id" field.
+func (wdu *WebhookDeliveryUpdate) SetObClusterID(i int64) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetObClusterID()
+	wdu.mutation.SetObClusterID(i)
+	return wdu
+}
+
+// SetNillableObClusterID sets the "ob_cluster_id" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableObClusterID(i *int64) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetObClusterID(*i)
+	}
+	return wdu
+}
+
+// AddObClusterID adds i to the "ob_cluster_id" field.
+func (wdu *WebhookDeliveryUpdate) AddObClusterID(i int64) *WebhookDeliveryUpdate {
+	wdu.mutation.AddObClusterID(i)
+	return wdu
+}
+
+// SetPayload sets the "payload" field.
+func (wdu *WebhookDeliveryUpdate) SetPayload(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetPayload(s)
+	return wdu
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillablePayload(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetPayload(*s)
+	}
+	return wdu
+}
+
+// SetStatus sets the "status" field.
+func (wdu *WebhookDeliveryUpdate) SetStatus(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetStatus(s)
+	return wdu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableStatus(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetStatus(*s)
+	}
+	return wdu
+}
+
+// SetAttempts sets the "attempts" field.
+func (wdu *WebhookDeliveryUpdate) SetAttempts(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetAttempts()
+	wdu.mutation.SetAttempts(i)
+	return wdu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableAttempts(i *int) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetAttempts(*i)
+	}
+	return wdu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (wdu *WebhookDeliveryUpdate) AddAttempts(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.AddAttempts(i)
+	return wdu
+}
+
+// SetNextAttemptTime sets the "next_attempt_time" field.
+func (wdu *WebhookDeliveryUpdate) SetNextAttemptTime(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetNextAttemptTime(t)
+	return wdu
+}
+
+// SetNillableNextAttemptTime sets the "next_attempt_time" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableNextAttemptTime(t *time.Time) *WebhookDeliveryUpdate {
+	if t != nil {
+		wdu.SetNextAttemptTime(*t)
+	}
+	return wdu
+}
+
+// SetLastStatusCode sets the "last_status_code" field.
+func (wdu *WebhookDeliveryUpdate) SetLastStatusCode(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetLastStatusCode()
+	wdu.mutation.SetLastStatusCode(i)
+	return wdu
+}
+
+// SetNillableLastStatusCode sets the "last_status_code" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableLastStatusCode(i *int) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetLastStatusCode(*i)
+	}
+	return wdu
+}
+
+// AddLastStatusCode adds i to the "last_status_code" field.
+func (wdu *WebhookDeliveryUpdate) AddLastStatusCode(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.AddLastStatusCode(i)
+	return wdu
+}
+
+// SetLastError sets the "last_error" field.
+func (wdu *WebhookDeliveryUpdate) SetLastError(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetLastError(s)
+	return wdu
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableLastError(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetLastError(*s)
+	}
+	return wdu
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wdu *WebhookDeliveryUpdate) Mutation() *WebhookDeliveryMutation {
+	return wdu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wdu *WebhookDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	wdu.defaults()
+	return withHooks(ctx, wdu.sqlSave, wdu.mutation, wdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := wdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wdu *WebhookDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := wdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := wdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wdu *WebhookDeliveryUpdate) defaults() {
+	if _, ok := wdu.mutation.UpdateTime(); !ok {
+		v := webhookdelivery.UpdateDefaultUpdateTime()
+		wdu.mutation.SetUpdateTime(v)
+	}
+}
+
+func (wdu *WebhookDeliveryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt))
+	if ps := wdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wdu.mutation.CreateTime(); ok {
+		_spec.SetField(webhookdelivery.FieldCreateTime, field.TypeTime, value)
+	}
+	if value, ok := wdu.mutation.UpdateTime(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := wdu.mutation.Subscriber(); ok {
+		_spec.SetField(webhookdelivery.FieldSubscriber, field.TypeString, value)
+	}
+	if value, ok := wdu.mutation.URL(); ok {
+		_spec.SetField(webhookdelivery.FieldURL, field.TypeString, value)
+	}
+	if value, ok := wdu.mutation.Event(); ok {
+		_spec.SetField(webhookdelivery.FieldEvent, field.TypeString, value)
+	}
+	if value, ok := wdu.mutation.Namespace(); ok {
+		_spec.SetField(webhookdelivery.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := wdu.mutation.ObCluster(); ok {
+		_spec.SetField(webhookdelivery.FieldObCluster, field.TypeString, value)
+	}
+	if value, ok := wdu.mutation.ObClusterID(); ok {
+		_spec.SetField(webhookdelivery.FieldObClusterID, field.TypeInt64, value)
+	}
+	if value, ok := wdu.mutation.AddedObClusterID(); ok {
+		_spec.AddField(webhookdelivery.FieldObClusterID, field.TypeInt64, value)
+	}
+	if value, ok := wdu.mutation.Payload(); ok {
+		_spec.SetField(webhookdelivery.FieldPayload, field.TypeString, value)
+	}
+	if value, ok := wdu.mutation.Status(); ok {
+		_spec.SetField(webhookdelivery.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := wdu.mutation.Attempts(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.AddedAttempts(); ok {
+		_spec.AddField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.NextAttemptTime(); ok {
+		_spec.SetField(webhookdelivery.FieldNextAttemptTime, field.TypeTime, value)
+	}
+	if value, ok := wdu.mutation.LastStatusCode(); ok {
+		_spec.SetField(webhookdelivery.FieldLastStatusCode, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.AddedLastStatusCode(); ok {
+		_spec.AddField(webhookdelivery.FieldLastStatusCode, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.LastError(); ok {
+		_spec.SetField(webhookdelivery.FieldLastError, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wdu.mutation.done = true
+	return n, nil
+}
+
+// WebhookDeliveryUpdateOne is the builder for updating a single WebhookDelivery entity.
+type WebhookDeliveryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebhookDeliveryMutation
+}
+
+// SetCreateTime sets the "create_time" field.
+func (wduo *WebhookDeliveryUpdateOne) SetCreateTime(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetCreateTime(t)
+	return wduo
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableCreateTime(t *time.Time) *WebhookDeliveryUpdateOne {
+	if t != nil {
+		wduo.SetCreateTime(*t)
+	}
+	return wduo
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (wduo *WebhookDeliveryUpdateOne) SetUpdateTime(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetUpdateTime(t)
+	return wduo
+}
+
+// SetSubscriber sets the "subscriber" field.
+func (wduo *WebhookDeliveryUpdateOne) SetSubscriber(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetSubscriber(s)
+	return wduo
+}
+
+// SetNillableSubscriber sets the "subscriber" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableSubscriber(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetSubscriber(*s)
+	}
+	return wduo
+}
+
+// SetURL sets the "url" field.
+func (wduo *WebhookDeliveryUpdateOne) SetURL(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetURL(s)
+	return wduo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableURL(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetURL(*s)
+	}
+	return wduo
+}
+
+// SetEvent sets the "event" field.
+func (wduo *WebhookDeliveryUpdateOne) SetEvent(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetEvent(s)
+	return wduo
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableEvent(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetEvent(*s)
+	}
+	return wduo
+}
+
+// SetNamespace sets the "namespace" field.
+func (wduo *WebhookDeliveryUpdateOne) SetNamespace(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetNamespace(s)
+	return wduo
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableNamespace(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetNamespace(*s)
+	}
+	return wduo
+}
+
+// SetObCluster sets the "ob_cluster" field.
+func (wduo *WebhookDeliveryUpdateOne) SetObCluster(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetObCluster(s)
+	return wduo
+}
+
+// SetNillableObCluster sets the "ob_cluster" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableObCluster(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetObCluster(*s)
+	}
+	return wduo
+}
+
+// SetObClusterID sets the "ob_cluster_id" field.
+func (wduo *WebhookDeliveryUpdateOne) SetObClusterID(i int64) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetObClusterID()
+	wduo.mutation.SetObClusterID(i)
+	return wduo
+}
+
+// SetNillableObClusterID sets the "ob_cluster_id" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableObClusterID(i *int64) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetObClusterID(*i)
+	}
+	return wduo
+}
+
+// AddObClusterID adds i to the "ob_cluster_id" field.
+func (wduo *WebhookDeliveryUpdateOne) AddObClusterID(i int64) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddObClusterID(i)
+	return wduo
+}
+
+// SetPayload sets the "payload" field.
+func (wduo *WebhookDeliveryUpdateOne) SetPayload(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetPayload(s)
+	return wduo
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillablePayload(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetPayload(*s)
+	}
+	return wduo
+}
+
+// SetStatus sets the "status" field.
+func (wduo *WebhookDeliveryUpdateOne) SetStatus(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetStatus(s)
+	return wduo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableStatus(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetStatus(*s)
+	}
+	return wduo
+}
+
+// SetAttempts sets the "attempts" field.
+func (wduo *WebhookDeliveryUpdateOne) SetAttempts(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetAttempts()
+	wduo.mutation.SetAttempts(i)
+	return wduo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableAttempts(i *int) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetAttempts(*i)
+	}
+	return wduo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (wduo *WebhookDeliveryUpdateOne) AddAttempts(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddAttempts(i)
+	return wduo
+}
+
+// SetNextAttemptTime sets the "next_attempt_time" field.
+func (wduo *WebhookDeliveryUpdateOne) SetNextAttemptTime(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetNextAttemptTime(t)
+	return wduo
+}
+
+// SetNillableNextAttemptTime sets the "next_attempt_time" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableNextAttemptTime(t *time.Time) *WebhookDeliveryUpdateOne {
+	if t != nil {
+		wduo.SetNextAttemptTime(*t)
+	}
+	return wduo
+}
+
+// SetLastStatusCode sets the "last_status_code" field.
+func (wduo *WebhookDeliveryUpdateOne) SetLastStatusCode(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetLastStatusCode()
+	wduo.mutation.SetLastStatusCode(i)
+	return wduo
+}
+
+// SetNillableLastStatusCode sets the "last_status_code" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableLastStatusCode(i *int) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetLastStatusCode(*i)
+	}
+	return wduo
+}
+
+// AddLastStatusCode adds i to the "last_status_code" field.
+func (wduo *WebhookDeliveryUpdateOne) AddLastStatusCode(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddLastStatusCode(i)
+	return wduo
+}
+
+// SetLastError sets the "last_error" field.
+func (wduo *WebhookDeliveryUpdateOne) SetLastError(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetLastError(s)
+	return wduo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableLastError(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetLastError(*s)
+	}
+	return wduo
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wduo *WebhookDeliveryUpdateOne) Mutation() *WebhookDeliveryMutation {
+	return wduo.mutation
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (wduo *WebhookDeliveryUpdateOne) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdateOne {
+	wduo.mutation.Where(ps...)
+	return wduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wduo *WebhookDeliveryUpdateOne) Select(field string, fields ...string) *WebhookDeliveryUpdateOne {
+	wduo.fields = append([]string{field}, fields...)
+	return wduo
+}
+
+// Save executes the query and returns the updated WebhookDelivery entity.
+func (wduo *WebhookDeliveryUpdateOne) Save(ctx context.Context) (*WebhookDelivery, error) {
+	wduo.defaults()
+	return withHooks(ctx, wduo.sqlSave, wduo.mutation, wduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) SaveX(ctx context.Context) *WebhookDelivery {
+	node, err := wduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wduo *WebhookDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := wduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := wduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wduo *WebhookDeliveryUpdateOne) defaults() {
+	if _, ok := wduo.mutation.UpdateTime(); !ok {
+		v := webhookdelivery.UpdateDefaultUpdateTime()
+		wduo.mutation.SetUpdateTime(v)
+	}
+}
+
+func (wduo *WebhookDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *WebhookDelivery, err error) {
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt))
+	id, ok := wduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebhookDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookdelivery.FieldID)
+		for _, f := range fields {
+			if !webhookdelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webhookdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wduo.mutation.CreateTime(); ok {
+		_spec.SetField(webhookdelivery.FieldCreateTime, field.TypeTime, value)
+	}
+	if value, ok := wduo.mutation.UpdateTime(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := wduo.mutation.Subscriber(); ok {
+		_spec.SetField(webhookdelivery.FieldSubscriber, field.TypeString, value)
+	}
+	if value, ok := wduo.mutation.URL(); ok {
+		_spec.SetField(webhookdelivery.FieldURL, field.TypeString, value)
+	}
+	if value, ok := wduo.mutation.Event(); ok {
+		_spec.SetField(webhookdelivery.FieldEvent, field.TypeString, value)
+	}
+	if value, ok := wduo.mutation.Namespace(); ok {
+		_spec.SetField(webhookdelivery.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := wduo.mutation.ObCluster(); ok {
+		_spec.SetField(webhookdelivery.FieldObCluster, field.TypeString, value)
+	}
+	if value, ok := wduo.mutation.ObClusterID(); ok {
+		_spec.SetField(webhookdelivery.FieldObClusterID, field.TypeInt64, value)
+	}
+	if value, ok := wduo.mutation.AddedObClusterID(); ok {
+		_spec.AddField(webhookdelivery.FieldObClusterID, field.TypeInt64, value)
+	}
+	if value, ok := wduo.mutation.Payload(); ok {
+		_spec.SetField(webhookdelivery.FieldPayload, field.TypeString, value)
+	}
+	if value, ok := wduo.mutation.Status(); ok {
+		_spec.SetField(webhookdelivery.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := wduo.mutation.Attempts(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.AddedAttempts(); ok {
+		_spec.AddField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.NextAttemptTime(); ok {
+		_spec.SetField(webhookdelivery.FieldNextAttemptTime, field.TypeTime, value)
+	}
+	if value, ok := wduo.mutation.LastStatusCode(); ok {
+		_spec.SetField(webhookdelivery.FieldLastStatusCode, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.AddedLastStatusCode(); ok {
+		_spec.AddField(webhookdelivery.FieldLastStatusCode, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.LastError(); ok {
+		_spec.SetField(webhookdelivery.FieldLastError, field.TypeString, value)
+	}
+	_node = &WebhookDelivery{config: wduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wduo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,60 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crypto
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptAndDecrypt(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "key")
+	require.Nil(t, os.WriteFile(keyFile, []byte("000102030405060708090a0b0c0d0e0f000102030405060708090a0b0c0d0e0f\n"), 0600))
+	key, err := LoadKey(keyFile)
+	require.Nil(t, err)
+	require.Equal(t, 32, len(key))
+
+	encrypted, err := Encrypt(key, "password")
+	require.Nil(t, err)
+	require.NotContains(t, encrypted, "password")
+	decrypted, err := Decrypt(key, encrypted)
+	require.Nil(t, err)
+	require.Equal(t, "password", decrypted)
+
+	// nonce is random
+	another, err := Encrypt(key, "password")
+	require.Nil(t, err)
+	require.NotEqual(t, encrypted, another)
+
+	key[0] ^= 1
+	_, err = Decrypt(key, encrypted)
+	require.NotNil(t, err)
+	_, err = Decrypt(key, "invalid")
+	require.NotNil(t, err)
+}
+
+func TestLoadInvalidKey(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "key")
+	require.Nil(t, os.WriteFile(keyFile, []byte("0001"), 0600))
+	_, err := LoadKey(keyFile)
+	require.NotNil(t, err)
+	_, err = LoadKey(filepath.Join(t.TempDir(), "not_exist"))
+	require.NotNil(t, err)
+}
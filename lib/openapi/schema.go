@@ -0,0 +1,115 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package openapi
+
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
+type Schema = map[string]interface{}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// Components collects schemas of named struct types, operations refer to them with $ref
+type Components struct {
+	schemas map[string]Schema
+}
+
+func NewComponents() *Components {
+	return &Components{
+		schemas: make(map[string]Schema),
+	}
+}
+
+func (c *Components) Schemas() map[string]Schema {
+	return c.schemas
+}
+
+// SchemaOf returns the schema of the type of v, derived from the type definition and json tags
+func (c *Components) SchemaOf(v interface{}) Schema {
+	return c.schemaOfType(reflect.TypeOf(v))
+}
+
+func (c *Components) schemaOfType(t reflect.Type) Schema {
+	if t == nil {
+		return Schema{}
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		return c.schemaOfType(t.Elem())
+	case reflect.Struct:
+		if t == timeType {
+			return Schema{"type": "string", "format": "date-time"}
+		}
+		return c.refOfStruct(t)
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return Schema{"type": "string", "format": "byte"}
+		}
+		return Schema{"type": "array", "items": c.schemaOfType(t.Elem())}
+	case reflect.Map:
+		return Schema{"type": "object", "additionalProperties": c.schemaOfType(t.Elem())}
+	case reflect.String:
+		return Schema{"type": "string"}
+	case reflect.Bool:
+		return Schema{"type": "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return Schema{"type": "integer", "format": "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return Schema{"type": "integer", "format": "int64"}
+	case reflect.Float32:
+		return Schema{"type": "number", "format": "float"}
+	case reflect.Float64:
+		return Schema{"type": "number", "format": "double"}
+	default:
+		// interface{} and other types accept any value
+		return Schema{}
+	}
+}
+
+func (c *Components) refOfStruct(t reflect.Type) Schema {
+	name := t.Name()
+	ref := Schema{"$ref": "#/components/schemas/" + name}
+	if _, ok := c.schemas[name]; ok {
+		return ref
+	}
+	// register before resolving fields, so recursive types refer to themselves
+	schema := Schema{"type": "object"}
+	c.schemas[name] = schema
+	properties := Schema{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		fieldName := field.Name
+		if tag, ok := field.Tag.Lookup("json"); ok {
+			tagName := strings.Split(tag, ",")[0]
+			if tagName == "-" {
+				continue
+			}
+			if tagName != "" {
+				fieldName = tagName
+			}
+		}
+		properties[fieldName] = c.schemaOfType(field.Type)
+	}
+	schema["properties"] = properties
+	return ref
+}
@@ -0,0 +1,55 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package openapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testItem struct {
+	Name     string `json:"name"`
+	Count    int64  `json:"count,omitempty"`
+	Ignored  string `json:"-"`
+	Children []*testItem
+	Time     time.Time `json:"time"`
+	hidden   string
+}
+
+func TestSchemaOfStruct(t *testing.T) {
+	components := NewComponents()
+	schema := components.SchemaOf(&testItem{})
+	require.Equal(t, "#/components/schemas/testItem", schema["$ref"])
+
+	itemSchema := components.Schemas()["testItem"]
+	properties := itemSchema["properties"].(Schema)
+	require.Equal(t, 4, len(properties))
+	require.Equal(t, Schema{"type": "string"}, properties["name"])
+	require.Equal(t, Schema{"type": "integer", "format": "int64"}, properties["count"])
+	require.Equal(t, Schema{"type": "string", "format": "date-time"}, properties["time"])
+	require.Equal(t, Schema{"type": "array", "items": Schema{"$ref": "#/components/schemas/testItem"}}, properties["Children"])
+}
+
+func TestSchemaOfBasicTypes(t *testing.T) {
+	components := NewComponents()
+	require.Equal(t, Schema{"type": "boolean"}, components.SchemaOf(true))
+	require.Equal(t, Schema{"type": "array", "items": Schema{"type": "string"}}, components.SchemaOf([]string{}))
+	require.Equal(t, Schema{}, components.SchemaOf(nil))
+	require.Equal(t, 0, len(components.Schemas()))
+}
@@ -0,0 +1,131 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// TokenBucket allows burst requests at once and refills at rate per second
+type TokenBucket struct {
+	mutex  sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func NewTokenBucket(rate float64, burst int) *TokenBucket {
+	return &TokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+func (b *TokenBucket) refill(now time.Time) {
+	if now.After(b.last) {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+	}
+}
+
+// AllowAt takes a token at time now, returns false and the time to wait for the next token if there's none
+func (b *TokenBucket) AllowAt(now time.Time) (bool, time.Duration) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.refill(now)
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	if b.rate <= 0 {
+		return false, time.Duration(math.MaxInt64)
+	}
+	return false, time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
+
+func (b *TokenBucket) Allow() (bool, time.Duration) {
+	return b.AllowAt(time.Now())
+}
+
+// idleAt returns whether the bucket is full at time now, a full bucket behaves the same as a new one
+func (b *TokenBucket) idleAt(now time.Time) bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.refill(now)
+	return b.tokens >= b.burst
+}
+
+// KeyedLimiter keeps a token bucket for every key, buckets of idle keys are removed periodically to bound the memory
+type KeyedLimiter struct {
+	mutex         sync.Mutex
+	rate          float64
+	burst         int
+	buckets       map[string]*TokenBucket
+	sweepInterval time.Duration
+	lastSweep     time.Time
+}
+
+func NewKeyedLimiter(rate float64, burst int) *KeyedLimiter {
+	return &KeyedLimiter{
+		rate:          rate,
+		burst:         burst,
+		buckets:       make(map[string]*TokenBucket),
+		sweepInterval: time.Minute,
+		lastSweep:     time.Now(),
+	}
+}
+
+func (l *KeyedLimiter) bucket(key string, now time.Time) *TokenBucket {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if now.Sub(l.lastSweep) >= l.sweepInterval {
+		for k, b := range l.buckets {
+			if b.idleAt(now) {
+				delete(l.buckets, k)
+			}
+		}
+		l.lastSweep = now
+	}
+	b, ok := l.buckets[key]
+	if !ok {
+		b = NewTokenBucket(l.rate, l.burst)
+		b.last = now
+		l.buckets[key] = b
+	}
+	return b
+}
+
+// AllowAt takes a token of key at time now, returns false and the time to wait for the next token if there's none
+func (l *KeyedLimiter) AllowAt(key string, now time.Time) (bool, time.Duration) {
+	return l.bucket(key, now).AllowAt(now)
+}
+
+func (l *KeyedLimiter) Allow(key string) (bool, time.Duration) {
+	return l.AllowAt(key, time.Now())
+}
+
+// Size returns the number of keys tracked
+func (l *KeyedLimiter) Size() int {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return len(l.buckets)
+}
@@ -0,0 +1,67 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Now()
+	bucket := NewTokenBucket(2, 3)
+	bucket.last = now
+	for i := 0; i < 3; i++ {
+		ok, _ := bucket.AllowAt(now)
+		require.True(t, ok)
+	}
+	ok, wait := bucket.AllowAt(now)
+	require.False(t, ok)
+	require.Equal(t, 500*time.Millisecond, wait)
+
+	ok, _ = bucket.AllowAt(now.Add(500 * time.Millisecond))
+	require.True(t, ok)
+	ok, _ = bucket.AllowAt(now.Add(500 * time.Millisecond))
+	require.False(t, ok)
+
+	// tokens never exceed burst
+	for i := 0; i < 3; i++ {
+		ok, _ = bucket.AllowAt(now.Add(time.Hour))
+		require.True(t, ok)
+	}
+	ok, _ = bucket.AllowAt(now.Add(time.Hour))
+	require.False(t, ok)
+}
+
+func TestKeyedLimiter(t *testing.T) {
+	now := time.Now()
+	limiter := NewKeyedLimiter(1, 1)
+	ok, _ := limiter.AllowAt("a", now)
+	require.True(t, ok)
+	ok, _ = limiter.AllowAt("a", now)
+	require.False(t, ok)
+	ok, _ = limiter.AllowAt("b", now)
+	require.True(t, ok)
+	require.Equal(t, 2, limiter.Size())
+
+	// idle buckets are removed
+	ok, _ = limiter.AllowAt("c", now.Add(2*time.Minute))
+	require.True(t, ok)
+	require.Equal(t, 1, limiter.Size())
+}
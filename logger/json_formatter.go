@@ -0,0 +1,48 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package logger
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+// FIELD_TRACE_ID is the key of trace id in json logs
+const FIELD_TRACE_ID = "trace_id"
+
+// JsonFormatter formats logs into json objects, one per line, with the trace id in context as field trace_id
+type JsonFormatter struct {
+	logrus.JSONFormatter
+}
+
+// Format renders a single log entry
+func (f *JsonFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	if entry.Context == nil {
+		return f.JSONFormatter.Format(entry)
+	}
+	traceId, ok := entry.Context.Value(TraceIdKey{}).(string)
+	if !ok {
+		return f.JSONFormatter.Format(entry)
+	}
+	// entry.Data may be shared with the entry it derives from, format a copy
+	withTraceId := *entry
+	withTraceId.Data = make(logrus.Fields, len(entry.Data)+1)
+	for k, v := range entry.Data {
+		withTraceId.Data[k] = v
+	}
+	withTraceId.Data[FIELD_TRACE_ID] = traceId
+	return f.JSONFormatter.Format(&withTraceId)
+}
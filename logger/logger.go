@@ -29,6 +29,12 @@ import (
 const defaultTimestampFormat = "2006-01-02T15:04:05.99999-07:00"
 const INIT_TRACEID = "0000000000000000"
 
+// log formats
+const (
+	FORMAT_TEXT = "text"
+	FORMAT_JSON = "json"
+)
+
 var textFormatter = &TextFormatter{
 	TimestampFormat:        "2006-01-02T15:04:05.99999-07:00", // log timestamp format
 	FullTimestamp:          true,
@@ -37,29 +43,40 @@ var textFormatter = &TextFormatter{
 		"WARNING": "WARN", // log level string, use WARN
 	},
 	// log caller, filename:line callFunction
-	CallerPrettyfier: func(frame *runtime.Frame) (string, string) {
-		n := 0
-		filename := frame.File
-		// 获取包名
-		for i := len(filename) - 1; i > 0; i-- {
-			if filename[i] == '/' {
-				n++
-				if n >= 2 {
-					filename = filename[i+1:]
-					break
-				}
+	CallerPrettyfier: prettyCaller,
+}
+
+var jsonFormatter = &JsonFormatter{
+	JSONFormatter: logrus.JSONFormatter{
+		TimestampFormat:  defaultTimestampFormat,
+		CallerPrettyfier: prettyCaller,
+	},
+}
+
+// prettyCaller returns the function name and filename:line of the caller, filename is kept with its package directory
+func prettyCaller(frame *runtime.Frame) (string, string) {
+	n := 0
+	filename := frame.File
+	// 获取包名
+	for i := len(filename) - 1; i > 0; i-- {
+		if filename[i] == '/' {
+			n++
+			if n >= 2 {
+				filename = filename[i+1:]
+				break
 			}
 		}
+	}
 
-		name := frame.Function
-		idx := strings.LastIndex(name, ".")
-		return name[idx+1:], fmt.Sprintf("%s:%d", filename, frame.Line)
-	},
+	name := frame.Function
+	idx := strings.LastIndex(name, ".")
+	return name[idx+1:], fmt.Sprintf("%s:%d", filename, frame.Line)
 }
 
 type LoggerConfig struct {
 	Output     io.Writer
 	Level      string `yaml:"level"`
+	Format     string `yaml:"format"`
 	Filename   string `yaml:"filename"`
 	MaxSize    int    `yaml:"maxsize"`
 	MaxAge     int    `yaml:"maxage"`
@@ -90,8 +107,15 @@ func InitLogger(config LoggerConfig) *logrus.Logger {
 	}
 	logger.SetLevel(level)
 
-	// log format
-	logger.SetFormatter(textFormatter)
+	// log format, text by default
+	switch config.Format {
+	case "", FORMAT_TEXT:
+		logger.SetFormatter(textFormatter)
+	case FORMAT_JSON:
+		logger.SetFormatter(jsonFormatter)
+	default:
+		panic(fmt.Sprintf("unsupported log format: %s", config.Format))
+	}
 	logger.SetReportCaller(true)
 
 	return logger
@@ -19,8 +19,11 @@ package logger
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/sirupsen/logrus"
@@ -60,7 +63,7 @@ func TestLogFile(t *testing.T) {
 	InitLogger(LoggerConfig{
 		Output:     nil,
 		Level:      "debug",
-		Filename:   "../tests/test.log",
+		Filename:   filepath.Join(t.TempDir(), "test.log"),
 		MaxSize:    10, // 10M
 		MaxAge:     3,  // 3days
 		MaxBackups: 3,
@@ -72,3 +75,39 @@ func TestLogFile(t *testing.T) {
 	logrus.Debugf("debug-log-%d", 1)
 	logrus.WithField("field-key-1", "field-val-1").Infof("info-log-%d", 1)
 }
+
+func TestJsonFormat(t *testing.T) {
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	logger := InitLogger(LoggerConfig{
+		Output: buf,
+		Level:  "info",
+		Format: FORMAT_JSON,
+	})
+	defer logger.SetFormatter(textFormatter)
+
+	ctx := context.WithValue(context.Background(), TraceIdKey{}, "TRACE-ID")
+	entry := logger.WithContext(ctx).WithField("action", "ObRootServiceInfo")
+	entry.Infof("info-log-%d", 1)
+	if _, ok := entry.Data[FIELD_TRACE_ID]; ok {
+		t.Fatalf("trace id should not be added to fields of the entry")
+	}
+
+	record := make(map[string]interface{})
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("log should be a json object: %v, %s", err, buf.String())
+	}
+	expected := map[string]interface{}{
+		"level":        "info",
+		"msg":          "info-log-1",
+		"action":       "ObRootServiceInfo",
+		FIELD_TRACE_ID: "TRACE-ID",
+	}
+	for k, v := range expected {
+		if record[k] != v {
+			t.Errorf("expect %s to be %v, got %v", k, v, record[k])
+		}
+	}
+	if file, _ := record["file"].(string); !strings.HasPrefix(file, "logger/logger_test.go:") {
+		t.Errorf("unexpected caller file %v", record["file"])
+	}
+}
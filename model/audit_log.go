@@ -0,0 +1,50 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"time"
+)
+
+// AuditLogInfo records a change of an entity, Entity is one of ObCluster, ObClusterGroup, ObProxyGroup, ObProxy and WebhookDelivery.
+// The entity before and after the change is in the fields of its kind, Before and After for ObCluster, ObProxyGroupBefore and ObProxyGroupAfter
+// for ObProxyGroup and so on, the one before is nil for a newly created entity and the one after is nil for a purged or deleted one
+type AuditLogInfo struct {
+	Id                    int                  `json:"Id"`
+	Time                  time.Time            `json:"Time"`
+	Action                string               `json:"Action"`
+	Operation             string               `json:"Operation"`
+	Entity                string               `json:"Entity"`
+	EntityName            string               `json:"EntityName,omitempty"`
+	ObCluster             string               `json:"ObCluster"`
+	ObClusterId           int64                `json:"ObClusterId"`
+	ObProxyGroup          string               `json:"ObProxyGroup,omitempty"`
+	RemoteIp              string               `json:"RemoteIp"`
+	Principal             string               `json:"Principal"`
+	UserAgent             string               `json:"UserAgent"`
+	TraceId               string               `json:"TraceId"`
+	Before                *ObRootServiceInfo   `json:"Before"`
+	After                 *ObRootServiceInfo   `json:"After"`
+	ObClusterGroupBefore  *ObClusterGroupInfo  `json:"ObClusterGroupBefore,omitempty"`
+	ObClusterGroupAfter   *ObClusterGroupInfo  `json:"ObClusterGroupAfter,omitempty"`
+	ObProxyGroupBefore    *ObProxyGroup        `json:"ObProxyGroupBefore,omitempty"`
+	ObProxyGroupAfter     *ObProxyGroup        `json:"ObProxyGroupAfter,omitempty"`
+	ObProxyBefore         *ObProxyInfo         `json:"ObProxyBefore,omitempty"`
+	ObProxyAfter          *ObProxyInfo         `json:"ObProxyAfter,omitempty"`
+	WebhookDeliveryBefore *WebhookDeliveryInfo `json:"WebhookDeliveryBefore,omitempty"`
+	WebhookDeliveryAfter  *WebhookDeliveryInfo `json:"WebhookDeliveryAfter,omitempty"`
+}
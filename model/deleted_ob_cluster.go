@@ -0,0 +1,31 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"time"
+)
+
+// DeletedObCluster is a cluster in recycle bin, it can be restored before PurgeTime
+type DeletedObCluster struct {
+	ObCluster       string             `json:"ObCluster"`
+	ObClusterId     int64              `json:"ObClusterId"`
+	Type            string             `json:"Type"`
+	DeleteTime      time.Time          `json:"DeleteTime"`
+	PurgeTime       time.Time          `json:"PurgeTime"`
+	RootServiceInfo *ObRootServiceInfo `json:"RootServiceInfo"`
+}
@@ -0,0 +1,29 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+type ObClusterGroupInfo struct {
+	ObCluster        string                  `json:"ObCluster"`
+	PrimaryClusterId int64                   `json:"PrimaryClusterId"`
+	Clusters         []*ObClusterGroupMember `json:"Clusters"`
+}
+
+type ObClusterGroupMember struct {
+	ObClusterId int64  `json:"ObClusterId"`
+	Type        string `json:"Type"`
+	TimeStamp   int64  `json:"timestamp"`
+}
@@ -0,0 +1,35 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+// ObRootServiceInfoBatch is a list of rootservice info registered together,
+// with Atomic all of them are applied or none of them is applied
+type ObRootServiceInfoBatch struct {
+	Atomic   bool                 `json:"Atomic"`
+	Clusters []*ObRootServiceInfo `json:"Clusters"`
+}
+
+// ObRootServiceInfoResult is the result of a rootservice info in batch, in the same order as the request
+type ObRootServiceInfoResult struct {
+	ObCluster   string           `json:"ObCluster"`
+	ObClusterId int64            `json:"ObClusterId"`
+	Success     bool             `json:"Success"`
+	Created     bool             `json:"Created"`
+	ErrorCode   string           `json:"ErrorCode,omitempty"`
+	Message     string           `json:"Message,omitempty"`
+	Errors      ValidationErrors `json:"Errors,omitempty"`
+}
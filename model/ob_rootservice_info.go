@@ -16,6 +16,17 @@
 
 package model
 
+import (
+	"net"
+	"strconv"
+	"strings"
+)
+
+const (
+	OB_CLUSTER_TYPE_PRIMARY = "PRIMARY"
+	OB_CLUSTER_TYPE_STANDBY = "STANDBY"
+)
+
 type ObRootServiceInfo struct {
 	ObClusterId    int64           `json:"ObClusterId"`
 	ObRegionId     int64           `json:"ObRegionId"`
@@ -48,3 +59,21 @@ func (r *ObRootServiceInfo) Fill() {
 		r.ObClusterId = r.ObRegionId
 	}
 }
+
+// SqlAddress returns the address with sql port clients connect to, the address is returned as is without sql port
+func (s *ObServerInfo) SqlAddress() string {
+	host, _, err := net.SplitHostPort(s.Address)
+	if err != nil || s.SqlPort <= 0 {
+		return s.Address
+	}
+	return net.JoinHostPort(host, strconv.Itoa(s.SqlPort))
+}
+
+// RsListString returns sql addresses of rootservers in the form ip:port;ip:port, as obproxy accepts with -r
+func (r *ObRootServiceInfo) RsListString() string {
+	addresses := make([]string, 0, len(r.RsList))
+	for _, server := range r.RsList {
+		addresses = append(addresses, server.SqlAddress())
+	}
+	return strings.Join(addresses, ";")
+}
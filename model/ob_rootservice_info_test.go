@@ -45,3 +45,15 @@ func TestFillObCluster(t *testing.T) {
 	require.Equal(t, int64(1), info.ObClusterId)
 	require.Equal(t, "helloworld", info.ObCluster)
 }
+
+func TestRsListString(t *testing.T) {
+	info := &ObRootServiceInfo{
+		RsList: []*ObServerInfo{
+			{Address: "1.1.1.1:2882", SqlPort: 2881},
+			{Address: "1.1.1.2:2882", SqlPort: 2881},
+			{Address: "1.1.1.3:2882"},
+		},
+	}
+	require.Equal(t, "1.1.1.1:2881;1.1.1.2:2881;1.1.1.3:2882", info.RsListString())
+	require.Equal(t, "", (&ObRootServiceInfo{}).RsListString())
+}
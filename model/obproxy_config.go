@@ -27,11 +27,13 @@ import (
 
 const (
 	OBPROXY_BIN_URL_FORMAT        = "%s/client?Action=GetObProxy"
+	META_DATABASE_URL_FORMAT      = "%s/services?Action=ObRootServiceInfo&ObCluster=%s"
 	CONFIG_URL_FORMAT_TEMPLATE_V1 = "%s/services?Action=ObRootServiceInfo&ObRegion=${ObRegion}"
 	CONFIG_URL_FORMAT_TEMPLATE_V2 = "%s/services?Action=ObRootServiceInfo&version=2&ObCluster=${ObCluster}&ObClusterId=${OBClusterId}"
 )
 
 type ObProxyConfig struct {
+	ObProxyGroup  string                `json:"ObProxyGroup,omitempty"`
 	ObProxyBinUrl string                `json:"ObProxyBinUrl"`
 	MetaDatabase  *MetaDatabaseInfo     `json:"ObProxyDatabaseInfo"`
 	ConfigUrlList []*RootServiceInfoUrl `json:"ObRootServiceInfoUrlList"`
@@ -39,6 +41,7 @@ type ObProxyConfig struct {
 }
 
 type ObProxyConfigWithTemplate struct {
+	ObProxyGroup  string            `json:"ObProxyGroup,omitempty"`
 	ObProxyBinUrl string            `json:"ObProxyBinUrl"`
 	MetaDatabase  *MetaDatabaseInfo `json:"ObProxyDatabaseInfo"`
 	Version       string            `json:"Version"`
@@ -63,6 +66,7 @@ type RootServiceInfoUrl struct {
 	Url       string `json:"ObRootServiceInfoUrl"`
 }
 
+// NewDefaultMetaDatabaseInfo returns the placeholder meta database info for obproxy when meta database is not configured
 func NewDefaultMetaDatabaseInfo(serviceAddress string) *MetaDatabaseInfo {
 	return &MetaDatabaseInfo{
 		Database:  "***",
@@ -72,15 +76,26 @@ func NewDefaultMetaDatabaseInfo(serviceAddress string) *MetaDatabaseInfo {
 	}
 }
 
+// NewMetaDatabaseInfo returns the meta database info for obproxy, rootservice info of the meta cluster is served by configserver
+func NewMetaDatabaseInfo(serviceAddress, obCluster, database, user, password string) *MetaDatabaseInfo {
+	return &MetaDatabaseInfo{
+		Database:  database,
+		User:      user,
+		Password:  password,
+		ConfigUrl: fmt.Sprintf(META_DATABASE_URL_FORMAT, serviceAddress, obCluster),
+	}
+}
+
 func NewObProxyConfigVersionOnly(version string) *ObProxyConfigVersionOnly {
 	return &ObProxyConfigVersionOnly{
 		Version: version,
 	}
 }
 
-func NewObProxyConfig(serviceAddress string, configUrlList []*RootServiceInfoUrl) (*ObProxyConfig, error) {
+// NewObProxyConfig returns the config for obproxy in group, group is empty if the obproxy is not in any group,
+// the version is calculated with group so each group has its own version
+func NewObProxyConfig(serviceAddress, group string, metaDatabaseInfo *MetaDatabaseInfo, configUrlList []*RootServiceInfoUrl) (*ObProxyConfig, error) {
 	obProxyBinUrl := fmt.Sprintf(OBPROXY_BIN_URL_FORMAT, serviceAddress)
-	metaDatabaseInfo := NewDefaultMetaDatabaseInfo(serviceAddress)
 	metaJson, err := json.Marshal(metaDatabaseInfo)
 	if err != nil {
 		return nil, errors.Wrap(err, "encode obproxy metadb")
@@ -89,21 +104,23 @@ func NewObProxyConfig(serviceAddress string, configUrlList []*RootServiceInfoUrl
 	if err != nil {
 		return nil, errors.Wrap(err, "encode config urls")
 	}
-	strForMd5 := string(configUrlJson) + string(metaJson) + obProxyBinUrl
+	strForMd5 := string(configUrlJson) + string(metaJson) + obProxyBinUrl + group
 	h := md5.New()
 	h.Write([]byte(strForMd5))
 	version := hex.EncodeToString(h.Sum(nil))
 	return &ObProxyConfig{
+		ObProxyGroup:  group,
 		ObProxyBinUrl: obProxyBinUrl,
-		MetaDatabase:  NewDefaultMetaDatabaseInfo(serviceAddress),
+		MetaDatabase:  metaDatabaseInfo,
 		ConfigUrlList: configUrlList,
 		Version:       version,
 	}, nil
 }
 
-func NewObProxyConfigWithTemplate(serviceAddress string, clusterNames []string) (*ObProxyConfigWithTemplate, error) {
+// NewObProxyConfigWithTemplate returns the config with url templates for obproxy in group,
+// templates default to the ones of configserver if not specified
+func NewObProxyConfigWithTemplate(serviceAddress, group string, metaDatabaseInfo *MetaDatabaseInfo, clusterNames []string, templateStrV1, templateStrV2 string) (*ObProxyConfigWithTemplate, error) {
 	obProxyBinUrl := fmt.Sprintf(OBPROXY_BIN_URL_FORMAT, serviceAddress)
-	metaDatabaseInfo := NewDefaultMetaDatabaseInfo(serviceAddress)
 	metaJson, err := json.Marshal(metaDatabaseInfo)
 	if err != nil {
 		return nil, errors.Wrap(err, "encode obproxy metadb")
@@ -114,25 +131,30 @@ func NewObProxyConfigWithTemplate(serviceAddress string, clusterNames []string)
 		return nil, errors.Wrap(err, "encode cluster names")
 	}
 
-	templateStrV1 := fmt.Sprintf(CONFIG_URL_FORMAT_TEMPLATE_V1, serviceAddress)
+	if templateStrV1 == "" {
+		templateStrV1 = fmt.Sprintf(CONFIG_URL_FORMAT_TEMPLATE_V1, serviceAddress)
+	}
 	templateV1Json, err := json.Marshal(templateStrV1)
 	if err != nil {
 		return nil, errors.Wrap(err, "encode config url template v1")
 	}
 
-	templateStrV2 := fmt.Sprintf(CONFIG_URL_FORMAT_TEMPLATE_V2, serviceAddress)
+	if templateStrV2 == "" {
+		templateStrV2 = fmt.Sprintf(CONFIG_URL_FORMAT_TEMPLATE_V2, serviceAddress)
+	}
 	templateV2Json, err := json.Marshal(templateStrV2)
 	if err != nil {
 		return nil, errors.Wrap(err, "encode config url template v2")
 	}
 
-	strForMd5 := string(clusterNamesJson) + string(templateV1Json) + string(templateV2Json) + string(metaJson) + obProxyBinUrl
+	strForMd5 := string(clusterNamesJson) + string(templateV1Json) + string(templateV2Json) + string(metaJson) + obProxyBinUrl + group
 	h := md5.New()
 	h.Write([]byte(strForMd5))
 	version := hex.EncodeToString(h.Sum(nil))
 	return &ObProxyConfigWithTemplate{
+		ObProxyGroup:  group,
 		ObProxyBinUrl: obProxyBinUrl,
-		MetaDatabase:  NewDefaultMetaDatabaseInfo(serviceAddress),
+		MetaDatabase:  metaDatabaseInfo,
 		ObClusters:    clusterNames,
 		TemplateV1:    templateStrV1,
 		TemplateV2:    templateStrV2,
@@ -0,0 +1,81 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var obProxyGroupNamePattern = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+// ObProxyGroup is a group of obproxy deployments only seeing ObClusters, the group of a request is selected by
+// parameter ObProxyGroup, then the auth user in Users, then the client ip in Clients (ip or CIDR)
+type ObProxyGroup struct {
+	Name          string                    `json:"Name"`
+	ObClusters    []string                  `json:"ObClusters"`
+	Clients       []string                  `json:"Clients"`
+	Users         []string                  `json:"Users"`
+	MetaDatabase  *ObProxyGroupMetaDatabase `json:"MetaDatabase,omitempty"`
+	UrlTemplateV1 string                    `json:"ObRootServiceInfoUrlTemplate,omitempty"`
+	UrlTemplateV2 string                    `json:"ObRootServiceInfoUrlTemplateV2,omitempty"`
+	CreateTime    time.Time                 `json:"CreateTime"`
+	UpdateTime    time.Time                 `json:"UpdateTime"`
+}
+
+// ObProxyGroupMetaDatabase overrides the meta database of the namespace, empty fields are inherited,
+// Password is only accepted on save and never returned, an empty one keeps the saved password
+type ObProxyGroupMetaDatabase struct {
+	ObCluster string `json:"ObCluster"`
+	Database  string `json:"Database"`
+	User      string `json:"User"`
+	Password  string `json:"Password,omitempty"`
+}
+
+// Validate checks the proxy group and returns all the problems found, nil if it's valid
+func (g *ObProxyGroup) Validate() ValidationErrors {
+	var errs ValidationErrors
+	if !obProxyGroupNamePattern.MatchString(g.Name) {
+		errs.add("Name", "name %s should be 1 to 64 letters, digits, '_' or '-'", g.Name)
+	}
+	if len(g.ObClusters) == 0 {
+		errs.add("ObClusters", "ob clusters should not be empty")
+	}
+	for i, cluster := range g.ObClusters {
+		if cluster == "" {
+			errs.add(fmt.Sprintf("ObClusters[%d]", i), "ob cluster name is required")
+		}
+	}
+	for i, client := range g.Clients {
+		if strings.Contains(client, "/") {
+			if _, _, err := net.ParseCIDR(client); err != nil {
+				errs.add(fmt.Sprintf("Clients[%d]", i), "%s is not a valid CIDR", client)
+			}
+		} else if net.ParseIP(client) == nil {
+			errs.add(fmt.Sprintf("Clients[%d]", i), "%s is not a valid ip address", client)
+		}
+	}
+	for i, user := range g.Users {
+		if user == "" {
+			errs.add(fmt.Sprintf("Users[%d]", i), "user name is required")
+		}
+	}
+	return errs
+}
@@ -0,0 +1,73 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"time"
+)
+
+const MAX_OBPROXY_NAME_LENGTH = 128
+
+// ObProxyHeartbeat is reported by obproxy or its sidecar periodically, Name identifies the obproxy and defaults to Address,
+// Version is the version of obproxy and ConfigVersion is the Version of obproxy config currently applied
+type ObProxyHeartbeat struct {
+	Name          string `json:"Name"`
+	Address       string `json:"Address"`
+	Version       string `json:"Version"`
+	ConfigVersion string `json:"ConfigVersion"`
+}
+
+// ObProxyHeartbeatResult tells obproxy the current config version of its group, Lagging means it should reload the config
+type ObProxyHeartbeatResult struct {
+	ObProxyGroup  string `json:"ObProxyGroup"`
+	ConfigVersion string `json:"ConfigVersion"`
+	Lagging       bool   `json:"Lagging"`
+}
+
+// ObProxyInfo is an obproxy reported by heartbeat, Alive means it's seen recently,
+// Lagging means the config version it runs is not the current one of its group
+type ObProxyInfo struct {
+	Name                 string    `json:"Name"`
+	Address              string    `json:"Address"`
+	Version              string    `json:"Version"`
+	ObProxyGroup         string    `json:"ObProxyGroup"`
+	ConfigVersion        string    `json:"ConfigVersion"`
+	CurrentConfigVersion string    `json:"CurrentConfigVersion"`
+	FirstSeenTime        time.Time `json:"FirstSeenTime"`
+	LastSeenTime         time.Time `json:"LastSeenTime"`
+	Alive                bool      `json:"Alive"`
+	Lagging              bool      `json:"Lagging"`
+}
+
+// Fill sets name to address if not specified
+func (h *ObProxyHeartbeat) Fill() {
+	if h.Name == "" {
+		h.Name = h.Address
+	}
+}
+
+// Validate checks the heartbeat and returns all the problems found, nil if it's valid
+func (h *ObProxyHeartbeat) Validate() ValidationErrors {
+	var errs ValidationErrors
+	if _, err := parseServerAddress(h.Address); err != nil {
+		errs.add("Address", "%v", err)
+	}
+	if len(h.Name) > MAX_OBPROXY_NAME_LENGTH {
+		errs.add("Name", "name should be at most %d characters", MAX_OBPROXY_NAME_LENGTH)
+	}
+	return errs
+}
@@ -0,0 +1,31 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"time"
+)
+
+// ObProxyPackage is an obproxy binary of a version and architecture in the repository
+type ObProxyPackage struct {
+	Version    string    `json:"Version"`
+	Arch       string    `json:"Arch"`
+	Size       int64     `json:"Size"`
+	Sha256     string    `json:"Sha256"`
+	ModifyTime time.Time `json:"ModifyTime"`
+	Url        string    `json:"Url"`
+}
@@ -0,0 +1,134 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+const (
+	OB_SERVER_ROLE_LEADER   = "LEADER"
+	OB_SERVER_ROLE_FOLLOWER = "FOLLOWER"
+
+	MIN_PORT = 1
+	MAX_PORT = 65535
+)
+
+// FieldError describes a problem of a field, Field is the path of the field in json format, like RsList[0].address
+type FieldError struct {
+	Field   string `json:"Field"`
+	Message string `json:"Message"`
+}
+
+type ValidationErrors []*FieldError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, fieldError := range e {
+		messages = append(messages, fmt.Sprintf("%s: %s", fieldError.Field, fieldError.Message))
+	}
+	return strings.Join(messages, "; ")
+}
+
+func (e *ValidationErrors) add(field string, format string, args ...interface{}) {
+	*e = append(*e, &FieldError{
+		Field:   field,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func isValidPort(port int) bool {
+	return port >= MIN_PORT && port <= MAX_PORT
+}
+
+// parseServerAddress parses address in format ip:port, ipv6 address should be enclosed in square brackets,
+// returns the normalized address used to detect duplicate servers
+func parseServerAddress(address string) (string, error) {
+	host, portStr, err := net.SplitHostPort(address)
+	if err != nil {
+		return "", fmt.Errorf("address %s is not in ip:port format", address)
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return "", fmt.Errorf("%s in address %s is not a valid ipv4 or ipv6 address", host, address)
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil || !isValidPort(port) {
+		return "", fmt.Errorf("port %s in address %s should be in range [%d, %d]", portStr, address, MIN_PORT, MAX_PORT)
+	}
+	return net.JoinHostPort(ip.String(), strconv.Itoa(port)), nil
+}
+
+func validateServerList(field string, servers []*ObServerInfo, addresses map[string]string, errs *ValidationErrors) int {
+	leaderCount := 0
+	for i, server := range servers {
+		serverField := fmt.Sprintf("%s[%d]", field, i)
+		if server == nil {
+			errs.add(serverField, "server info is required")
+			continue
+		}
+		if normalized, err := parseServerAddress(server.Address); err != nil {
+			errs.add(serverField+".address", "%v", err)
+		} else if duplicated, ok := addresses[normalized]; ok {
+			errs.add(serverField+".address", "address %s is duplicated with %s", server.Address, duplicated)
+		} else {
+			addresses[normalized] = serverField
+		}
+		switch server.Role {
+		case OB_SERVER_ROLE_LEADER:
+			leaderCount++
+		case OB_SERVER_ROLE_FOLLOWER:
+		default:
+			errs.add(serverField+".role", "role %s should be one of %s, %s", server.Role, OB_SERVER_ROLE_LEADER, OB_SERVER_ROLE_FOLLOWER)
+		}
+		if !isValidPort(server.SqlPort) {
+			errs.add(serverField+".sql_port", "sql port %d should be in range [%d, %d]", server.SqlPort, MIN_PORT, MAX_PORT)
+		}
+	}
+	return leaderCount
+}
+
+// Validate checks the rootservice info semantically and returns all the problems found, nil if it's valid
+func (r *ObRootServiceInfo) Validate() ValidationErrors {
+	var errs ValidationErrors
+	if len(r.ObCluster) == 0 {
+		errs.add("ObCluster", "ob cluster name is required")
+	}
+	if r.ObClusterId <= 0 {
+		errs.add("ObClusterId", "ob cluster id should be positive")
+	}
+	switch r.Type {
+	case "", OB_CLUSTER_TYPE_PRIMARY, OB_CLUSTER_TYPE_STANDBY:
+	default:
+		errs.add("Type", "type %s should be one of %s, %s", r.Type, OB_CLUSTER_TYPE_PRIMARY, OB_CLUSTER_TYPE_STANDBY)
+	}
+
+	addresses := make(map[string]string)
+	if len(r.RsList) == 0 {
+		errs.add("RsList", "rs list should not be empty")
+	} else {
+		leaderCount := validateServerList("RsList", r.RsList, addresses, &errs)
+		if leaderCount != 1 {
+			errs.add("RsList", "rs list should contain exactly one %s, got %d", OB_SERVER_ROLE_LEADER, leaderCount)
+		}
+	}
+	validateServerList("ReadonlyRsList", r.ReadonlyRsList, addresses, &errs)
+	return errs
+}
@@ -0,0 +1,85 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newValidRootServiceInfo() *ObRootServiceInfo {
+	return &ObRootServiceInfo{
+		ObCluster:   "c1",
+		ObClusterId: 1,
+		Type:        OB_CLUSTER_TYPE_PRIMARY,
+		RsList: []*ObServerInfo{
+			{Address: "1.1.1.1:2882", Role: OB_SERVER_ROLE_LEADER, SqlPort: 2881},
+			{Address: "[::1]:2882", Role: OB_SERVER_ROLE_FOLLOWER, SqlPort: 2881},
+		},
+	}
+}
+
+func fieldsOf(errs ValidationErrors) []string {
+	fields := make([]string, 0, len(errs))
+	for _, err := range errs {
+		fields = append(fields, err.Field)
+	}
+	return fields
+}
+
+func TestValidateRootServiceInfo(t *testing.T) {
+	require.Nil(t, newValidRootServiceInfo().Validate())
+}
+
+func TestValidateEmptyRsList(t *testing.T) {
+	info := newValidRootServiceInfo()
+	info.RsList = nil
+	info.Type = "UNKNOWN"
+	info.ObClusterId = 0
+	require.Equal(t, []string{"ObClusterId", "Type", "RsList"}, fieldsOf(info.Validate()))
+}
+
+func TestValidateServers(t *testing.T) {
+	info := newValidRootServiceInfo()
+	info.RsList = []*ObServerInfo{
+		{Address: "1.1.1.1", Role: OB_SERVER_ROLE_LEADER, SqlPort: 2881},
+		{Address: "1.1.1.2:2882", Role: "LEARNER", SqlPort: 0},
+		{Address: "1.1.1.2:2882", Role: OB_SERVER_ROLE_LEADER, SqlPort: 2881},
+		{Address: "host:2882", Role: OB_SERVER_ROLE_FOLLOWER, SqlPort: 2881},
+		{Address: "1.1.1.3:70000", Role: OB_SERVER_ROLE_FOLLOWER, SqlPort: 2881},
+	}
+	errs := info.Validate()
+	require.Equal(t, []string{
+		"RsList[0].address",
+		"RsList[1].role",
+		"RsList[1].sql_port",
+		"RsList[2].address",
+		"RsList[3].address",
+		"RsList[4].address",
+		"RsList",
+	}, fieldsOf(errs))
+	require.Contains(t, errs.Error(), "exactly one LEADER, got 2")
+}
+
+func TestValidateDuplicateAcrossLists(t *testing.T) {
+	info := newValidRootServiceInfo()
+	info.ReadonlyRsList = []*ObServerInfo{
+		{Address: "[0:0:0:0:0:0:0:1]:2882", Role: OB_SERVER_ROLE_FOLLOWER, SqlPort: 2881},
+	}
+	require.Equal(t, []string{"ReadonlyRsList[0].address"}, fieldsOf(info.Validate()))
+}
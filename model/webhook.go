@@ -0,0 +1,51 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"time"
+)
+
+// WebhookEvent is the payload sent to webhook subscribers
+type WebhookEvent struct {
+	Event                    string             `json:"Event"`
+	Time                     time.Time          `json:"Time"`
+	Namespace                string             `json:"Namespace"`
+	ObCluster                string             `json:"ObCluster"`
+	ObClusterId              int64              `json:"ObClusterId,omitempty"`
+	PrimaryClusterId         int64              `json:"PrimaryClusterId,omitempty"`
+	PreviousPrimaryClusterId int64              `json:"PreviousPrimaryClusterId,omitempty"`
+	Before                   *ObRootServiceInfo `json:"Before,omitempty"`
+	After                    *ObRootServiceInfo `json:"After,omitempty"`
+}
+
+// WebhookDeliveryInfo is the delivery status of an event to a subscriber
+type WebhookDeliveryInfo struct {
+	Id              int       `json:"Id"`
+	Subscriber      string    `json:"Subscriber"`
+	Url             string    `json:"Url"`
+	Event           string    `json:"Event"`
+	ObCluster       string    `json:"ObCluster"`
+	ObClusterId     int64     `json:"ObClusterId"`
+	Status          string    `json:"Status"`
+	Attempts        int       `json:"Attempts"`
+	NextAttemptTime time.Time `json:"NextAttemptTime"`
+	LastStatusCode  int       `json:"LastStatusCode"`
+	LastError       string    `json:"LastError"`
+	CreateTime      time.Time `json:"CreateTime"`
+	UpdateTime      time.Time `json:"UpdateTime"`
+}
@@ -0,0 +1,188 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/oceanbase/configserver/config"
+)
+
+// access log formats, combined is the format of apache and nginx
+const (
+	ACCESS_LOG_FORMAT_COMBINED = "combined"
+	ACCESS_LOG_FORMAT_JSON     = "json"
+)
+
+const (
+	COMBINED_TIME_FORMAT = "02/Jan/2006:15:04:05 -0700"
+	// traceIdKey keeps the trace id of the request in gin context for the access log
+	traceIdKey = "configserver/trace_id"
+)
+
+// fields of access logs in json format besides request log fields
+const (
+	LOG_FIELD_TIME       = "time"
+	LOG_FIELD_URI        = "uri"
+	LOG_FIELD_PROTO      = "proto"
+	LOG_FIELD_SIZE       = "size"
+	LOG_FIELD_USER       = "user"
+	LOG_FIELD_REFERER    = "referer"
+	LOG_FIELD_USER_AGENT = "user_agent"
+	LOG_FIELD_TRACE_ID   = "trace_id"
+)
+
+func getAccessLogConfig() *config.AccessLogConfig {
+	if server := GetConfigServer(); server != nil && server.Config != nil {
+		return newAccessLogConfig(server.Config.AccessLog)
+	}
+	return newAccessLogConfig(nil)
+}
+
+// newAccessLogConfig returns a copy of the configured one with defaults filled
+func newAccessLogConfig(configured *config.AccessLogConfig) *config.AccessLogConfig {
+	accessLogConfig := &config.AccessLogConfig{}
+	if configured != nil {
+		*accessLogConfig = *configured
+	}
+	if accessLogConfig.Format == "" {
+		accessLogConfig.Format = ACCESS_LOG_FORMAT_COMBINED
+	}
+	return accessLogConfig
+}
+
+// accessLogger writes one line per request, lines are written by a single call to be complete when written concurrently
+type accessLogger struct {
+	writer     io.Writer
+	format     string
+	sampleRate float64
+}
+
+// newAccessLogger returns the access logger writing to the rotated file of conf, or nil if access log is disabled
+func newAccessLogger(conf *config.AccessLogConfig) (*accessLogger, error) {
+	if conf.Filename == "" {
+		return nil, nil
+	}
+	if conf.Format != ACCESS_LOG_FORMAT_COMBINED && conf.Format != ACCESS_LOG_FORMAT_JSON {
+		return nil, fmt.Errorf("unsupported access log format: %s", conf.Format)
+	}
+	return &accessLogger{
+		writer: &lumberjack.Logger{
+			Filename:   conf.Filename,
+			MaxSize:    conf.MaxSize,
+			MaxAge:     conf.MaxAge,
+			MaxBackups: conf.MaxBackups,
+			LocalTime:  conf.LocalTime,
+			Compress:   conf.Compress,
+		},
+		format:     conf.Format,
+		sampleRate: conf.SuccessfulReadSampleRate,
+	}, nil
+}
+
+// sampled returns whether the request is logged, only successful reads are sampled
+func (l *accessLogger) sampled(c *gin.Context) bool {
+	if l.sampleRate <= 0 || l.sampleRate >= 1 {
+		return true
+	}
+	if isWriteRequest(c) || c.Writer.Status() >= http.StatusBadRequest {
+		return true
+	}
+	return rand.Float64() < l.sampleRate
+}
+
+func (l *accessLogger) render(c *gin.Context, start time.Time) ([]byte, error) {
+	user := ""
+	if authUser := getAuthUser(c); authUser != nil {
+		user = authUser.Name
+	}
+	size := c.Writer.Size()
+	if size < 0 {
+		size = 0
+	}
+	if l.format == ACCESS_LOG_FORMAT_COMBINED {
+		sizeText := "-"
+		if size > 0 {
+			sizeText = fmt.Sprint(size)
+		}
+		if user == "" {
+			user = "-"
+		}
+		return []byte(fmt.Sprintf("%s - %s [%s] %q %d %s %q %q\n",
+			c.ClientIP(),
+			user,
+			start.Format(COMBINED_TIME_FORMAT),
+			c.Request.Method+" "+c.Request.URL.RequestURI()+" "+c.Request.Proto,
+			c.Writer.Status(),
+			sizeText,
+			c.Request.Referer(),
+			c.Request.UserAgent())), nil
+	}
+	fields := getRequestLogFields(c)
+	fields[LOG_FIELD_TIME] = start.Format(time.RFC3339Nano)
+	fields[LOG_FIELD_URI] = c.Request.URL.RequestURI()
+	fields[LOG_FIELD_PROTO] = c.Request.Proto
+	fields[LOG_FIELD_STATUS] = c.Writer.Status()
+	fields[LOG_FIELD_SIZE] = size
+	fields[LOG_FIELD_COST] = time.Since(start).Milliseconds()
+	fields[LOG_FIELD_REFERER] = c.Request.Referer()
+	fields[LOG_FIELD_USER_AGENT] = c.Request.UserAgent()
+	if user != "" {
+		fields[LOG_FIELD_USER] = user
+	}
+	if traceId := c.GetString(traceIdKey); traceId != "" {
+		fields[LOG_FIELD_TRACE_ID] = traceId
+	}
+	content, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	return append(content, '\n'), nil
+}
+
+// accessLogHandler writes access logs of requests after they are served, including the rejected ones,
+// it does nothing if logger is nil
+func accessLogHandler(logger *accessLogger) gin.HandlerFunc {
+	fn := func(c *gin.Context) {
+		if logger == nil {
+			c.Next()
+			return
+		}
+		start := time.Now()
+		c.Next()
+		if !logger.sampled(c) {
+			return
+		}
+		content, err := logger.render(c, start)
+		if err == nil {
+			_, err = logger.writer.Write(content)
+		}
+		if err != nil {
+			log.WithError(err).Warn("failed to write access log")
+		}
+	}
+	return fn
+}
@@ -0,0 +1,112 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oceanbase/configserver/config"
+)
+
+func accessLogTestRouter(logger *accessLogger) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(accessLogHandler(logger))
+	handler := handlerFunctionWrapper(func(ctxlog context.Context, c *gin.Context) *ApiResponse {
+		if c.Query("ObCluster") == "" {
+			return NewIllegalArgumentResponse(nil)
+		}
+		return NewSuccessResponse("successful")
+	})
+	r.GET("/services", handler)
+	r.POST("/services", handler)
+	return r
+}
+
+func accessLogTestRequest(r *gin.Engine, method string, url string) {
+	req, _ := http.NewRequest(method, url, nil)
+	req.RemoteAddr = "10.0.0.1:12345"
+	req.Header.Set("User-Agent", "obproxy")
+	r.ServeHTTP(httptest.NewRecorder(), req)
+}
+
+func TestNewAccessLogger(t *testing.T) {
+	logger, err := newAccessLogger(newAccessLogConfig(nil))
+	require.Nil(t, err)
+	require.Nil(t, logger)
+
+	_, err = newAccessLogger(newAccessLogConfig(&config.AccessLogConfig{Filename: "access.log", Format: "xml"}))
+	require.NotNil(t, err)
+
+	logger, err = newAccessLogger(newAccessLogConfig(&config.AccessLogConfig{Filename: "access.log"}))
+	require.Nil(t, err)
+	require.Equal(t, ACCESS_LOG_FORMAT_COMBINED, logger.format)
+}
+
+func TestAccessLogCombined(t *testing.T) {
+	var buf bytes.Buffer
+	r := accessLogTestRouter(&accessLogger{writer: &buf, format: ACCESS_LOG_FORMAT_COMBINED})
+	accessLogTestRequest(r, http.MethodGet, "/services?Action=ObRootServiceInfo&ObCluster=c1")
+	pattern := regexp.MustCompile(`^10\.0\.0\.1 - - \[[^\]]+\] "GET /services\?Action=ObRootServiceInfo&ObCluster=c1 HTTP/1\.1" 200 \d+ "" "obproxy"\n$`)
+	require.Regexp(t, pattern, buf.String())
+}
+
+func TestAccessLogJson(t *testing.T) {
+	var buf bytes.Buffer
+	r := accessLogTestRouter(&accessLogger{writer: &buf, format: ACCESS_LOG_FORMAT_JSON})
+	accessLogTestRequest(r, http.MethodGet, "/services?Action=ObRootServiceInfo&ObCluster=c1&ObClusterId=1")
+
+	record := make(map[string]interface{})
+	require.Nil(t, json.Unmarshal(buf.Bytes(), &record))
+	require.Equal(t, "10.0.0.1", record[LOG_FIELD_CLIENT_IP])
+	require.Equal(t, "GET", record[LOG_FIELD_METHOD])
+	require.Equal(t, "ObRootServiceInfo", record[LOG_FIELD_ACTION])
+	require.Equal(t, "c1", record[LOG_FIELD_OB_CLUSTER])
+	require.Equal(t, "1", record[LOG_FIELD_OB_CLUSTER_ID])
+	require.Equal(t, float64(http.StatusOK), record[LOG_FIELD_STATUS])
+	require.Equal(t, "obproxy", record[LOG_FIELD_USER_AGENT])
+	require.NotEmpty(t, record[LOG_FIELD_TRACE_ID])
+	require.Greater(t, record[LOG_FIELD_SIZE], float64(0))
+	require.NotContains(t, record, LOG_FIELD_USER)
+}
+
+func TestAccessLogSampling(t *testing.T) {
+	var buf bytes.Buffer
+	r := accessLogTestRouter(&accessLogger{writer: &buf, format: ACCESS_LOG_FORMAT_COMBINED, sampleRate: 0.000001})
+	for i := 0; i < 10; i++ {
+		accessLogTestRequest(r, http.MethodGet, "/services?Action=ObRootServiceInfo&ObCluster=c1")
+	}
+	require.Empty(t, buf.String())
+
+	// failed reads and writes are always logged
+	accessLogTestRequest(r, http.MethodGet, "/services?Action=ObRootServiceInfo")
+	accessLogTestRequest(r, http.MethodPost, "/services?Action=ObRootServiceInfo&ObCluster=c1")
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Equal(t, 2, len(lines))
+	require.Contains(t, lines[0], `"GET /services?Action=ObRootServiceInfo HTTP/1.1" 400`)
+	require.Contains(t, lines[1], `"POST /services?Action=ObRootServiceInfo&ObCluster=c1 HTTP/1.1" 200`)
+}
@@ -0,0 +1,153 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/oceanbase/configserver/config"
+)
+
+const (
+	ACL_READ  = "read"
+	ACL_WRITE = "write"
+	ACL_ADMIN = "admin"
+	// heartbeats of obproxies, checked by read acl if heartbeat acl is not configured
+	ACL_HEARTBEAT = "heartbeat"
+
+	DEBUG_PATH_PREFIX = "/debug/"
+)
+
+// actions for operating configserver itself, or changing clusters beyond what observers do, like role changes and purges
+var adminActions = map[string]bool{
+	"ListAuditLogs":         true,
+	"ListWebhookDeliveries": true,
+	"RetryWebhookDelivery":  true,
+	"SwitchoverObCluster":   true,
+	"FailoverObCluster":     true,
+	"RestoreObCluster":      true,
+	"PurgeObCluster":        true,
+}
+
+// actions whose writes are admin requests, reads of them are ordinary reads
+var adminWriteActions = map[string]bool{
+	"ObProxyGroup": true,
+}
+
+// acl holds allowed ip blocks of each request class, nil means all are allowed
+type acl struct {
+	rules map[string][]*net.IPNet
+}
+
+func newAcl(conf *config.AclConfig) (*acl, error) {
+	a := &acl{
+		rules: make(map[string][]*net.IPNet),
+	}
+	if conf == nil {
+		return a, nil
+	}
+	for class, addresses := range map[string][]string{
+		ACL_READ:      conf.Read,
+		ACL_WRITE:     conf.Write,
+		ACL_ADMIN:     conf.Admin,
+		ACL_HEARTBEAT: conf.Heartbeat,
+	} {
+		if len(addresses) == 0 {
+			continue
+		}
+		ipNets, err := parseIpNets(addresses)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse %s acl", class)
+		}
+		a.rules[class] = ipNets
+	}
+	if _, ok := a.rules[ACL_HEARTBEAT]; !ok {
+		if ipNets, ok := a.rules[ACL_READ]; ok {
+			a.rules[ACL_HEARTBEAT] = ipNets
+		}
+	}
+	return a, nil
+}
+
+// getRequestClass returns whether the request is an admin request, a heartbeat, a write or a read
+func getRequestClass(c *gin.Context) string {
+	action := c.Query("Action")
+	if strings.HasPrefix(c.Request.URL.Path, DEBUG_PATH_PREFIX) || adminActions[action] {
+		return ACL_ADMIN
+	}
+	if isHeartbeatRequest(c) {
+		return ACL_HEARTBEAT
+	}
+	if isWriteRequest(c) {
+		if adminWriteActions[action] {
+			return ACL_ADMIN
+		}
+		return ACL_WRITE
+	}
+	return ACL_READ
+}
+
+func (a *acl) allow(class, clientIp string) bool {
+	ipNets, ok := a.rules[class]
+	return !ok || containsIp(ipNets, clientIp)
+}
+
+func getAclConfig() *config.AclConfig {
+	if server := GetConfigServer(); server != nil && server.Config != nil {
+		return server.Config.Acl
+	}
+	return nil
+}
+
+// setTrustedProxies makes client ip taken from proxy headers only when the request comes from trusted proxies,
+// no proxy is trusted unless configured, otherwise any client is able to forge the ip used by acl, rate limit and so on
+func setTrustedProxies(r *gin.Engine, conf *config.AclConfig) error {
+	var trustedProxies []string
+	if conf != nil {
+		trustedProxies = conf.TrustedProxies
+	}
+	return errors.Wrap(r.SetTrustedProxies(trustedProxies), "set trusted proxies")
+}
+
+// aclHandler rejects requests from clients not allowed to send the class of request with 403
+func aclHandler(conf *config.AclConfig) gin.HandlerFunc {
+	a, err := newAcl(conf)
+	if err != nil {
+		log.WithError(err).Fatal("initialize acl")
+	}
+	fn := func(c *gin.Context) {
+		class := getRequestClass(c)
+		clientIp := c.ClientIP()
+		if a.allow(class, clientIp) {
+			c.Next()
+			return
+		}
+		handlerFunctionWrapper(func(ctxlog context.Context, c *gin.Context) *ApiResponse {
+			log.WithContext(ctxlog).Warnf("%s request %s from %s denied by acl", class, getRequestAction(c), clientIp)
+			return NewForbiddenResponse(errors.New(fmt.Sprintf("client %s is not allowed to send %s requests", clientIp, class)))
+		})(c)
+		c.Abort()
+	}
+	return gin.HandlerFunc(fn)
+}
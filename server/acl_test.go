@@ -0,0 +1,170 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oceanbase/configserver/config"
+)
+
+func newAclTestRouter(t *testing.T, conf *config.AclConfig) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	require.Nil(t, setTrustedProxies(r, conf))
+	r.Use(aclHandler(conf))
+	r.GET("/services", okHandler)
+	r.POST("/services", okHandler)
+	r.DELETE("/services", okHandler)
+	r.GET("/debug/vars", okHandler)
+	return r
+}
+
+func serveAclTestRequest(r *gin.Engine, method, url, remoteAddr, forwardedFor string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(method, url, nil)
+	req.RemoteAddr = remoteAddr
+	if forwardedFor != "" {
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+	}
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestAcl(t *testing.T) {
+	r := newAclTestRouter(t, &config.AclConfig{
+		Write: []string{"10.0.0.0/24"},
+		Admin: []string{"127.0.0.1"},
+	})
+
+	// reads are not limited
+	w := serveAclTestRequest(r, http.MethodGet, "/services?Action=ObRootServiceInfo", "10.1.0.1:1000", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	w = serveAclTestRequest(r, http.MethodPost, "/services?Action=GetObProxyConfig", "10.1.0.1:1000", "")
+	require.Equal(t, http.StatusOK, w.Code)
+
+	w = serveAclTestRequest(r, http.MethodPost, "/services?Action=ObRootServiceInfo", "10.0.0.1:1000", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	w = serveAclTestRequest(r, http.MethodDelete, "/services?Action=ObRootServiceInfo", "10.1.0.1:1000", "")
+	require.Equal(t, http.StatusForbidden, w.Code)
+	response := new(ApiResponse)
+	require.Nil(t, json.Unmarshal(w.Body.Bytes(), response))
+	require.Equal(t, ErrorCodeForbidden, response.ErrorCode)
+	require.NotEmpty(t, response.TraceId)
+
+	// admin requests are only allowed from admin acl
+	w = serveAclTestRequest(r, http.MethodGet, "/debug/vars", "10.0.0.1:1000", "")
+	require.Equal(t, http.StatusForbidden, w.Code)
+	w = serveAclTestRequest(r, http.MethodGet, "/services?Action=ListAuditLogs", "10.0.0.1:1000", "")
+	require.Equal(t, http.StatusForbidden, w.Code)
+	w = serveAclTestRequest(r, http.MethodGet, "/debug/vars", "127.0.0.1:1000", "")
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestAclAdminWrites(t *testing.T) {
+	r := newAclTestRouter(t, &config.AclConfig{
+		Write: []string{"10.0.0.0/24"},
+		Admin: []string{"127.0.0.1"},
+	})
+
+	adminRequests := []struct {
+		method string
+		action string
+	}{
+		{http.MethodPost, "SwitchoverObCluster"},
+		{http.MethodPost, "FailoverObCluster"},
+		{http.MethodPost, "RestoreObCluster"},
+		{http.MethodDelete, "PurgeObCluster"},
+		{http.MethodPost, "ObProxyGroup"},
+		{http.MethodDelete, "ObProxyGroup"},
+	}
+	for _, request := range adminRequests {
+		url := "/services?Action=" + request.action
+		w := serveAclTestRequest(r, request.method, url, "10.0.0.1:1000", "")
+		require.Equal(t, http.StatusForbidden, w.Code, "%s %s from write acl", request.method, request.action)
+		w = serveAclTestRequest(r, request.method, url, "127.0.0.1:1000", "")
+		require.Equal(t, http.StatusOK, w.Code, "%s %s from admin acl", request.method, request.action)
+	}
+
+	// reading obproxy group is an ordinary read
+	w := serveAclTestRequest(r, http.MethodGet, "/services?Action=ObProxyGroup", "10.1.0.1:1000", "")
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestAclHeartbeat(t *testing.T) {
+	// heartbeats are checked by read acl by default, obproxies don't need to be allowed to write
+	r := newAclTestRouter(t, &config.AclConfig{
+		Read:  []string{"10.1.0.0/24"},
+		Write: []string{"10.0.0.0/24"},
+	})
+	w := serveAclTestRequest(r, http.MethodPost, "/services?Action=ObProxyHeartbeat", "10.1.0.1:1000", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	w = serveAclTestRequest(r, http.MethodPost, "/services?Action=ObRootServiceInfo", "10.1.0.1:1000", "")
+	require.Equal(t, http.StatusForbidden, w.Code)
+	w = serveAclTestRequest(r, http.MethodPost, "/services?Action=ObProxyHeartbeat", "10.2.0.1:1000", "")
+	require.Equal(t, http.StatusForbidden, w.Code)
+
+	r = newAclTestRouter(t, &config.AclConfig{
+		Read:      []string{"10.1.0.0/24"},
+		Write:     []string{"10.0.0.0/24"},
+		Heartbeat: []string{"10.2.0.0/24"},
+	})
+	w = serveAclTestRequest(r, http.MethodPost, "/services?Action=ObProxyHeartbeat", "10.2.0.1:1000", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	w = serveAclTestRequest(r, http.MethodPost, "/services?Action=ObProxyHeartbeat", "10.1.0.1:1000", "")
+	require.Equal(t, http.StatusForbidden, w.Code)
+	w = serveAclTestRequest(r, http.MethodPost, "/services?Action=ObProxyHeartbeat", "10.0.0.1:1000", "")
+	require.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestAclTrustedProxies(t *testing.T) {
+	r := newAclTestRouter(t, &config.AclConfig{
+		Write:          []string{"10.0.0.0/24"},
+		TrustedProxies: []string{"192.168.0.1"},
+	})
+
+	w := serveAclTestRequest(r, http.MethodPost, "/services?Action=ObRootServiceInfo", "192.168.0.1:1000", "10.0.0.1")
+	require.Equal(t, http.StatusOK, w.Code)
+	w = serveAclTestRequest(r, http.MethodPost, "/services?Action=ObRootServiceInfo", "192.168.0.1:1000", "10.1.0.1")
+	require.Equal(t, http.StatusForbidden, w.Code)
+
+	// proxy headers from untrusted clients are ignored
+	w = serveAclTestRequest(r, http.MethodPost, "/services?Action=ObRootServiceInfo", "192.168.0.2:1000", "10.0.0.1")
+	require.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestNewAclInvalidConfig(t *testing.T) {
+	_, err := newAcl(&config.AclConfig{Read: []string{"invalid"}})
+	require.NotNil(t, err)
+}
+
+func TestAclNoTrustedProxiesByDefault(t *testing.T) {
+	for _, conf := range []*config.AclConfig{nil, {Write: []string{"10.0.0.0/24"}}} {
+		r := newAclTestRouter(t, conf)
+		r.GET("/client-ip", func(c *gin.Context) {
+			c.String(http.StatusOK, c.ClientIP())
+		})
+		w := serveAclTestRequest(r, http.MethodGet, "/client-ip", "192.168.0.1:1000", "10.0.0.1")
+		require.Equal(t, "192.168.0.1", w.Body.String())
+	}
+}
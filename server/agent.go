@@ -0,0 +1,540 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/oceanbase/configserver/client"
+	"github.com/oceanbase/configserver/config"
+	libhttp "github.com/oceanbase/configserver/lib/http"
+	"github.com/oceanbase/configserver/lib/trace"
+	"github.com/oceanbase/configserver/logger"
+	"github.com/oceanbase/configserver/model"
+)
+
+const (
+	AGENT_DATA_FILE = "agent_data.json"
+	// agent data includes obproxy config with the meta database password, so it's only readable by the owner
+	AGENT_DATA_DIR_MODE  = 0700
+	AGENT_DATA_FILE_MODE = 0600
+
+	AGENT_STALE_HEADER = "X-Configserver-Stale"
+	AGE_HEADER         = "Age"
+	WARNING_HEADER     = "Warning"
+	// warning of stale response defined in RFC 7234
+	STALE_WARNING = `110 - "Response is Stale"`
+
+	DEFAULT_AGENT_REFRESH_INTERVAL = 30 * time.Second
+	DEFAULT_AGENT_STALE_AFTER      = 2 * time.Minute
+	DEFAULT_AGENT_IDLE_TIMEOUT     = 24 * time.Hour
+)
+
+// read only actions served by agent
+var agentActions = map[string]bool{
+	"ObRootServiceInfo":               true,
+	"GetObProxyConfig":                true,
+	"GetObRootServiceInfoUrlTemplate": true,
+	"ObIDCRegionInfo":                 true,
+}
+
+// agentEntry is the last known good data of a request to upstream
+type agentEntry struct {
+	Action     string          `json:"Action"`
+	Params     url.Values      `json:"Params"`
+	Data       json.RawMessage `json:"Data,omitempty"`
+	FetchTime  time.Time       `json:"FetchTime"`
+	AccessTime time.Time       `json:"AccessTime"`
+	// error of the last refresh, data is kept until upstream recovers
+	LastError string `json:"LastError,omitempty"`
+}
+
+func agentEntryKey(action string, params url.Values) string {
+	return action + "?" + params.Encode()
+}
+
+// Agent serves read only apis on the host from data refreshed from upstream configservers,
+// the last known good data is persisted and served during upstream outages
+type Agent struct {
+	Config   *config.AgentConfig
+	Router   *gin.Engine
+	upstream *client.Client
+	dataFile string
+	lock     sync.RWMutex
+	entries  map[string]*agentEntry
+}
+
+func NewAgent(conf *config.AgentConfig) (*Agent, error) {
+	if conf.Address == "" && conf.Socket == "" {
+		return nil, errors.New("neither address nor socket is specified")
+	}
+	if conf.Upstream == nil {
+		return nil, errors.New("upstream is not specified")
+	}
+	if conf.DataDir == "" {
+		return nil, errors.New("data dir is not specified")
+	}
+	agentConfig := *conf
+	if agentConfig.RefreshInterval <= 0 {
+		agentConfig.RefreshInterval = DEFAULT_AGENT_REFRESH_INTERVAL
+	}
+	if agentConfig.StaleAfter <= 0 {
+		agentConfig.StaleAfter = DEFAULT_AGENT_STALE_AFTER
+	}
+	if agentConfig.IdleTimeout <= 0 {
+		agentConfig.IdleTimeout = DEFAULT_AGENT_IDLE_TIMEOUT
+	}
+	if agentConfig.AdvertiseAddress == "" && agentConfig.Address != "" {
+		agentConfig.AdvertiseAddress = GetLocalUrl(agentConfig.Address)
+	}
+	upstream, err := client.New(client.Options{
+		Endpoints: conf.Upstream.Endpoints,
+		Namespace: conf.Upstream.Namespace,
+		Username:  conf.Upstream.Username,
+		Password:  conf.Upstream.Password,
+		Timeout:   conf.Upstream.Timeout,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "create upstream client")
+	}
+	accessLogger, err := newAccessLogger(newAccessLogConfig(conf.AccessLog))
+	if err != nil {
+		return nil, errors.Wrap(err, "create access logger")
+	}
+	if err := os.MkdirAll(conf.DataDir, AGENT_DATA_DIR_MODE); err != nil {
+		return nil, errors.Wrapf(err, "create data dir %s", conf.DataDir)
+	}
+	agent := &Agent{
+		Config:   &agentConfig,
+		Router:   gin.New(),
+		upstream: upstream,
+		dataFile: filepath.Join(conf.DataDir, AGENT_DATA_FILE),
+		entries:  make(map[string]*agentEntry),
+	}
+	// the agent serves clients on the host directly, proxy headers are not trusted
+	if err := setTrustedProxies(agent.Router, nil); err != nil {
+		return nil, err
+	}
+	if err := agent.load(); err != nil {
+		log.WithError(err).Warnf("ignore agent data in %s", agent.dataFile)
+	}
+	agent.initRoutes(accessLogger)
+	return agent, nil
+}
+
+// GetLocalUrl returns the url of a server listening on address for clients on the same host
+func GetLocalUrl(address string) string {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return "http://" + address
+	}
+	if host == "" || host == "0.0.0.0" || host == "::" {
+		host = "127.0.0.1"
+	}
+	return "http://" + net.JoinHostPort(host, port)
+}
+
+func (a *Agent) initRoutes(accessLogger *accessLogger) {
+	a.Router.Use(
+		accessLogHandler(accessLogger),
+		gin.Recovery(),
+		compressionHandler(newCompressionConfig(a.Config.Compression)),
+	)
+	handler := handlerFunctionWrapper(a.handleRequest)
+	a.Router.GET("/services", handler)
+	a.Router.POST("/services", handler)
+	a.Router.GET(NAMESPACE_PATH_PREFIX+":namespace/services", handler)
+	a.Router.POST(NAMESPACE_PATH_PREFIX+":namespace/services", handler)
+}
+
+// Run serves requests and refreshes data until ctx is cancelled
+func (a *Agent) Run(ctx context.Context) error {
+	srv := &http.Server{Handler: a.Router}
+	if a.Config.Address != "" {
+		listener, err := libhttp.NewTcpListener(a.Config.Address)
+		if err != nil {
+			return errors.Wrapf(err, "listen on address %s", a.Config.Address)
+		}
+		log.WithContext(ctx).Infof("agent listen on address: %s", a.Config.Address)
+		go func() {
+			if err := srv.Serve(listener); err != nil && err != http.ErrServerClosed {
+				log.WithError(err).Error("agent tcp server exited")
+			}
+		}()
+	}
+	if a.Config.Socket != "" {
+		// remove the socket left by the previous run
+		_ = os.Remove(a.Config.Socket)
+		listener, err := libhttp.NewSocketListener(a.Config.Socket)
+		if err != nil {
+			_ = srv.Close()
+			return errors.Wrapf(err, "listen on socket %s", a.Config.Socket)
+		}
+		log.WithContext(ctx).Infof("agent listen on socket: %s", a.Config.Socket)
+		go func() {
+			if err := srv.Serve(listener); err != nil && err != http.ErrServerClosed {
+				log.WithError(err).Error("agent socket server exited")
+			}
+		}()
+	}
+
+	a.runRefresher(ctx)
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return srv.Shutdown(shutdownCtx)
+}
+
+// runRefresher refreshes data periodically until ctx is cancelled
+func (a *Agent) runRefresher(ctx context.Context) {
+	a.refresh(ctx)
+	ticker := time.NewTicker(a.Config.RefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			a.refresh(ctx)
+		}
+	}
+}
+
+// refresh removes idle entries and refreshes the others, entries added by prefetching are refreshed in the same round
+func (a *Agent) refresh(ctx context.Context) {
+	ctxlog := trace.ContextWithTraceId(logger.INIT_TRACEID)
+	refreshed := make(map[string]bool)
+	for {
+		keys := a.pendingKeys(refreshed)
+		if len(keys) == 0 {
+			break
+		}
+		for _, key := range keys {
+			if ctx.Err() != nil {
+				return
+			}
+			refreshed[key] = true
+			a.lock.RLock()
+			entry, ok := a.entries[key]
+			a.lock.RUnlock()
+			if !ok {
+				continue
+			}
+			if _, err := a.fetch(ctx, entry.Action, entry.Params); err != nil {
+				log.WithContext(ctxlog).WithError(err).Warnf("refresh %s from upstream", key)
+			}
+		}
+	}
+	if err := a.save(); err != nil {
+		log.WithContext(ctxlog).WithError(err).Warn("save agent data")
+	}
+}
+
+// pendingKeys removes idle entries and returns keys of entries not refreshed yet
+func (a *Agent) pendingKeys(refreshed map[string]bool) []string {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	keys := make([]string, 0, len(a.entries))
+	for key, entry := range a.entries {
+		if time.Since(entry.AccessTime) > a.Config.IdleTimeout {
+			delete(a.entries, key)
+			continue
+		}
+		if !refreshed[key] {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// fetch requests upstream and updates the entry, the entry is created if it doesn't exist,
+// failures of requests are recorded in the entry without removing its data
+func (a *Agent) fetch(ctx context.Context, action string, params url.Values) (*agentEntry, error) {
+	var data json.RawMessage
+	err := a.upstream.Do(ctx, http.MethodGet, action, params, nil, &data)
+	if err == nil {
+		data, err = a.rewriteData(action, data)
+	}
+
+	key := agentEntryKey(action, params)
+	now := time.Now()
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	entry, ok := a.entries[key]
+	if !ok {
+		if err != nil {
+			return nil, err
+		}
+		entry = &agentEntry{Action: action, Params: params, AccessTime: now}
+		a.entries[key] = entry
+	}
+	if err != nil {
+		entry.LastError = err.Error()
+		return copyAgentEntry(entry), err
+	}
+	entry.Data = data
+	entry.FetchTime = now
+	entry.LastError = ""
+	a.prefetch(action, data, now)
+	return copyAgentEntry(entry), nil
+}
+
+func copyAgentEntry(entry *agentEntry) *agentEntry {
+	copied := *entry
+	return &copied
+}
+
+// prefetch adds entries of rootservice info urls in obproxy config, so obproxy can start during upstream outages,
+// it's called with the lock held
+func (a *Agent) prefetch(action string, data json.RawMessage, now time.Time) {
+	if action != "GetObProxyConfig" {
+		return
+	}
+	obProxyConfig := new(model.ObProxyConfig)
+	if err := json.Unmarshal(data, obProxyConfig); err != nil {
+		return
+	}
+	urls := make([]string, 0, len(obProxyConfig.ConfigUrlList)+1)
+	for _, configUrl := range obProxyConfig.ConfigUrlList {
+		urls = append(urls, configUrl.Url)
+	}
+	if obProxyConfig.MetaDatabase != nil {
+		urls = append(urls, obProxyConfig.MetaDatabase.ConfigUrl)
+	}
+	for _, rawUrl := range urls {
+		u, err := url.Parse(rawUrl)
+		if err != nil {
+			continue
+		}
+		params := u.Query()
+		action := params.Get("Action")
+		if !agentActions[action] {
+			continue
+		}
+		params.Del("Action")
+		key := agentEntryKey(action, params)
+		if _, ok := a.entries[key]; !ok {
+			a.entries[key] = &agentEntry{Action: action, Params: params, AccessTime: now}
+		}
+	}
+}
+
+// rewriteData replaces the address of upstream in urls of obproxy config with the advertise address,
+// so obproxy keeps querying rootservice info from the agent
+func (a *Agent) rewriteData(action string, data json.RawMessage) (json.RawMessage, error) {
+	if a.Config.AdvertiseAddress == "" {
+		return data, nil
+	}
+	switch action {
+	case "GetObProxyConfig":
+		obProxyConfig := new(model.ObProxyConfig)
+		if err := json.Unmarshal(data, obProxyConfig); err != nil {
+			return nil, errors.Wrap(err, "parse obproxy config")
+		}
+		for _, configUrl := range obProxyConfig.ConfigUrlList {
+			configUrl.Url = rewriteServiceUrl(configUrl.Url, a.Config.AdvertiseAddress)
+		}
+		if obProxyConfig.MetaDatabase != nil {
+			obProxyConfig.MetaDatabase.ConfigUrl = rewriteServiceUrl(obProxyConfig.MetaDatabase.ConfigUrl, a.Config.AdvertiseAddress)
+		}
+		return json.Marshal(obProxyConfig)
+	case "GetObRootServiceInfoUrlTemplate":
+		obProxyConfig := new(model.ObProxyConfigWithTemplate)
+		if err := json.Unmarshal(data, obProxyConfig); err != nil {
+			return nil, errors.Wrap(err, "parse obproxy config with template")
+		}
+		obProxyConfig.TemplateV1 = rewriteServiceUrl(obProxyConfig.TemplateV1, a.Config.AdvertiseAddress)
+		obProxyConfig.TemplateV2 = rewriteServiceUrl(obProxyConfig.TemplateV2, a.Config.AdvertiseAddress)
+		if obProxyConfig.MetaDatabase != nil {
+			obProxyConfig.MetaDatabase.ConfigUrl = rewriteServiceUrl(obProxyConfig.MetaDatabase.ConfigUrl, a.Config.AdvertiseAddress)
+		}
+		return json.Marshal(obProxyConfig)
+	}
+	return data, nil
+}
+
+// rewriteServiceUrl replaces the address of urls of /services with address, other urls are returned as is
+func rewriteServiceUrl(rawUrl, address string) string {
+	u, err := url.Parse(rawUrl)
+	if err != nil || !strings.HasSuffix(u.Path, "/services") {
+		return rawUrl
+	}
+	return strings.TrimSuffix(address, "/") + strings.TrimPrefix(rawUrl, u.Scheme+"://"+u.Host)
+}
+
+// handleRequest serves the request from the last known good data, upstream is requested only if there is no data
+func (a *Agent) handleRequest(ctxlog context.Context, c *gin.Context) *ApiResponse {
+	action := c.Query("Action")
+	if !agentActions[action] || isWriteRequest(c) {
+		return NewErrorCodeResponse(ErrorCodeInvalidAction, errors.Errorf("action %s is not supported by agent", action))
+	}
+	params := c.Request.URL.Query()
+	for _, name := range []string{"Action", FORMAT_PARAM, "VersionOnly"} {
+		params.Del(name)
+	}
+	if namespace := c.Param("namespace"); namespace != "" {
+		params.Set("Namespace", namespace)
+	}
+
+	key := agentEntryKey(action, params)
+	a.lock.Lock()
+	entry, ok := a.entries[key]
+	if ok {
+		entry.AccessTime = time.Now()
+		entry = copyAgentEntry(entry)
+	}
+	a.lock.Unlock()
+	if !ok || len(entry.Data) == 0 {
+		var err error
+		entry, err = a.fetch(ctxlog, action, params)
+		if err != nil {
+			return newAgentUpstreamErrorResponse(err)
+		}
+		if len(entry.Data) == 0 {
+			return newAgentUpstreamErrorResponse(errors.New(entry.LastError))
+		}
+	}
+
+	age := time.Since(entry.FetchTime)
+	c.Header(AGE_HEADER, strconv.FormatInt(int64(age.Seconds()), 10))
+	if entry.LastError != "" || age > a.Config.StaleAfter {
+		c.Header(AGENT_STALE_HEADER, "true")
+		c.Header(WARNING_HEADER, STALE_WARNING)
+		log.WithContext(ctxlog).Warnf("serve stale data of %s fetched at %s, last error: %s", key, entry.FetchTime.Format(time.RFC3339), entry.LastError)
+	} else {
+		c.Header(AGENT_STALE_HEADER, "false")
+	}
+	return newAgentDataResponse(c, action, entry.Data)
+}
+
+// newAgentDataResponse decodes data into the types of the action, so the response is rendered the same as configserver
+func newAgentDataResponse(c *gin.Context, action string, data json.RawMessage) *ApiResponse {
+	switch action {
+	case "GetObProxyConfig":
+		obProxyConfig := new(model.ObProxyConfig)
+		if err := json.Unmarshal(data, obProxyConfig); err != nil {
+			return NewErrorResponse(errors.Wrap(err, "parse obproxy config"))
+		}
+		return newAgentObProxyConfigResponse(c, obProxyConfig.Version, obProxyConfig)
+	case "GetObRootServiceInfoUrlTemplate":
+		obProxyConfig := new(model.ObProxyConfigWithTemplate)
+		if err := json.Unmarshal(data, obProxyConfig); err != nil {
+			return NewErrorResponse(errors.Wrap(err, "parse obproxy config with template"))
+		}
+		return newAgentObProxyConfigResponse(c, obProxyConfig.Version, obProxyConfig)
+	case "ObRootServiceInfo":
+		if trimmed := strings.TrimSpace(string(data)); strings.HasPrefix(trimmed, "[") {
+			infos := make([]*model.ObRootServiceInfo, 0)
+			if err := json.Unmarshal(data, &infos); err != nil {
+				return NewErrorResponse(errors.Wrap(err, "parse rootservice info list"))
+			}
+			return NewSuccessResponse(infos)
+		}
+		info := new(model.ObRootServiceInfo)
+		if err := json.Unmarshal(data, info); err != nil {
+			return NewErrorResponse(errors.Wrap(err, "parse rootservice info"))
+		}
+		return NewSuccessResponse(info)
+	}
+	return NewSuccessResponse(data)
+}
+
+func newAgentObProxyConfigResponse(c *gin.Context, version string, data interface{}) *ApiResponse {
+	versionOnly, err := isVersionOnly(c)
+	if err != nil {
+		return NewIllegalArgumentResponse(errors.Wrap(err, "invalid parameter, failed to parse versiononly"))
+	}
+	return newObProxyConfigResponse(c, version, versionOnly, data)
+}
+
+// newAgentUpstreamErrorResponse returns the error of upstream as is, or UpstreamUnavailable if upstream can't be reached
+func newAgentUpstreamErrorResponse(err error) *ApiResponse {
+	var apiErr *client.Error
+	if errors.As(err, &apiErr) && !apiErr.Temporary() && apiErr.ErrorCode != "" {
+		return &ApiResponse{
+			Code:      apiErr.StatusCode,
+			Message:   apiErr.Message,
+			ErrorCode: ErrorCode(apiErr.ErrorCode),
+		}
+	}
+	return NewErrorCodeResponse(ErrorCodeUpstreamUnavailable, errors.Wrap(err, "no data available"))
+}
+
+func (a *Agent) load() error {
+	content, err := os.ReadFile(a.dataFile)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "read agent data")
+	}
+	entries := make([]*agentEntry, 0)
+	if err := json.Unmarshal(content, &entries); err != nil {
+		return errors.Wrap(err, "parse agent data")
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	for _, entry := range entries {
+		a.entries[agentEntryKey(entry.Action, entry.Params)] = entry
+	}
+	return nil
+}
+
+// save writes entries to a temporary file and renames it, so the data file is never partially written
+func (a *Agent) save() error {
+	a.lock.RLock()
+	entries := make([]*agentEntry, 0, len(a.entries))
+	for _, entry := range a.entries {
+		if len(entry.Data) > 0 {
+			entries = append(entries, entry)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return agentEntryKey(entries[i].Action, entries[i].Params) < agentEntryKey(entries[j].Action, entries[j].Params)
+	})
+	content, err := json.MarshalIndent(entries, "", "  ")
+	a.lock.RUnlock()
+	if err != nil {
+		return errors.Wrap(err, "encode agent data")
+	}
+	tmpFile := fmt.Sprintf("%s.tmp", a.dataFile)
+	// the mode is only applied when the file is created, so a temporary file left by older versions is removed first
+	if err := os.Remove(tmpFile); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "remove temporary agent data")
+	}
+	if err := os.WriteFile(tmpFile, content, AGENT_DATA_FILE_MODE); err != nil {
+		return errors.Wrap(err, "write agent data")
+	}
+	return errors.Wrap(os.Rename(tmpFile, a.dataFile), "rename agent data")
+}
@@ -0,0 +1,199 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oceanbase/configserver/config"
+	"github.com/oceanbase/configserver/model"
+)
+
+func serveAgentRequestForTest(a *Agent, method, url string) (*httptest.ResponseRecorder, *ApiResponse) {
+	w := serveV3RequestForTest(a.Router, method, url, nil)
+	response := new(ApiResponse)
+	_ = json.Unmarshal(w.Body.Bytes(), response)
+	return w, response
+}
+
+func TestRewriteServiceUrl(t *testing.T) {
+	address := "http://127.0.0.1:8088"
+	require.Equal(t, "http://127.0.0.1:8088/services?Action=ObRootServiceInfo&ObCluster=c1", rewriteServiceUrl("http://10.0.0.1:8080/services?Action=ObRootServiceInfo&ObCluster=c1", address))
+	require.Equal(t, "http://127.0.0.1:8088/ns/ns1/services?Action=ObRootServiceInfo&ObRegion=${ObRegion}", rewriteServiceUrl("http://10.0.0.1:8080/ns/ns1/services?Action=ObRootServiceInfo&ObRegion=${ObRegion}", address+"/"))
+	require.Equal(t, "http://10.0.0.1:8080/client?Action=GetObProxy", rewriteServiceUrl("http://10.0.0.1:8080/client?Action=GetObProxy", address))
+	require.Equal(t, "http://127.0.0.1:8088", GetLocalUrl(":8088"))
+	require.Equal(t, "http://127.0.0.1:8088", GetLocalUrl("0.0.0.0:8088"))
+	require.Equal(t, "http://10.0.0.1:8088", GetLocalUrl("10.0.0.1:8088"))
+}
+
+func TestAgent(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_agent")
+	r := gin.New()
+	InitConfigServerRoutes(r)
+	upstream := httptest.NewServer(r)
+	defer upstream.Close()
+	w := serveV3RequestForTest(r, http.MethodPost, "/services?Action=ObRootServiceInfo&ObCluster=c1&ObClusterId=1&version=2", []byte(testRootServiceJson))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	_, err := NewAgent(&config.AgentConfig{Upstream: &config.UpstreamConfig{Endpoints: []string{upstream.URL}}, DataDir: t.TempDir()})
+	require.NotNil(t, err)
+
+	agentConfig := &config.AgentConfig{
+		Address:  "127.0.0.1:8088",
+		Upstream: &config.UpstreamConfig{Endpoints: []string{upstream.URL}, Timeout: time.Second},
+		DataDir:  filepath.Join(t.TempDir(), "data"),
+	}
+	agent, err := NewAgent(agentConfig)
+	require.Nil(t, err)
+	require.Equal(t, "http://127.0.0.1:8088", agent.Config.AdvertiseAddress)
+
+	// urls of rootservice info in obproxy config point to the agent
+	w, response := serveAgentRequestForTest(agent, http.MethodGet, "/services?Action=GetObProxyConfig")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "false", w.Header().Get(AGENT_STALE_HEADER))
+	require.Equal(t, "0", w.Header().Get(AGE_HEADER))
+	data, _ := json.Marshal(response.Data)
+	obProxyConfig := new(model.ObProxyConfig)
+	require.Nil(t, json.Unmarshal(data, obProxyConfig))
+	require.Equal(t, "http://127.0.0.1:8088/services?Action=ObRootServiceInfo&ObCluster=c1", obProxyConfig.ConfigUrlList[0].Url)
+	require.Equal(t, `"`+obProxyConfig.Version+`"`, w.Header().Get("ETag"))
+
+	w, response = serveAgentRequestForTest(agent, http.MethodGet, "/services?Action=GetObProxyConfig&VersionOnly=true")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, map[string]interface{}{"Version": obProxyConfig.Version}, response.Data)
+
+	// errors of upstream are returned as is
+	w, response = serveAgentRequestForTest(agent, http.MethodGet, "/services?Action=ObRootServiceInfo&ObCluster=c2")
+	require.Equal(t, http.StatusNotFound, w.Code)
+	require.Equal(t, ErrorCodeClusterNotFound, response.ErrorCode)
+
+	// write actions are not supported
+	w, response = serveAgentRequestForTest(agent, http.MethodPost, "/services?Action=ObRootServiceInfo&ObCluster=c1")
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Equal(t, ErrorCodeInvalidAction, response.ErrorCode)
+
+	// rootservice info urls in obproxy config are prefetched
+	agent.refresh(context.Background())
+	key := agentEntryKey("ObRootServiceInfo", url.Values{"ObCluster": []string{"c1"}})
+	require.NotEmpty(t, agent.entries[key].Data)
+
+	// last known good data is served during upstream outage
+	upstream.Close()
+	agent.refresh(context.Background())
+	require.NotEmpty(t, agent.entries[key].LastError)
+	w = serveV3RequestForTest(agent.Router, http.MethodGet, "/services?Action=ObRootServiceInfo&ObCluster=c1&Format=text", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "1.1.1.1:2881\n", w.Body.String())
+	require.Equal(t, "true", w.Header().Get(AGENT_STALE_HEADER))
+	require.Equal(t, STALE_WARNING, w.Header().Get(WARNING_HEADER))
+
+	w, response = serveAgentRequestForTest(agent, http.MethodGet, "/services?Action=ObRootServiceInfo&ObCluster=c3")
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	require.Equal(t, ErrorCodeUpstreamUnavailable, response.ErrorCode)
+
+	// data is only readable by the owner as it includes the meta database password
+	stat, err := os.Stat(agentConfig.DataDir)
+	require.Nil(t, err)
+	require.Equal(t, os.FileMode(AGENT_DATA_DIR_MODE), stat.Mode().Perm())
+	stat, err = os.Stat(filepath.Join(agentConfig.DataDir, AGENT_DATA_FILE))
+	require.Nil(t, err)
+	require.Equal(t, os.FileMode(AGENT_DATA_FILE_MODE), stat.Mode().Perm())
+
+	// data is kept across restarts
+	agent, err = NewAgent(agentConfig)
+	require.Nil(t, err)
+	w, response = serveAgentRequestForTest(agent, http.MethodGet, "/services?Action=GetObProxyConfig")
+	require.Equal(t, http.StatusOK, w.Code)
+	w, response = serveAgentRequestForTest(agent, http.MethodGet, "/services?Action=ObRootServiceInfo&ObCluster=c1")
+	require.Equal(t, http.StatusOK, w.Code)
+	data, _ = json.Marshal(response.Data)
+	info := new(model.ObRootServiceInfo)
+	require.Nil(t, json.Unmarshal(data, info))
+	require.Equal(t, "c1", info.ObCluster)
+
+	// idle data is removed
+	agent.Config.IdleTimeout = time.Nanosecond
+	agent.refresh(context.Background())
+	require.Empty(t, agent.entries)
+}
+
+func TestAgentNamespace(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_agent_namespace")
+	r := gin.New()
+	InitConfigServerRoutes(r)
+	upstream := httptest.NewServer(r)
+	defer upstream.Close()
+	w := serveV3RequestForTest(r, http.MethodPost, "/ns/ns1/services?Action=ObRootServiceInfo&ObCluster=c1&ObClusterId=1&version=2", []byte(testRootServiceJson))
+	require.Equal(t, http.StatusOK, w.Code)
+	w = serveV3RequestForTest(r, http.MethodPost, "/ns/ns2/services?Action=ObRootServiceInfo&ObCluster=c1&ObClusterId=1&version=2", []byte(strings.ReplaceAll(testRootServiceJson, "1.1.1.1", "2.2.2.2")))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	agent, err := NewAgent(&config.AgentConfig{
+		Address:  "127.0.0.1:8088",
+		Upstream: &config.UpstreamConfig{Endpoints: []string{upstream.URL}, Namespace: "ns1", Timeout: time.Second},
+		DataDir:  filepath.Join(t.TempDir(), "data"),
+	})
+	require.Nil(t, err)
+
+	// the upstream namespace is the default, the one in path takes precedence
+	w = serveV3RequestForTest(agent.Router, http.MethodGet, "/services?Action=ObRootServiceInfo&ObCluster=c1&Format=text", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "1.1.1.1:2881\n", w.Body.String())
+	w = serveV3RequestForTest(agent.Router, http.MethodGet, "/ns/ns2/services?Action=ObRootServiceInfo&ObCluster=c1&Format=text", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "2.2.2.2:2881\n", w.Body.String())
+
+	// the data cached for each namespace is refreshed from the namespace
+	agent.refresh(context.Background())
+	w = serveV3RequestForTest(agent.Router, http.MethodGet, "/ns/ns2/services?Action=ObRootServiceInfo&ObCluster=c1&Format=text", nil)
+	require.Equal(t, "2.2.2.2:2881\n", w.Body.String())
+	w = serveV3RequestForTest(agent.Router, http.MethodGet, "/services?Action=ObRootServiceInfo&ObCluster=c1&Format=text", nil)
+	require.Equal(t, "1.1.1.1:2881\n", w.Body.String())
+}
+
+func TestAgentIgnoresProxyHeaders(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	agent, err := NewAgent(&config.AgentConfig{
+		Address:  "127.0.0.1:8088",
+		Upstream: &config.UpstreamConfig{Endpoints: []string{"http://127.0.0.1:1"}},
+		DataDir:  t.TempDir(),
+	})
+	require.Nil(t, err)
+	agent.Router.GET("/client-ip", func(c *gin.Context) {
+		c.String(http.StatusOK, c.ClientIP())
+	})
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/client-ip", nil)
+	req.RemoteAddr = "127.0.0.1:1000"
+	req.Header.Set("X-Forwarded-For", "10.0.0.1")
+	agent.Router.ServeHTTP(w, req)
+	require.Equal(t, "127.0.0.1", w.Body.String())
+}
@@ -0,0 +1,247 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/oceanbase/configserver/ent"
+	"github.com/oceanbase/configserver/lib/codec"
+	"github.com/oceanbase/configserver/model"
+)
+
+const (
+	AUDIT_PRINCIPAL_SYSTEM = "system"
+)
+
+// kinds of entities recorded in audit logs
+const (
+	AUDIT_ENTITY_OB_CLUSTER       = "ObCluster"
+	AUDIT_ENTITY_OB_CLUSTER_GROUP = "ObClusterGroup"
+	AUDIT_ENTITY_OBPROXY_GROUP    = "ObProxyGroup"
+	AUDIT_ENTITY_OBPROXY          = "ObProxy"
+	AUDIT_ENTITY_WEBHOOK_DELIVERY = "WebhookDelivery"
+)
+
+type auditInfoKey struct{}
+
+// auditInfo describes who makes the change, it's carried by context to the storage hook
+type auditInfo struct {
+	Action    string
+	RemoteIp  string
+	Principal string
+	UserAgent string
+	TraceId   string
+}
+
+func contextWithAuditInfo(ctx context.Context, info *auditInfo) context.Context {
+	return context.WithValue(ctx, auditInfoKey{}, info)
+}
+
+// contextWithRequestAuditInfo attaches information of the request to ctx,
+// action is the Action parameter for the legacy api, or the method and route for the v3 api
+func contextWithRequestAuditInfo(ctx context.Context, c *gin.Context, traceId string) context.Context {
+	info := &auditInfo{
+		Action:    getRequestAction(c),
+		RemoteIp:  c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+		TraceId:   traceId,
+	}
+	if user := getAuthUser(c); user != nil {
+		info.Principal = user.Name
+	}
+	return contextWithAuditInfo(ctx, info)
+}
+
+// getAuditInfo returns the audit info in ctx, changes made without a request are considered made by system
+func getAuditInfo(ctx context.Context) *auditInfo {
+	if info, ok := ctx.Value(auditInfoKey{}).(*auditInfo); ok && info != nil {
+		return info
+	}
+	return &auditInfo{
+		Principal: AUDIT_PRINCIPAL_SYSTEM,
+	}
+}
+
+// newAuditLogCreate returns the builder of an audit log of the entity changed by whom the info describes
+func newAuditLogCreate(client *ent.Client, info *auditInfo, namespace, entity, operation string) *ent.AuditLogCreate {
+	return client.AuditLog.Create().
+		SetNamespace(namespace).
+		SetAction(info.Action).
+		SetOperation(operation).
+		SetEntity(entity).
+		SetObCluster("").
+		SetObClusterID(0).
+		SetRemoteIP(info.RemoteIp).
+		SetPrincipal(info.Principal).
+		SetUserAgent(info.UserAgent).
+		SetTraceID(info.TraceId)
+}
+
+// setAuditPayloads sets the entity before and after the change encoded by encode, a nil one is left empty
+func setAuditPayloads[T any](builder *ent.AuditLogCreate, before, after *T, encode func(*T) (string, error)) error {
+	if before != nil {
+		payload, err := encode(before)
+		if err != nil {
+			return err
+		}
+		builder.SetBeforeJSON(payload)
+	}
+	if after != nil {
+		payload, err := encode(after)
+		if err != nil {
+			return err
+		}
+		builder.SetAfterJSON(payload)
+	}
+	return nil
+}
+
+func createAuditLogs(ctx context.Context, client *ent.Client, builders []*ent.AuditLogCreate) error {
+	if len(builders) == 0 {
+		return nil
+	}
+	return wrapStorageError(client.AuditLog.CreateBulk(builders...).Exec(ctx), "save audit log")
+}
+
+// saveAuditLogs records the changes of ObCluster with the payload before and after the change, an update not changing the rootservice info is skipped,
+// it's called by the storage hook with the same client as the change, so audit logs are committed or rolled back together with the change
+func saveAuditLogs(ctx context.Context, client *ent.Client, changes []*obClusterChange) error {
+	info := getAuditInfo(ctx)
+	builders := make([]*ent.AuditLogCreate, 0, len(changes))
+	for _, change := range changes {
+		if change.Operation == CLUSTER_OPERATION_UPDATE && change.Before.RootserviceJSON == change.After.RootserviceJSON {
+			continue
+		}
+		cluster := change.Cluster()
+		builder := newAuditLogCreate(client, info, cluster.Namespace, AUDIT_ENTITY_OB_CLUSTER, change.Operation).
+			SetObCluster(cluster.Name).
+			SetObClusterID(cluster.ObClusterID)
+		if change.Before != nil {
+			builder.SetBeforeJSON(change.Before.RootserviceJSON)
+		}
+		if change.After != nil {
+			builder.SetAfterJSON(change.After.RootserviceJSON)
+		}
+		builders = append(builders, builder)
+	}
+	return createAuditLogs(ctx, client, builders)
+}
+
+func encodeObClusterGroupAuditPayload(group *ent.ObClusterGroup) (string, error) {
+	payload, err := codec.MarshalToJsonString(&model.ObClusterGroupInfo{
+		ObCluster:        group.Name,
+		PrimaryClusterId: group.PrimaryClusterID,
+	})
+	return payload, errors.Wrap(err, "serialize ob cluster group")
+}
+
+// saveObClusterGroupAuditLogs records the changes of primary of ObClusterGroup, ob cluster of the audit logs is the name of the group
+func saveObClusterGroupAuditLogs(ctx context.Context, client *ent.Client, changes []*obClusterGroupChange) error {
+	info := getAuditInfo(ctx)
+	builders := make([]*ent.AuditLogCreate, 0, len(changes))
+	for _, change := range changes {
+		group := change.Group()
+		builder := newAuditLogCreate(client, info, group.Namespace, AUDIT_ENTITY_OB_CLUSTER_GROUP, getEntityOperation(change.Before, change.After)).
+			SetObCluster(group.Name)
+		if err := setAuditPayloads(builder, change.Before, change.After, encodeObClusterGroupAuditPayload); err != nil {
+			return err
+		}
+		builders = append(builders, builder)
+	}
+	return createAuditLogs(ctx, client, builders)
+}
+
+// encodeObProxyGroupAuditPayload returns the proxy group as returned by the ObProxyGroup api, the meta database password is never recorded
+func encodeObProxyGroupAuditPayload(group *ent.ObProxyGroup) (string, error) {
+	payload, err := codec.MarshalToJsonString(convertObProxyGroup(group))
+	return payload, errors.Wrap(err, "serialize obproxy group")
+}
+
+// saveObProxyGroupAuditLogs records the changes of ObProxyGroup like saveAuditLogs, ob cluster of the audit logs is empty
+func saveObProxyGroupAuditLogs(ctx context.Context, client *ent.Client, changes []*obProxyGroupChange) error {
+	info := getAuditInfo(ctx)
+	builders := make([]*ent.AuditLogCreate, 0, len(changes))
+	for _, change := range changes {
+		group := change.Group()
+		builder := newAuditLogCreate(client, info, group.Namespace, AUDIT_ENTITY_OBPROXY_GROUP, change.Operation).
+			SetObproxyGroup(group.Name)
+		if err := setAuditPayloads(builder, change.Before, change.After, encodeObProxyGroupAuditPayload); err != nil {
+			return err
+		}
+		builders = append(builders, builder)
+	}
+	return createAuditLogs(ctx, client, builders)
+}
+
+// encodeObProxyAuditPayload returns what the obproxy reported, the status computed when listed is not recorded
+func encodeObProxyAuditPayload(proxy *ent.ObProxy) (string, error) {
+	payload, err := codec.MarshalToJsonString(&model.ObProxyInfo{
+		Name:          proxy.Name,
+		Address:       proxy.Address,
+		Version:       proxy.Version,
+		ObProxyGroup:  proxy.ObproxyGroup,
+		ConfigVersion: proxy.ConfigVersion,
+		FirstSeenTime: proxy.CreateTime,
+		LastSeenTime:  proxy.LastSeenTime,
+	})
+	return payload, errors.Wrap(err, "serialize obproxy")
+}
+
+// saveObProxyAuditLogs records the changes of ObProxy, obproxy group of the audit logs is the group of the obproxy
+func saveObProxyAuditLogs(ctx context.Context, client *ent.Client, changes []*obProxyChange) error {
+	info := getAuditInfo(ctx)
+	builders := make([]*ent.AuditLogCreate, 0, len(changes))
+	for _, change := range changes {
+		proxy := change.ObProxy()
+		builder := newAuditLogCreate(client, info, proxy.Namespace, AUDIT_ENTITY_OBPROXY, change.Operation).
+			SetEntityName(proxy.Name).
+			SetObproxyGroup(proxy.ObproxyGroup)
+		if err := setAuditPayloads(builder, change.Before, change.After, encodeObProxyAuditPayload); err != nil {
+			return err
+		}
+		builders = append(builders, builder)
+	}
+	return createAuditLogs(ctx, client, builders)
+}
+
+func encodeWebhookDeliveryAuditPayload(delivery *ent.WebhookDelivery) (string, error) {
+	payload, err := codec.MarshalToJsonString(convertWebhookDelivery(delivery))
+	return payload, errors.Wrap(err, "serialize webhook delivery")
+}
+
+// saveWebhookDeliveryAuditLogs records the deliveries sent again, ob cluster of the audit logs is the cluster the delivery is about
+func saveWebhookDeliveryAuditLogs(ctx context.Context, client *ent.Client, changes []*webhookDeliveryChange) error {
+	info := getAuditInfo(ctx)
+	builders := make([]*ent.AuditLogCreate, 0, len(changes))
+	for _, change := range changes {
+		delivery := change.After
+		builder := newAuditLogCreate(client, info, delivery.Namespace, AUDIT_ENTITY_WEBHOOK_DELIVERY, CLUSTER_OPERATION_UPDATE).
+			SetEntityName(strconv.Itoa(delivery.ID)).
+			SetObCluster(delivery.ObCluster).
+			SetObClusterID(delivery.ObClusterID)
+		if err := setAuditPayloads(builder, change.Before, change.After, encodeWebhookDeliveryAuditPayload); err != nil {
+			return err
+		}
+		builders = append(builders, builder)
+	}
+	return createAuditLogs(ctx, client, builders)
+}
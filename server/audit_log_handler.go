@@ -0,0 +1,257 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/oceanbase/configserver/ent"
+	"github.com/oceanbase/configserver/ent/auditlog"
+	"github.com/oceanbase/configserver/model"
+)
+
+const (
+	DEFAULT_AUDIT_RETENTION      = 90 * 24 * time.Hour
+	DEFAULT_AUDIT_PURGE_INTERVAL = time.Hour
+)
+
+var auditLogListOnce sync.Once
+var auditLogListFunc func(*gin.Context)
+
+func getAuditLogListFunc() func(*gin.Context) {
+	auditLogListOnce.Do(func() {
+		auditLogListFunc = handlerFunctionWrapper(listAuditLogs)
+	})
+	return auditLogListFunc
+}
+
+type AuditLogListParam struct {
+	Namespace   string
+	ObCluster   string
+	ObClusterId int64
+	// only return changes of the obproxy group, or the obproxies in it
+	ObProxyGroup string
+	// only return changes of the kind of entities
+	Entity    string
+	StartTime *time.Time
+	EndTime   *time.Time
+	Limit     int
+	// id of the last audit log in previous page, audit logs are returned from the latest
+	Cursor int
+}
+
+func getAuditRetention() time.Duration {
+	auditConfig := GetConfigServer().Config.Audit
+	if auditConfig == nil || auditConfig.Retention <= 0 {
+		return DEFAULT_AUDIT_RETENTION
+	}
+	return auditConfig.Retention
+}
+
+func getAuditPurgeInterval() time.Duration {
+	auditConfig := GetConfigServer().Config.Audit
+	if auditConfig == nil || auditConfig.PurgeInterval <= 0 {
+		return DEFAULT_AUDIT_PURGE_INTERVAL
+	}
+	return auditConfig.PurgeInterval
+}
+
+func getAuditLogListParam(c *gin.Context) (*AuditLogListParam, error) {
+	namespace, err := getNamespace(c)
+	if err != nil {
+		return nil, err
+	}
+	param := &AuditLogListParam{
+		Namespace:    namespace,
+		ObCluster:    c.Query("ObCluster"),
+		ObProxyGroup: c.Query(OBPROXY_GROUP_PARAM),
+		Entity:       c.Query("Entity"),
+	}
+	switch param.Entity {
+	case "", AUDIT_ENTITY_OB_CLUSTER, AUDIT_ENTITY_OB_CLUSTER_GROUP, AUDIT_ENTITY_OBPROXY_GROUP, AUDIT_ENTITY_OBPROXY, AUDIT_ENTITY_WEBHOOK_DELIVERY:
+	default:
+		return nil, errors.Errorf("unsupported entity %s", param.Entity)
+	}
+	if obClusterId, ok := c.GetQuery("ObClusterId"); ok {
+		param.ObClusterId, err = strconv.ParseInt(obClusterId, 10, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse ob cluster id")
+		}
+	}
+	if startTime, ok := c.GetQuery("StartTime"); ok {
+		t, err := parseTimeParam(startTime)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse start time")
+		}
+		param.StartTime = &t
+	}
+	if endTime, ok := c.GetQuery("EndTime"); ok {
+		t, err := parseTimeParam(endTime)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse end time")
+		}
+		param.EndTime = &t
+	}
+	param.Limit, err = getLimitParam(c)
+	if err != nil {
+		return nil, err
+	}
+	if cursor, ok := c.GetQuery("Cursor"); ok && cursor != "" {
+		param.Cursor, err = strconv.Atoi(cursor)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse cursor")
+		}
+	}
+	return param, nil
+}
+
+// decodeAuditPayload decodes the payload into result, result is left nil if the payload is empty
+func decodeAuditPayload[T any](payload string, result **T) error {
+	if payload == "" {
+		return nil
+	}
+	*result = new(T)
+	return errors.Wrap(json.Unmarshal([]byte(payload), *result), "deserialize audit payload")
+}
+
+func convertAuditLog(auditLog *ent.AuditLog) (*model.AuditLogInfo, error) {
+	info := &model.AuditLogInfo{
+		Id:           auditLog.ID,
+		Time:         auditLog.CreateTime,
+		Action:       auditLog.Action,
+		Operation:    auditLog.Operation,
+		Entity:       auditLog.Entity,
+		EntityName:   auditLog.EntityName,
+		ObCluster:    auditLog.ObCluster,
+		ObClusterId:  auditLog.ObClusterID,
+		ObProxyGroup: auditLog.ObproxyGroup,
+		RemoteIp:     auditLog.RemoteIP,
+		Principal:    auditLog.Principal,
+		UserAgent:    auditLog.UserAgent,
+		TraceId:      auditLog.TraceID,
+	}
+	switch auditLog.Entity {
+	case AUDIT_ENTITY_OB_CLUSTER_GROUP:
+		if err := decodeAuditPayload(auditLog.BeforeJSON, &info.ObClusterGroupBefore); err != nil {
+			return nil, err
+		}
+		return info, decodeAuditPayload(auditLog.AfterJSON, &info.ObClusterGroupAfter)
+	case AUDIT_ENTITY_OBPROXY_GROUP:
+		if err := decodeAuditPayload(auditLog.BeforeJSON, &info.ObProxyGroupBefore); err != nil {
+			return nil, err
+		}
+		return info, decodeAuditPayload(auditLog.AfterJSON, &info.ObProxyGroupAfter)
+	case AUDIT_ENTITY_OBPROXY:
+		if err := decodeAuditPayload(auditLog.BeforeJSON, &info.ObProxyBefore); err != nil {
+			return nil, err
+		}
+		return info, decodeAuditPayload(auditLog.AfterJSON, &info.ObProxyAfter)
+	case AUDIT_ENTITY_WEBHOOK_DELIVERY:
+		if err := decodeAuditPayload(auditLog.BeforeJSON, &info.WebhookDeliveryBefore); err != nil {
+			return nil, err
+		}
+		return info, decodeAuditPayload(auditLog.AfterJSON, &info.WebhookDeliveryAfter)
+	}
+	if err := decodeAuditPayload(auditLog.BeforeJSON, &info.Before); err != nil {
+		return nil, err
+	}
+	return info, decodeAuditPayload(auditLog.AfterJSON, &info.After)
+}
+
+// queryAuditLogs returns audit logs from the latest along with the cursor of next page, the cursor is empty if there's no more
+func queryAuditLogs(ctxlog context.Context, param *AuditLogListParam) ([]*model.AuditLogInfo, string, error) {
+	query := GetConfigServer().Client.AuditLog.Query().Where(auditlog.Namespace(param.Namespace))
+	if param.ObCluster != "" {
+		query = query.Where(auditlog.ObCluster(param.ObCluster))
+	}
+	if param.ObClusterId != 0 {
+		query = query.Where(auditlog.ObClusterID(param.ObClusterId))
+	}
+	if param.ObProxyGroup != "" {
+		query = query.Where(auditlog.ObproxyGroup(param.ObProxyGroup))
+	}
+	if param.Entity != "" {
+		query = query.Where(auditlog.Entity(param.Entity))
+	}
+	if param.StartTime != nil {
+		query = query.Where(auditlog.CreateTimeGTE(*param.StartTime))
+	}
+	if param.EndTime != nil {
+		query = query.Where(auditlog.CreateTimeLT(*param.EndTime))
+	}
+	if param.Cursor > 0 {
+		query = query.Where(auditlog.IDLT(param.Cursor))
+	}
+	auditLogs, err := query.Order(ent.Desc(auditlog.FieldID)).Limit(param.Limit + 1).All(ctxlog)
+	if err != nil {
+		return nil, "", wrapStorageError(err, "query audit logs")
+	}
+	nextCursor := ""
+	if len(auditLogs) > param.Limit {
+		auditLogs = auditLogs[:param.Limit]
+		nextCursor = strconv.Itoa(auditLogs[len(auditLogs)-1].ID)
+	}
+	auditLogInfos := make([]*model.AuditLogInfo, 0, len(auditLogs))
+	for _, auditLog := range auditLogs {
+		info, err := convertAuditLog(auditLog)
+		if err != nil {
+			return nil, "", err
+		}
+		auditLogInfos = append(auditLogInfos, info)
+	}
+	return auditLogInfos, nextCursor, nil
+}
+
+// purgeExpiredAuditLogs deletes audit logs older than retention, returns the affected rows
+func purgeExpiredAuditLogs(ctxlog context.Context, retention time.Duration) (int, error) {
+	affected, err := GetConfigServer().Client.AuditLog.
+		Delete().
+		Where(auditlog.CreateTimeLT(time.Now().Add(-retention))).
+		Exec(ctxlog)
+	if err != nil {
+		return 0, wrapStorageError(err, "purge expired audit logs")
+	}
+	return affected, nil
+}
+
+// runAuditLogPurger purges expired audit logs periodically until ctx is cancelled
+func runAuditLogPurger(ctx context.Context) {
+	runPurger(ctx, "audit logs", getAuditPurgeInterval(), getAuditRetention(), purgeExpiredAuditLogs)
+}
+
+func listAuditLogs(ctxlog context.Context, c *gin.Context) *ApiResponse {
+	param, err := getAuditLogListParam(c)
+	if err != nil {
+		return NewIllegalArgumentResponse(errors.Wrap(err, "parse list audit logs parameter"))
+	}
+	auditLogs, nextCursor, err := queryAuditLogs(ctxlog, param)
+	if err != nil {
+		return NewErrorResponse(errors.Wrap(err, fmt.Sprintf("list audit logs in namespace %s", param.Namespace)))
+	}
+	return NewSuccessResponse(&IterableData{
+		Contents:   auditLogs,
+		NextCursor: nextCursor,
+	})
+}
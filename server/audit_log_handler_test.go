@@ -0,0 +1,269 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oceanbase/configserver/config"
+	"github.com/oceanbase/configserver/model"
+)
+
+func auditTestRequest(method string, url string, body string, f func(context.Context, *gin.Context) *ApiResponse) *ApiResponse {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest(method, url, bytes.NewBuffer([]byte(body)))
+	c.Request.RemoteAddr = "2.2.2.2:12345"
+	c.Request.Header.Set("User-Agent", "audit-test")
+	c.Set(authUserKey, &config.AuthUserConfig{Name: "user1"})
+	return f(contextWithRequestAuditInfo(context.Background(), c, "trace1"), c)
+}
+
+func listAuditTestLogs(t *testing.T, query string) *IterableData {
+	response := auditTestRequest("GET", "http://1.1.1.1:8080/services?Action=ListAuditLogs&"+query, "", listAuditLogs)
+	require.Equal(t, http.StatusOK, response.Code)
+	return response.Data.(*IterableData)
+}
+
+func TestAuditLog(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_audit_log")
+
+	registerUrl := "http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster=g1&ObClusterId=1&version=2"
+	response := auditTestRequest("POST", registerUrl, fmt.Sprintf(testGroupRootServiceJsonFormat, model.OB_CLUSTER_TYPE_PRIMARY, 1, 1, 100), createOrUpdateObRootServiceInfo)
+	require.Equal(t, http.StatusOK, response.Code)
+	response = auditTestRequest("POST", registerUrl, fmt.Sprintf(testGroupRootServiceJsonFormat, model.OB_CLUSTER_TYPE_PRIMARY, 1, 2, 200), createOrUpdateObRootServiceInfo)
+	require.Equal(t, http.StatusOK, response.Code)
+	// registering the same rootservice info again changes nothing and leaves no audit log
+	response = auditTestRequest("POST", registerUrl, fmt.Sprintf(testGroupRootServiceJsonFormat, model.OB_CLUSTER_TYPE_PRIMARY, 1, 2, 200), createOrUpdateObRootServiceInfo)
+	require.Equal(t, http.StatusOK, response.Code)
+	response = auditTestRequest("DELETE", registerUrl, "", deleteObRootServiceInfo)
+	require.Equal(t, http.StatusOK, response.Code)
+	response = auditTestRequest("POST", "http://1.1.1.1:8080/services?Action=RestoreObCluster&ObCluster=g1&ObClusterId=1", "", restoreObCluster)
+	require.Equal(t, http.StatusOK, response.Code)
+	// changes made without request are recorded as system
+	registerGroupTestCluster(t, model.OB_CLUSTER_TYPE_STANDBY, 2, 100)
+
+	auditLogs := listAuditTestLogs(t, "ObCluster=g1&ObClusterId=1").Contents.([]*model.AuditLogInfo)
+	require.Equal(t, 4, len(auditLogs))
+	require.Equal(t, AUDIT_ENTITY_OB_CLUSTER, auditLogs[0].Entity)
+	require.Equal(t, CLUSTER_OPERATION_RESTORE, auditLogs[0].Operation)
+	require.Equal(t, CLUSTER_OPERATION_DELETE, auditLogs[1].Operation)
+	require.Equal(t, CLUSTER_OPERATION_UPDATE, auditLogs[2].Operation)
+	require.Equal(t, CLUSTER_OPERATION_CREATE, auditLogs[3].Operation)
+
+	created := auditLogs[3]
+	require.Nil(t, created.Before)
+	require.Equal(t, "1.1.1.1:2882", created.After.RsList[0].Address)
+	require.Equal(t, "ObRootServiceInfo", created.Action)
+	require.Equal(t, "2.2.2.2", created.RemoteIp)
+	require.Equal(t, "user1", created.Principal)
+	require.Equal(t, "audit-test", created.UserAgent)
+	require.Equal(t, "trace1", created.TraceId)
+
+	updated := auditLogs[2]
+	require.Equal(t, "1.1.1.1:2882", updated.Before.RsList[0].Address)
+	require.Equal(t, "1.1.1.2:2882", updated.After.RsList[0].Address)
+	require.Equal(t, "RestoreObCluster", auditLogs[0].Action)
+
+	auditLogs = listAuditTestLogs(t, "ObCluster=g1&ObClusterId=2").Contents.([]*model.AuditLogInfo)
+	require.Equal(t, 1, len(auditLogs))
+	require.Equal(t, AUDIT_PRINCIPAL_SYSTEM, auditLogs[0].Principal)
+
+	// pagination
+	page := listAuditTestLogs(t, "ObCluster=g1&Entity=ObCluster&Limit=3")
+	require.Equal(t, 3, len(page.Contents.([]*model.AuditLogInfo)))
+	require.NotEmpty(t, page.NextCursor)
+	page = listAuditTestLogs(t, "ObCluster=g1&Entity=ObCluster&Limit=3&Cursor="+page.NextCursor)
+	require.Equal(t, 2, len(page.Contents.([]*model.AuditLogInfo)))
+	require.Empty(t, page.NextCursor)
+
+	// time range
+	auditLogs = listAuditTestLogs(t, fmt.Sprintf("EndTime=%d", time.Now().Add(-time.Hour).Unix())).Contents.([]*model.AuditLogInfo)
+	require.Equal(t, 0, len(auditLogs))
+
+	affected, err := purgeExpiredAuditLogs(context.Background(), DEFAULT_AUDIT_RETENTION)
+	require.Nil(t, err)
+	require.Equal(t, 0, affected)
+	affected, err = purgeExpiredAuditLogs(context.Background(), -1)
+	require.Nil(t, err)
+	require.Less(t, 5, affected)
+}
+
+func TestAuditLogOfFailover(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_audit_log_failover")
+
+	registerGroupTestCluster(t, model.OB_CLUSTER_TYPE_STANDBY, 1, 100)
+	// failed switchover changes nothing and leaves no audit log
+	response := auditTestRequest("POST", "http://1.1.1.1:8080/services?Action=SwitchoverObCluster&ObCluster=g1&ObClusterId=1", "", switchoverObCluster)
+	require.Equal(t, http.StatusConflict, response.Code)
+	require.Equal(t, 1, len(listAuditTestLogs(t, "ObCluster=g1&Entity=ObCluster").Contents.([]*model.AuditLogInfo)))
+	groupLogs := len(listAuditTestLogs(t, "ObCluster=g1&Entity=ObClusterGroup").Contents.([]*model.AuditLogInfo))
+
+	response = auditTestRequest("POST", "http://1.1.1.1:8080/services?Action=FailoverObCluster&ObCluster=g1&ObClusterId=1", "", failoverObCluster)
+	require.Equal(t, http.StatusOK, response.Code)
+	auditLogs := listAuditTestLogs(t, "ObCluster=g1&Entity=ObCluster").Contents.([]*model.AuditLogInfo)
+	require.Equal(t, 2, len(auditLogs))
+	require.Equal(t, "FailoverObCluster", auditLogs[0].Action)
+	require.Equal(t, model.OB_CLUSTER_TYPE_STANDBY, auditLogs[0].Before.Type)
+	require.Equal(t, model.OB_CLUSTER_TYPE_PRIMARY, auditLogs[0].After.Type)
+
+	// the primary change of the group is recorded as well
+	auditLogs = listAuditTestLogs(t, "ObCluster=g1&Entity=ObClusterGroup").Contents.([]*model.AuditLogInfo)
+	require.Equal(t, groupLogs+1, len(auditLogs))
+	require.Equal(t, "FailoverObCluster", auditLogs[0].Action)
+	require.Equal(t, CLUSTER_OPERATION_UPDATE, auditLogs[0].Operation)
+	require.Equal(t, int64(0), auditLogs[0].ObClusterGroupBefore.PrimaryClusterId)
+	require.Equal(t, int64(1), auditLogs[0].ObClusterGroupAfter.PrimaryClusterId)
+}
+
+func TestAuditLogOfObProxyGroup(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_audit_log_obproxy_group")
+	keyFile := filepath.Join(t.TempDir(), "key")
+	require.Nil(t, os.WriteFile(keyFile, []byte("000102030405060708090a0b0c0d0e0f"), 0600))
+	configServer.Config.MetaDatabase = &config.MetaDatabaseConfig{KeyFile: keyFile}
+	defer func() {
+		configServer.Config.MetaDatabase = nil
+	}()
+
+	groupUrl := "http://1.1.1.1:8080/services?Action=ObProxyGroup&ObProxyGroup=g1"
+	response := auditTestRequest("POST", groupUrl, `{"Name":"g1","ObClusters":["c1"],"MetaDatabase":{"User":"proxyro","Password":"password1"}}`, saveObProxyGroup)
+	require.Equal(t, http.StatusOK, response.Code)
+	response = auditTestRequest("POST", groupUrl, `{"Name":"g1","ObClusters":["c1","c2"]}`, saveObProxyGroup)
+	require.Equal(t, http.StatusOK, response.Code)
+	response = auditTestRequest("DELETE", groupUrl, "", deleteObProxyGroup)
+	require.Equal(t, http.StatusOK, response.Code)
+
+	auditLogs := listAuditTestLogs(t, "ObProxyGroup=g1").Contents.([]*model.AuditLogInfo)
+	require.Equal(t, 3, len(auditLogs))
+	require.Equal(t, CLUSTER_OPERATION_DELETE, auditLogs[0].Operation)
+	require.Equal(t, CLUSTER_OPERATION_UPDATE, auditLogs[1].Operation)
+	require.Equal(t, CLUSTER_OPERATION_CREATE, auditLogs[2].Operation)
+	for _, auditLog := range auditLogs {
+		require.Equal(t, AUDIT_ENTITY_OBPROXY_GROUP, auditLog.Entity)
+		require.Equal(t, "g1", auditLog.ObProxyGroup)
+		require.Empty(t, auditLog.ObCluster)
+		require.Nil(t, auditLog.Before)
+		require.Nil(t, auditLog.After)
+		require.Equal(t, "ObProxyGroup", auditLog.Action)
+		require.Equal(t, "user1", auditLog.Principal)
+	}
+
+	created := auditLogs[2]
+	require.Nil(t, created.ObProxyGroupBefore)
+	require.Equal(t, []string{"c1"}, created.ObProxyGroupAfter.ObClusters)
+	require.Equal(t, "proxyro", created.ObProxyGroupAfter.MetaDatabase.User)
+	require.Equal(t, []string{"c1", "c2"}, auditLogs[1].ObProxyGroupAfter.ObClusters)
+	require.Nil(t, auditLogs[0].ObProxyGroupAfter)
+	require.Equal(t, []string{"c1", "c2"}, auditLogs[0].ObProxyGroupBefore.ObClusters)
+
+	// password is never recorded
+	rawAuditLogs, err := GetConfigServer().Client.AuditLog.Query().All(context.Background())
+	require.Nil(t, err)
+	for _, auditLog := range rawAuditLogs {
+		require.NotContains(t, auditLog.BeforeJSON, "password1")
+		require.NotContains(t, auditLog.AfterJSON, "password1")
+	}
+
+	// audit logs of clusters are not mixed with the group
+	require.Equal(t, 0, len(listAuditTestLogs(t, "ObCluster=g1").Contents.([]*model.AuditLogInfo)))
+}
+
+func TestAuditLogOfObProxy(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_audit_log_obproxy")
+
+	heartbeatUrl := "http://1.1.1.1:8080/services?Action=ObProxyHeartbeat"
+	response := auditTestRequest("POST", heartbeatUrl, `{"Name":"proxy1","Address":"10.0.0.1:2883","Version":"4.2.1","ConfigVersion":"v1"}`, reportObProxyHeartbeat)
+	require.Equal(t, http.StatusOK, response.Code)
+	// a heartbeat reporting nothing new only refreshes the last seen time and leaves no audit log
+	response = auditTestRequest("POST", heartbeatUrl, `{"Name":"proxy1","Address":"10.0.0.1:2883","Version":"4.2.1","ConfigVersion":"v1"}`, reportObProxyHeartbeat)
+	require.Equal(t, http.StatusOK, response.Code)
+	response = auditTestRequest("POST", heartbeatUrl, `{"Name":"proxy1","Address":"10.0.0.1:2883","Version":"4.2.1","ConfigVersion":"v2"}`, reportObProxyHeartbeat)
+	require.Equal(t, http.StatusOK, response.Code)
+	affected, err := purgeExpiredObProxies(context.Background(), -1)
+	require.Nil(t, err)
+	require.Equal(t, 1, affected)
+
+	auditLogs := listAuditTestLogs(t, "Entity=ObProxy").Contents.([]*model.AuditLogInfo)
+	require.Equal(t, 3, len(auditLogs))
+	require.Equal(t, CLUSTER_OPERATION_DELETE, auditLogs[0].Operation)
+	require.Equal(t, AUDIT_PRINCIPAL_SYSTEM, auditLogs[0].Principal)
+	require.Equal(t, "v2", auditLogs[0].ObProxyBefore.ConfigVersion)
+	require.Nil(t, auditLogs[0].ObProxyAfter)
+
+	updated := auditLogs[1]
+	require.Equal(t, CLUSTER_OPERATION_UPDATE, updated.Operation)
+	require.Equal(t, "proxy1", updated.EntityName)
+	require.Equal(t, "ObProxyHeartbeat", updated.Action)
+	require.Equal(t, "user1", updated.Principal)
+	require.Equal(t, "v1", updated.ObProxyBefore.ConfigVersion)
+	require.Equal(t, "v2", updated.ObProxyAfter.ConfigVersion)
+	require.Equal(t, "10.0.0.1:2883", updated.ObProxyAfter.Address)
+
+	require.Equal(t, CLUSTER_OPERATION_CREATE, auditLogs[2].Operation)
+	require.Nil(t, auditLogs[2].ObProxyBefore)
+}
+
+func TestAuditLogOfWebhookDeliveryRetry(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	receiver, server := initWebhookTestServer(t, "ent_audit_log_webhook", http.StatusInternalServerError, []string{WEBHOOK_EVENT_CLUSTER_CREATED})
+	defer server.Close()
+
+	registerGroupTestCluster(t, model.OB_CLUSTER_TYPE_PRIMARY, 1, 100)
+	for i := 0; i < 2; i++ {
+		time.Sleep(10 * time.Millisecond)
+		dispatched, err := dispatchWebhookDeliveries(context.Background())
+		require.Nil(t, err)
+		require.Equal(t, 1, dispatched)
+	}
+	require.Equal(t, 1, len(listWebhookTestDeliveries(t, "Status=DEAD")))
+	// attempts made by the dispatcher are not recorded
+	require.Equal(t, 0, len(listAuditTestLogs(t, "Entity=WebhookDelivery").Contents.([]*model.AuditLogInfo)))
+
+	receiver.status = http.StatusOK
+	response := auditTestRequest("POST", "http://1.1.1.1:8080/services?Action=RetryWebhookDelivery&Id=1", "", retryWebhookDelivery)
+	require.Equal(t, http.StatusOK, response.Code)
+	dispatched, err := dispatchWebhookDeliveries(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, 1, dispatched)
+
+	auditLogs := listAuditTestLogs(t, "Entity=WebhookDelivery").Contents.([]*model.AuditLogInfo)
+	require.Equal(t, 1, len(auditLogs))
+	retried := auditLogs[0]
+	require.Equal(t, "RetryWebhookDelivery", retried.Action)
+	require.Equal(t, CLUSTER_OPERATION_UPDATE, retried.Operation)
+	require.Equal(t, "1", retried.EntityName)
+	require.Equal(t, "g1", retried.ObCluster)
+	require.Equal(t, "user1", retried.Principal)
+	require.Equal(t, WEBHOOK_DELIVERY_STATUS_DEAD, retried.WebhookDeliveryBefore.Status)
+	require.Equal(t, WEBHOOK_DELIVERY_STATUS_PENDING, retried.WebhookDeliveryAfter.Status)
+	require.Equal(t, 0, retried.WebhookDeliveryAfter.Attempts)
+}
@@ -0,0 +1,83 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/oceanbase/configserver/config"
+)
+
+const authUserKey = "configserver/auth_user"
+
+func findAuthUser(name, password string) *config.AuthUserConfig {
+	server := GetConfigServer()
+	if server == nil || server.Config == nil || server.Config.Auth == nil {
+		return nil
+	}
+	for _, user := range server.Config.Auth.Users {
+		if user.Name == name && subtle.ConstantTimeCompare([]byte(user.Password), []byte(password)) == 1 {
+			return user
+		}
+	}
+	return nil
+}
+
+// getAuthUser returns the user authenticated by authHandler, nil if the request is anonymous
+func getAuthUser(c *gin.Context) *config.AuthUserConfig {
+	if value, ok := c.Get(authUserKey); ok {
+		return value.(*config.AuthUserConfig)
+	}
+	return nil
+}
+
+// authHandler authenticates requests with basic auth credentials,
+// anonymous requests are allowed, but requests with wrong credentials are rejected,
+// users bound to a namespace are not allowed to access other namespaces
+func authHandler() gin.HandlerFunc {
+	fn := func(c *gin.Context) {
+		name, password, ok := c.Request.BasicAuth()
+		if !ok {
+			c.Next()
+			return
+		}
+		user := findAuthUser(name, password)
+		if user == nil {
+			handlerFunctionWrapper(func(ctxlog context.Context, c *gin.Context) *ApiResponse {
+				return NewUnauthorizedResponse(errors.New(fmt.Sprintf("invalid credentials for user %s", name)))
+			})(c)
+			c.Abort()
+			return
+		}
+		namespace := getRequestedNamespace(c)
+		if user.Namespace != "" && namespace != "" && namespace != user.Namespace {
+			handlerFunctionWrapper(func(ctxlog context.Context, c *gin.Context) *ApiResponse {
+				return NewForbiddenResponse(errors.New(fmt.Sprintf("user %s is not allowed to access namespace %s", name, namespace)))
+			})(c)
+			c.Abort()
+			return
+		}
+		c.Set(authUserKey, user)
+		c.Next()
+	}
+	return gin.HandlerFunc(fn)
+}
@@ -0,0 +1,244 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"compress/flate"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/oceanbase/configserver/config"
+)
+
+const (
+	ENCODING_GZIP    = "gzip"
+	ENCODING_DEFLATE = "deflate"
+
+	DEFAULT_COMPRESSION_MIN_SIZE = 1024
+)
+
+// supported encodings in order of preference when clients accept them with the same quality
+var supportedEncodings = []string{ENCODING_GZIP, ENCODING_DEFLATE}
+
+func getCompressionConfig() *config.CompressionConfig {
+	if server := GetConfigServer(); server != nil && server.Config != nil {
+		return newCompressionConfig(server.Config.Compression)
+	}
+	return newCompressionConfig(nil)
+}
+
+// newCompressionConfig returns a copy of the configured one with defaults filled
+func newCompressionConfig(configured *config.CompressionConfig) *config.CompressionConfig {
+	compressionConfig := &config.CompressionConfig{}
+	if configured != nil {
+		*compressionConfig = *configured
+	}
+	if compressionConfig.MinSize <= 0 {
+		compressionConfig.MinSize = DEFAULT_COMPRESSION_MIN_SIZE
+	}
+	if compressionConfig.Level == nil {
+		level := flate.DefaultCompression
+		compressionConfig.Level = &level
+	}
+	return compressionConfig
+}
+
+// negotiateEncoding returns the supported encoding with the highest quality in Accept-Encoding,
+// or empty if the client accepts none of them
+func negotiateEncoding(acceptEncoding string) string {
+	selected := ""
+	selectedQuality := 0.0
+	qualities := make(map[string]float64)
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		fields := strings.Split(part, ";")
+		coding := strings.ToLower(strings.TrimSpace(fields[0]))
+		if coding == "" {
+			continue
+		}
+		quality := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				q, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64)
+				if err != nil {
+					q = 0
+				}
+				quality = q
+			}
+		}
+		qualities[coding] = quality
+	}
+	for _, encoding := range supportedEncodings {
+		quality, ok := qualities[encoding]
+		if !ok {
+			quality, ok = qualities["*"]
+		}
+		if ok && quality > selectedQuality {
+			selected, selectedQuality = encoding, quality
+		}
+	}
+	return selected
+}
+
+// compressWriter buffers the response until it reaches min size, then decides whether to compress it
+type compressWriter struct {
+	gin.ResponseWriter
+	encoding   string
+	level      int
+	minSize    int
+	buffer     []byte
+	decided    bool
+	compressor io.WriteCloser
+}
+
+func (w *compressWriter) Write(data []byte) (int, error) {
+	if !w.decided {
+		w.buffer = append(w.buffer, data...)
+		if len(w.buffer) < w.minSize {
+			return len(data), nil
+		}
+		if err := w.decide(true); err != nil {
+			return 0, err
+		}
+		return len(data), nil
+	}
+	if w.compressor != nil {
+		return w.compressor.Write(data)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *compressWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+func (w *compressWriter) Flush() {
+	if !w.decided {
+		_ = w.decide(false)
+	}
+	if flusher, ok := w.compressor.(interface{ Flush() error }); ok {
+		_ = flusher.Flush()
+	}
+	w.ResponseWriter.Flush()
+}
+
+// compressible excludes responses encoded already, and responses served with ranges like obproxy binaries
+func (w *compressWriter) compressible() bool {
+	header := w.Header()
+	if header.Get("Content-Encoding") != "" || header.Get("Accept-Ranges") != "" || header.Get("Content-Range") != "" {
+		return false
+	}
+	if strings.HasPrefix(header.Get("Content-Type"), "application/octet-stream") {
+		return false
+	}
+	status := w.Status()
+	return status >= http.StatusOK && status != http.StatusNoContent && status != http.StatusNotModified && status != http.StatusPartialContent
+}
+
+func (w *compressWriter) decide(compress bool) error {
+	w.decided = true
+	if compress && w.compressible() {
+		header := w.Header()
+		header.Set("Content-Encoding", w.encoding)
+		header.Del("Content-Length")
+		// the compressed body is not byte-identical to the uncompressed one
+		if etag := header.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+			header.Set("ETag", "W/"+etag)
+		}
+		compressor, err := newCompressor(w.ResponseWriter, w.encoding, w.level)
+		if err != nil {
+			return err
+		}
+		w.compressor = compressor
+	}
+	buffer := w.buffer
+	w.buffer = nil
+	if len(buffer) == 0 {
+		return nil
+	}
+	var err error
+	if w.compressor != nil {
+		_, err = w.compressor.Write(buffer)
+	} else {
+		_, err = w.ResponseWriter.Write(buffer)
+	}
+	return err
+}
+
+func (w *compressWriter) close() error {
+	if !w.decided {
+		if err := w.decide(false); err != nil {
+			return err
+		}
+	}
+	if w.compressor != nil {
+		return w.compressor.Close()
+	}
+	return nil
+}
+
+func newCompressor(writer io.Writer, encoding string, level int) (io.WriteCloser, error) {
+	switch encoding {
+	case ENCODING_GZIP:
+		return gzip.NewWriterLevel(writer, level)
+	case ENCODING_DEFLATE:
+		return flate.NewWriter(writer, level)
+	default:
+		return nil, errors.Errorf("unsupported encoding %s", encoding)
+	}
+}
+
+// compressionHandler compresses responses of at least min size with the encoding negotiated by Accept-Encoding
+func compressionHandler(conf *config.CompressionConfig) gin.HandlerFunc {
+	if _, err := gzip.NewWriterLevel(io.Discard, *conf.Level); err != nil {
+		log.WithError(err).Fatal("initialize compression")
+	}
+	fn := func(c *gin.Context) {
+		if conf.Disabled || c.Request.Method == http.MethodHead {
+			c.Next()
+			return
+		}
+		c.Header("Vary", "Accept-Encoding")
+		encoding := negotiateEncoding(c.GetHeader("Accept-Encoding"))
+		if encoding == "" {
+			c.Next()
+			return
+		}
+		writer := &compressWriter{
+			ResponseWriter: c.Writer,
+			encoding:       encoding,
+			level:          *conf.Level,
+			minSize:        conf.MinSize,
+		}
+		c.Writer = writer
+		defer func() {
+			if err := writer.close(); err != nil {
+				log.WithError(err).Warn("failed to write compressed response")
+			}
+			c.Writer = writer.ResponseWriter
+		}()
+		c.Next()
+	}
+	return fn
+}
@@ -0,0 +1,140 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/oceanbase/configserver/config"
+)
+
+func newCompressionTestRouter(conf *config.CompressionConfig) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(compressionHandler(conf))
+	r.GET("/large", func(c *gin.Context) {
+		c.Header("ETag", `"v1"`)
+		c.String(http.StatusOK, strings.Repeat("configserver", 1000))
+	})
+	r.GET("/small", func(c *gin.Context) {
+		c.String(http.StatusOK, "configserver")
+	})
+	r.GET("/binary", func(c *gin.Context) {
+		http.ServeContent(c.Writer, c.Request, "obproxy", time.Now(), bytes.NewReader(bytes.Repeat([]byte("obproxy"), 1000)))
+	})
+	return r
+}
+
+func serveCompressionTestRequest(r *gin.Engine, url, acceptEncoding string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, url, nil)
+	if acceptEncoding != "" {
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+	}
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestNegotiateEncoding(t *testing.T) {
+	require.Equal(t, "", negotiateEncoding(""))
+	require.Equal(t, "", negotiateEncoding("br, identity"))
+	require.Equal(t, ENCODING_GZIP, negotiateEncoding("gzip, deflate, br"))
+	require.Equal(t, ENCODING_GZIP, negotiateEncoding("GZIP"))
+	require.Equal(t, ENCODING_DEFLATE, negotiateEncoding("deflate"))
+	require.Equal(t, ENCODING_DEFLATE, negotiateEncoding("gzip;q=0.5, deflate"))
+	require.Equal(t, ENCODING_DEFLATE, negotiateEncoding("gzip;q=0, *"))
+	require.Equal(t, ENCODING_GZIP, negotiateEncoding("*"))
+}
+
+func TestCompression(t *testing.T) {
+	r := newCompressionTestRouter(newCompressionConfig(&config.CompressionConfig{MinSize: 1024}))
+	expected := strings.Repeat("configserver", 1000)
+
+	w := serveCompressionTestRequest(r, "/large", "gzip, deflate")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, ENCODING_GZIP, w.Header().Get("Content-Encoding"))
+	require.Equal(t, "Accept-Encoding", w.Header().Get("Vary"))
+	require.Equal(t, `W/"v1"`, w.Header().Get("ETag"))
+	require.Less(t, w.Body.Len(), len(expected))
+	reader, err := gzip.NewReader(w.Body)
+	require.Nil(t, err)
+	content, err := io.ReadAll(reader)
+	require.Nil(t, err)
+	require.Equal(t, expected, string(content))
+
+	w = serveCompressionTestRequest(r, "/large", "deflate")
+	require.Equal(t, ENCODING_DEFLATE, w.Header().Get("Content-Encoding"))
+	content, err = io.ReadAll(flate.NewReader(w.Body))
+	require.Nil(t, err)
+	require.Equal(t, expected, string(content))
+
+	// not accepted by the client
+	w = serveCompressionTestRequest(r, "/large", "")
+	require.Equal(t, "", w.Header().Get("Content-Encoding"))
+	require.Equal(t, `"v1"`, w.Header().Get("ETag"))
+	require.Equal(t, expected, w.Body.String())
+
+	// smaller than min size
+	w = serveCompressionTestRequest(r, "/small", "gzip")
+	require.Equal(t, "", w.Header().Get("Content-Encoding"))
+	require.Equal(t, "configserver", w.Body.String())
+
+	// range responses are never compressed
+	w = serveCompressionTestRequest(r, "/binary", "gzip")
+	require.Equal(t, "", w.Header().Get("Content-Encoding"))
+	require.Equal(t, 7000, w.Body.Len())
+
+	// disabled
+	r = newCompressionTestRouter(newCompressionConfig(&config.CompressionConfig{Disabled: true}))
+	w = serveCompressionTestRequest(r, "/large", "gzip")
+	require.Equal(t, "", w.Header().Get("Content-Encoding"))
+	require.Equal(t, expected, w.Body.String())
+}
+
+func TestCompressionLevel(t *testing.T) {
+	require.Equal(t, flate.DefaultCompression, *newCompressionConfig(nil).Level)
+	require.Equal(t, DEFAULT_COMPRESSION_MIN_SIZE, newCompressionConfig(nil).MinSize)
+
+	// level 0 is no compression rather than the default level
+	conf := &config.CompressionConfig{}
+	require.Nil(t, yaml.Unmarshal([]byte("level: 0"), conf))
+	conf = newCompressionConfig(conf)
+	require.Equal(t, flate.NoCompression, *conf.Level)
+
+	r := newCompressionTestRouter(conf)
+	expected := strings.Repeat("configserver", 1000)
+	w := serveCompressionTestRequest(r, "/large", "gzip")
+	require.Equal(t, ENCODING_GZIP, w.Header().Get("Content-Encoding"))
+	require.Greater(t, w.Body.Len(), len(expected))
+	reader, err := gzip.NewReader(w.Body)
+	require.Nil(t, err)
+	content, err := io.ReadAll(reader)
+	require.Nil(t, err)
+	require.Equal(t, expected, string(content))
+}
@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"net/http"
 
+	atlas "ariga.io/atlas/sql/schema"
+	"entgo.io/ent/dialect/sql/schema"
 	"github.com/gin-gonic/gin"
 	_ "github.com/go-sql-driver/mysql"
 	_ "github.com/mattn/go-sqlite3"
@@ -28,6 +30,7 @@ import (
 
 	"github.com/oceanbase/configserver/config"
 	"github.com/oceanbase/configserver/ent"
+	"github.com/oceanbase/configserver/ent/migrate"
 	"github.com/oceanbase/configserver/lib/trace"
 	"github.com/oceanbase/configserver/logger"
 )
@@ -66,10 +69,11 @@ func (server *ConfigServer) Run() error {
 	}
 
 	server.Client = client
+	registerStorageHooks(server.Client)
 
 	defer server.Client.Close()
 
-	if err := server.Client.Schema.Create(context.Background()); err != nil {
+	if err := migrateSchema(context.Background(), server.Client); err != nil {
 		return errors.Wrap(err, "create configserver schema")
 	}
 
@@ -77,6 +81,16 @@ func (server *ConfigServer) Run() error {
 	ctx, cancel := context.WithCancel(trace.ContextWithTraceId(logger.INIT_TRACEID))
 	server.Server.Cancel = cancel
 
+	// purge clusters expired in recycle bin
+	go runRecycleBinPurger(ctx)
+	// purge expired audit logs
+	go runAuditLogPurger(ctx)
+	// send webhook deliveries and purge the finished ones
+	go runWebhookDispatcher(ctx)
+	go runWebhookDeliveryPurger(ctx)
+	// remove obproxies without heartbeat
+	go runObProxyPurger(ctx)
+
 	// register route
 	InitConfigServerRoutes(server.Server.Router)
 
@@ -84,3 +98,40 @@ func (server *ConfigServer) Run() error {
 	server.Server.Run(ctx)
 	return nil
 }
+
+// indexes created by older versions and no longer defined, only they are dropped when migrating,
+// other indexes not in the schema, like the ones added by operators, are kept
+var obsoleteIndexes = map[string]bool{
+	// the unique index of ob cluster without namespace
+	"obcluster_name_ob_cluster_id": true,
+}
+
+// keepUnknownIndexes is a diff hook removing the drops of indexes not in obsoleteIndexes
+func keepUnknownIndexes(next schema.Differ) schema.Differ {
+	return schema.DiffFunc(func(current, desired *atlas.Schema) ([]atlas.Change, error) {
+		changes, err := next.Diff(current, desired)
+		if err != nil {
+			return nil, err
+		}
+		for _, change := range changes {
+			modify, ok := change.(*atlas.ModifyTable)
+			if !ok {
+				continue
+			}
+			kept := make([]atlas.Change, 0, len(modify.Changes))
+			for _, c := range modify.Changes {
+				if drop, ok := c.(*atlas.DropIndex); ok && !obsoleteIndexes[drop.I.Name] {
+					continue
+				}
+				kept = append(kept, c)
+			}
+			modify.Changes = kept
+		}
+		return changes, nil
+	})
+}
+
+// migrateSchema creates or upgrades tables of configserver, only obsolete indexes are dropped
+func migrateSchema(ctx context.Context, client *ent.Client, opts ...schema.MigrateOption) error {
+	return client.Schema.Create(ctx, append(opts, migrate.WithDropIndex(true), schema.WithDiffHook(keepUnknownIndexes))...)
+}
@@ -0,0 +1,133 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	stderrors "errors"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/oceanbase/configserver/ent"
+)
+
+// ErrorCode is a stable identifier of the error type returned to clients, each code maps to a http status
+type ErrorCode string
+
+const (
+	ErrorCodeInvalidParameter    ErrorCode = "InvalidParameter"
+	ErrorCodeInvalidAction       ErrorCode = "InvalidAction"
+	ErrorCodeUnauthorized        ErrorCode = "Unauthorized"
+	ErrorCodeForbidden           ErrorCode = "Forbidden"
+	ErrorCodeClusterNotFound     ErrorCode = "ClusterNotFound"
+	ErrorCodeResourceNotFound    ErrorCode = "ResourceNotFound"
+	ErrorCodeConflict            ErrorCode = "Conflict"
+	ErrorCodeTooManyRequests     ErrorCode = "TooManyRequests"
+	ErrorCodeNotImplemented      ErrorCode = "NotImplemented"
+	ErrorCodeStorageUnavailable  ErrorCode = "StorageUnavailable"
+	ErrorCodeServerBusy          ErrorCode = "ServerBusy"
+	ErrorCodeUpstreamUnavailable ErrorCode = "UpstreamUnavailable"
+	ErrorCodeInternalError       ErrorCode = "InternalError"
+)
+
+type errorCodeInfo struct {
+	status      int
+	description string
+}
+
+var errorCodeInfos = map[ErrorCode]*errorCodeInfo{
+	ErrorCodeInvalidParameter:    {http.StatusBadRequest, "illegal argument"},
+	ErrorCodeInvalidAction:       {http.StatusBadRequest, "illegal argument"},
+	ErrorCodeUnauthorized:        {http.StatusUnauthorized, "unauthorized"},
+	ErrorCodeForbidden:           {http.StatusForbidden, "forbidden"},
+	ErrorCodeClusterNotFound:     {http.StatusNotFound, "resource not found"},
+	ErrorCodeResourceNotFound:    {http.StatusNotFound, "resource not found"},
+	ErrorCodeConflict:            {http.StatusConflict, "conflict"},
+	ErrorCodeTooManyRequests:     {http.StatusTooManyRequests, "too many requests"},
+	ErrorCodeNotImplemented:      {http.StatusNotImplemented, "request not implemented"},
+	ErrorCodeStorageUnavailable:  {http.StatusServiceUnavailable, "storage unavailable"},
+	ErrorCodeServerBusy:          {http.StatusServiceUnavailable, "server busy"},
+	ErrorCodeUpstreamUnavailable: {http.StatusServiceUnavailable, "upstream unavailable"},
+	ErrorCodeInternalError:       {http.StatusInternalServerError, "got internal error"},
+}
+
+func (code ErrorCode) HttpStatus() int {
+	if info, ok := errorCodeInfos[code]; ok {
+		return info.status
+	}
+	return http.StatusInternalServerError
+}
+
+func (code ErrorCode) Description() string {
+	if info, ok := errorCodeInfos[code]; ok {
+		return info.description
+	}
+	return errorCodeInfos[ErrorCodeInternalError].description
+}
+
+// ApiError is an error with error code, it can be wrapped with more context and the code is still found by GetErrorCode
+type ApiError struct {
+	Code ErrorCode
+	Err  error
+}
+
+func (e *ApiError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ApiError) Unwrap() error {
+	return e.Err
+}
+
+func NewApiError(code ErrorCode, err error) error {
+	return &ApiError{
+		Code: code,
+		Err:  err,
+	}
+}
+
+func NewApiErrorf(code ErrorCode, format string, args ...interface{}) error {
+	return NewApiError(code, errors.New(fmt.Sprintf(format, args...)))
+}
+
+// GetErrorCode returns the code of the outermost ApiError in the error chain, ErrorCodeInternalError if there's none
+func GetErrorCode(err error) ErrorCode {
+	var apiError *ApiError
+	if stderrors.As(err, &apiError) {
+		return apiError.Code
+	}
+	return ErrorCodeInternalError
+}
+
+// wrapStorageError attaches error code to errors returned by storage,
+// errors that are not caused by the request are considered as storage unavailable
+func wrapStorageError(err error, message string) error {
+	if err == nil {
+		return nil
+	}
+	code := ErrorCodeStorageUnavailable
+	switch {
+	case ent.IsNotFound(err):
+		code = ErrorCodeResourceNotFound
+	case ent.IsConstraintError(err):
+		code = ErrorCodeConflict
+	case ent.IsValidationError(err):
+		code = ErrorCodeInvalidParameter
+	}
+	return NewApiError(code, errors.Wrap(err, message))
+}
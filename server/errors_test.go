@@ -0,0 +1,111 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetErrorCode(t *testing.T) {
+	err := NewApiErrorf(ErrorCodeClusterNotFound, "no obcluster found")
+	require.Equal(t, ErrorCodeClusterNotFound, GetErrorCode(err))
+	require.Equal(t, ErrorCodeClusterNotFound, GetErrorCode(errors.Wrap(err, "query")))
+	require.Equal(t, ErrorCodeInternalError, GetErrorCode(errors.New("unknown")))
+
+	response := NewErrorResponse(errors.Wrap(err, "query"))
+	require.Equal(t, http.StatusNotFound, response.Code)
+	require.Equal(t, ErrorCodeClusterNotFound, response.ErrorCode)
+	require.False(t, response.Successful)
+}
+
+func TestErrorResponseMessage(t *testing.T) {
+	// messages are the same as the ones before error codes are introduced
+	err := errors.New("reason")
+	for message, response := range map[string]*ApiResponse{
+		"bad request: reason":             NewBadRequestResponse(err),
+		"illegal argument: reason":        NewIllegalArgumentResponse(err),
+		"unauthorized: reason":            NewUnauthorizedResponse(err),
+		"forbidden: reason":               NewForbiddenResponse(err),
+		"resource not found: reason":      NewNotFoundResponse(err),
+		"request not implemented: reason": NewNotImplementedResponse(err),
+		"got internal error: reason":      NewErrorResponse(err),
+	} {
+		require.Equal(t, message, response.Message)
+		require.NotEmpty(t, response.ErrorCode)
+	}
+	response := NewBadRequestResponse(err)
+	require.Equal(t, http.StatusBadRequest, response.Code)
+	require.Equal(t, ErrorCodeInvalidParameter, response.ErrorCode)
+}
+
+func TestErrorCodeOfInvalidParameter(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_error_code_parameter")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster=c1&ObClusterId=abc", nil)
+	response := getObRootServiceInfo(context.Background(), c)
+	require.Equal(t, http.StatusBadRequest, response.Code)
+	require.Equal(t, ErrorCodeInvalidParameter, response.ErrorCode)
+}
+
+func TestErrorCodeOfClusterNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_error_code_not_found")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster=c1", nil)
+	response := getObRootServiceInfo(context.Background(), c)
+	require.Equal(t, http.StatusNotFound, response.Code)
+	require.Equal(t, ErrorCodeClusterNotFound, response.ErrorCode)
+}
+
+func TestErrorCodeOfStorageUnavailable(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_error_code_storage")
+	require.Nil(t, GetConfigServer().Client.Close())
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster=c1", nil)
+	response := getObRootServiceInfo(context.Background(), c)
+	require.Equal(t, http.StatusServiceUnavailable, response.Code)
+	require.Equal(t, ErrorCodeStorageUnavailable, response.ErrorCode)
+}
+
+func TestErrorCodeOfSwitchoverConflict(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_error_code_conflict")
+
+	registerGroupTestCluster(t, "STANDBY", 1, 100)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "http://1.1.1.1:8080/services?Action=SwitchoverObCluster&ObCluster=g1&ObClusterId=1", nil)
+	response := switchoverObCluster(context.Background(), c)
+	require.Equal(t, http.StatusConflict, response.Code)
+	require.Equal(t, ErrorCodeConflict, response.ErrorCode)
+}
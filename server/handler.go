@@ -18,6 +18,7 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"sync"
 	"time"
@@ -46,24 +47,73 @@ func getServerIdentity() string {
 	return ip
 }
 
+// apiRequest keeps what the request is logged with, it's shared by the legacy Action api and the v3 api
+type apiRequest struct {
+	ctxlog  context.Context
+	traceId string
+	fields  log.Fields
+	tStart  time.Time
+}
+
+// startApiRequest assigns a trace id to the request and logs it
+func startApiRequest(c *gin.Context) *apiRequest {
+	traceId := trace.RandomTraceId()
+	request := &apiRequest{
+		ctxlog:  contextWithRequestAuditInfo(trace.ContextWithTraceId(traceId), c, traceId),
+		traceId: traceId,
+		fields:  getRequestLogFields(c),
+		tStart:  time.Now(),
+	}
+	c.Set(traceIdKey, traceId)
+	log.WithContext(request.ctxlog).WithFields(request.fields).Infof("handle request: %s %s", c.Request.Method, c.Request.RequestURI)
+	return request
+}
+
+func (request *apiRequest) cost() int64 {
+	return time.Now().Sub(request.tStart).Milliseconds()
+}
+
+// logResponse logs the status of the request, and the response body at debug level if it's not empty
+func (request *apiRequest) logResponse(status int, responseJson string) {
+	request.fields[LOG_FIELD_STATUS] = status
+	request.fields[LOG_FIELD_COST] = request.cost()
+	log.WithContext(request.ctxlog).WithFields(request.fields).Info("request handled")
+	if responseJson != "" && log.IsLevelEnabled(log.DebugLevel) {
+		log.WithContext(request.ctxlog).WithFields(request.fields).Debugf("response: %s", truncateForLog(responseJson, MAX_LOGGED_RESPONSE_SIZE))
+	}
+}
+
+// logError logs the request failed to be rendered with the status written instead
+func (request *apiRequest) logError(status int, err error, message string) {
+	request.fields[LOG_FIELD_STATUS] = status
+	request.fields[LOG_FIELD_COST] = request.cost()
+	log.WithContext(request.ctxlog).WithFields(request.fields).WithError(err).Error(message)
+}
+
 func handlerFunctionWrapper(f func(context.Context, *gin.Context) *ApiResponse) func(*gin.Context) {
 	fn := func(c *gin.Context) {
-		tStart := time.Now()
-		traceId := trace.RandomTraceId()
-		ctxlog := trace.ContextWithTraceId(traceId)
-		log.WithContext(ctxlog).Infof("handle request: %s %s", c.Request.Method, c.Request.RequestURI)
-		response := f(ctxlog, c)
-		cost := time.Now().Sub(tStart).Milliseconds()
-		response.TraceId = traceId
-		response.Cost = cost
+		request := startApiRequest(c)
+		var response *ApiResponse
+		format, err := getResponseFormat(c)
+		if err != nil {
+			format = RESPONSE_FORMAT_JSON
+			response = NewIllegalArgumentResponse(errors.Wrap(err, "invalid parameter, failed to parse format"))
+		} else {
+			response = f(request.ctxlog, c)
+		}
+		response.TraceId = request.traceId
+		response.Cost = request.cost()
 		response.Server = getServerIdentity()
 		responseJson, err := codec.MarshalToJsonString(response)
 		if err != nil {
-			log.WithContext(ctxlog).Errorf("response: %s", "response serialization error")
+			request.logError(http.StatusInternalServerError, err, "response serialization error")
 			c.JSON(http.StatusInternalServerError, NewErrorResponse(errors.Wrap(err, "serialize response")))
-		} else {
-			log.WithContext(ctxlog).Infof("response: %s", responseJson)
-			c.String(response.Code, string(responseJson))
+			return
+		}
+		request.logResponse(response.Code, responseJson)
+		if err := writeResponse(c, format, response, responseJson); err != nil {
+			request.logError(http.StatusBadRequest, err, fmt.Sprintf("render response in format %s", format))
+			c.String(http.StatusBadRequest, "%s: %v\n", ErrorCodeInvalidParameter.Description(), err)
 		}
 	}
 	return fn
@@ -71,62 +121,100 @@ func handlerFunctionWrapper(f func(context.Context, *gin.Context) *ApiResponse)
 
 func invalidAction(ctxlog context.Context, c *gin.Context) *ApiResponse {
 	log.WithContext(ctxlog).Error("invalid action")
-	return NewIllegalArgumentResponse(errors.New("invalid action"))
+	return NewErrorCodeResponse(ErrorCodeInvalidAction, errors.New("invalid action"))
 }
 
-func getHandler() gin.HandlerFunc {
-	fn := func(c *gin.Context) {
-		action := c.Query("Action")
-		switch action {
-		case "ObRootServiceInfo":
-			getObRootServiceGetFunc()(c)
-
-		case "GetObProxyConfig":
-			getObProxyConfigFunc()(c)
-
-		case "GetObRootServiceInfoUrlTemplate":
-			getObProxyConfigWithTemplateFunc()(c)
+// actions supported by each http method, every action should also be described in openapi spec
+var getActions = map[string]func() func(*gin.Context){
+	"ObRootServiceInfo":               getObRootServiceGetFunc,
+	"GetObProxyConfig":                getObProxyConfigFunc,
+	"GetObRootServiceInfoUrlTemplate": getObProxyConfigWithTemplateFunc,
+	"ObIDCRegionInfo":                 getObIdcRegionInfoFunc,
+	"ObClusterGroup":                  getObClusterGroupFunc,
+	"ListObClusters":                  getObClusterListFunc,
+	"ListDeletedObClusters":           getDeletedObClusterListFunc,
+	"ListAuditLogs":                   getAuditLogListFunc,
+	"ListWebhookDeliveries":           getWebhookDeliveryListFunc,
+	"ObProxyGroup":                    getObProxyGroupGetFunc,
+	"ListObProxyGroups":               getObProxyGroupListFunc,
+	"ListObProxies":                   getObProxyListFunc,
+}
 
-		case "ObIDCRegionInfo":
-			getObIdcRegionInfoFunc()(c)
+var postActions = map[string]func() func(*gin.Context){
+	"ObRootServiceInfo":               getObRootServicePostFunc,
+	"BatchObRootServiceInfo":          getObRootServiceBatchFunc,
+	"GetObProxyConfig":                getObProxyConfigFunc,
+	"GetObRootServiceInfoUrlTemplate": getObProxyConfigWithTemplateFunc,
+	"SwitchoverObCluster":             getObClusterSwitchoverFunc,
+	"FailoverObCluster":               getObClusterFailoverFunc,
+	"RestoreObCluster":                getObClusterRestoreFunc,
+	"RetryWebhookDelivery":            getWebhookDeliveryRetryFunc,
+	"ObProxyGroup":                    getObProxyGroupPostFunc,
+	"ObProxyHeartbeat":                getObProxyHeartbeatFunc,
+}
 
-		default:
-			getInvalidActionFunc()(c)
-		}
-	}
-	return gin.HandlerFunc(fn)
+var deleteActions = map[string]func() func(*gin.Context){
+	"ObRootServiceInfo": getObRootServiceDeleteFunc,
+	"PurgeObCluster":    getObClusterPurgeFunc,
+	"ObProxyGroup":      getObProxyGroupDeleteFunc,
 }
 
-func postHandler() gin.HandlerFunc {
+// actions posted without changing anything, they are served with POST for clients unable to send GET
+var readOnlyPostActions = map[string]bool{
+	"GetObProxyConfig":                true,
+	"GetObRootServiceInfoUrlTemplate": true,
+}
 
-	fn := func(c *gin.Context) {
-		action, _ := c.GetQuery("Action")
-		switch action {
-		case "ObRootServiceInfo":
-			getObRootServicePostFunc()(c)
+// actions reporting status of the client itself, they are writes checked by acl and rate limit as a separate class,
+// so that obproxies are able to report without being allowed to change clusters
+var heartbeatActions = map[string]bool{
+	"ObProxyHeartbeat": true,
+}
 
-		case "GetObProxyConfig":
-			getObProxyConfigFunc()(c)
+// getRequestAction returns the Action of the request, routes without Action are identified by method and path
+func getRequestAction(c *gin.Context) string {
+	if action := c.Query("Action"); action != "" {
+		return action
+	}
+	return c.Request.Method + " " + c.FullPath()
+}
 
-		case "GetObRootServiceInfoUrlTemplate":
-			getObProxyConfigWithTemplateFunc()(c)
-		default:
-			getInvalidActionFunc()(c)
-		}
+// isWriteRequest returns whether the request may change anything
+func isWriteRequest(c *gin.Context) bool {
+	switch c.Request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	case http.MethodPost:
+		return !readOnlyPostActions[c.Query("Action")]
 	}
+	return true
+}
 
-	return gin.HandlerFunc(fn)
+// isHeartbeatRequest returns whether the request reports status of the client itself
+func isHeartbeatRequest(c *gin.Context) bool {
+	return c.Request.Method == http.MethodPost && heartbeatActions[c.Query("Action")]
 }
 
-func deleteHandler() gin.HandlerFunc {
+func actionHandler(actions map[string]func() func(*gin.Context)) gin.HandlerFunc {
 	fn := func(c *gin.Context) {
-		action, _ := c.GetQuery("Action")
-		switch action {
-		case "ObRootServiceInfo":
-			getObRootServiceDeleteFunc()(c)
-		default:
+		action := c.Query("Action")
+		if actionFunc, ok := actions[action]; ok {
+			actionFunc()(c)
+		} else {
 			getInvalidActionFunc()(c)
 		}
 	}
 	return gin.HandlerFunc(fn)
 }
+
+func getHandler() gin.HandlerFunc {
+	return actionHandler(getActions)
+}
+
+func postHandler() gin.HandlerFunc {
+	return actionHandler(postActions)
+}
+
+func deleteHandler() gin.HandlerFunc {
+	return actionHandler(deleteActions)
+}
@@ -0,0 +1,117 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/oceanbase/configserver/config"
+	"github.com/oceanbase/configserver/ent"
+	"github.com/oceanbase/configserver/lib/crypto"
+	"github.com/oceanbase/configserver/model"
+)
+
+// keys loaded from key files, key files are read only once
+var metaDatabaseKeys sync.Map
+
+func loadMetaDatabaseKey(path string) ([]byte, error) {
+	if key, ok := metaDatabaseKeys.Load(path); ok {
+		return key.([]byte), nil
+	}
+	key, err := crypto.LoadKey(path)
+	if err != nil {
+		return nil, err
+	}
+	metaDatabaseKeys.Store(path, key)
+	return key, nil
+}
+
+// mergeMetaDatabaseInfoConfig returns the settings with empty fields of override inherited from base
+func mergeMetaDatabaseInfoConfig(base, override *config.MetaDatabaseInfoConfig) *config.MetaDatabaseInfoConfig {
+	merged := &config.MetaDatabaseInfoConfig{}
+	if base != nil {
+		*merged = *base
+	}
+	if override == nil {
+		return merged
+	}
+	if override.ObCluster != "" {
+		merged.ObCluster = override.ObCluster
+	}
+	if override.Database != "" {
+		merged.Database = override.Database
+	}
+	if override.User != "" {
+		merged.User = override.User
+	}
+	if override.EncryptedPassword != "" {
+		merged.EncryptedPassword = override.EncryptedPassword
+	}
+	return merged
+}
+
+func getMetaDatabaseConfig() *config.MetaDatabaseConfig {
+	if metaDatabaseConfig := GetConfigServer().Config.MetaDatabase; metaDatabaseConfig != nil {
+		return metaDatabaseConfig
+	}
+	return &config.MetaDatabaseConfig{}
+}
+
+func getMetaDatabaseKey() ([]byte, error) {
+	keyFile := getMetaDatabaseConfig().KeyFile
+	if keyFile == "" {
+		return nil, errors.New("key file of meta database not configured")
+	}
+	key, err := loadMetaDatabaseKey(keyFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "load key of meta database")
+	}
+	return key, nil
+}
+
+// getMetaDatabaseInfo returns the meta database for obproxy in namespace and proxy group with the password decrypted,
+// settings of proxy group override the ones of namespace, a placeholder is returned if meta database is not configured
+func getMetaDatabaseInfo(serviceAddress, namespace string, group *ent.ObProxyGroup) (*model.MetaDatabaseInfo, error) {
+	serviceAddress = getNamespaceServiceAddress(serviceAddress, namespace)
+	metaDatabaseConfig := getMetaDatabaseConfig()
+	infoConfig := mergeMetaDatabaseInfoConfig(metaDatabaseConfig.Default, metaDatabaseConfig.Namespaces[namespace])
+	if group != nil {
+		infoConfig = mergeMetaDatabaseInfoConfig(infoConfig, &config.MetaDatabaseInfoConfig{
+			ObCluster:         group.MetaObCluster,
+			Database:          group.MetaDatabase,
+			User:              group.MetaUser,
+			EncryptedPassword: group.MetaEncryptedPassword,
+		})
+	}
+	if infoConfig.ObCluster == "" {
+		return model.NewDefaultMetaDatabaseInfo(serviceAddress), nil
+	}
+	password := ""
+	if infoConfig.EncryptedPassword != "" {
+		key, err := getMetaDatabaseKey()
+		if err != nil {
+			return nil, err
+		}
+		password, err = crypto.Decrypt(key, infoConfig.EncryptedPassword)
+		if err != nil {
+			return nil, errors.Wrapf(err, "decrypt password of meta database in namespace %s", namespace)
+		}
+	}
+	return model.NewMetaDatabaseInfo(serviceAddress, infoConfig.ObCluster, infoConfig.Database, infoConfig.User, password), nil
+}
@@ -0,0 +1,91 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/oceanbase/configserver/config"
+	"github.com/oceanbase/configserver/lib/crypto"
+)
+
+func TestMetaDatabaseInfo(t *testing.T) {
+	initObClusterGroupTestServer(t, "ent_meta_database")
+
+	// placeholder is returned if not configured
+	obProxyConfig, err := buildObProxyConfig(context.Background(), getDefaultServiceAddress(), DEFAULT_NAMESPACE, nil)
+	require.Nil(t, err)
+	require.Equal(t, "***", obProxyConfig.MetaDatabase.Password)
+
+	keyFile := filepath.Join(t.TempDir(), "key")
+	require.Nil(t, os.WriteFile(keyFile, []byte("000102030405060708090a0b0c0d0e0f"), 0600))
+	key, err := crypto.LoadKey(keyFile)
+	require.Nil(t, err)
+	password1, err := crypto.Encrypt(key, "password1")
+	require.Nil(t, err)
+	password2, err := crypto.Encrypt(key, "password2")
+	require.Nil(t, err)
+
+	configServer.Config.MetaDatabase = &config.MetaDatabaseConfig{
+		KeyFile: keyFile,
+		Default: &config.MetaDatabaseInfoConfig{
+			ObCluster:         "meta",
+			Database:          "obproxy",
+			User:              "proxyro",
+			EncryptedPassword: password1,
+		},
+		Namespaces: map[string]*config.MetaDatabaseInfoConfig{
+			"ns1": {User: "proxyro_ns1", EncryptedPassword: password2},
+		},
+	}
+	defer func() {
+		configServer.Config.MetaDatabase = nil
+	}()
+
+	obProxyConfig, err = buildObProxyConfig(context.Background(), getDefaultServiceAddress(), DEFAULT_NAMESPACE, nil)
+	require.Nil(t, err)
+	require.Equal(t, "obproxy", obProxyConfig.MetaDatabase.Database)
+	require.Equal(t, "proxyro", obProxyConfig.MetaDatabase.User)
+	require.Equal(t, "password1", obProxyConfig.MetaDatabase.Password)
+	require.Equal(t, getDefaultServiceAddress()+"/services?Action=ObRootServiceInfo&ObCluster=meta", obProxyConfig.MetaDatabase.ConfigUrl)
+	version := obProxyConfig.Version
+
+	obProxyConfig, err = buildObProxyConfig(context.Background(), getDefaultServiceAddress(), "ns1", nil)
+	require.Nil(t, err)
+	require.Equal(t, "obproxy", obProxyConfig.MetaDatabase.Database)
+	require.Equal(t, "proxyro_ns1", obProxyConfig.MetaDatabase.User)
+	require.Equal(t, "password2", obProxyConfig.MetaDatabase.Password)
+	require.Equal(t, getDefaultServiceAddress()+"/ns/ns1/services?Action=ObRootServiceInfo&ObCluster=meta", obProxyConfig.MetaDatabase.ConfigUrl)
+
+	// version changes with password so obproxy reloads
+	configServer.Config.MetaDatabase.Default.EncryptedPassword = password2
+	obProxyConfig, err = buildObProxyConfig(context.Background(), getDefaultServiceAddress(), DEFAULT_NAMESPACE, nil)
+	require.Nil(t, err)
+	require.NotEqual(t, version, obProxyConfig.Version)
+	obProxyConfigWithTemplate, err := buildObProxyConfigWithTemplate(context.Background(), getDefaultServiceAddress(), DEFAULT_NAMESPACE, nil)
+	require.Nil(t, err)
+	require.Equal(t, "password2", obProxyConfigWithTemplate.MetaDatabase.Password)
+
+	configServer.Config.MetaDatabase.Default.EncryptedPassword = "invalid"
+	_, err = buildObProxyConfig(context.Background(), getDefaultServiceAddress(), DEFAULT_NAMESPACE, nil)
+	require.NotNil(t, err)
+}
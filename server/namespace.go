@@ -0,0 +1,67 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+const (
+	DEFAULT_NAMESPACE     = "default"
+	NAMESPACE_PATH_PREFIX = "/ns/"
+)
+
+var namespacePattern = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+// getRequestedNamespace returns the namespace explicitly specified by the client,
+// namespace in path prefix takes precedence over query parameter
+func getRequestedNamespace(c *gin.Context) string {
+	if namespace := c.Param("namespace"); namespace != "" {
+		return namespace
+	}
+	return c.Query("Namespace")
+}
+
+// getNamespace returns the namespace a request works on,
+// if not specified by the client, use the namespace bound to the authenticated user or the default namespace
+func getNamespace(c *gin.Context) (string, error) {
+	namespace := getRequestedNamespace(c)
+	if namespace == "" {
+		if user := getAuthUser(c); user != nil && user.Namespace != "" {
+			namespace = user.Namespace
+		} else {
+			namespace = DEFAULT_NAMESPACE
+		}
+	}
+	if !namespacePattern.MatchString(namespace) {
+		return "", errors.Errorf("invalid namespace %s", namespace)
+	}
+	return namespace, nil
+}
+
+// getNamespaceServiceAddress returns the address prefix of urls generated for clients in namespace,
+// urls of the default namespace keep the same with the ones without namespace
+func getNamespaceServiceAddress(serviceAddress, namespace string) string {
+	if namespace == DEFAULT_NAMESPACE {
+		return serviceAddress
+	}
+	return fmt.Sprintf("%s%s%s", serviceAddress, NAMESPACE_PATH_PREFIX, namespace)
+}
@@ -0,0 +1,238 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	atlas "ariga.io/atlas/sql/schema"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oceanbase/configserver/config"
+	"github.com/oceanbase/configserver/ent"
+)
+
+func TestGetNamespaceDefault(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+
+	c.Request, _ = http.NewRequest("GET", "http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster=c1", nil)
+	namespace, err := getNamespace(c)
+	require.Nil(t, err)
+	require.Equal(t, DEFAULT_NAMESPACE, namespace)
+}
+
+func TestGetNamespaceFromQuery(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+
+	c.Request, _ = http.NewRequest("GET", "http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster=c1&Namespace=ns1", nil)
+	namespace, err := getNamespace(c)
+	require.Nil(t, err)
+	require.Equal(t, "ns1", namespace)
+}
+
+func TestGetNamespaceFromPath(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+
+	c.Request, _ = http.NewRequest("GET", "http://1.1.1.1:8080/ns/ns2/services?Action=ObRootServiceInfo&ObCluster=c1&Namespace=ns1", nil)
+	c.Params = []gin.Param{{Key: "namespace", Value: "ns2"}}
+	namespace, err := getNamespace(c)
+	require.Nil(t, err)
+	require.Equal(t, "ns2", namespace)
+}
+
+func TestGetNamespaceFromAuthUser(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+
+	c.Request, _ = http.NewRequest("GET", "http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster=c1", nil)
+	c.Set(authUserKey, &config.AuthUserConfig{Name: "u1", Namespace: "ns3"})
+	namespace, err := getNamespace(c)
+	require.Nil(t, err)
+	require.Equal(t, "ns3", namespace)
+}
+
+func TestGetNamespaceInvalid(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+
+	c.Request, _ = http.NewRequest("GET", "http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster=c1&Namespace=a/b", nil)
+	_, err := getNamespace(c)
+	require.NotNil(t, err)
+}
+
+func TestNamespaceServiceAddress(t *testing.T) {
+	configServerConfig, _ := config.ParseConfigServerConfig("../etc/config.yaml")
+	configServer = &ConfigServer{
+		Config: configServerConfig,
+	}
+	require.Equal(t, "http://127.0.0.1:8080", getNamespaceServiceAddress(getDefaultServiceAddress(), DEFAULT_NAMESPACE))
+	require.Equal(t, "http://127.0.0.1:8080/ns/ns1", getNamespaceServiceAddress(getDefaultServiceAddress(), "ns1"))
+}
+
+func TestObRootServiceInfoNamespaceIsolation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// mock db client
+	client, _ := ent.Open("sqlite3", "file:ent_namespace?mode=memory&cache=shared&_fk=1")
+	client.Schema.Create(context.Background())
+
+	configServerConfig, _ := config.ParseConfigServerConfig("../etc/config.yaml")
+	configServer = &ConfigServer{
+		Config: configServerConfig,
+		Client: client,
+	}
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster=c1&ObClusterId=1&version=2&Namespace=ns1", bytes.NewBuffer([]byte(testRootServiceJson)))
+	response := createOrUpdateObRootServiceInfo(context.Background(), c)
+	require.Equal(t, http.StatusOK, response.Code)
+
+	w = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster=c1&Namespace=ns1", nil)
+	response = getObRootServiceInfo(context.Background(), c)
+	require.Equal(t, http.StatusOK, response.Code)
+
+	w = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster=c1", nil)
+	response = getObRootServiceInfo(context.Background(), c)
+	require.Equal(t, http.StatusNotFound, response.Code)
+}
+
+func TestAuthHandlerNamespaceForbidden(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	configServer = &ConfigServer{
+		Config: &config.ConfigServerConfig{
+			Auth: &config.AuthConfig{
+				Users: []*config.AuthUserConfig{{Name: "u1", Password: "p1", Namespace: "ns1"}},
+			},
+		},
+	}
+	r := gin.New()
+	r.Use(authHandler())
+	r.GET(NAMESPACE_PATH_PREFIX+":namespace/services", func(c *gin.Context) {
+		c.String(http.StatusOK, "ok")
+	})
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/ns/ns1/services", nil)
+	req.SetBasicAuth("u1", "p1")
+	r.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodGet, "/ns/ns2/services", nil)
+	req.SetBasicAuth("u1", "p1")
+	r.ServeHTTP(w, req)
+	require.Equal(t, http.StatusForbidden, w.Code)
+
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodGet, "/ns/ns1/services", nil)
+	req.SetBasicAuth("u1", "wrong")
+	r.ServeHTTP(w, req)
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestMigrateSchemaFromBaseline(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	dsn := "file:ent_namespace_migrate?mode=memory&cache=shared&_fk=1"
+	drv, err := entsql.Open("sqlite3", dsn)
+	require.Nil(t, err)
+	defer drv.Close()
+
+	// ob_clusters created by versions without namespace
+	columns := []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "ob_cluster_id", Type: field.TypeInt64},
+		{Name: "type", Type: field.TypeString},
+		{Name: "rootservice_json", Type: field.TypeString, Size: 65536},
+	}
+	baseline := &schema.Table{
+		Name:       "ob_clusters",
+		Columns:    columns,
+		PrimaryKey: []*schema.Column{columns[0]},
+		Indexes: []*schema.Index{
+			{Name: "obcluster_update_time", Columns: []*schema.Column{columns[2]}},
+			{Name: "obcluster_name_ob_cluster_id", Unique: true, Columns: []*schema.Column{columns[3], columns[4]}},
+			// added by operators, it's not in the schema
+			{Name: "obcluster_type", Columns: []*schema.Column{columns[5]}},
+		},
+	}
+	migrator, err := schema.NewMigrate(drv)
+	require.Nil(t, err)
+	require.Nil(t, migrator.Create(context.Background(), baseline))
+
+	// sqlite rebuilds the table, so check the planned changes rather than indexes left,
+	// mysql keeps indexes not dropped explicitly
+	droppedIndexes := make(map[string]bool)
+	recordDroppedIndexes := func(next schema.Differ) schema.Differ {
+		return schema.DiffFunc(func(current, desired *atlas.Schema) ([]atlas.Change, error) {
+			changes, err := next.Diff(current, desired)
+			for _, change := range changes {
+				if modify, ok := change.(*atlas.ModifyTable); ok && modify.T.Name == "ob_clusters" {
+					for _, c := range modify.Changes {
+						if drop, ok := c.(*atlas.DropIndex); ok {
+							droppedIndexes[drop.I.Name] = true
+						}
+					}
+				}
+			}
+			return changes, err
+		})
+	}
+	client := ent.NewClient(ent.Driver(drv))
+	require.Nil(t, migrateSchema(context.Background(), client, schema.WithDiffHook(recordDroppedIndexes)))
+	require.True(t, droppedIndexes["obcluster_name_ob_cluster_id"])
+	require.False(t, droppedIndexes["obcluster_type"])
+
+	configServerConfig, _ := config.ParseConfigServerConfig("../etc/config.yaml")
+	configServer = &ConfigServer{
+		Config: configServerConfig,
+		Client: client,
+	}
+	for _, namespace := range []string{DEFAULT_NAMESPACE, "ns1"} {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("POST", "http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster=c1&ObClusterId=1&version=2&Namespace="+namespace, bytes.NewBuffer([]byte(testRootServiceJson)))
+		response := createOrUpdateObRootServiceInfo(context.Background(), c)
+		require.Equal(t, http.StatusOK, response.Code, namespace)
+	}
+	count, err := client.ObCluster.Query().Count(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, 2, count)
+}
@@ -0,0 +1,176 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/oceanbase/configserver/ent"
+	"github.com/oceanbase/configserver/model"
+)
+
+const (
+	MAX_BATCH_SIZE = 100
+
+	BATCH_NOT_APPLIED_MESSAGE = "not applied since other cluster in the atomic batch failed"
+)
+
+var obRootServiceBatchOnce sync.Once
+var obRootServiceBatchFunc func(*gin.Context)
+
+func getObRootServiceBatchFunc() func(*gin.Context) {
+	obRootServiceBatchOnce.Do(func() {
+		obRootServiceBatchFunc = handlerFunctionWrapper(batchObRootServiceInfo)
+	})
+	return obRootServiceBatchFunc
+}
+
+func setBatchResultError(result *model.ObRootServiceInfoResult, err error) {
+	result.Success = false
+	result.Created = false
+	result.ErrorCode = string(GetErrorCode(err))
+	result.Message = err.Error()
+}
+
+// validateRootServiceInfoBatch validates all the clusters in the batch and returns the results in the same order,
+// the result of an invalid cluster is filled with the problems found
+func validateRootServiceInfoBatch(batch *model.ObRootServiceInfoBatch) ([]*model.ObRootServiceInfoResult, bool) {
+	results := make([]*model.ObRootServiceInfoResult, 0, len(batch.Clusters))
+	indexes := make(map[string]int)
+	valid := true
+	for i, obRootServiceInfo := range batch.Clusters {
+		result := &model.ObRootServiceInfoResult{}
+		results = append(results, result)
+		if obRootServiceInfo == nil {
+			result.Errors = model.ValidationErrors{{Field: fmt.Sprintf("Clusters[%d]", i), Message: "rootservice info is required"}}
+		} else {
+			obRootServiceInfo.Fill()
+			result.ObCluster = obRootServiceInfo.ObCluster
+			result.ObClusterId = obRootServiceInfo.ObClusterId
+			result.Errors = obRootServiceInfo.Validate()
+			if len(obRootServiceInfo.Type) == 0 {
+				result.Errors = append(result.Errors, &model.FieldError{Field: "Type", Message: "ob cluster type is required"})
+			}
+			key := fmt.Sprintf("%s:%d", obRootServiceInfo.ObCluster, obRootServiceInfo.ObClusterId)
+			if j, ok := indexes[key]; ok {
+				result.Errors = append(result.Errors, &model.FieldError{Field: "ObClusterId", Message: fmt.Sprintf("ob cluster is duplicated with Clusters[%d]", j)})
+			} else {
+				indexes[key] = i
+			}
+		}
+		if len(result.Errors) > 0 {
+			valid = false
+			result.ErrorCode = string(ErrorCodeInvalidParameter)
+			result.Message = result.Errors.Error()
+		}
+	}
+	return results, valid
+}
+
+// saveRootServiceInfoBatchAtomic saves all the clusters in a single transaction, nothing is applied if any of them fails
+func saveRootServiceInfoBatchAtomic(ctxlog context.Context, namespace string, clusters []*model.ObRootServiceInfo, results []*model.ObRootServiceInfoResult) error {
+	failed := -1
+	err := withTx(ctxlog, GetConfigServer().Client, func(tx *ent.Tx) error {
+		for i, obRootServiceInfo := range clusters {
+			created, err := saveRootServiceInfoInTx(ctxlog, tx, namespace, obRootServiceInfo)
+			if err != nil {
+				failed = i
+				return errors.Wrap(err, fmt.Sprintf("save ob cluster %s with ob cluster id %d", obRootServiceInfo.ObCluster, obRootServiceInfo.ObClusterId))
+			}
+			results[i].Success = true
+			results[i].Created = created
+		}
+		return nil
+	})
+	if err != nil {
+		for i, result := range results {
+			if i == failed {
+				setBatchResultError(result, err)
+			} else {
+				result.Success = false
+				result.Created = false
+				result.Message = BATCH_NOT_APPLIED_MESSAGE
+			}
+		}
+	}
+	return err
+}
+
+// saveRootServiceInfoBatch saves the valid clusters one by one, failure of a cluster doesn't affect the others
+func saveRootServiceInfoBatch(ctxlog context.Context, namespace string, clusters []*model.ObRootServiceInfo, results []*model.ObRootServiceInfoResult) {
+	for i, obRootServiceInfo := range clusters {
+		if len(results[i].Errors) > 0 {
+			continue
+		}
+		created, err := saveRootServiceInfo(ctxlog, namespace, obRootServiceInfo)
+		if err != nil {
+			setBatchResultError(results[i], errors.Wrap(err, "save ob rootservice info"))
+			continue
+		}
+		results[i].Success = true
+		results[i].Created = created
+	}
+}
+
+func batchObRootServiceInfo(ctxlog context.Context, c *gin.Context) *ApiResponse {
+	batch := &model.ObRootServiceInfoBatch{Atomic: true}
+	err := c.ShouldBindJSON(batch)
+	if err != nil {
+		return NewIllegalArgumentResponse(errors.Wrap(err, "bind rootservice info batch"))
+	}
+	namespace, err := getNamespace(c)
+	if err != nil {
+		return NewIllegalArgumentResponse(errors.Wrap(err, "parse namespace"))
+	}
+	if len(batch.Clusters) == 0 {
+		return NewIllegalArgumentResponse(errors.New("no cluster in batch"))
+	}
+	if len(batch.Clusters) > MAX_BATCH_SIZE {
+		return NewIllegalArgumentResponse(errors.Errorf("batch size %d exceeds the limit %d", len(batch.Clusters), MAX_BATCH_SIZE))
+	}
+
+	results, valid := validateRootServiceInfoBatch(batch)
+	log.WithContext(ctxlog).Infof("save %d rootservice info in namespace %s, atomic: %t", len(batch.Clusters), namespace, batch.Atomic)
+	if !batch.Atomic {
+		saveRootServiceInfoBatch(ctxlog, namespace, batch.Clusters, results)
+		return NewSuccessResponse(results)
+	}
+
+	if !valid {
+		for _, result := range results {
+			if len(result.Errors) == 0 {
+				result.Message = BATCH_NOT_APPLIED_MESSAGE
+			}
+		}
+		response := NewIllegalArgumentResponse(errors.New("invalid rootservice info in atomic batch"))
+		response.Data = results
+		return response
+	}
+	err = saveRootServiceInfoBatchAtomic(ctxlog, namespace, batch.Clusters, results)
+	if err != nil {
+		response := NewErrorResponse(errors.Wrap(err, "save rootservice info batch"))
+		response.Data = results
+		return response
+	}
+	return NewSuccessResponse(results)
+}
@@ -0,0 +1,143 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oceanbase/configserver/model"
+)
+
+const testBatchRootServiceJsonFormat = "{\"Type\":\"%s\",\"ObClusterId\":%d,\"ObCluster\":\"b1\",\"ReadonlyRsList\":[],\"RsList\":[{\"address\":\"%s\",\"role\":\"LEADER\",\"sql_port\":2881}],\"timestamp\":100}"
+
+func batchTestRequest(t *testing.T, atomic bool, clusters ...string) *ApiResponse {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	body := fmt.Sprintf("{\"Atomic\":%t,\"Clusters\":[", atomic)
+	for i, cluster := range clusters {
+		if i > 0 {
+			body += ","
+		}
+		body += cluster
+	}
+	body += "]}"
+	c.Request, _ = http.NewRequest("POST", "http://1.1.1.1:8080/services?Action=BatchObRootServiceInfo", bytes.NewBuffer([]byte(body)))
+	return batchObRootServiceInfo(context.Background(), c)
+}
+
+func batchTestClusterCount(t *testing.T) int {
+	count, err := GetConfigServer().Client.ObCluster.Query().Count(context.Background())
+	require.Nil(t, err)
+	return count
+}
+
+func TestBatchObRootServiceInfoAtomic(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_batch_atomic")
+
+	response := batchTestRequest(t, true,
+		fmt.Sprintf(testBatchRootServiceJsonFormat, model.OB_CLUSTER_TYPE_PRIMARY, 1, "1.1.1.1:2882"),
+		fmt.Sprintf(testBatchRootServiceJsonFormat, model.OB_CLUSTER_TYPE_STANDBY, 2, "1.1.1.2:2882"))
+	require.Equal(t, http.StatusOK, response.Code)
+	results := response.Data.([]*model.ObRootServiceInfoResult)
+	require.Equal(t, 2, len(results))
+	require.True(t, results[0].Success)
+	require.True(t, results[0].Created)
+	require.True(t, results[1].Success)
+	require.Equal(t, 2, batchTestClusterCount(t))
+
+	primaryClusterId, err := getPrimaryClusterId(context.Background(), DEFAULT_NAMESPACE, "b1")
+	require.Nil(t, err)
+	require.Equal(t, int64(1), primaryClusterId)
+
+	// update is not reported as created
+	response = batchTestRequest(t, true, fmt.Sprintf(testBatchRootServiceJsonFormat, model.OB_CLUSTER_TYPE_STANDBY, 2, "1.1.1.3:2882"))
+	require.Equal(t, http.StatusOK, response.Code)
+	results = response.Data.([]*model.ObRootServiceInfoResult)
+	require.True(t, results[0].Success)
+	require.False(t, results[0].Created)
+}
+
+func TestBatchObRootServiceInfoAtomicInvalid(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_batch_atomic_invalid")
+
+	response := batchTestRequest(t, true,
+		fmt.Sprintf(testBatchRootServiceJsonFormat, model.OB_CLUSTER_TYPE_PRIMARY, 1, "1.1.1.1:2882"),
+		fmt.Sprintf(testBatchRootServiceJsonFormat, model.OB_CLUSTER_TYPE_STANDBY, 2, "1.1.1.2"))
+	require.Equal(t, http.StatusBadRequest, response.Code)
+	require.Equal(t, ErrorCodeInvalidParameter, response.ErrorCode)
+	results := response.Data.([]*model.ObRootServiceInfoResult)
+	require.False(t, results[0].Success)
+	require.Equal(t, BATCH_NOT_APPLIED_MESSAGE, results[0].Message)
+	require.False(t, results[1].Success)
+	require.Equal(t, string(ErrorCodeInvalidParameter), results[1].ErrorCode)
+	require.Equal(t, "RsList[0].address", results[1].Errors[0].Field)
+	require.Equal(t, 0, batchTestClusterCount(t))
+
+	// duplicated clusters in the same batch
+	response = batchTestRequest(t, true,
+		fmt.Sprintf(testBatchRootServiceJsonFormat, model.OB_CLUSTER_TYPE_PRIMARY, 1, "1.1.1.1:2882"),
+		fmt.Sprintf(testBatchRootServiceJsonFormat, model.OB_CLUSTER_TYPE_STANDBY, 1, "1.1.1.2:2882"))
+	require.Equal(t, http.StatusBadRequest, response.Code)
+	require.Equal(t, 0, batchTestClusterCount(t))
+}
+
+func TestBatchObRootServiceInfoNotAtomic(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_batch_not_atomic")
+
+	response := batchTestRequest(t, false,
+		fmt.Sprintf(testBatchRootServiceJsonFormat, model.OB_CLUSTER_TYPE_PRIMARY, 1, "1.1.1.1:2882"),
+		fmt.Sprintf(testBatchRootServiceJsonFormat, "", 2, "1.1.1.2:2882"))
+	require.Equal(t, http.StatusOK, response.Code)
+	results := response.Data.([]*model.ObRootServiceInfoResult)
+	require.True(t, results[0].Success)
+	require.False(t, results[1].Success)
+	require.Equal(t, "Type", results[1].Errors[0].Field)
+	require.Equal(t, 1, batchTestClusterCount(t))
+}
+
+func TestBatchObRootServiceInfoStorageUnavailable(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_batch_storage")
+	require.Nil(t, GetConfigServer().Client.Close())
+
+	response := batchTestRequest(t, true,
+		fmt.Sprintf(testBatchRootServiceJsonFormat, model.OB_CLUSTER_TYPE_PRIMARY, 1, "1.1.1.1:2882"),
+		fmt.Sprintf(testBatchRootServiceJsonFormat, model.OB_CLUSTER_TYPE_STANDBY, 2, "1.1.1.2:2882"))
+	require.Equal(t, http.StatusServiceUnavailable, response.Code)
+	results := response.Data.([]*model.ObRootServiceInfoResult)
+	require.False(t, results[0].Success)
+	require.False(t, results[1].Success)
+}
+
+func TestBatchObRootServiceInfoEmpty(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_batch_empty")
+
+	response := batchTestRequest(t, true)
+	require.Equal(t, http.StatusBadRequest, response.Code)
+}
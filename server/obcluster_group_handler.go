@@ -0,0 +1,285 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/oceanbase/configserver/ent"
+	"github.com/oceanbase/configserver/ent/obcluster"
+	"github.com/oceanbase/configserver/ent/obclustergroup"
+	"github.com/oceanbase/configserver/model"
+)
+
+var obClusterGroupOnce sync.Once
+var obClusterGroupFunc func(*gin.Context)
+var obClusterSwitchoverOnce sync.Once
+var obClusterSwitchoverFunc func(*gin.Context)
+var obClusterFailoverOnce sync.Once
+var obClusterFailoverFunc func(*gin.Context)
+
+func getObClusterGroupFunc() func(*gin.Context) {
+	obClusterGroupOnce.Do(func() {
+		obClusterGroupFunc = handlerFunctionWrapper(getObClusterGroup)
+	})
+	return obClusterGroupFunc
+}
+
+func getObClusterSwitchoverFunc() func(*gin.Context) {
+	obClusterSwitchoverOnce.Do(func() {
+		obClusterSwitchoverFunc = handlerFunctionWrapper(switchoverObCluster)
+	})
+	return obClusterSwitchoverFunc
+}
+
+func getObClusterFailoverFunc() func(*gin.Context) {
+	obClusterFailoverOnce.Do(func() {
+		obClusterFailoverFunc = handlerFunctionWrapper(failoverObCluster)
+	})
+	return obClusterFailoverFunc
+}
+
+// electPrimaryClusterId returns the primary cluster id of a cluster group deterministically,
+// the current primary is kept as long as it still claims PRIMARY, otherwise the cluster claiming PRIMARY
+// with the latest timestamp is elected, ties are broken by the smaller cluster id.
+// when no cluster claims PRIMARY, the current primary is kept if it still exists.
+func electPrimaryClusterId(currentPrimaryId int64, clusters []*model.ObRootServiceInfo) int64 {
+	candidates := make([]*model.ObRootServiceInfo, 0, len(clusters))
+	currentExists := false
+	for _, cluster := range clusters {
+		if cluster.ObClusterId == currentPrimaryId {
+			if cluster.Type == model.OB_CLUSTER_TYPE_PRIMARY {
+				return currentPrimaryId
+			}
+			currentExists = true
+		}
+		if cluster.Type == model.OB_CLUSTER_TYPE_PRIMARY {
+			candidates = append(candidates, cluster)
+		}
+	}
+	if len(candidates) == 0 {
+		if currentExists {
+			return currentPrimaryId
+		}
+		return 0
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].TimeStamp != candidates[j].TimeStamp {
+			return candidates[i].TimeStamp > candidates[j].TimeStamp
+		}
+		return candidates[i].ObClusterId < candidates[j].ObClusterId
+	})
+	return candidates[0].ObClusterId
+}
+
+func queryObClusterGroup(ctx context.Context, client *ent.ObClusterGroupClient, namespace, name string) (*ent.ObClusterGroup, error) {
+	group, err := client.Query().Where(obclustergroup.Namespace(namespace), obclustergroup.Name(name)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return group, err
+}
+
+// getPrimaryClusterId returns the primary cluster id recorded in cluster group, 0 if there's no record
+func getPrimaryClusterId(ctx context.Context, namespace, name string) (int64, error) {
+	group, err := queryObClusterGroup(ctx, GetConfigServer().Client.ObClusterGroup, namespace, name)
+	if err != nil {
+		return 0, wrapStorageError(err, fmt.Sprintf("query ob cluster group %s in namespace %s", name, namespace))
+	}
+	if group == nil {
+		return 0, nil
+	}
+	return group.PrimaryClusterID, nil
+}
+
+// syncObClusterGroup updates the primary of the cluster group after clusters of the group changed,
+// the group is removed if there's no cluster left
+func syncObClusterGroup(ctx context.Context, tx *ent.Tx, namespace, name string) error {
+	clusters, err := queryActiveObClusters(tx.ObCluster).Where(obcluster.Namespace(namespace), obcluster.Name(name)).All(ctx)
+	if err != nil {
+		return wrapStorageError(err, "query ob clusters of group")
+	}
+	if len(clusters) == 0 {
+		_, err = tx.ObClusterGroup.Delete().Where(obclustergroup.Namespace(namespace), obclustergroup.Name(name)).Exec(ctx)
+		return wrapStorageError(err, "delete ob cluster group")
+	}
+	group, err := queryObClusterGroup(ctx, tx.ObClusterGroup, namespace, name)
+	if err != nil {
+		return wrapStorageError(err, "query ob cluster group")
+	}
+	var currentPrimaryId int64
+	if group != nil {
+		currentPrimaryId = group.PrimaryClusterID
+	}
+	rootServiceInfoList := make([]*model.ObRootServiceInfo, 0, len(clusters))
+	for _, cluster := range clusters {
+		rootServiceInfo, err := decodeRootServiceInfo(cluster)
+		if err != nil {
+			return err
+		}
+		rootServiceInfoList = append(rootServiceInfoList, rootServiceInfo)
+	}
+	primaryClusterId := electPrimaryClusterId(currentPrimaryId, rootServiceInfoList)
+	if group != nil && primaryClusterId == currentPrimaryId {
+		return nil
+	}
+	return setObClusterGroupPrimary(ctx, tx, namespace, name, primaryClusterId)
+}
+
+func setObClusterGroupPrimary(ctx context.Context, tx *ent.Tx, namespace, name string, primaryClusterId int64) error {
+	err := tx.ObClusterGroup.
+		Create().
+		SetNamespace(namespace).
+		SetName(name).
+		SetPrimaryClusterID(primaryClusterId).
+		OnConflict().
+		SetPrimaryClusterID(primaryClusterId).
+		UpdateUpdateTime().
+		Exec(ctx)
+	return wrapStorageError(err, "save ob cluster group")
+}
+
+// switchObClusterPrimary sets the primary of the cluster group and demotes the others to standby in a transaction,
+// a switchover requires the current primary to be registered, while a failover doesn't.
+func switchObClusterPrimary(ctx context.Context, namespace, name string, primaryClusterId int64, failover bool) error {
+	return withTx(ctx, GetConfigServer().Client, func(tx *ent.Tx) error {
+		clusters, err := queryActiveObClusters(tx.ObCluster).Where(obcluster.Namespace(namespace), obcluster.Name(name)).All(ctx)
+		if err != nil {
+			return wrapStorageError(err, "query ob clusters of group")
+		}
+		group, err := queryObClusterGroup(ctx, tx.ObClusterGroup, namespace, name)
+		if err != nil {
+			return wrapStorageError(err, "query ob cluster group")
+		}
+
+		targetExists := false
+		currentPrimaryExists := false
+		for _, cluster := range clusters {
+			if cluster.ObClusterID == primaryClusterId {
+				targetExists = true
+			}
+			if group != nil && cluster.ObClusterID == group.PrimaryClusterID {
+				currentPrimaryExists = true
+			}
+		}
+		if !targetExists {
+			return NewApiErrorf(ErrorCodeClusterNotFound, "no obcluster found with name %s and ob cluster id %d", name, primaryClusterId)
+		}
+		if !failover && !currentPrimaryExists {
+			return NewApiErrorf(ErrorCodeConflict, "current primary of ob cluster %s is not registered, use failover instead", name)
+		}
+
+		for _, cluster := range clusters {
+			clusterType := model.OB_CLUSTER_TYPE_STANDBY
+			if cluster.ObClusterID == primaryClusterId {
+				clusterType = model.OB_CLUSTER_TYPE_PRIMARY
+			}
+			rootServiceInfo, err := decodeRootServiceInfo(cluster)
+			if err != nil {
+				return err
+			}
+			if cluster.Type == clusterType && rootServiceInfo.Type == clusterType {
+				continue
+			}
+			rootServiceInfo.Type = clusterType
+			rsBytes, err := json.Marshal(rootServiceInfo)
+			if err != nil {
+				return errors.Wrap(err, "serialize ob rootservice info")
+			}
+			err = tx.ObCluster.
+				UpdateOneID(cluster.ID).
+				SetType(clusterType).
+				SetRootserviceJSON(string(rsBytes)).
+				Exec(ctx)
+			if err != nil {
+				return wrapStorageError(err, fmt.Sprintf("update type of ob cluster %s with ob cluster id %d", name, cluster.ObClusterID))
+			}
+		}
+		return setObClusterGroupPrimary(ctx, tx, namespace, name, primaryClusterId)
+	})
+}
+
+func getObClusterGroup(ctxlog context.Context, c *gin.Context) *ApiResponse {
+	param, err := getCommonParam(c)
+	if err != nil {
+		return NewIllegalArgumentResponse(errors.Wrap(err, "parse ob cluster group query parameter"))
+	}
+	client := GetConfigServer().Client
+	clusters, err := queryActiveObClusters(client.ObCluster).
+		Where(obcluster.Namespace(param.Namespace), obcluster.Name(param.ObCluster)).
+		Order(ent.Asc(obcluster.FieldObClusterID)).
+		All(ctxlog)
+	if err != nil {
+		return NewErrorResponse(wrapStorageError(err, "query ob clusters of group"))
+	}
+	if len(clusters) == 0 {
+		return NewErrorCodeResponse(ErrorCodeClusterNotFound, errors.New(fmt.Sprintf("no obcluster found with query param %v", param)))
+	}
+	primaryClusterId, err := getPrimaryClusterId(ctxlog, param.Namespace, param.ObCluster)
+	if err != nil {
+		return NewErrorResponse(err)
+	}
+	groupInfo := &model.ObClusterGroupInfo{
+		ObCluster:        param.ObCluster,
+		PrimaryClusterId: primaryClusterId,
+		Clusters:         make([]*model.ObClusterGroupMember, 0, len(clusters)),
+	}
+	for _, cluster := range clusters {
+		rootServiceInfo, err := decodeRootServiceInfo(cluster)
+		if err != nil {
+			return NewErrorResponse(err)
+		}
+		groupInfo.Clusters = append(groupInfo.Clusters, &model.ObClusterGroupMember{
+			ObClusterId: cluster.ObClusterID,
+			Type:        rootServiceInfo.Type,
+			TimeStamp:   rootServiceInfo.TimeStamp,
+		})
+	}
+	return NewSuccessResponse(groupInfo)
+}
+
+func changeObClusterPrimary(ctxlog context.Context, c *gin.Context, failover bool) *ApiResponse {
+	param, err := getCommonParam(c)
+	if err != nil {
+		return NewIllegalArgumentResponse(errors.Wrap(err, "parse ob cluster switchover parameter"))
+	}
+	if param.ObClusterId == 0 {
+		return NewIllegalArgumentResponse(errors.New("ob cluster id of the new primary is required"))
+	}
+	log.WithContext(ctxlog).Infof("set primary of ob cluster %s in namespace %s to ob cluster id %d, failover: %t", param.ObCluster, param.Namespace, param.ObClusterId, failover)
+	err = switchObClusterPrimary(ctxlog, param.Namespace, param.ObCluster, param.ObClusterId, failover)
+	if err != nil {
+		return NewErrorResponse(errors.Wrap(err, "switch primary of ob cluster"))
+	}
+	return NewSuccessResponse("successful")
+}
+
+func switchoverObCluster(ctxlog context.Context, c *gin.Context) *ApiResponse {
+	return changeObClusterPrimary(ctxlog, c, false)
+}
+
+func failoverObCluster(ctxlog context.Context, c *gin.Context) *ApiResponse {
+	return changeObClusterPrimary(ctxlog, c, true)
+}
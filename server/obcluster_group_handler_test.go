@@ -0,0 +1,142 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oceanbase/configserver/config"
+	"github.com/oceanbase/configserver/ent"
+	"github.com/oceanbase/configserver/model"
+)
+
+const testGroupRootServiceJsonFormat = "{\"Type\":\"%s\",\"ObClusterId\":%d,\"ObCluster\":\"g1\",\"ReadonlyRsList\":[],\"RsList\":[{\"address\":\"1.1.1.%d:2882\",\"role\":\"LEADER\",\"sql_port\":2881}],\"timestamp\":%d}"
+
+func initObClusterGroupTestServer(t *testing.T, dbName string) {
+	client, err := ent.Open("sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", dbName))
+	require.Nil(t, err)
+	require.Nil(t, client.Schema.Create(context.Background()))
+	registerStorageHooks(client)
+	configServerConfig, _ := config.ParseConfigServerConfig("../etc/config.yaml")
+	configServer = &ConfigServer{
+		Config: configServerConfig,
+		Client: client,
+	}
+}
+
+func registerGroupTestCluster(t *testing.T, clusterType string, clusterId int64, timestamp int64) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	body := fmt.Sprintf(testGroupRootServiceJsonFormat, clusterType, clusterId, clusterId, timestamp)
+	c.Request, _ = http.NewRequest("POST", fmt.Sprintf("http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster=g1&ObClusterId=%d&version=2", clusterId), bytes.NewBuffer([]byte(body)))
+	response := createOrUpdateObRootServiceInfo(context.Background(), c)
+	require.Equal(t, http.StatusOK, response.Code)
+}
+
+func getGroupTestPrimary(t *testing.T) *model.ObRootServiceInfo {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster=g1", nil)
+	response := getObRootServiceInfo(context.Background(), c)
+	require.Equal(t, http.StatusOK, response.Code)
+	return response.Data.(*model.ObRootServiceInfo)
+}
+
+func TestElectPrimaryClusterId(t *testing.T) {
+	clusters := []*model.ObRootServiceInfo{
+		{ObClusterId: 1, Type: model.OB_CLUSTER_TYPE_PRIMARY, TimeStamp: 1},
+		{ObClusterId: 2, Type: model.OB_CLUSTER_TYPE_PRIMARY, TimeStamp: 2},
+		{ObClusterId: 3, Type: model.OB_CLUSTER_TYPE_STANDBY, TimeStamp: 3},
+	}
+	// current primary still claims PRIMARY
+	require.Equal(t, int64(1), electPrimaryClusterId(1, clusters))
+	// no primary yet, latest PRIMARY wins
+	require.Equal(t, int64(2), electPrimaryClusterId(0, clusters))
+	// current primary demoted
+	require.Equal(t, int64(2), electPrimaryClusterId(3, clusters))
+
+	standbys := []*model.ObRootServiceInfo{
+		{ObClusterId: 1, Type: model.OB_CLUSTER_TYPE_STANDBY, TimeStamp: 1},
+	}
+	require.Equal(t, int64(1), electPrimaryClusterId(1, standbys))
+	require.Equal(t, int64(0), electPrimaryClusterId(0, standbys))
+}
+
+func TestSelectPrimaryClusterDeterministic(t *testing.T) {
+	clusters := []*model.ObRootServiceInfo{
+		{ObClusterId: 2, Type: model.OB_CLUSTER_TYPE_PRIMARY, TimeStamp: 1},
+		{ObClusterId: 1, Type: model.OB_CLUSTER_TYPE_PRIMARY, TimeStamp: 1},
+		{ObClusterId: 3, Type: model.OB_CLUSTER_TYPE_STANDBY, TimeStamp: 5},
+	}
+	require.Equal(t, int64(1), selectPrimaryCluster(clusters, 0).ObClusterId)
+	require.Equal(t, int64(3), selectPrimaryCluster(clusters, 3).ObClusterId)
+}
+
+func TestObClusterGroupPrimaryDuringFailover(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_group_failover")
+
+	registerGroupTestCluster(t, model.OB_CLUSTER_TYPE_PRIMARY, 1, 100)
+	registerGroupTestCluster(t, model.OB_CLUSTER_TYPE_STANDBY, 2, 100)
+	require.Equal(t, int64(1), getGroupTestPrimary(t).ObClusterId)
+
+	// both clusters claim PRIMARY, the recorded primary is kept
+	registerGroupTestCluster(t, model.OB_CLUSTER_TYPE_PRIMARY, 2, 200)
+	require.Equal(t, int64(1), getGroupTestPrimary(t).ObClusterId)
+
+	// explicit failover to cluster 2
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "http://1.1.1.1:8080/services?Action=FailoverObCluster&ObCluster=g1&ObClusterId=2", nil)
+	response := failoverObCluster(context.Background(), c)
+	require.Equal(t, http.StatusOK, response.Code)
+
+	primary := getGroupTestPrimary(t)
+	require.Equal(t, int64(2), primary.ObClusterId)
+	require.Equal(t, model.OB_CLUSTER_TYPE_PRIMARY, primary.Type)
+
+	w = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "http://1.1.1.1:8080/services?Action=ObClusterGroup&ObCluster=g1", nil)
+	response = getObClusterGroup(context.Background(), c)
+	require.Equal(t, http.StatusOK, response.Code)
+	groupInfo := response.Data.(*model.ObClusterGroupInfo)
+	require.Equal(t, int64(2), groupInfo.PrimaryClusterId)
+	require.Equal(t, model.OB_CLUSTER_TYPE_STANDBY, groupInfo.Clusters[0].Type)
+	require.Equal(t, model.OB_CLUSTER_TYPE_PRIMARY, groupInfo.Clusters[1].Type)
+}
+
+func TestSwitchoverObClusterNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_group_switchover")
+
+	registerGroupTestCluster(t, model.OB_CLUSTER_TYPE_PRIMARY, 1, 100)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "http://1.1.1.1:8080/services?Action=SwitchoverObCluster&ObCluster=g1&ObClusterId=3", nil)
+	response := switchoverObCluster(context.Background(), c)
+	require.Equal(t, http.StatusNotFound, response.Code)
+}
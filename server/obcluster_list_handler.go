@@ -0,0 +1,289 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/oceanbase/configserver/ent"
+	"github.com/oceanbase/configserver/ent/obcluster"
+	"github.com/oceanbase/configserver/ent/predicate"
+	"github.com/oceanbase/configserver/model"
+)
+
+const (
+	DEFAULT_LIST_LIMIT = 100
+	MAX_LIST_LIMIT     = 1000
+
+	SORT_ORDER_ASC  = "asc"
+	SORT_ORDER_DESC = "desc"
+)
+
+var obClusterListOnce sync.Once
+var obClusterListFunc func(*gin.Context)
+
+func getObClusterListFunc() func(*gin.Context) {
+	obClusterListOnce.Do(func() {
+		obClusterListFunc = handlerFunctionWrapper(listObClusters)
+	})
+	return obClusterListFunc
+}
+
+type ObClusterListParam struct {
+	Namespace    string
+	NamePrefix   string
+	Type         string
+	UpdatedSince *time.Time
+	SortBy       string
+	Order        string
+	Limit        int
+	Cursor       *obClusterListCursor
+}
+
+// obClusterListCursor records the sort key of the last item returned,
+// the next page starts right after it
+type obClusterListCursor struct {
+	SortBy string `json:"s"`
+	Order  string `json:"o"`
+	Value  string `json:"v"`
+	Id     int    `json:"i"`
+}
+
+func encodeObClusterListCursor(cursor *obClusterListCursor) (string, error) {
+	cursorBytes, err := json.Marshal(cursor)
+	if err != nil {
+		return "", errors.Wrap(err, "encode cursor")
+	}
+	return base64.RawURLEncoding.EncodeToString(cursorBytes), nil
+}
+
+func decodeObClusterListCursor(cursorStr string) (*obClusterListCursor, error) {
+	cursorBytes, err := base64.RawURLEncoding.DecodeString(cursorStr)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode cursor")
+	}
+	cursor := new(obClusterListCursor)
+	err = json.Unmarshal(cursorBytes, cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode cursor")
+	}
+	return cursor, nil
+}
+
+func parseTimeParam(value string) (time.Time, error) {
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(seconds, 0), nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+// getLimitParam returns the page size, DEFAULT_LIST_LIMIT if it's not specified
+func getLimitParam(c *gin.Context) (int, error) {
+	limitStr, ok := c.GetQuery("Limit")
+	if !ok {
+		return DEFAULT_LIST_LIMIT, nil
+	}
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil {
+		return 0, errors.Wrap(err, "parse limit")
+	}
+	if limit <= 0 || limit > MAX_LIST_LIMIT {
+		return 0, errors.Errorf("limit should be in range [1, %d]", MAX_LIST_LIMIT)
+	}
+	return limit, nil
+}
+
+func getObClusterListParam(c *gin.Context) (*ObClusterListParam, error) {
+	namespace, err := getNamespace(c)
+	if err != nil {
+		return nil, err
+	}
+	param := &ObClusterListParam{
+		Namespace:  namespace,
+		NamePrefix: c.Query("NamePrefix"),
+		Type:       c.Query("Type"),
+		SortBy:     c.DefaultQuery("SortBy", obcluster.FieldName),
+		Order:      strings.ToLower(c.DefaultQuery("Order", SORT_ORDER_ASC)),
+	}
+	switch param.SortBy {
+	case obcluster.FieldName, obcluster.FieldCreateTime, obcluster.FieldUpdateTime:
+	default:
+		return nil, errors.Errorf("unsupported sort field %s", param.SortBy)
+	}
+	if param.Order != SORT_ORDER_ASC && param.Order != SORT_ORDER_DESC {
+		return nil, errors.Errorf("unsupported sort order %s", param.Order)
+	}
+	if updatedSince, ok := c.GetQuery("UpdatedSince"); ok {
+		t, err := parseTimeParam(updatedSince)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse updated since")
+		}
+		param.UpdatedSince = &t
+	}
+	param.Limit, err = getLimitParam(c)
+	if err != nil {
+		return nil, err
+	}
+	if cursorStr, ok := c.GetQuery("Cursor"); ok && cursorStr != "" {
+		param.Cursor, err = decodeObClusterListCursor(cursorStr)
+		if err != nil {
+			return nil, err
+		}
+		if param.Cursor.SortBy != param.SortBy || param.Cursor.Order != param.Order {
+			return nil, errors.New("cursor doesn't match sort field and order")
+		}
+	}
+	return param, nil
+}
+
+func obClusterSortValue(cluster *ent.ObCluster, sortBy string) string {
+	switch sortBy {
+	case obcluster.FieldCreateTime:
+		return cluster.CreateTime.Format(time.RFC3339Nano)
+	case obcluster.FieldUpdateTime:
+		return cluster.UpdateTime.Format(time.RFC3339Nano)
+	default:
+		return cluster.Name
+	}
+}
+
+// obClusterCursorPredicate returns the predicate selecting clusters after the cursor in sort order
+func obClusterCursorPredicate(cursor *obClusterListCursor) (predicate.ObCluster, error) {
+	desc := cursor.Order == SORT_ORDER_DESC
+	idAfter := obcluster.IDGT(cursor.Id)
+	if desc {
+		idAfter = obcluster.IDLT(cursor.Id)
+	}
+	switch cursor.SortBy {
+	case obcluster.FieldName:
+		if desc {
+			return obcluster.Or(obcluster.NameLT(cursor.Value), obcluster.And(obcluster.NameEQ(cursor.Value), idAfter)), nil
+		}
+		return obcluster.Or(obcluster.NameGT(cursor.Value), obcluster.And(obcluster.NameEQ(cursor.Value), idAfter)), nil
+	case obcluster.FieldCreateTime, obcluster.FieldUpdateTime:
+		t, err := time.Parse(time.RFC3339Nano, cursor.Value)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse cursor value")
+		}
+		if cursor.SortBy == obcluster.FieldCreateTime {
+			if desc {
+				return obcluster.Or(obcluster.CreateTimeLT(t), obcluster.And(obcluster.CreateTimeEQ(t), idAfter)), nil
+			}
+			return obcluster.Or(obcluster.CreateTimeGT(t), obcluster.And(obcluster.CreateTimeEQ(t), idAfter)), nil
+		}
+		if desc {
+			return obcluster.Or(obcluster.UpdateTimeLT(t), obcluster.And(obcluster.UpdateTimeEQ(t), idAfter)), nil
+		}
+		return obcluster.Or(obcluster.UpdateTimeGT(t), obcluster.And(obcluster.UpdateTimeEQ(t), idAfter)), nil
+	default:
+		return nil, errors.Errorf("unsupported sort field %s", cursor.SortBy)
+	}
+}
+
+func queryObClusterSummaries(ctx context.Context, param *ObClusterListParam) ([]*model.ObClusterSummary, string, error) {
+	predicates := []predicate.ObCluster{obcluster.Namespace(param.Namespace)}
+	if param.NamePrefix != "" {
+		predicates = append(predicates, obcluster.NameHasPrefix(param.NamePrefix))
+	}
+	if param.Type != "" {
+		predicates = append(predicates, obcluster.TypeEQ(param.Type))
+	}
+	if param.UpdatedSince != nil {
+		predicates = append(predicates, obcluster.UpdateTimeGTE(*param.UpdatedSince))
+	}
+	if param.Cursor != nil {
+		cursorPredicate, err := obClusterCursorPredicate(param.Cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		predicates = append(predicates, cursorPredicate)
+	}
+	order := ent.Asc(param.SortBy, obcluster.FieldID)
+	if param.Order == SORT_ORDER_DESC {
+		order = ent.Desc(param.SortBy, obcluster.FieldID)
+	}
+
+	// query one more item to find out whether there's a next page
+	clusters, err := queryActiveObClusters(GetConfigServer().Client.ObCluster).
+		Where(predicates...).
+		Order(order).
+		Limit(param.Limit + 1).
+		All(ctx)
+	if err != nil {
+		return nil, "", wrapStorageError(err, "query ob clusters")
+	}
+
+	nextCursor := ""
+	if len(clusters) > param.Limit {
+		clusters = clusters[:param.Limit]
+		last := clusters[len(clusters)-1]
+		nextCursor, err = encodeObClusterListCursor(&obClusterListCursor{
+			SortBy: param.SortBy,
+			Order:  param.Order,
+			Value:  obClusterSortValue(last, param.SortBy),
+			Id:     last.ID,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	summaries := make([]*model.ObClusterSummary, 0, len(clusters))
+	for _, cluster := range clusters {
+		rootServiceInfo, err := decodeRootServiceInfo(cluster)
+		if err != nil {
+			return nil, "", err
+		}
+		summaries = append(summaries, &model.ObClusterSummary{
+			ObCluster:   cluster.Name,
+			ObClusterId: cluster.ObClusterID,
+			Type:        cluster.Type,
+			RsListSize:  len(rootServiceInfo.RsList),
+			CreateTime:  cluster.CreateTime,
+			UpdateTime:  cluster.UpdateTime,
+		})
+	}
+	return summaries, nextCursor, nil
+}
+
+func listObClusters(ctxlog context.Context, c *gin.Context) *ApiResponse {
+	param, err := getObClusterListParam(c)
+	if err != nil {
+		return NewIllegalArgumentResponse(errors.Wrap(err, "parse list ob clusters parameter"))
+	}
+	log.WithContext(ctxlog).Infof("list ob clusters in namespace %s, name prefix '%s', type '%s', sort by %s %s, limit %d",
+		param.Namespace, param.NamePrefix, param.Type, param.SortBy, param.Order, param.Limit)
+	summaries, nextCursor, err := queryObClusterSummaries(ctxlog, param)
+	if err != nil {
+		return NewErrorResponse(errors.Wrap(err, fmt.Sprintf("list ob clusters in namespace %s", param.Namespace)))
+	}
+	return NewSuccessResponse(&IterableData{
+		Contents:   summaries,
+		NextCursor: nextCursor,
+	})
+}
@@ -0,0 +1,136 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oceanbase/configserver/model"
+)
+
+func listObClustersForTest(t *testing.T, query string) *IterableData {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "http://1.1.1.1:8080/services?Action=ListObClusters&"+query, nil)
+	response := listObClusters(context.Background(), c)
+	require.Equal(t, http.StatusOK, response.Code)
+	return response.Data.(*IterableData)
+}
+
+func TestListObClustersPagination(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_list")
+	client := GetConfigServer().Client
+	for i := 1; i <= 5; i++ {
+		name := fmt.Sprintf("c%d", i)
+		json := fmt.Sprintf("{\"Type\":\"PRIMARY\",\"ObClusterId\":%d,\"ObCluster\":\"%s\",\"RsList\":[{\"address\":\"1.1.1.1:2882\",\"role\":\"LEADER\",\"sql_port\":2881}]}", i, name)
+		err := client.ObCluster.Create().SetName(name).SetObClusterID(int64(i)).SetType("PRIMARY").SetRootserviceJSON(json).Exec(context.Background())
+		require.Nil(t, err)
+	}
+	err := client.ObCluster.Create().SetName("other").SetObClusterID(1).SetType("STANDBY").SetRootserviceJSON("{}").Exec(context.Background())
+	require.Nil(t, err)
+
+	names := make([]string, 0, 5)
+	cursor := ""
+	for {
+		data := listObClustersForTest(t, "NamePrefix=c&Limit=2&Order=desc&Cursor="+cursor)
+		for _, summary := range data.Contents.([]*model.ObClusterSummary) {
+			names = append(names, summary.ObCluster)
+			require.Equal(t, 1, summary.RsListSize)
+		}
+		if data.NextCursor == "" {
+			break
+		}
+		cursor = data.NextCursor
+	}
+	require.Equal(t, []string{"c5", "c4", "c3", "c2", "c1"}, names)
+
+	data := listObClustersForTest(t, "Type=STANDBY")
+	summaries := data.Contents.([]*model.ObClusterSummary)
+	require.Equal(t, 1, len(summaries))
+	require.Equal(t, "other", summaries[0].ObCluster)
+	require.Equal(t, "", data.NextCursor)
+}
+
+func TestListObClustersUpdatedSince(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_list_updated_since")
+	client := GetConfigServer().Client
+	createTime := time.Now().Add(-time.Hour)
+	for i := 1; i <= 2; i++ {
+		name := fmt.Sprintf("c%d", i)
+		json := fmt.Sprintf("{\"Type\":\"PRIMARY\",\"ObClusterId\":1,\"ObCluster\":\"%s\",\"RsList\":[{\"address\":\"1.1.1.1:2882\",\"role\":\"LEADER\",\"sql_port\":2881}]}", name)
+		err := client.ObCluster.Create().SetName(name).SetObClusterID(1).SetType("PRIMARY").SetRootserviceJSON(json).SetCreateTime(createTime).SetUpdateTime(createTime).Exec(context.Background())
+		require.Nil(t, err)
+	}
+
+	updateTime := time.Now()
+	rootServiceInfo := &model.ObRootServiceInfo{
+		ObCluster:   "c1",
+		ObClusterId: 1,
+		Type:        model.OB_CLUSTER_TYPE_PRIMARY,
+		RsList:      []*model.ObServerInfo{{Address: "1.1.1.2:2882", Role: "LEADER", SqlPort: 2881}},
+	}
+	created, err := saveRootServiceInfo(context.Background(), DEFAULT_NAMESPACE, rootServiceInfo)
+	require.Nil(t, err)
+	require.False(t, created)
+
+	data := listObClustersForTest(t, "UpdatedSince="+updateTime.Add(-time.Second).UTC().Format(time.RFC3339Nano))
+	summaries := data.Contents.([]*model.ObClusterSummary)
+	require.Equal(t, 1, len(summaries))
+	require.Equal(t, "c1", summaries[0].ObCluster)
+
+	data = listObClustersForTest(t, "UpdatedSince="+time.Now().Add(time.Second).UTC().Format(time.RFC3339Nano))
+	require.Equal(t, 0, len(data.Contents.([]*model.ObClusterSummary)))
+
+	data = listObClustersForTest(t, "SortBy=update_time&Order=desc")
+	summaries = data.Contents.([]*model.ObClusterSummary)
+	require.Equal(t, 2, len(summaries))
+	require.Equal(t, "c1", summaries[0].ObCluster)
+}
+
+func parseObClusterListParamForTest(query string) (*ObClusterListParam, error) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "http://1.1.1.1:8080/services?Action=ListObClusters&"+query, nil)
+	return getObClusterListParam(c)
+}
+
+func TestListObClustersInvalidParam(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	_, err := parseObClusterListParamForTest("SortBy=rootservice_json")
+	require.NotNil(t, err)
+
+	_, err = parseObClusterListParamForTest("Limit=0")
+	require.NotNil(t, err)
+
+	_, err = parseObClusterListParamForTest("Cursor=invalid")
+	require.NotNil(t, err)
+
+	param, err := parseObClusterListParamForTest("UpdatedSince=2025-01-01T00:00:00Z&SortBy=update_time")
+	require.Nil(t, err)
+	require.NotNil(t, param.UpdatedSince)
+}
@@ -0,0 +1,251 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+// functions in this file are shared by the legacy Action api and the v3 api,
+// they don't depend on gin, parameters are parsed and results are rendered by the handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/oceanbase/configserver/ent"
+	"github.com/oceanbase/configserver/ent/obcluster"
+	"github.com/oceanbase/configserver/model"
+)
+
+func decodeRootServiceInfo(cluster *ent.ObCluster) (*model.ObRootServiceInfo, error) {
+	var rootServiceInfo model.ObRootServiceInfo
+	err := json.Unmarshal([]byte(cluster.RootserviceJSON), &rootServiceInfo)
+	if err != nil {
+		return nil, errors.Wrap(err, "deserialize root service info")
+	}
+	rootServiceInfo.Fill()
+	return &rootServiceInfo, nil
+}
+
+// queryActiveObClusters queries clusters not in recycle bin, all the read paths should use it
+func queryActiveObClusters(client *ent.ObClusterClient) *ent.ObClusterQuery {
+	return client.Query().Where(obcluster.DeletedAtIsNil())
+}
+
+// getRootServiceInfoList returns all the clusters with the name, or the one with cluster id if it's specified,
+// an error with ErrorCodeClusterNotFound is returned along with an empty list if there's no cluster found
+func getRootServiceInfoList(ctxlog context.Context, namespace string, obCluster string, obClusterId int64) ([]*model.ObRootServiceInfo, error) {
+	var clusters []*ent.ObCluster
+	var err error
+	rootServiceInfoList := make([]*model.ObRootServiceInfo, 0, 4)
+	client := GetConfigServer().Client
+
+	if obClusterId != 0 {
+		log.WithContext(ctxlog).Infof("query ob clusters with namespace %s, obcluster %s and obcluster_id %d", namespace, obCluster, obClusterId)
+		clusters, err = queryActiveObClusters(client.ObCluster).Where(obcluster.Namespace(namespace), obcluster.Name(obCluster), obcluster.ObClusterID(obClusterId)).Order(ent.Asc(obcluster.FieldObClusterID)).All(ctxlog)
+	} else {
+		log.WithContext(ctxlog).Infof("query ob clusters with namespace %s, obcluster %s", namespace, obCluster)
+		clusters, err = queryActiveObClusters(client.ObCluster).Where(obcluster.Namespace(namespace), obcluster.Name(obCluster)).Order(ent.Asc(obcluster.FieldObClusterID)).All(ctxlog)
+	}
+	if err != nil {
+		return nil, wrapStorageError(err, "query ob clusters from db")
+	}
+	if len(clusters) == 0 {
+		return rootServiceInfoList, NewApiErrorf(ErrorCodeClusterNotFound, "no root service info found with namespace %s, obcluster %s, obcluster id %d", namespace, obCluster, obClusterId)
+	}
+	for _, cluster := range clusters {
+		rootServiceInfo, err := decodeRootServiceInfo(cluster)
+		if err != nil {
+			return nil, err
+		}
+		rootServiceInfoList = append(rootServiceInfoList, rootServiceInfo)
+	}
+	return rootServiceInfoList, nil
+}
+
+// getPrimaryRootServiceInfo returns the primary among clusters with the same name
+func getPrimaryRootServiceInfo(ctxlog context.Context, namespace string, obCluster string, rootServiceInfoList []*model.ObRootServiceInfo) (*model.ObRootServiceInfo, error) {
+	primaryClusterId, err := getPrimaryClusterId(ctxlog, namespace, obCluster)
+	if err != nil {
+		return nil, err
+	}
+	return selectPrimaryCluster(rootServiceInfoList, primaryClusterId), nil
+}
+
+// saveRootServiceInfo creates or updates the cluster and updates its group in a transaction,
+// returns whether the cluster is newly created
+func saveRootServiceInfo(ctxlog context.Context, namespace string, obRootServiceInfo *model.ObRootServiceInfo) (bool, error) {
+	created := false
+	err := withTx(ctxlog, GetConfigServer().Client, func(tx *ent.Tx) error {
+		var err error
+		created, err = saveRootServiceInfoInTx(ctxlog, tx, namespace, obRootServiceInfo)
+		return err
+	})
+	return created, err
+}
+
+// saveRootServiceInfoInTx creates or updates the cluster and updates its group in the given transaction
+func saveRootServiceInfoInTx(ctxlog context.Context, tx *ent.Tx, namespace string, obRootServiceInfo *model.ObRootServiceInfo) (bool, error) {
+	rsBytes, err := json.Marshal(obRootServiceInfo)
+	if err != nil {
+		return false, errors.Wrap(err, "serialize ob rootservice info")
+	}
+	rootServiceInfoJson := string(rsBytes)
+	log.WithContext(ctxlog).Infof("store rootservice info %s in namespace %s", rootServiceInfoJson, namespace)
+
+	// clusters in recycle bin are taken out only by RestoreObCluster, or registered again after purged
+	deleted, err := tx.ObCluster.Query().
+		Where(obcluster.Namespace(namespace), obcluster.Name(obRootServiceInfo.ObCluster), obcluster.ObClusterID(obRootServiceInfo.ObClusterId), obcluster.DeletedAtNotNil()).
+		Exist(ctxlog)
+	if err != nil {
+		return false, wrapStorageError(err, "check ob cluster in recycle bin")
+	}
+	if deleted {
+		return false, NewApiErrorf(ErrorCodeConflict, "ob cluster %s with ob cluster id %d is in recycle bin, restore or purge it before registering", obRootServiceInfo.ObCluster, obRootServiceInfo.ObClusterId)
+	}
+	exists, err := queryActiveObClusters(tx.ObCluster).
+		Where(obcluster.Namespace(namespace), obcluster.Name(obRootServiceInfo.ObCluster), obcluster.ObClusterID(obRootServiceInfo.ObClusterId)).
+		Exist(ctxlog)
+	if err != nil {
+		return false, wrapStorageError(err, "check ob cluster existence")
+	}
+	err = tx.ObCluster.
+		Create().
+		SetNamespace(namespace).
+		SetName(obRootServiceInfo.ObCluster).
+		SetObClusterID(obRootServiceInfo.ObClusterId).
+		SetType(obRootServiceInfo.Type).
+		SetRootserviceJSON(rootServiceInfoJson).
+		OnConflict().
+		SetType(obRootServiceInfo.Type).
+		SetRootserviceJSON(rootServiceInfoJson).
+		UpdateUpdateTime().
+		Exec(ctxlog)
+	if err != nil {
+		return false, wrapStorageError(err, "save ob cluster")
+	}
+	return !exists, syncObClusterGroup(ctxlog, tx, namespace, obRootServiceInfo.ObCluster)
+}
+
+// deleteRootServiceInfo moves the cluster into recycle bin and updates its group in a transaction, returns the affected rows
+func deleteRootServiceInfo(ctxlog context.Context, namespace string, obCluster string, obClusterId int64) (int, error) {
+	var affected int
+	err := withTx(ctxlog, GetConfigServer().Client, func(tx *ent.Tx) error {
+		var err error
+		affected, err = tx.ObCluster.
+			Update().
+			Where(obcluster.Namespace(namespace), obcluster.Name(obCluster), obcluster.ObClusterID(obClusterId), obcluster.DeletedAtIsNil()).
+			SetDeletedAt(time.Now()).
+			Save(ctxlog)
+		if err != nil {
+			return wrapStorageError(err, "delete ob cluster")
+		}
+		return syncObClusterGroup(ctxlog, tx, namespace, obCluster)
+	})
+	if err == nil {
+		log.WithContext(ctxlog).Infof("move obcluster %s with ob cluster id %d in namespace %s into recycle bin, affected rows %d", obCluster, obClusterId, namespace, affected)
+	}
+	return affected, err
+}
+
+// queryObProxyGroupClusters returns active clusters in namespace visible to the proxy group, all of them if group is nil
+func queryObProxyGroupClusters(ctxlog context.Context, namespace string, group *ent.ObProxyGroup) ([]*ent.ObCluster, error) {
+	query := queryActiveObClusters(GetConfigServer().Client.ObCluster).Where(obcluster.Namespace(namespace))
+	if group != nil {
+		query = query.Where(obcluster.NameIn(group.ObClusters...))
+	}
+	clusters, err := query.All(ctxlog)
+	if err != nil {
+		return nil, wrapStorageError(err, "query ob clusters")
+	}
+	return clusters, nil
+}
+
+func getObProxyGroupName(group *ent.ObProxyGroup) string {
+	if group == nil {
+		return ""
+	}
+	return group.Name
+}
+
+func buildObProxyConfig(ctxlog context.Context, serviceAddress, namespace string, group *ent.ObProxyGroup) (*model.ObProxyConfig, error) {
+	namespaceServiceAddress := getNamespaceServiceAddress(serviceAddress, namespace)
+	rootServiceInfoUrlMap := make(map[string]*model.RootServiceInfoUrl)
+	clusters, err := queryObProxyGroupClusters(ctxlog, namespace, group)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cluster := range clusters {
+		rootServiceInfoUrlMap[cluster.Name] = &model.RootServiceInfoUrl{
+			ObCluster: cluster.Name,
+			Url:       fmt.Sprintf(CONFIG_URL_FORMAT, namespaceServiceAddress, cluster.Name),
+		}
+	}
+	rootServiceInfoUrls := make([]*model.RootServiceInfoUrl, 0, len(rootServiceInfoUrlMap))
+	for _, info := range rootServiceInfoUrlMap {
+		rootServiceInfoUrls = append(rootServiceInfoUrls, info)
+	}
+	// sort urls so the version is the same for identical clusters
+	sort.Slice(rootServiceInfoUrls, func(i, j int) bool {
+		return rootServiceInfoUrls[i].ObCluster < rootServiceInfoUrls[j].ObCluster
+	})
+	metaDatabaseInfo, err := getMetaDatabaseInfo(serviceAddress, namespace, group)
+	if err != nil {
+		return nil, errors.Wrap(err, "get meta database info")
+	}
+	obProxyConfig, err := model.NewObProxyConfig(namespaceServiceAddress, getObProxyGroupName(group), metaDatabaseInfo, rootServiceInfoUrls)
+	if err != nil {
+		return nil, errors.Wrap(err, "generate obproxy config")
+	}
+	return obProxyConfig, nil
+}
+
+func buildObProxyConfigWithTemplate(ctxlog context.Context, serviceAddress, namespace string, group *ent.ObProxyGroup) (*model.ObProxyConfigWithTemplate, error) {
+	namespaceServiceAddress := getNamespaceServiceAddress(serviceAddress, namespace)
+	clusterMap := make(map[string]interface{})
+	clusters, err := queryObProxyGroupClusters(ctxlog, namespace, group)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cluster := range clusters {
+		clusterMap[cluster.Name] = nil
+	}
+	clusterNames := make([]string, 0, len(clusterMap))
+	for clusterName := range clusterMap {
+		clusterNames = append(clusterNames, clusterName)
+	}
+	sort.Strings(clusterNames)
+
+	metaDatabaseInfo, err := getMetaDatabaseInfo(serviceAddress, namespace, group)
+	if err != nil {
+		return nil, errors.Wrap(err, "get meta database info")
+	}
+	var templateV1, templateV2 string
+	if group != nil {
+		templateV1, templateV2 = group.URLTemplateV1, group.URLTemplateV2
+	}
+	obProxyConfigWithTemplate, err := model.NewObProxyConfigWithTemplate(namespaceServiceAddress, getObProxyGroupName(group), metaDatabaseInfo, clusterNames, templateV1, templateV2)
+	if err != nil {
+		return nil, errors.Wrap(err, "generate obproxy config with template")
+	}
+	return obProxyConfigWithTemplate, nil
+}
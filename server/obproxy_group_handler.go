@@ -0,0 +1,251 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/oceanbase/configserver/ent"
+	"github.com/oceanbase/configserver/ent/obproxygroup"
+	"github.com/oceanbase/configserver/lib/crypto"
+	"github.com/oceanbase/configserver/model"
+)
+
+const OBPROXY_GROUP_PARAM = "ObProxyGroup"
+
+var obProxyGroupGetOnce sync.Once
+var obProxyGroupGetFunc func(*gin.Context)
+var obProxyGroupListOnce sync.Once
+var obProxyGroupListFunc func(*gin.Context)
+var obProxyGroupPostOnce sync.Once
+var obProxyGroupPostFunc func(*gin.Context)
+var obProxyGroupDeleteOnce sync.Once
+var obProxyGroupDeleteFunc func(*gin.Context)
+
+func getObProxyGroupGetFunc() func(*gin.Context) {
+	obProxyGroupGetOnce.Do(func() {
+		obProxyGroupGetFunc = handlerFunctionWrapper(getObProxyGroup)
+	})
+	return obProxyGroupGetFunc
+}
+
+func getObProxyGroupListFunc() func(*gin.Context) {
+	obProxyGroupListOnce.Do(func() {
+		obProxyGroupListFunc = handlerFunctionWrapper(listObProxyGroups)
+	})
+	return obProxyGroupListFunc
+}
+
+func getObProxyGroupPostFunc() func(*gin.Context) {
+	obProxyGroupPostOnce.Do(func() {
+		obProxyGroupPostFunc = handlerFunctionWrapper(saveObProxyGroup)
+	})
+	return obProxyGroupPostFunc
+}
+
+func getObProxyGroupDeleteFunc() func(*gin.Context) {
+	obProxyGroupDeleteOnce.Do(func() {
+		obProxyGroupDeleteFunc = handlerFunctionWrapper(deleteObProxyGroup)
+	})
+	return obProxyGroupDeleteFunc
+}
+
+func convertObProxyGroup(group *ent.ObProxyGroup) *model.ObProxyGroup {
+	result := &model.ObProxyGroup{
+		Name:          group.Name,
+		ObClusters:    group.ObClusters,
+		Clients:       group.Clients,
+		Users:         group.Users,
+		UrlTemplateV1: group.URLTemplateV1,
+		UrlTemplateV2: group.URLTemplateV2,
+		CreateTime:    group.CreateTime,
+		UpdateTime:    group.UpdateTime,
+	}
+	if group.MetaObCluster != "" || group.MetaDatabase != "" || group.MetaUser != "" {
+		result.MetaDatabase = &model.ObProxyGroupMetaDatabase{
+			ObCluster: group.MetaObCluster,
+			Database:  group.MetaDatabase,
+			User:      group.MetaUser,
+		}
+	}
+	return result
+}
+
+// selectObProxyGroup returns the proxy group of the request, the group is specified by parameter ObProxyGroup,
+// or the first group containing the auth user, or the first group containing the client ip,
+// nil is returned if the request is not in any group
+func selectObProxyGroup(ctxlog context.Context, c *gin.Context, namespace string) (*ent.ObProxyGroup, error) {
+	client := GetConfigServer().Client
+	if name := c.Query(OBPROXY_GROUP_PARAM); name != "" {
+		group, err := client.ObProxyGroup.Query().Where(obproxygroup.Namespace(namespace), obproxygroup.Name(name)).Only(ctxlog)
+		if err != nil {
+			return nil, wrapStorageError(err, "query obproxy group "+name)
+		}
+		return group, nil
+	}
+	groups, err := client.ObProxyGroup.Query().Where(obproxygroup.Namespace(namespace)).Order(ent.Asc(obproxygroup.FieldName)).All(ctxlog)
+	if err != nil {
+		return nil, wrapStorageError(err, "query obproxy groups")
+	}
+	if user := getAuthUser(c); user != nil {
+		for _, group := range groups {
+			if containsString(group.Users, user.Name) {
+				return group, nil
+			}
+		}
+	}
+	clientIp := c.ClientIP()
+	for _, group := range groups {
+		ipNets, err := parseIpNets(group.Clients)
+		if err != nil {
+			log.WithContext(ctxlog).WithError(err).Warnf("ignore invalid clients of obproxy group %s", group.Name)
+			continue
+		}
+		if containsIp(ipNets, clientIp) {
+			return group, nil
+		}
+	}
+	return nil, nil
+}
+
+func getObProxyGroup(ctxlog context.Context, c *gin.Context) *ApiResponse {
+	namespace, err := getNamespace(c)
+	if err != nil {
+		return NewIllegalArgumentResponse(errors.Wrap(err, "invalid parameter, failed to parse namespace"))
+	}
+	name := c.Query(OBPROXY_GROUP_PARAM)
+	if name == "" {
+		return NewIllegalArgumentResponse(errors.New("obproxy group name is required"))
+	}
+	group, err := GetConfigServer().Client.ObProxyGroup.Query().Where(obproxygroup.Namespace(namespace), obproxygroup.Name(name)).Only(ctxlog)
+	if err != nil {
+		return NewErrorResponse(wrapStorageError(err, "query obproxy group "+name))
+	}
+	return NewSuccessResponse(convertObProxyGroup(group))
+}
+
+func listObProxyGroups(ctxlog context.Context, c *gin.Context) *ApiResponse {
+	namespace, err := getNamespace(c)
+	if err != nil {
+		return NewIllegalArgumentResponse(errors.Wrap(err, "invalid parameter, failed to parse namespace"))
+	}
+	groups, err := GetConfigServer().Client.ObProxyGroup.Query().Where(obproxygroup.Namespace(namespace)).Order(ent.Asc(obproxygroup.FieldName)).All(ctxlog)
+	if err != nil {
+		return NewErrorResponse(wrapStorageError(err, "query obproxy groups"))
+	}
+	result := make([]*model.ObProxyGroup, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, convertObProxyGroup(group))
+	}
+	return NewSuccessResponse(result)
+}
+
+// saveObProxyGroup creates or replaces the proxy group, the meta database password is encrypted before saved
+func saveObProxyGroup(ctxlog context.Context, c *gin.Context) *ApiResponse {
+	namespace, err := getNamespace(c)
+	if err != nil {
+		return NewIllegalArgumentResponse(errors.Wrap(err, "invalid parameter, failed to parse namespace"))
+	}
+	group := new(model.ObProxyGroup)
+	if err := c.ShouldBindJSON(group); err != nil {
+		return NewIllegalArgumentResponse(errors.Wrap(err, "bind obproxy group"))
+	}
+	if errs := group.Validate(); len(errs) > 0 {
+		return NewValidationErrorResponse(errs)
+	}
+	meta := group.MetaDatabase
+	if meta == nil {
+		meta = &model.ObProxyGroupMetaDatabase{}
+	}
+	encryptedPassword := ""
+	if meta.Password != "" {
+		key, err := getMetaDatabaseKey()
+		if err != nil {
+			return NewIllegalArgumentResponse(errors.Wrap(err, "encrypt meta database password"))
+		}
+		if encryptedPassword, err = crypto.Encrypt(key, meta.Password); err != nil {
+			return NewErrorResponse(errors.Wrap(err, "encrypt meta database password"))
+		}
+	}
+
+	created := false
+	err = withTx(ctxlog, GetConfigServer().Client, func(tx *ent.Tx) error {
+		existing, err := tx.ObProxyGroup.Query().Where(obproxygroup.Namespace(namespace), obproxygroup.Name(group.Name)).Only(ctxlog)
+		if ent.IsNotFound(err) {
+			created = true
+			return wrapStorageError(tx.ObProxyGroup.Create().
+				SetNamespace(namespace).
+				SetName(group.Name).
+				SetObClusters(group.ObClusters).
+				SetClients(group.Clients).
+				SetUsers(group.Users).
+				SetMetaObCluster(meta.ObCluster).
+				SetMetaDatabase(meta.Database).
+				SetMetaUser(meta.User).
+				SetMetaEncryptedPassword(encryptedPassword).
+				SetURLTemplateV1(group.UrlTemplateV1).
+				SetURLTemplateV2(group.UrlTemplateV2).
+				Exec(ctxlog), "create obproxy group")
+		}
+		if err != nil {
+			return wrapStorageError(err, "query obproxy group")
+		}
+		update := existing.Update().
+			SetObClusters(group.ObClusters).
+			SetClients(group.Clients).
+			SetUsers(group.Users).
+			SetMetaObCluster(meta.ObCluster).
+			SetMetaDatabase(meta.Database).
+			SetMetaUser(meta.User).
+			SetURLTemplateV1(group.UrlTemplateV1).
+			SetURLTemplateV2(group.UrlTemplateV2)
+		if encryptedPassword != "" {
+			update = update.SetMetaEncryptedPassword(encryptedPassword)
+		}
+		return wrapStorageError(update.Exec(ctxlog), "update obproxy group")
+	})
+	if err != nil {
+		return NewErrorResponse(errors.Wrapf(err, "save obproxy group %s", group.Name))
+	}
+	log.WithContext(ctxlog).Infof("save obproxy group %s in namespace %s, created %t", group.Name, namespace, created)
+	return NewSuccessResponse("successful")
+}
+
+func deleteObProxyGroup(ctxlog context.Context, c *gin.Context) *ApiResponse {
+	namespace, err := getNamespace(c)
+	if err != nil {
+		return NewIllegalArgumentResponse(errors.Wrap(err, "invalid parameter, failed to parse namespace"))
+	}
+	name := c.Query(OBPROXY_GROUP_PARAM)
+	if name == "" {
+		return NewIllegalArgumentResponse(errors.New("obproxy group name is required"))
+	}
+	affected, err := GetConfigServer().Client.ObProxyGroup.Delete().Where(obproxygroup.Namespace(namespace), obproxygroup.Name(name)).Exec(ctxlog)
+	if err != nil {
+		return NewErrorResponse(wrapStorageError(err, "delete obproxy group "+name))
+	}
+	if affected == 0 {
+		return NewNotFoundResponse(errors.Errorf("obproxy group %s not found in namespace %s", name, namespace))
+	}
+	log.WithContext(ctxlog).Infof("delete obproxy group %s in namespace %s", name, namespace)
+	return NewSuccessResponse("success")
+}
@@ -0,0 +1,161 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oceanbase/configserver/config"
+	"github.com/oceanbase/configserver/ent/obproxygroup"
+	"github.com/oceanbase/configserver/model"
+)
+
+func obProxyGroupTestRequest(method, url string, body interface{}, remoteAddr string, f func(context.Context, *gin.Context) *ApiResponse) *ApiResponse {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	var reader *bytes.Buffer
+	if body != nil {
+		content, _ := json.Marshal(body)
+		reader = bytes.NewBuffer(content)
+	} else {
+		reader = bytes.NewBuffer(nil)
+	}
+	c.Request, _ = http.NewRequest(method, url, reader)
+	c.Request.RemoteAddr = remoteAddr
+	return f(context.Background(), c)
+}
+
+func TestObProxyGroup(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_obproxy_group")
+	for _, name := range []string{"c1", "c2", "c3"} {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("POST", "http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster="+name+"&ObClusterId=1&version=2", bytes.NewBuffer([]byte(strings.ReplaceAll(testRootServiceJson, "c1", name))))
+		require.Equal(t, http.StatusOK, createOrUpdateObRootServiceInfo(context.Background(), c).Code)
+	}
+	keyFile := filepath.Join(t.TempDir(), "key")
+	require.Nil(t, os.WriteFile(keyFile, []byte("000102030405060708090a0b0c0d0e0f"), 0600))
+	configServer.Config.MetaDatabase = &config.MetaDatabaseConfig{
+		KeyFile: keyFile,
+		Default: &config.MetaDatabaseInfoConfig{ObCluster: "meta", Database: "obproxy", User: "proxyro"},
+	}
+	defer func() {
+		configServer.Config.MetaDatabase = nil
+	}()
+
+	// invalid group
+	response := obProxyGroupTestRequest("POST", "http://1.1.1.1:8080/services?Action=ObProxyGroup", &model.ObProxyGroup{Name: "g1", Clients: []string{"invalid"}}, "", saveObProxyGroup)
+	require.Equal(t, http.StatusBadRequest, response.Code)
+
+	groupA := &model.ObProxyGroup{
+		Name:          "a",
+		ObClusters:    []string{"c1", "c2"},
+		Clients:       []string{"10.0.0.0/24"},
+		MetaDatabase:  &model.ObProxyGroupMetaDatabase{User: "proxyro_a", Password: "password_a"},
+		UrlTemplateV1: "http://vip_a/services?Action=ObRootServiceInfo&ObRegion=${ObRegion}",
+	}
+	response = obProxyGroupTestRequest("POST", "http://1.1.1.1:8080/services?Action=ObProxyGroup", groupA, "", saveObProxyGroup)
+	require.Equal(t, http.StatusOK, response.Code)
+	groupB := &model.ObProxyGroup{Name: "b", ObClusters: []string{"c3"}, Clients: []string{"10.0.0.1"}, Users: []string{"user_b"}}
+	response = obProxyGroupTestRequest("POST", "http://1.1.1.1:8080/services?Action=ObProxyGroup", groupB, "", saveObProxyGroup)
+	require.Equal(t, http.StatusOK, response.Code)
+
+	// password is stored encrypted and never returned
+	group, err := configServer.Client.ObProxyGroup.Query().Where(obproxygroup.Name("a")).Only(context.Background())
+	require.Nil(t, err)
+	require.NotEmpty(t, group.MetaEncryptedPassword)
+	require.NotContains(t, group.MetaEncryptedPassword, "password_a")
+	response = obProxyGroupTestRequest("GET", "http://1.1.1.1:8080/services?Action=ObProxyGroup&ObProxyGroup=a", nil, "", getObProxyGroup)
+	require.Equal(t, http.StatusOK, response.Code)
+	require.Equal(t, "proxyro_a", response.Data.(*model.ObProxyGroup).MetaDatabase.User)
+	require.Empty(t, response.Data.(*model.ObProxyGroup).MetaDatabase.Password)
+
+	response = obProxyGroupTestRequest("GET", "http://1.1.1.1:8080/services?Action=ListObProxyGroups", nil, "", listObProxyGroups)
+	require.Equal(t, http.StatusOK, response.Code)
+	require.Equal(t, 2, len(response.Data.([]*model.ObProxyGroup)))
+
+	// group selected by client ip, first group by name wins
+	response = obProxyGroupTestRequest("GET", "http://1.1.1.1:8080/services?Action=GetObProxyConfig", nil, "10.0.0.1:1000", getObProxyConfig)
+	require.Equal(t, http.StatusOK, response.Code)
+	obProxyConfigA := response.Data.(*model.ObProxyConfig)
+	require.Equal(t, "a", obProxyConfigA.ObProxyGroup)
+	require.Equal(t, 2, len(obProxyConfigA.ConfigUrlList))
+	require.Equal(t, "proxyro_a", obProxyConfigA.MetaDatabase.User)
+	require.Equal(t, "password_a", obProxyConfigA.MetaDatabase.Password)
+	require.Equal(t, "obproxy", obProxyConfigA.MetaDatabase.Database)
+
+	// group selected by parameter
+	response = obProxyGroupTestRequest("GET", "http://1.1.1.1:8080/services?Action=GetObProxyConfig&ObProxyGroup=b", nil, "10.0.0.1:1000", getObProxyConfig)
+	require.Equal(t, http.StatusOK, response.Code)
+	obProxyConfigB := response.Data.(*model.ObProxyConfig)
+	require.Equal(t, 1, len(obProxyConfigB.ConfigUrlList))
+	require.Equal(t, "c3", obProxyConfigB.ConfigUrlList[0].ObCluster)
+	require.NotEqual(t, obProxyConfigA.Version, obProxyConfigB.Version)
+
+	// group selected by auth user
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "http://1.1.1.1:8080/services?Action=GetObRootServiceInfoUrlTemplate", nil)
+	c.Request.RemoteAddr = "10.0.0.1:1000"
+	c.Set(authUserKey, &config.AuthUserConfig{Name: "user_b"})
+	response = getObProxyConfigWithTemplate(context.Background(), c)
+	require.Equal(t, http.StatusOK, response.Code)
+	require.Equal(t, []string{"c3"}, response.Data.(*model.ObProxyConfigWithTemplate).ObClusters)
+
+	response = obProxyGroupTestRequest("GET", "http://1.1.1.1:8080/services?Action=GetObRootServiceInfoUrlTemplate", nil, "10.0.0.2:1000", getObProxyConfigWithTemplate)
+	require.Equal(t, http.StatusOK, response.Code)
+	require.Equal(t, []string{"c1", "c2"}, response.Data.(*model.ObProxyConfigWithTemplate).ObClusters)
+	require.Equal(t, groupA.UrlTemplateV1, response.Data.(*model.ObProxyConfigWithTemplate).TemplateV1)
+
+	// clients not in any group see all the clusters
+	response = obProxyGroupTestRequest("GET", "http://1.1.1.1:8080/services?Action=GetObProxyConfig", nil, "10.1.0.1:1000", getObProxyConfig)
+	require.Equal(t, http.StatusOK, response.Code)
+	require.Equal(t, "", response.Data.(*model.ObProxyConfig).ObProxyGroup)
+	require.Equal(t, 3, len(response.Data.(*model.ObProxyConfig).ConfigUrlList))
+
+	response = obProxyGroupTestRequest("GET", "http://1.1.1.1:8080/services?Action=GetObProxyConfig&ObProxyGroup=none", nil, "10.0.0.1:1000", getObProxyConfig)
+	require.Equal(t, http.StatusNotFound, response.Code)
+
+	// update keeps the saved password if not specified
+	groupA.ObClusters = []string{"c1"}
+	groupA.MetaDatabase.Password = ""
+	response = obProxyGroupTestRequest("POST", "http://1.1.1.1:8080/services?Action=ObProxyGroup", groupA, "", saveObProxyGroup)
+	require.Equal(t, http.StatusOK, response.Code)
+	response = obProxyGroupTestRequest("GET", "http://1.1.1.1:8080/services?Action=GetObProxyConfig", nil, "10.0.0.1:1000", getObProxyConfig)
+	require.Equal(t, 1, len(response.Data.(*model.ObProxyConfig).ConfigUrlList))
+	require.Equal(t, "password_a", response.Data.(*model.ObProxyConfig).MetaDatabase.Password)
+	require.NotEqual(t, obProxyConfigA.Version, response.Data.(*model.ObProxyConfig).Version)
+
+	response = obProxyGroupTestRequest("DELETE", "http://1.1.1.1:8080/services?Action=ObProxyGroup&ObProxyGroup=a", nil, "", deleteObProxyGroup)
+	require.Equal(t, http.StatusOK, response.Code)
+	response = obProxyGroupTestRequest("DELETE", "http://1.1.1.1:8080/services?Action=ObProxyGroup&ObProxyGroup=a", nil, "", deleteObProxyGroup)
+	require.Equal(t, http.StatusNotFound, response.Code)
+	response = obProxyGroupTestRequest("GET", "http://1.1.1.1:8080/services?Action=GetObProxyConfig", nil, "10.0.0.1:1000", getObProxyConfig)
+	require.Equal(t, "b", response.Data.(*model.ObProxyConfig).ObProxyGroup)
+}
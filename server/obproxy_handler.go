@@ -18,8 +18,9 @@ package server
 
 import (
 	"context"
-	"fmt"
+	"net/http"
 	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/gin-gonic/gin"
@@ -32,6 +33,10 @@ import (
 
 const (
 	CONFIG_URL_FORMAT = "%s/services?Action=ObRootServiceInfo&ObCluster=%s"
+
+	// obproxy config can be cached but must be revalidated with ETag before use
+	OBPROXY_CONFIG_CACHE_CONTROL = "no-cache"
+	VERSION_ONLY_ETAG_SUFFIX     = "-version-only"
 )
 
 var obProxyConfigOnce sync.Once
@@ -53,10 +58,6 @@ func getObProxyConfigWithTemplateFunc() func(*gin.Context) {
 	return obProxyConfigWithTemplateFunc
 }
 
-func getServiceAddress() string {
-	return fmt.Sprintf("http://%s:%d", GetConfigServer().Config.Vip.Address, GetConfigServer().Config.Vip.Port)
-}
-
 func isVersionOnly(c *gin.Context) (bool, error) {
 	ret := false
 	var err error
@@ -67,78 +68,93 @@ func isVersionOnly(c *gin.Context) (bool, error) {
 	return ret, err
 }
 
+// etagMatches returns whether the etag is listed in If-None-Match, weak etags are compared as strong ones
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, value := range strings.Split(ifNoneMatch, ",") {
+		value = strings.TrimPrefix(strings.TrimSpace(value), "W/")
+		if value == "*" || value == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// getObProxyConfigEtag returns the etag of the config version, the full config in json has the version as is,
+// the version only response and other formats have their own etags, so a cached representation is never taken as another
+func getObProxyConfigEtag(c *gin.Context, version string, versionOnly bool) string {
+	value := version
+	if versionOnly {
+		value += VERSION_ONLY_ETAG_SUFFIX
+	}
+	if format, err := getResponseFormat(c); err == nil && format != RESPONSE_FORMAT_JSON {
+		value += "-" + format
+	}
+	return strconv.Quote(value)
+}
+
+// newObProxyConfigResponse returns the config with ETag of its version, or 304 if the client already has it
+func newObProxyConfigResponse(c *gin.Context, version string, versionOnly bool, data interface{}) *ApiResponse {
+	etag := getObProxyConfigEtag(c, version, versionOnly)
+	if versionOnly {
+		data = model.NewObProxyConfigVersionOnly(version)
+	}
+	c.Header("ETag", etag)
+	c.Header("Cache-Control", OBPROXY_CONFIG_CACHE_CONTROL)
+	isGet := c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead
+	if isGet && etagMatches(c.GetHeader("If-None-Match"), etag) {
+		return NewNotModifiedResponse()
+	}
+	return NewSuccessResponse(data)
+}
+
 func getObProxyConfig(ctxlog context.Context, c *gin.Context) *ApiResponse {
 	var response *ApiResponse
-	client := GetConfigServer().Client
 
 	versionOnly, err := isVersionOnly(c)
 	if err != nil {
 		return NewIllegalArgumentResponse(errors.Wrap(err, "invalid parameter, failed to parse versiononly"))
 	}
 
-	rootServiceInfoUrlMap := make(map[string]*model.RootServiceInfoUrl)
-	clusters, err := client.ObCluster.Query().All(context.Background())
+	namespace, err := getNamespace(c)
 	if err != nil {
-		return NewErrorResponse(errors.Wrap(err, "query ob clusters"))
+		return NewIllegalArgumentResponse(errors.Wrap(err, "invalid parameter, failed to parse namespace"))
 	}
 
-	for _, cluster := range clusters {
-		rootServiceInfoUrlMap[cluster.Name] = &model.RootServiceInfoUrl{
-			ObCluster: cluster.Name,
-			Url:       fmt.Sprintf(CONFIG_URL_FORMAT, getServiceAddress(), cluster.Name),
-		}
-	}
-	rootServiceInfoUrls := make([]*model.RootServiceInfoUrl, 0, len(rootServiceInfoUrlMap))
-	for _, info := range rootServiceInfoUrlMap {
-		rootServiceInfoUrls = append(rootServiceInfoUrls, info)
+	group, err := selectObProxyGroup(ctxlog, c, namespace)
+	if err != nil {
+		return NewErrorResponse(errors.Wrap(err, "select obproxy group"))
 	}
-	obProxyConfig, err := model.NewObProxyConfig(getServiceAddress(), rootServiceInfoUrls)
+	obProxyConfig, err := buildObProxyConfig(ctxlog, getServiceAddress(ctxlog, c), namespace, group)
 	if err != nil {
-		response = NewErrorResponse(errors.Wrap(err, "generate obproxy config"))
+		response = NewErrorResponse(err)
 	} else {
-		if versionOnly {
-			response = NewSuccessResponse(model.NewObProxyConfigVersionOnly(obProxyConfig.Version))
-		} else {
-			response = NewSuccessResponse(obProxyConfig)
-		}
+		response = newObProxyConfigResponse(c, obProxyConfig.Version, versionOnly, obProxyConfig)
 	}
 	return response
 }
 
 func getObProxyConfigWithTemplate(ctxlog context.Context, c *gin.Context) *ApiResponse {
 	var response *ApiResponse
-	client := GetConfigServer().Client
 
 	versionOnly, err := isVersionOnly(c)
 	if err != nil {
 		return NewIllegalArgumentResponse(errors.Wrap(err, "invalid parameter, failed to parse versiononly"))
 	}
 
-	clusterMap := make(map[string]interface{})
-	clusters, err := client.ObCluster.Query().All(context.Background())
-
+	namespace, err := getNamespace(c)
 	if err != nil {
-		return NewErrorResponse(errors.Wrap(err, "query ob clusters"))
+		return NewIllegalArgumentResponse(errors.Wrap(err, "invalid parameter, failed to parse namespace"))
 	}
 
-	for _, cluster := range clusters {
-		clusterMap[cluster.Name] = nil
-	}
-	clusterNames := make([]string, 0, len(clusterMap))
-	for clusterName := range clusterMap {
-		clusterNames = append(clusterNames, clusterName)
+	group, err := selectObProxyGroup(ctxlog, c, namespace)
+	if err != nil {
+		return NewErrorResponse(errors.Wrap(err, "select obproxy group"))
 	}
-
-	obProxyConfigWithTemplate, err := model.NewObProxyConfigWithTemplate(getServiceAddress(), clusterNames)
-
+	obProxyConfigWithTemplate, err := buildObProxyConfigWithTemplate(ctxlog, getServiceAddress(ctxlog, c), namespace, group)
 	if err != nil {
-		response = NewErrorResponse(errors.Wrap(err, "generate obproxy config with template"))
+		response = NewErrorResponse(err)
 	} else {
-		if versionOnly {
-			response = NewSuccessResponse(model.NewObProxyConfigVersionOnly(obProxyConfigWithTemplate.Version))
-		} else {
-			response = NewSuccessResponse(obProxyConfigWithTemplate)
-		}
+		response = newObProxyConfigResponse(c, obProxyConfigWithTemplate.Version, versionOnly, obProxyConfigWithTemplate)
 	}
 	return response
 }
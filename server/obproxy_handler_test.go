@@ -17,9 +17,11 @@
 package server
 
 import (
+	"bytes"
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gin-gonic/gin"
@@ -103,3 +105,72 @@ func TestGetObproxyConfigWithTemplate(t *testing.T) {
 	response := getObProxyConfigWithTemplate(context.Background(), c)
 	require.Equal(t, http.StatusOK, response.Code)
 }
+
+func TestObProxyConfigConditionalGet(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_obproxy_conditional_get")
+	for _, name := range []string{"c3", "c1", "c2"} {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("POST", "http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster="+name+"&ObClusterId=1&version=2", bytes.NewBuffer([]byte(strings.ReplaceAll(testRootServiceJson, "c1", name))))
+		require.Equal(t, http.StatusOK, createOrUpdateObRootServiceInfo(context.Background(), c).Code)
+	}
+	r := gin.New()
+	InitConfigServerRoutes(r)
+
+	for _, url := range []string{"/services?Action=GetObProxyConfig", "/services?Action=GetObRootServiceInfoUrlTemplate", V3_API_PREFIX + "/proxy-config"} {
+		w := serveV3RequestForTest(r, http.MethodGet, url, nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		etag := w.Header().Get("ETag")
+		require.NotEmpty(t, etag)
+		require.Equal(t, OBPROXY_CONFIG_CACHE_CONTROL, w.Header().Get("Cache-Control"))
+
+		w = serveV3RequestForTest(r, http.MethodGet, url, nil)
+		require.Equal(t, etag, w.Header().Get("ETag"))
+
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		req.Header.Set("If-None-Match", `"other", `+etag)
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		require.Equal(t, http.StatusNotModified, w.Code)
+		require.Empty(t, w.Body.String())
+
+		// version only response has its own etag
+		req = httptest.NewRequest(http.MethodGet, url+"&VersionOnly=true", nil)
+		if url == V3_API_PREFIX+"/proxy-config" {
+			req = httptest.NewRequest(http.MethodGet, url+"?VersionOnly=true", nil)
+		}
+		req.Header.Set("If-None-Match", etag)
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+		require.NotEqual(t, etag, w.Header().Get("ETag"))
+	}
+
+	// other formats of the same config have their own etags
+	url := "/services?Action=GetObProxyConfig"
+	etag := serveV3RequestForTest(r, http.MethodGet, url, nil).Header().Get("ETag")
+	req := httptest.NewRequest(http.MethodGet, url+"&Format=yaml", nil)
+	req.Header.Set("If-None-Match", etag)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	yamlEtag := w.Header().Get("ETag")
+	require.NotEqual(t, etag, yamlEtag)
+
+	req = httptest.NewRequest(http.MethodGet, url+"&Format=yaml", nil)
+	req.Header.Set("If-None-Match", yamlEtag)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.Equal(t, http.StatusNotModified, w.Code)
+
+	obProxyConfig, err := buildObProxyConfig(context.Background(), getDefaultServiceAddress(), DEFAULT_NAMESPACE, nil)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(obProxyConfig.ConfigUrlList))
+	for i, name := range []string{"c1", "c2", "c3"} {
+		require.Equal(t, name, obProxyConfig.ConfigUrlList[i].ObCluster)
+	}
+	obProxyConfigWithTemplate, err := buildObProxyConfigWithTemplate(context.Background(), getDefaultServiceAddress(), DEFAULT_NAMESPACE, nil)
+	require.Nil(t, err)
+	require.Equal(t, []string{"c1", "c2", "c3"}, obProxyConfigWithTemplate.ObClusters)
+}
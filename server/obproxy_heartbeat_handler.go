@@ -0,0 +1,237 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/oceanbase/configserver/config"
+	"github.com/oceanbase/configserver/ent"
+	"github.com/oceanbase/configserver/ent/obproxy"
+	"github.com/oceanbase/configserver/ent/obproxygroup"
+	"github.com/oceanbase/configserver/model"
+)
+
+const (
+	DEFAULT_OBPROXY_ALIVE_TIMEOUT  = 3 * time.Minute
+	DEFAULT_OBPROXY_RETENTION      = 7 * 24 * time.Hour
+	DEFAULT_OBPROXY_PURGE_INTERVAL = time.Hour
+)
+
+var obProxyHeartbeatOnce sync.Once
+var obProxyHeartbeatFunc func(*gin.Context)
+var obProxyListOnce sync.Once
+var obProxyListFunc func(*gin.Context)
+
+func getObProxyHeartbeatFunc() func(*gin.Context) {
+	obProxyHeartbeatOnce.Do(func() {
+		obProxyHeartbeatFunc = handlerFunctionWrapper(reportObProxyHeartbeat)
+	})
+	return obProxyHeartbeatFunc
+}
+
+func getObProxyListFunc() func(*gin.Context) {
+	obProxyListOnce.Do(func() {
+		obProxyListFunc = handlerFunctionWrapper(listObProxies)
+	})
+	return obProxyListFunc
+}
+
+func getObProxyHeartbeatConfig() *config.ObProxyHeartbeatConfig {
+	heartbeatConfig := &config.ObProxyHeartbeatConfig{}
+	if configured := GetConfigServer().Config.ObProxyHeartbeat; configured != nil {
+		*heartbeatConfig = *configured
+	}
+	if heartbeatConfig.AliveTimeout <= 0 {
+		heartbeatConfig.AliveTimeout = DEFAULT_OBPROXY_ALIVE_TIMEOUT
+	}
+	if heartbeatConfig.Retention <= 0 {
+		heartbeatConfig.Retention = DEFAULT_OBPROXY_RETENTION
+	}
+	if heartbeatConfig.PurgeInterval <= 0 {
+		heartbeatConfig.PurgeInterval = DEFAULT_OBPROXY_PURGE_INTERVAL
+	}
+	return heartbeatConfig
+}
+
+// getCurrentConfigVersions returns versions of obproxy config and obproxy config with template of the group
+// served with serviceAddress, obproxy may use either of them
+func getCurrentConfigVersions(ctxlog context.Context, serviceAddress, namespace string, group *ent.ObProxyGroup) ([]string, error) {
+	obProxyConfig, err := buildObProxyConfig(ctxlog, serviceAddress, namespace, group)
+	if err != nil {
+		return nil, err
+	}
+	obProxyConfigWithTemplate, err := buildObProxyConfigWithTemplate(ctxlog, serviceAddress, namespace, group)
+	if err != nil {
+		return nil, err
+	}
+	return []string{obProxyConfig.Version, obProxyConfigWithTemplate.Version}, nil
+}
+
+// matchConfigVersion returns the current version the obproxy runs, or the version of obproxy config if it's lagging
+func matchConfigVersion(configVersion string, currentVersions []string) (string, bool) {
+	for _, version := range currentVersions {
+		if version == configVersion {
+			return version, true
+		}
+	}
+	return currentVersions[0], false
+}
+
+func reportObProxyHeartbeat(ctxlog context.Context, c *gin.Context) *ApiResponse {
+	namespace, err := getNamespace(c)
+	if err != nil {
+		return NewIllegalArgumentResponse(errors.Wrap(err, "invalid parameter, failed to parse namespace"))
+	}
+	heartbeat := new(model.ObProxyHeartbeat)
+	if err := c.ShouldBindJSON(heartbeat); err != nil {
+		return NewIllegalArgumentResponse(errors.Wrap(err, "bind obproxy heartbeat"))
+	}
+	heartbeat.Fill()
+	if errs := heartbeat.Validate(); len(errs) > 0 {
+		return NewValidationErrorResponse(errs)
+	}
+	group, err := selectObProxyGroup(ctxlog, c, namespace)
+	if err != nil {
+		return NewErrorResponse(errors.Wrap(err, "select obproxy group"))
+	}
+	groupName := getObProxyGroupName(group)
+	serviceAddress := getServiceAddress(ctxlog, c)
+	currentVersions, err := getCurrentConfigVersions(ctxlog, serviceAddress, namespace, group)
+	if err != nil {
+		return NewErrorResponse(errors.Wrap(err, "get current config version"))
+	}
+
+	now := time.Now()
+	err = GetConfigServer().Client.ObProxy.
+		Create().
+		SetNamespace(namespace).
+		SetName(heartbeat.Name).
+		SetAddress(heartbeat.Address).
+		SetVersion(heartbeat.Version).
+		SetConfigVersion(heartbeat.ConfigVersion).
+		SetObproxyGroup(groupName).
+		SetServiceAddress(serviceAddress).
+		SetLastSeenTime(now).
+		OnConflictColumns(obproxy.FieldNamespace, obproxy.FieldName).
+		SetAddress(heartbeat.Address).
+		SetVersion(heartbeat.Version).
+		SetConfigVersion(heartbeat.ConfigVersion).
+		SetObproxyGroup(groupName).
+		SetServiceAddress(serviceAddress).
+		SetLastSeenTime(now).
+		SetUpdateTime(now).
+		Exec(ctxlog)
+	if err != nil {
+		return NewErrorResponse(wrapStorageError(err, "save obproxy heartbeat"))
+	}
+	currentVersion, upToDate := matchConfigVersion(heartbeat.ConfigVersion, currentVersions)
+	if !upToDate {
+		log.WithContext(ctxlog).Infof("obproxy %s in namespace %s runs config version %s, current version %s", heartbeat.Name, namespace, heartbeat.ConfigVersion, currentVersion)
+	}
+	return NewSuccessResponse(&model.ObProxyHeartbeatResult{
+		ObProxyGroup:  groupName,
+		ConfigVersion: currentVersion,
+		Lagging:       !upToDate,
+	})
+}
+
+func listObProxies(ctxlog context.Context, c *gin.Context) *ApiResponse {
+	namespace, err := getNamespace(c)
+	if err != nil {
+		return NewIllegalArgumentResponse(errors.Wrap(err, "invalid parameter, failed to parse namespace"))
+	}
+	client := GetConfigServer().Client
+	query := client.ObProxy.Query().Where(obproxy.Namespace(namespace))
+	if groupName := c.Query(OBPROXY_GROUP_PARAM); groupName != "" {
+		query = query.Where(obproxy.ObproxyGroup(groupName))
+	}
+	proxies, err := query.Order(ent.Asc(obproxy.FieldObproxyGroup), ent.Asc(obproxy.FieldName)).All(ctxlog)
+	if err != nil {
+		return NewErrorResponse(wrapStorageError(err, "query obproxies"))
+	}
+
+	// current versions are calculated once for each group and service address, obproxies of deleted groups are compared with the ones without group
+	groups, err := client.ObProxyGroup.Query().Where(obproxygroup.Namespace(namespace)).All(ctxlog)
+	if err != nil {
+		return NewErrorResponse(wrapStorageError(err, "query obproxy groups"))
+	}
+	groupMap := make(map[string]*ent.ObProxyGroup)
+	for _, group := range groups {
+		groupMap[group.Name] = group
+	}
+	type versionKey struct {
+		group          string
+		serviceAddress string
+	}
+	currentVersionsMap := make(map[versionKey][]string)
+	aliveTimeout := getObProxyHeartbeatConfig().AliveTimeout
+	now := time.Now()
+	result := make([]*model.ObProxyInfo, 0, len(proxies))
+	for _, proxy := range proxies {
+		serviceAddress := proxy.ServiceAddress
+		if serviceAddress == "" {
+			serviceAddress = getDefaultServiceAddress()
+		}
+		key := versionKey{group: proxy.ObproxyGroup, serviceAddress: serviceAddress}
+		versions, ok := currentVersionsMap[key]
+		if !ok {
+			versions, err = getCurrentConfigVersions(ctxlog, serviceAddress, namespace, groupMap[proxy.ObproxyGroup])
+			if err != nil {
+				return NewErrorResponse(errors.Wrap(err, "get current config version"))
+			}
+			currentVersionsMap[key] = versions
+		}
+		currentVersion, upToDate := matchConfigVersion(proxy.ConfigVersion, versions)
+		result = append(result, &model.ObProxyInfo{
+			Name:                 proxy.Name,
+			Address:              proxy.Address,
+			Version:              proxy.Version,
+			ObProxyGroup:         proxy.ObproxyGroup,
+			ConfigVersion:        proxy.ConfigVersion,
+			CurrentConfigVersion: currentVersion,
+			FirstSeenTime:        proxy.CreateTime,
+			LastSeenTime:         proxy.LastSeenTime,
+			Alive:                now.Sub(proxy.LastSeenTime) <= aliveTimeout,
+			Lagging:              !upToDate,
+		})
+	}
+	return NewSuccessResponse(result)
+}
+
+func purgeExpiredObProxies(ctxlog context.Context, retention time.Duration) (int, error) {
+	affected, err := GetConfigServer().Client.ObProxy.
+		Delete().
+		Where(obproxy.LastSeenTimeLT(time.Now().Add(-retention))).
+		Exec(ctxlog)
+	if err != nil {
+		return 0, wrapStorageError(err, "purge expired obproxies")
+	}
+	return affected, nil
+}
+
+// runObProxyPurger removes obproxies without heartbeat for retention periodically until ctx is cancelled
+func runObProxyPurger(ctx context.Context) {
+	heartbeatConfig := getObProxyHeartbeatConfig()
+	runPurger(ctx, "obproxies", heartbeatConfig.PurgeInterval, heartbeatConfig.Retention, purgeExpiredObProxies)
+}
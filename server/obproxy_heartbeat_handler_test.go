@@ -0,0 +1,104 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oceanbase/configserver/ent/obproxy"
+	"github.com/oceanbase/configserver/model"
+)
+
+func TestObProxyHeartbeat(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_obproxy_heartbeat")
+	for _, name := range []string{"c1", "c2"} {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("POST", "http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster="+name+"&ObClusterId=1&version=2", bytes.NewBuffer([]byte(strings.ReplaceAll(testRootServiceJson, "c1", name))))
+		require.Equal(t, http.StatusOK, createOrUpdateObRootServiceInfo(context.Background(), c).Code)
+	}
+	response := obProxyGroupTestRequest("POST", "http://1.1.1.1:8080/services?Action=ObProxyGroup", &model.ObProxyGroup{Name: "g1", ObClusters: []string{"c1"}, Clients: []string{"10.0.0.2"}}, "", saveObProxyGroup)
+	require.Equal(t, http.StatusOK, response.Code)
+
+	obProxyConfig, err := buildObProxyConfig(context.Background(), getDefaultServiceAddress(), DEFAULT_NAMESPACE, nil)
+	require.Nil(t, err)
+
+	// invalid heartbeat
+	response = obProxyGroupTestRequest("POST", "http://1.1.1.1:8080/services?Action=ObProxyHeartbeat", &model.ObProxyHeartbeat{Address: "invalid"}, "10.0.0.1:1000", reportObProxyHeartbeat)
+	require.Equal(t, http.StatusBadRequest, response.Code)
+
+	// up to date obproxy
+	response = obProxyGroupTestRequest("POST", "http://1.1.1.1:8080/services?Action=ObProxyHeartbeat", &model.ObProxyHeartbeat{Address: "10.0.0.1:2883", Version: "4.2.1", ConfigVersion: obProxyConfig.Version}, "10.0.0.1:1000", reportObProxyHeartbeat)
+	require.Equal(t, http.StatusOK, response.Code)
+	result := response.Data.(*model.ObProxyHeartbeatResult)
+	require.False(t, result.Lagging)
+	require.Equal(t, obProxyConfig.Version, result.ConfigVersion)
+
+	// obproxy in group runs the version of all clusters
+	response = obProxyGroupTestRequest("POST", "http://1.1.1.1:8080/services?Action=ObProxyHeartbeat", &model.ObProxyHeartbeat{Name: "proxy2", Address: "10.0.0.2:2883", ConfigVersion: obProxyConfig.Version}, "10.0.0.2:1000", reportObProxyHeartbeat)
+	require.Equal(t, http.StatusOK, response.Code)
+	result = response.Data.(*model.ObProxyHeartbeatResult)
+	require.True(t, result.Lagging)
+	require.Equal(t, "g1", result.ObProxyGroup)
+	require.NotEqual(t, obProxyConfig.Version, result.ConfigVersion)
+
+	// heartbeat of the same obproxy updates the record
+	response = obProxyGroupTestRequest("POST", "http://1.1.1.1:8080/services?Action=ObProxyHeartbeat", &model.ObProxyHeartbeat{Name: "proxy2", Address: "10.0.0.2:2883", ConfigVersion: result.ConfigVersion}, "10.0.0.2:1000", reportObProxyHeartbeat)
+	require.Equal(t, http.StatusOK, response.Code)
+	require.False(t, response.Data.(*model.ObProxyHeartbeatResult).Lagging)
+
+	_, err = configServer.Client.ObProxy.Update().Where(obproxy.Name("10.0.0.1:2883")).SetLastSeenTime(time.Now().Add(-time.Hour)).Save(context.Background())
+	require.Nil(t, err)
+	response = obProxyGroupTestRequest("GET", "http://1.1.1.1:8080/services?Action=ListObProxies", nil, "", listObProxies)
+	require.Equal(t, http.StatusOK, response.Code)
+	proxies := response.Data.([]*model.ObProxyInfo)
+	require.Equal(t, 2, len(proxies))
+	require.Equal(t, "10.0.0.1:2883", proxies[0].Name)
+	require.Equal(t, "4.2.1", proxies[0].Version)
+	require.False(t, proxies[0].Alive)
+	require.False(t, proxies[0].Lagging)
+	require.Equal(t, "proxy2", proxies[1].Name)
+	require.True(t, proxies[1].Alive)
+	require.False(t, proxies[1].Lagging)
+
+	// config changes make obproxies lagging
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster=c3&ObClusterId=1&version=2", bytes.NewBuffer([]byte(strings.ReplaceAll(testRootServiceJson, "c1", "c3"))))
+	require.Equal(t, http.StatusOK, createOrUpdateObRootServiceInfo(context.Background(), c).Code)
+	response = obProxyGroupTestRequest("GET", "http://1.1.1.1:8080/services?Action=ListObProxies&ObProxyGroup=g1", nil, "", listObProxies)
+	require.Equal(t, http.StatusOK, response.Code)
+	proxies = response.Data.([]*model.ObProxyInfo)
+	require.Equal(t, 1, len(proxies))
+	require.False(t, proxies[0].Lagging)
+	response = obProxyGroupTestRequest("GET", "http://1.1.1.1:8080/services?Action=ListObProxies", nil, "", listObProxies)
+	require.True(t, response.Data.([]*model.ObProxyInfo)[0].Lagging)
+
+	affected, err := purgeExpiredObProxies(context.Background(), 30*time.Minute)
+	require.Nil(t, err)
+	require.Equal(t, 1, affected)
+}
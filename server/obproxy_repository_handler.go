@@ -0,0 +1,368 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/oceanbase/configserver/config"
+	"github.com/oceanbase/configserver/model"
+)
+
+const (
+	CLIENT_API_PATH       = "/client"
+	OBPROXY_BIN_FILE_NAME = "obproxy"
+	DEFAULT_OBPROXY_ARCH  = "x86_64"
+
+	OBPROXY_VERSION_HEADER  = "X-ObProxy-Version"
+	OBPROXY_CHECKSUM_HEADER = "X-Checksum-Sha256"
+	OBPROXY_PACKAGE_URL     = "%s" + CLIENT_API_PATH + "?Action=GetObProxy&Arch=%s&Version=%s"
+)
+
+// versions and architectures are used as directory names, so only safe characters are allowed
+var obProxyPackageNamePattern = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9._-]*$`)
+
+// actions served under /client for obproxy
+var clientActions = map[string]func() func(*gin.Context){
+	"GetObProxy":          getObProxyDownloadFunc,
+	"ListObProxyVersions": getObProxyVersionListFunc,
+}
+
+var obProxyDownloadOnce sync.Once
+var obProxyDownloadFunc func(*gin.Context)
+var obProxyVersionListOnce sync.Once
+var obProxyVersionListFunc func(*gin.Context)
+
+func getObProxyDownloadFunc() func(*gin.Context) {
+	obProxyDownloadOnce.Do(func() {
+		obProxyDownloadFunc = downloadObProxy
+	})
+	return obProxyDownloadFunc
+}
+
+func getObProxyVersionListFunc() func(*gin.Context) {
+	obProxyVersionListOnce.Do(func() {
+		obProxyVersionListFunc = handlerFunctionWrapper(listObProxyVersions)
+	})
+	return obProxyVersionListFunc
+}
+
+func clientHandler() gin.HandlerFunc {
+	return actionHandler(clientActions)
+}
+
+func getObProxyRepositoryConfig() *config.ObProxyRepositoryConfig {
+	repositoryConfig := &config.ObProxyRepositoryConfig{}
+	if configured := GetConfigServer().Config.ObProxyRepository; configured != nil {
+		*repositoryConfig = *configured
+	}
+	if repositoryConfig.DefaultArch == "" {
+		repositoryConfig.DefaultArch = DEFAULT_OBPROXY_ARCH
+	}
+	return repositoryConfig
+}
+
+type obProxyChecksum struct {
+	size       int64
+	modifyTime time.Time
+	sha256     string
+}
+
+// checksums of binaries are cached until the file changes
+var obProxyChecksums sync.Map
+
+func getObProxyChecksum(p *obProxyPackageFile) (string, error) {
+	path := p.path
+	if value, ok := obProxyChecksums.Load(path); ok {
+		checksum := value.(*obProxyChecksum)
+		if checksum.size == p.Size && checksum.modifyTime.Equal(p.ModifyTime) {
+			return checksum.sha256, nil
+		}
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "open %s", path)
+	}
+	defer file.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", errors.Wrapf(err, "read %s", path)
+	}
+	sum := hex.EncodeToString(hash.Sum(nil))
+	obProxyChecksums.Store(path, &obProxyChecksum{
+		size:       p.Size,
+		modifyTime: p.ModifyTime,
+		sha256:     sum,
+	})
+	return sum, nil
+}
+
+// compareObProxyVersion compares versions like 4.2.1.0-100000012023 segment by segment, numeric segments are compared as numbers
+func compareObProxyVersion(a, b string) int {
+	split := func(version string) []string {
+		return strings.FieldsFunc(version, func(r rune) bool { return r == '.' || r == '-' })
+	}
+	segmentsA, segmentsB := split(a), split(b)
+	for i := 0; i < len(segmentsA) && i < len(segmentsB); i++ {
+		numberA, errA := strconv.ParseInt(segmentsA[i], 10, 64)
+		numberB, errB := strconv.ParseInt(segmentsB[i], 10, 64)
+		if errA == nil && errB == nil {
+			if numberA != numberB {
+				if numberA < numberB {
+					return -1
+				}
+				return 1
+			}
+			continue
+		}
+		if c := strings.Compare(segmentsA[i], segmentsB[i]); c != 0 {
+			return c
+		}
+	}
+	return len(segmentsA) - len(segmentsB)
+}
+
+type obProxyPackageFile struct {
+	*model.ObProxyPackage
+	path string
+}
+
+// listObProxyPackages scans the repository for binaries of arch, all architectures if arch is empty, latest versions first
+func listObProxyPackages(repositoryConfig *config.ObProxyRepositoryConfig, arch string) ([]*obProxyPackageFile, error) {
+	if repositoryConfig.Dir == "" {
+		return nil, NewApiErrorf(ErrorCodeResourceNotFound, "obproxy repository not configured")
+	}
+	archs := []string{arch}
+	if arch == "" {
+		entries, err := os.ReadDir(repositoryConfig.Dir)
+		if err != nil {
+			return nil, errors.Wrapf(err, "read obproxy repository %s", repositoryConfig.Dir)
+		}
+		archs = archs[:0]
+		for _, entry := range entries {
+			if entry.IsDir() && obProxyPackageNamePattern.MatchString(entry.Name()) {
+				archs = append(archs, entry.Name())
+			}
+		}
+	}
+	packages := make([]*obProxyPackageFile, 0)
+	for _, arch := range archs {
+		entries, err := os.ReadDir(filepath.Join(repositoryConfig.Dir, arch))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "read obproxy repository of arch %s", arch)
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() || !obProxyPackageNamePattern.MatchString(entry.Name()) {
+				continue
+			}
+			path := filepath.Join(repositoryConfig.Dir, arch, entry.Name(), OBPROXY_BIN_FILE_NAME)
+			info, err := os.Stat(path)
+			if err != nil || !info.Mode().IsRegular() {
+				continue
+			}
+			packages = append(packages, &obProxyPackageFile{
+				ObProxyPackage: &model.ObProxyPackage{
+					Version:    entry.Name(),
+					Arch:       arch,
+					Size:       info.Size(),
+					ModifyTime: info.ModTime(),
+				},
+				path: path,
+			})
+		}
+	}
+	sort.SliceStable(packages, func(i, j int) bool {
+		if packages[i].Arch != packages[j].Arch {
+			return packages[i].Arch < packages[j].Arch
+		}
+		return compareObProxyVersion(packages[i].Version, packages[j].Version) > 0
+	})
+	return packages, nil
+}
+
+func matchObProxyVersionRule(rule *config.ObProxyVersionRuleConfig, clientIp, namespace string) bool {
+	if len(rule.Namespaces) > 0 && !containsString(rule.Namespaces, namespace) {
+		return false
+	}
+	if len(rule.Clients) == 0 {
+		return true
+	}
+	ipNets, err := parseIpNets(rule.Clients)
+	if err != nil {
+		log.WithError(err).Warnf("ignore invalid obproxy version rule of version %s", rule.Version)
+		return false
+	}
+	return containsIp(ipNets, clientIp)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// selectObProxyPackage decides the version for the client, packages are of the same arch with latest versions first
+func selectObProxyPackage(repositoryConfig *config.ObProxyRepositoryConfig, packages []*obProxyPackageFile, version, clientIp, namespace string) *obProxyPackageFile {
+	find := func(version string) *obProxyPackageFile {
+		for _, p := range packages {
+			if p.Version == version {
+				return p
+			}
+		}
+		return nil
+	}
+	if version != "" {
+		return find(version)
+	}
+	for _, rule := range repositoryConfig.Rules {
+		if matchObProxyVersionRule(rule, clientIp, namespace) {
+			if p := find(rule.Version); p != nil {
+				return p
+			}
+			log.Warnf("version %s of obproxy version rule not found", rule.Version)
+		}
+	}
+	if p := find(repositoryConfig.DefaultVersion); p != nil {
+		return p
+	}
+	if len(packages) > 0 {
+		return packages[0]
+	}
+	return nil
+}
+
+func getObProxyPackageParam(c *gin.Context, name, defaultValue string) (string, error) {
+	value := c.Query(name)
+	if value == "" {
+		return defaultValue, nil
+	}
+	if !obProxyPackageNamePattern.MatchString(value) {
+		return "", NewApiErrorf(ErrorCodeInvalidParameter, "invalid %s %s", name, value)
+	}
+	return value, nil
+}
+
+func getObProxyPackageUrl(serviceAddress, namespace string, p *model.ObProxyPackage) string {
+	return fmt.Sprintf(OBPROXY_PACKAGE_URL, getNamespaceServiceAddress(serviceAddress, namespace), url.QueryEscape(p.Arch), url.QueryEscape(p.Version))
+}
+
+func findObProxyPackage(c *gin.Context) (*obProxyPackageFile, error) {
+	repositoryConfig := getObProxyRepositoryConfig()
+	arch, err := getObProxyPackageParam(c, "Arch", repositoryConfig.DefaultArch)
+	if err != nil {
+		return nil, err
+	}
+	version, err := getObProxyPackageParam(c, "Version", "")
+	if err != nil {
+		return nil, err
+	}
+	namespace, err := getNamespace(c)
+	if err != nil {
+		return nil, NewApiError(ErrorCodeInvalidParameter, errors.Wrap(err, "invalid parameter, failed to parse namespace"))
+	}
+	packages, err := listObProxyPackages(repositoryConfig, arch)
+	if err != nil {
+		return nil, err
+	}
+	p := selectObProxyPackage(repositoryConfig, packages, version, c.ClientIP(), namespace)
+	if p == nil {
+		return nil, NewApiErrorf(ErrorCodeResourceNotFound, "no obproxy found with arch %s, version %s", arch, version)
+	}
+	p.Sha256, err = getObProxyChecksum(p)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// downloadObProxy serves the selected obproxy binary, range requests are supported so broken downloads can be resumed
+func downloadObProxy(c *gin.Context) {
+	p, err := findObProxyPackage(c)
+	if err != nil {
+		handlerFunctionWrapper(func(ctxlog context.Context, c *gin.Context) *ApiResponse {
+			log.WithContext(ctxlog).WithError(err).Warn("find obproxy")
+			return NewErrorResponse(err)
+		})(c)
+		return
+	}
+	file, err := os.Open(p.path)
+	if err != nil {
+		handlerFunctionWrapper(func(ctxlog context.Context, c *gin.Context) *ApiResponse {
+			return NewErrorResponse(errors.Wrapf(err, "open obproxy of arch %s, version %s", p.Arch, p.Version))
+		})(c)
+		return
+	}
+	defer file.Close()
+	request := startApiRequest(c)
+	log.WithContext(request.ctxlog).WithFields(request.fields).Infof("serve obproxy of arch %s, version %s to %s", p.Arch, p.Version, c.ClientIP())
+	c.Header(OBPROXY_VERSION_HEADER, p.Version)
+	c.Header(OBPROXY_CHECKSUM_HEADER, p.Sha256)
+	c.Header("ETag", strconv.Quote(p.Sha256))
+	c.Header("Content-Disposition", "attachment; filename="+OBPROXY_BIN_FILE_NAME)
+	c.Header("Content-Type", "application/octet-stream")
+	http.ServeContent(c.Writer, c.Request, OBPROXY_BIN_FILE_NAME, p.ModifyTime, file)
+	request.logResponse(c.Writer.Status(), "")
+}
+
+func listObProxyVersions(ctxlog context.Context, c *gin.Context) *ApiResponse {
+	arch, err := getObProxyPackageParam(c, "Arch", "")
+	if err != nil {
+		return NewErrorResponse(err)
+	}
+	namespace, err := getNamespace(c)
+	if err != nil {
+		return NewIllegalArgumentResponse(errors.Wrap(err, "invalid parameter, failed to parse namespace"))
+	}
+	packages, err := listObProxyPackages(getObProxyRepositoryConfig(), arch)
+	if err != nil {
+		return NewErrorResponse(err)
+	}
+	serviceAddress := getServiceAddress(ctxlog, c)
+	result := make([]*model.ObProxyPackage, 0, len(packages))
+	for _, p := range packages {
+		if p.Sha256, err = getObProxyChecksum(p); err != nil {
+			return NewErrorResponse(err)
+		}
+		p.Url = getObProxyPackageUrl(serviceAddress, namespace, p.ObProxyPackage)
+		result = append(result, p.ObProxyPackage)
+	}
+	return NewSuccessResponse(result)
+}
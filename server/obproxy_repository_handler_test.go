@@ -0,0 +1,152 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oceanbase/configserver/config"
+	"github.com/oceanbase/configserver/model"
+)
+
+func initObProxyRepositoryTestServer(t *testing.T, repositoryConfig *config.ObProxyRepositoryConfig) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	dir := t.TempDir()
+	for _, p := range []struct{ arch, version string }{
+		{"x86_64", "4.2.1.0-1"},
+		{"x86_64", "4.2.10.0-1"},
+		{"x86_64", "4.2.2.0-1"},
+		{"aarch64", "4.2.1.0-1"},
+	} {
+		versionDir := filepath.Join(dir, p.arch, p.version)
+		require.Nil(t, os.MkdirAll(versionDir, 0755))
+		require.Nil(t, os.WriteFile(filepath.Join(versionDir, OBPROXY_BIN_FILE_NAME), []byte(p.arch+"/"+p.version), 0644))
+	}
+	// directories without binary are ignored
+	require.Nil(t, os.MkdirAll(filepath.Join(dir, "x86_64", "4.3.0.0-1"), 0755))
+
+	repositoryConfig.Dir = dir
+	configServerConfig, _ := config.ParseConfigServerConfig("../etc/config.yaml")
+	configServerConfig.ObProxyRepository = repositoryConfig
+	configServer = &ConfigServer{
+		Config: configServerConfig,
+	}
+	r := gin.New()
+	InitConfigServerRoutes(r)
+	return r
+}
+
+func serveObProxyRepositoryTestRequest(r *gin.Engine, url, remoteAddr string, header map[string]string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, url, nil)
+	req.RemoteAddr = remoteAddr
+	for name, value := range header {
+		req.Header.Set(name, value)
+	}
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestCompareObProxyVersion(t *testing.T) {
+	require.True(t, compareObProxyVersion("4.2.10.0-1", "4.2.2.0-1") > 0)
+	require.True(t, compareObProxyVersion("4.2.1.0-1", "4.2.1.0-2") < 0)
+	require.True(t, compareObProxyVersion("4.2.1", "4.2.1.0") < 0)
+	require.Equal(t, 0, compareObProxyVersion("4.2.1.0-1", "4.2.1.0-1"))
+}
+
+func TestListObProxyVersions(t *testing.T) {
+	r := initObProxyRepositoryTestServer(t, &config.ObProxyRepositoryConfig{})
+
+	w := serveObProxyRepositoryTestRequest(r, "/client?Action=ListObProxyVersions&Arch=x86_64", "10.0.0.1:1000", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	response := &struct {
+		Data []*model.ObProxyPackage
+	}{}
+	require.Nil(t, json.Unmarshal(w.Body.Bytes(), response))
+	require.Equal(t, 3, len(response.Data))
+	require.Equal(t, "4.2.10.0-1", response.Data[0].Version)
+	require.Equal(t, "4.2.2.0-1", response.Data[1].Version)
+	require.Equal(t, "4.2.1.0-1", response.Data[2].Version)
+	sum := sha256.Sum256([]byte("x86_64/4.2.10.0-1"))
+	require.Equal(t, hex.EncodeToString(sum[:]), response.Data[0].Sha256)
+	require.Contains(t, response.Data[0].Url, "/client?Action=GetObProxy&Arch=x86_64&Version=4.2.10.0-1")
+
+	w = serveObProxyRepositoryTestRequest(r, "/ns/ns1/client?Action=ListObProxyVersions", "10.0.0.1:1000", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Nil(t, json.Unmarshal(w.Body.Bytes(), response))
+	require.Equal(t, 4, len(response.Data))
+	require.Equal(t, "aarch64", response.Data[0].Arch)
+	require.Contains(t, response.Data[0].Url, "/ns/ns1/client?Action=GetObProxy")
+
+	w = serveObProxyRepositoryTestRequest(r, "/client?Action=ListObProxyVersions&Arch=../etc", "10.0.0.1:1000", nil)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestGetObProxy(t *testing.T) {
+	r := initObProxyRepositoryTestServer(t, &config.ObProxyRepositoryConfig{
+		DefaultVersion: "4.2.2.0-1",
+		Rules: []*config.ObProxyVersionRuleConfig{
+			{Version: "4.2.10.0-1", Clients: []string{"10.1.0.0/16"}},
+			{Version: "4.2.1.0-1", Namespaces: []string{"ns1"}},
+		},
+	})
+
+	// default version
+	w := serveObProxyRepositoryTestRequest(r, "/client?Action=GetObProxy", "10.0.0.1:1000", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "x86_64/4.2.2.0-1", w.Body.String())
+	require.Equal(t, "4.2.2.0-1", w.Header().Get(OBPROXY_VERSION_HEADER))
+	sum := sha256.Sum256([]byte("x86_64/4.2.2.0-1"))
+	require.Equal(t, hex.EncodeToString(sum[:]), w.Header().Get(OBPROXY_CHECKSUM_HEADER))
+
+	// versions selected by rules of client and namespace
+	w = serveObProxyRepositoryTestRequest(r, "/client?Action=GetObProxy", "10.1.0.1:1000", nil)
+	require.Equal(t, "x86_64/4.2.10.0-1", w.Body.String())
+	w = serveObProxyRepositoryTestRequest(r, "/ns/ns1/client?Action=GetObProxy&Arch=aarch64", "10.0.0.1:1000", nil)
+	require.Equal(t, "aarch64/4.2.1.0-1", w.Body.String())
+
+	// explicit version and range request
+	w = serveObProxyRepositoryTestRequest(r, "/client?Action=GetObProxy&Version=4.2.1.0-1", "10.0.0.1:1000", map[string]string{"Range": "bytes=7-"})
+	require.Equal(t, http.StatusPartialContent, w.Code)
+	require.Equal(t, "4.2.1.0-1", w.Body.String())
+
+	w = serveObProxyRepositoryTestRequest(r, "/client?Action=GetObProxy&Version=4.3.0.0-1", "10.0.0.1:1000", nil)
+	require.Equal(t, http.StatusNotFound, w.Code)
+	response := new(ApiResponse)
+	require.Nil(t, json.Unmarshal(w.Body.Bytes(), response))
+	require.Equal(t, ErrorCodeResourceNotFound, response.ErrorCode)
+
+	w = serveObProxyRepositoryTestRequest(r, "/client?Action=Unknown", "10.0.0.1:1000", nil)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestGetObProxyLatestVersion(t *testing.T) {
+	r := initObProxyRepositoryTestServer(t, &config.ObProxyRepositoryConfig{})
+	w := serveObProxyRepositoryTestRequest(r, "/client?Action=GetObProxy", "10.0.0.1:1000", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "x86_64/4.2.10.0-1", w.Body.String())
+}
@@ -18,7 +18,6 @@ package server
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strconv"
 	"sync"
@@ -27,8 +26,6 @@ import (
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 
-	"github.com/oceanbase/configserver/ent"
-	"github.com/oceanbase/configserver/ent/obcluster"
 	"github.com/oceanbase/configserver/model"
 )
 
@@ -71,13 +68,18 @@ func getObRootServiceDeleteFunc() func(*gin.Context) {
 }
 
 type RootServiceInfoParam struct {
+	Namespace   string
 	ObCluster   string
 	ObClusterId int64
 	Version     int
 }
 
 func getCommonParam(c *gin.Context) (*RootServiceInfoParam, error) {
-	var err error
+	namespace, err := getNamespace(c)
+	if err != nil {
+		return nil, err
+	}
+
 	name := ""
 	obCluster, obClusterOk := c.GetQuery("ObCluster")
 	obRegion, obRegionOk := c.GetQuery("ObRegion")
@@ -119,27 +121,37 @@ func getCommonParam(c *gin.Context) (*RootServiceInfoParam, error) {
 		}
 	}
 	return &RootServiceInfoParam{
+		Namespace:   namespace,
 		ObCluster:   name,
 		ObClusterId: clusterId,
 		Version:     version,
 	}, nil
 }
 
-func selectPrimaryCluster(clusters []*model.ObRootServiceInfo) *model.ObRootServiceInfo {
+// selectPrimaryCluster returns the primary cluster recorded in cluster group,
+// if it's not found, fall back to the cluster claiming PRIMARY with the latest timestamp,
+// ties are broken by the smaller cluster id so the result is deterministic
+func selectPrimaryCluster(clusters []*model.ObRootServiceInfo, primaryClusterId int64) *model.ObRootServiceInfo {
 	var primaryCluster *model.ObRootServiceInfo
 	for _, cluster := range clusters {
+		if primaryClusterId > 0 && cluster.ObClusterId == primaryClusterId {
+			return cluster
+		}
 		if primaryCluster == nil {
 			primaryCluster = cluster
-		} else {
-			if primaryCluster.Type != "PRIMARY" {
-				if cluster.Type == "PRIMARY" || cluster.TimeStamp > primaryCluster.TimeStamp {
-					primaryCluster = cluster
-				}
-			} else {
-				if cluster.Type == "PRIMARY" && cluster.TimeStamp > primaryCluster.TimeStamp {
-					primaryCluster = cluster
-				}
+			continue
+		}
+		isPrimary := cluster.Type == model.OB_CLUSTER_TYPE_PRIMARY
+		selectedIsPrimary := primaryCluster.Type == model.OB_CLUSTER_TYPE_PRIMARY
+		if isPrimary != selectedIsPrimary {
+			if isPrimary {
+				primaryCluster = cluster
 			}
+			continue
+		}
+		if cluster.TimeStamp > primaryCluster.TimeStamp ||
+			(cluster.TimeStamp == primaryCluster.TimeStamp && cluster.ObClusterId < primaryCluster.ObClusterId) {
+			primaryCluster = cluster
 		}
 	}
 	return primaryCluster
@@ -149,21 +161,20 @@ func getObIdcRegionInfo(ctxlog context.Context, c *gin.Context) *ApiResponse {
 	// return empty idc list
 	param, err := getCommonParam(c)
 	if err != nil {
-		return NewErrorResponse(errors.Wrap(err, "parse ob idc region info query parameter"))
+		return NewIllegalArgumentResponse(errors.Wrap(err, "parse ob idc region info query parameter"))
 	}
 
-	rootServiceInfoList, err := getRootServiceInfoList(ctxlog, param.ObCluster, param.ObClusterId)
+	rootServiceInfoList, err := getRootServiceInfoList(ctxlog, param.Namespace, param.ObCluster, param.ObClusterId)
 	if err != nil {
-		if rootServiceInfoList != nil && len(rootServiceInfoList) == 0 {
-			return NewNotFoundResponse(errors.New(fmt.Sprintf("no obcluster found with query param %v", param)))
-		} else {
-			return NewErrorResponse(errors.Wrap(err, fmt.Sprintf("get all rootservice info for cluster %s:%d", param.ObCluster, param.ObClusterId)))
-		}
+		return NewErrorResponse(errors.Wrap(err, fmt.Sprintf("get all rootservice info for cluster %s:%d", param.ObCluster, param.ObClusterId)))
 	}
 
 	idcList := make([]*model.IdcRegionInfo, 0, 0)
 	if param.Version < 2 || param.ObClusterId > 0 {
-		primaryCluster := selectPrimaryCluster(rootServiceInfoList)
+		primaryCluster, err := getPrimaryRootServiceInfo(ctxlog, param.Namespace, param.ObCluster, rootServiceInfoList)
+		if err != nil {
+			return NewErrorResponse(err)
+		}
 		obClusterIdcRegionInfo := &model.ObClusterIdcRegionInfo{
 			Cluster:        primaryCluster.ObCluster,
 			ClusterId:      primaryCluster.ObClusterId,
@@ -186,55 +197,24 @@ func getObIdcRegionInfo(ctxlog context.Context, c *gin.Context) *ApiResponse {
 	}
 }
 
-func getRootServiceInfoList(ctxlog context.Context, obCluster string, obClusterId int64) ([]*model.ObRootServiceInfo, error) {
-	var clusters []*ent.ObCluster
-	var err error
-	rootServiceInfoList := make([]*model.ObRootServiceInfo, 0, 4)
-	client := GetConfigServer().Client
-
-	if obClusterId != 0 {
-		log.WithContext(ctxlog).Infof("query ob clusters with obcluster %s and obcluster_id %d", obCluster, obClusterId)
-		clusters, err = client.ObCluster.Query().Where(obcluster.Name(obCluster), obcluster.ObClusterID(obClusterId)).All(context.Background())
-	} else {
-		log.WithContext(ctxlog).Infof("query ob clusters with obcluster %s", obCluster)
-		clusters, err = client.ObCluster.Query().Where(obcluster.Name(obCluster)).All(context.Background())
-	}
-	if err != nil {
-		return nil, errors.Wrap(err, "query ob clusters from db")
-	}
-	if len(clusters) == 0 {
-		return rootServiceInfoList, errors.New(fmt.Sprintf("no root service info found with obcluster %s, obcluster id %d", obCluster, obClusterId))
-	}
-	for _, cluster := range clusters {
-		var rootServiceInfo model.ObRootServiceInfo
-		err = json.Unmarshal([]byte(cluster.RootserviceJSON), &rootServiceInfo)
-		if err != nil {
-			return nil, errors.Wrap(err, "deserialize root service info")
-		}
-		rootServiceInfo.Fill()
-		rootServiceInfoList = append(rootServiceInfoList, &rootServiceInfo)
-	}
-	return rootServiceInfoList, nil
-}
-
 func getObRootServiceInfo(ctxlog context.Context, c *gin.Context) *ApiResponse {
 	var response *ApiResponse
 	param, err := getCommonParam(c)
 	if err != nil {
-		return NewErrorResponse(errors.Wrap(err, "parse rootservice query parameter"))
+		return NewIllegalArgumentResponse(errors.Wrap(err, "parse rootservice query parameter"))
 	}
-	rootServiceInfoList, err := getRootServiceInfoList(ctxlog, param.ObCluster, param.ObClusterId)
+	rootServiceInfoList, err := getRootServiceInfoList(ctxlog, param.Namespace, param.ObCluster, param.ObClusterId)
 	if err != nil {
-		if rootServiceInfoList != nil && len(rootServiceInfoList) == 0 {
-			return NewNotFoundResponse(errors.New(fmt.Sprintf("no obcluster found with query param %v", param)))
-		} else {
-			return NewErrorResponse(errors.Wrap(err, fmt.Sprintf("get all rootservice info for cluster %s:%d", param.ObCluster, param.ObClusterId)))
-		}
+		return NewErrorResponse(errors.Wrap(err, fmt.Sprintf("get all rootservice info for cluster %s:%d", param.ObCluster, param.ObClusterId)))
 	}
 
 	if param.Version < 2 || param.ObClusterId > 0 {
 		log.WithContext(ctxlog).Infof("return primary ob cluster")
-		response = NewSuccessResponse(selectPrimaryCluster(rootServiceInfoList))
+		primaryCluster, err := getPrimaryRootServiceInfo(ctxlog, param.Namespace, param.ObCluster, rootServiceInfoList)
+		if err != nil {
+			return NewErrorResponse(err)
+		}
+		response = NewSuccessResponse(primaryCluster)
 	} else {
 		log.WithContext(ctxlog).Infof("return all ob clusters")
 		response = NewSuccessResponse(rootServiceInfoList)
@@ -244,72 +224,50 @@ func getObRootServiceInfo(ctxlog context.Context, c *gin.Context) *ApiResponse {
 
 func createOrUpdateObRootServiceInfo(ctxlog context.Context, c *gin.Context) *ApiResponse {
 	var response *ApiResponse
-	client := GetConfigServer().Client
 	obRootServiceInfo := new(model.ObRootServiceInfo)
 	err := c.ShouldBindJSON(obRootServiceInfo)
 	if err != nil {
-		return NewErrorResponse(errors.Wrap(err, "bind rootservice query parameter"))
+		return NewIllegalArgumentResponse(errors.Wrap(err, "bind rootservice query parameter"))
 	}
 	obRootServiceInfo.Fill()
 	param, err := getCommonParam(c)
 	if err != nil {
-		return NewErrorResponse(errors.Wrap(err, "parse rootservice query parameter"))
-	}
-	if len(obRootServiceInfo.ObCluster) == 0 {
-		return NewIllegalArgumentResponse(errors.New("ob cluster name is required"))
+		return NewIllegalArgumentResponse(errors.Wrap(err, "parse rootservice query parameter"))
 	}
 	if param.Version > 1 {
 		if len(obRootServiceInfo.Type) == 0 {
 			return NewIllegalArgumentResponse(errors.New("ob cluster type is required when version > 1"))
 		}
 	}
+	if errs := obRootServiceInfo.Validate(); len(errs) > 0 {
+		return NewValidationErrorResponse(errs)
+	}
 
-	rsBytes, err := json.Marshal(obRootServiceInfo)
+	_, err = saveRootServiceInfo(ctxlog, param.Namespace, obRootServiceInfo)
 	if err != nil {
-		response = NewErrorResponse(errors.Wrap(err, "serialize ob rootservice info"))
+		response = NewErrorResponse(errors.Wrap(err, "save ob rootservice info"))
 	} else {
-		rootServiceInfoJson := string(rsBytes)
-		log.WithContext(ctxlog).Infof("store rootservice info %s", rootServiceInfoJson)
-
-		err := client.ObCluster.
-			Create().
-			SetName(obRootServiceInfo.ObCluster).
-			SetObClusterID(obRootServiceInfo.ObClusterId).
-			SetType(obRootServiceInfo.Type).
-			SetRootserviceJSON(rootServiceInfoJson).
-			OnConflict().
-			SetRootserviceJSON(rootServiceInfoJson).
-			Exec(context.Background())
-		if err != nil {
-			response = NewErrorResponse(errors.Wrap(err, "save ob rootservice info"))
-		} else {
-			response = NewSuccessResponse("successful")
-		}
+		response = NewSuccessResponse("successful")
 	}
 	return response
 }
 
 func deleteObRootServiceInfo(ctxlog context.Context, c *gin.Context) *ApiResponse {
 	var response *ApiResponse
-	client := GetConfigServer().Client
 
 	param, err := getCommonParam(c)
 	if err != nil {
-		return NewErrorResponse(errors.Wrap(err, "parse rootservice query parameter"))
+		return NewIllegalArgumentResponse(errors.Wrap(err, "parse rootservice query parameter"))
 	}
 	if param.Version < 2 {
 		response = NewIllegalArgumentResponse(errors.New("delete obcluster rs info is only supported when version >= 2"))
 	} else if param.ObClusterId == 0 {
 		response = NewIllegalArgumentResponse(errors.New("delete obcluster rs info is only supported with obcluster id"))
 	} else {
-		affected, err := client.ObCluster.
-			Delete().
-			Where(obcluster.Name(param.ObCluster), obcluster.ObClusterID(param.ObClusterId)).
-			Exec(context.Background())
+		_, err := deleteRootServiceInfo(ctxlog, param.Namespace, param.ObCluster, param.ObClusterId)
 		if err != nil {
-			response = NewErrorResponse(errors.Wrap(err, fmt.Sprintf("delete obcluster %s with ob cluster id %d in db", param.ObCluster, param.ObClusterId)))
+			response = NewErrorResponse(errors.Wrap(err, fmt.Sprintf("delete obcluster %s with ob cluster id %d in namespace %s in db", param.ObCluster, param.ObClusterId, param.Namespace)))
 		} else {
-			log.WithContext(ctxlog).Infof("delete obcluster %s with ob cluster id %d in db, affected rows %d", param.ObCluster, param.ObClusterId, affected)
 			response = NewSuccessResponse("success")
 		}
 	}
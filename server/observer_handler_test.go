@@ -28,6 +28,7 @@ import (
 
 	"github.com/oceanbase/configserver/config"
 	"github.com/oceanbase/configserver/ent"
+	"github.com/oceanbase/configserver/model"
 )
 
 const testRootServiceJson = "{\"Type\":\"PRIMARY\",\"ObClusterId\":1,\"ObRegionId\":1,\"ObCluster\":\"c1\",\"ObRegion\":\"c1\",\"ReadonlyRsList\":[],\"RsList\":[{\"address\":\"1.1.1.1:2882\",\"role\":\"LEADER\",\"sql_port\":2881}],\"timestamp\":1649435362283000}"
@@ -288,3 +289,26 @@ func TestDeleteObRootServiceInfoWithoutClusterId(t *testing.T) {
 	response := deleteObRootServiceInfo(context.Background(), c)
 	require.Equal(t, http.StatusBadRequest, response.Code)
 }
+
+func TestCreateOrUpdateObRootServiceInfoInvalid(t *testing.T) {
+	// test gin
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	invalidRootServiceJson := "{\"Type\":\"PRIMARY\",\"ObClusterId\":1,\"ObCluster\":\"c1\",\"RsList\":[{\"address\":\"1.1.1.1\",\"role\":\"LEADER\",\"sql_port\":0},{\"address\":\"1.1.1.2:2882\",\"role\":\"LEADER\",\"sql_port\":2881}]}"
+	c.Request, _ = http.NewRequest("POST", "http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster=c1&ObClusterId=1&version=2", bytes.NewBuffer([]byte(invalidRootServiceJson)))
+
+	// mock db client
+	client, _ := ent.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	client.Schema.Create(context.Background())
+
+	configServerConfig, _ := config.ParseConfigServerConfig("../etc/config.yaml")
+	configServer = &ConfigServer{
+		Config: configServerConfig,
+		Client: client,
+	}
+
+	response := createOrUpdateObRootServiceInfo(context.Background(), c)
+	require.Equal(t, http.StatusBadRequest, response.Code)
+	require.Equal(t, 3, len(response.Data.(model.ValidationErrors)))
+}
@@ -0,0 +1,644 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/oceanbase/configserver/config"
+	"github.com/oceanbase/configserver/lib/codec"
+	"github.com/oceanbase/configserver/lib/openapi"
+	"github.com/oceanbase/configserver/model"
+)
+
+const (
+	OPENAPI_PATH                = "/openapi.json"
+	OPENAPI_VERSION             = "3.0.3"
+	ACTION_API_PATH             = "/services"
+	OPENAPI_CONTENT_TYPE        = "application/json"
+	OPENAPI_BINARY_CONTENT_TYPE = "application/octet-stream"
+	OPENAPI_DEV_VERSION         = "dev"
+)
+
+// openApiOperation describes an api, either an Action of the legacy api or a route,
+// Actions are served at /services unless Path is specified.
+// Data lists the possible types of the data returned, multiple types means one of them.
+// Raw operations return the data as response body, the others return the data wrapped in ApiResponse.
+// Binary operations return a file as response body, and ApiResponse on failure.
+type openApiOperation struct {
+	Method      string
+	Path        string
+	Action      string
+	Summary     string
+	Parameters  []string
+	RequestBody interface{}
+	Data        []interface{}
+	Status      []int
+	Raw         bool
+	Binary      bool
+}
+
+// openApiIterable describes data of type IterableData with Contents of item type
+type openApiIterable struct {
+	Item interface{}
+}
+
+var openApiParameters = map[string]openapi.Schema{
+	"Namespace":    queryParameter("Namespace", "namespace, default namespace is used if not specified", openapi.Schema{"type": "string"}),
+	"ObCluster":    queryParameter("ObCluster", "ob cluster name", openapi.Schema{"type": "string"}),
+	"ObClusterId":  queryParameter("ObClusterId", "ob cluster id", openapi.Schema{"type": "integer", "format": "int64"}),
+	"ObRegion":     queryParameter("ObRegion", "ob cluster name, old format", openapi.Schema{"type": "string"}),
+	"ObRegionId":   queryParameter("ObRegionId", "ob cluster id, old format", openapi.Schema{"type": "integer", "format": "int64"}),
+	"version":      queryParameter("version", "version supports 1 or 2, 2 means with standby ob cluster support", openapi.Schema{"type": "integer", "enum": []int{1, 2}}),
+	"VersionOnly":  queryParameter("VersionOnly", "only return version", openapi.Schema{"type": "boolean"}),
+	"NamePrefix":   queryParameter("NamePrefix", "only return clusters with name starting with the prefix", openapi.Schema{"type": "string"}),
+	"Type":         queryParameter("Type", "only return clusters of the type", openapi.Schema{"type": "string"}),
+	"UpdatedSince": queryParameter("UpdatedSince", "only return clusters updated since the time, RFC3339 format or unix timestamp in seconds", openapi.Schema{"type": "string"}),
+	"SortBy":       queryParameter("SortBy", "sort field", openapi.Schema{"type": "string", "enum": []string{"name", "create_time", "update_time"}}),
+	"Order":        queryParameter("Order", "sort order", openapi.Schema{"type": "string", "enum": []string{SORT_ORDER_ASC, SORT_ORDER_DESC}}),
+	"Limit":        queryParameter("Limit", "page size", openapi.Schema{"type": "integer", "minimum": 1, "maximum": MAX_LIST_LIMIT}),
+	"StartTime":    queryParameter("StartTime", "only return records since the time, RFC3339 format or unix timestamp in seconds", openapi.Schema{"type": "string"}),
+	"EndTime":      queryParameter("EndTime", "only return records before the time, RFC3339 format or unix timestamp in seconds", openapi.Schema{"type": "string"}),
+	"Subscriber":   queryParameter("Subscriber", "only return deliveries to the webhook subscriber", openapi.Schema{"type": "string"}),
+	"Status":       queryParameter("Status", "only return deliveries of the status", openapi.Schema{"type": "string", "enum": []string{WEBHOOK_DELIVERY_STATUS_PENDING, WEBHOOK_DELIVERY_STATUS_SUCCEEDED, WEBHOOK_DELIVERY_STATUS_DEAD}}),
+	"Id":           queryParameter("Id", "id of the record", openapi.Schema{"type": "integer"}),
+	"Entity":       queryParameter("Entity", "only return audit logs of the kind of entities", openapi.Schema{"type": "string", "enum": []string{AUDIT_ENTITY_OB_CLUSTER, AUDIT_ENTITY_OB_CLUSTER_GROUP, AUDIT_ENTITY_OBPROXY_GROUP, AUDIT_ENTITY_OBPROXY, AUDIT_ENTITY_WEBHOOK_DELIVERY}}),
+	"Arch":         queryParameter("Arch", "architecture of obproxy, like x86_64 or aarch64", openapi.Schema{"type": "string"}),
+	"Version":      queryParameter("Version", "obproxy version, the version selected for the client is used if not specified", openapi.Schema{"type": "string"}),
+	"ObProxyGroup": queryParameter("ObProxyGroup", "obproxy group name, the group of obproxy is selected by auth user or client ip if not specified", openapi.Schema{"type": "string"}),
+	"Format":       queryParameter("Format", "response format, text renders rootservice info as rs list like ip:port;ip:port", openapi.Schema{"type": "string", "enum": []string{RESPONSE_FORMAT_JSON, RESPONSE_FORMAT_YAML, RESPONSE_FORMAT_TEXT}}),
+	"Cursor":       queryParameter("Cursor", "NextCursor returned by the previous page", openapi.Schema{"type": "string"}),
+	"name":         pathParameter("name", "ob cluster name", openapi.Schema{"type": "string"}),
+	"id":           pathParameter("id", "ob cluster id", openapi.Schema{"type": "integer", "format": "int64"}),
+}
+
+var rootServiceInfoParameters = []string{"Namespace", "ObCluster", "ObClusterId", "ObRegion", "ObRegionId", "version", "Format"}
+var listParameters = []string{"Namespace", "NamePrefix", "Type", "UpdatedSince", "SortBy", "Order", "Limit", "Cursor"}
+
+var openApiOperations = []*openApiOperation{
+	{
+		Method:     http.MethodGet,
+		Action:     "ObRootServiceInfo",
+		Summary:    "query rootservice info, return the primary cluster when version=1 or ObClusterId specified, otherwise all the clusters",
+		Parameters: rootServiceInfoParameters,
+		Data:       []interface{}{&model.ObRootServiceInfo{}, []*model.ObRootServiceInfo{}},
+	},
+	{
+		Method:      http.MethodPost,
+		Action:      "ObRootServiceInfo",
+		Summary:     "register rootservice info",
+		Parameters:  rootServiceInfoParameters,
+		RequestBody: &model.ObRootServiceInfo{},
+		Data:        []interface{}{""},
+	},
+	{
+		Method:      http.MethodPost,
+		Action:      "BatchObRootServiceInfo",
+		Summary:     "register rootservice info of multiple clusters, with Atomic all of them are applied in a transaction or none of them is applied",
+		Parameters:  []string{"Namespace"},
+		RequestBody: &model.ObRootServiceInfoBatch{},
+		Data:        []interface{}{[]*model.ObRootServiceInfoResult{}},
+	},
+	{
+		Method:     http.MethodDelete,
+		Action:     "ObRootServiceInfo",
+		Summary:    "delete rootservice info by moving the cluster into recycle bin, only supported when version=2 and ObClusterId specified",
+		Parameters: rootServiceInfoParameters,
+		Data:       []interface{}{""},
+	},
+	{
+		Method:     http.MethodGet,
+		Action:     "GetObProxyConfig",
+		Summary:    "query obproxy config with rootservice info urls of all clusters",
+		Parameters: []string{"Namespace", "ObProxyGroup", "VersionOnly", "Format"},
+		Data:       []interface{}{&model.ObProxyConfig{}, &model.ObProxyConfigVersionOnly{}},
+	},
+	{
+		Method:     http.MethodPost,
+		Action:     "GetObProxyConfig",
+		Summary:    "query obproxy config with rootservice info urls of all clusters",
+		Parameters: []string{"Namespace", "ObProxyGroup", "VersionOnly", "Format"},
+		Data:       []interface{}{&model.ObProxyConfig{}, &model.ObProxyConfigVersionOnly{}},
+	},
+	{
+		Method:     http.MethodGet,
+		Action:     "GetObRootServiceInfoUrlTemplate",
+		Summary:    "query obproxy config with rootservice info url templates",
+		Parameters: []string{"Namespace", "ObProxyGroup", "VersionOnly", "Format"},
+		Data:       []interface{}{&model.ObProxyConfigWithTemplate{}, &model.ObProxyConfigVersionOnly{}},
+	},
+	{
+		Method:     http.MethodPost,
+		Action:     "GetObRootServiceInfoUrlTemplate",
+		Summary:    "query obproxy config with rootservice info url templates",
+		Parameters: []string{"Namespace", "ObProxyGroup", "VersionOnly", "Format"},
+		Data:       []interface{}{&model.ObProxyConfigWithTemplate{}, &model.ObProxyConfigVersionOnly{}},
+	},
+	{
+		Method:     http.MethodGet,
+		Action:     "ObIDCRegionInfo",
+		Summary:    "query idc and region info, empty implementation just for compatibility",
+		Parameters: rootServiceInfoParameters,
+		Data:       []interface{}{&model.ObClusterIdcRegionInfo{}, []*model.ObClusterIdcRegionInfo{}},
+	},
+	{
+		Method:     http.MethodGet,
+		Action:     "ObClusterGroup",
+		Summary:    "query primary/standby group of clusters with the name",
+		Parameters: []string{"Namespace", "ObCluster", "ObRegion"},
+		Data:       []interface{}{&model.ObClusterGroupInfo{}},
+	},
+	{
+		Method:     http.MethodPost,
+		Action:     "SwitchoverObCluster",
+		Summary:    "switchover to the cluster, the current primary is required to be registered",
+		Parameters: []string{"Namespace", "ObCluster", "ObClusterId", "ObRegion", "ObRegionId"},
+		Data:       []interface{}{""},
+	},
+	{
+		Method:     http.MethodPost,
+		Action:     "FailoverObCluster",
+		Summary:    "failover to the cluster",
+		Parameters: []string{"Namespace", "ObCluster", "ObClusterId", "ObRegion", "ObRegionId"},
+		Data:       []interface{}{""},
+	},
+	{
+		Method:     http.MethodGet,
+		Action:     "ListObClusters",
+		Summary:    "list clusters with filters and cursor based pagination",
+		Parameters: listParameters,
+		Data:       []interface{}{&openApiIterable{Item: &model.ObClusterSummary{}}},
+	},
+	{
+		Method:     http.MethodGet,
+		Action:     "ListDeletedObClusters",
+		Summary:    "list clusters in recycle bin, filtered by name if ObCluster is specified",
+		Parameters: []string{"Namespace", "ObCluster", "ObRegion"},
+		Data:       []interface{}{&openApiIterable{Item: &model.DeletedObCluster{}}},
+	},
+	{
+		Method:     http.MethodPost,
+		Action:     "RestoreObCluster",
+		Summary:    "restore the cluster in recycle bin with its original rootservice info",
+		Parameters: []string{"Namespace", "ObCluster", "ObClusterId", "ObRegion", "ObRegionId"},
+		Data:       []interface{}{""},
+	},
+	{
+		Method:     http.MethodDelete,
+		Action:     "PurgeObCluster",
+		Summary:    "delete the cluster in recycle bin permanently",
+		Parameters: []string{"Namespace", "ObCluster", "ObClusterId", "ObRegion", "ObRegionId"},
+		Data:       []interface{}{""},
+	},
+	{
+		Method:     http.MethodGet,
+		Action:     "ListAuditLogs",
+		Summary:    "list audit logs of changes from the latest, with time range, cluster, obproxy group and entity filters",
+		Parameters: []string{"Namespace", "ObCluster", "ObClusterId", "ObProxyGroup", "Entity", "StartTime", "EndTime", "Limit", "Cursor"},
+		Data:       []interface{}{&openApiIterable{Item: &model.AuditLogInfo{}}},
+	},
+	{
+		Method:     http.MethodGet,
+		Action:     "ListWebhookDeliveries",
+		Summary:    "list webhook deliveries from the latest, DEAD deliveries failed all the attempts",
+		Parameters: []string{"Namespace", "Subscriber", "Status", "ObCluster", "Limit", "Cursor"},
+		Data:       []interface{}{&openApiIterable{Item: &model.WebhookDeliveryInfo{}}},
+	},
+	{
+		Method:     http.MethodPost,
+		Action:     "RetryWebhookDelivery",
+		Summary:    "send a finished webhook delivery again from the first attempt",
+		Parameters: []string{"Namespace", "Id"},
+		Data:       []interface{}{""},
+	},
+	{
+		Method:     http.MethodGet,
+		Action:     "ObProxyGroup",
+		Summary:    "query obproxy group",
+		Parameters: []string{"Namespace", "ObProxyGroup"},
+		Data:       []interface{}{&model.ObProxyGroup{}},
+	},
+	{
+		Method:     http.MethodGet,
+		Action:     "ListObProxyGroups",
+		Summary:    "list obproxy groups ordered by name",
+		Parameters: []string{"Namespace"},
+		Data:       []interface{}{[]*model.ObProxyGroup{}},
+	},
+	{
+		Method:      http.MethodPost,
+		Action:      "ObProxyGroup",
+		Summary:     "create or replace obproxy group, the meta database password is encrypted and an empty one keeps the saved password",
+		Parameters:  []string{"Namespace"},
+		RequestBody: &model.ObProxyGroup{},
+		Data:        []interface{}{""},
+	},
+	{
+		Method:      http.MethodPost,
+		Action:      "ObProxyHeartbeat",
+		Summary:     "report heartbeat of obproxy with the config version it runs, returns the current config version of its group",
+		Parameters:  []string{"Namespace", "ObProxyGroup"},
+		RequestBody: &model.ObProxyHeartbeat{},
+		Data:        []interface{}{&model.ObProxyHeartbeatResult{}},
+	},
+	{
+		Method:     http.MethodGet,
+		Action:     "ListObProxies",
+		Summary:    "list obproxies reported by heartbeat, with flags of alive and lagging behind the current config version",
+		Parameters: []string{"Namespace", "ObProxyGroup"},
+		Data:       []interface{}{[]*model.ObProxyInfo{}},
+	},
+	{
+		Method:     http.MethodDelete,
+		Action:     "ObProxyGroup",
+		Summary:    "delete obproxy group",
+		Parameters: []string{"Namespace", "ObProxyGroup"},
+		Data:       []interface{}{""},
+	},
+	{
+		Method:     http.MethodGet,
+		Path:       V3_API_PREFIX + "/clusters",
+		Summary:    "list clusters with filters and cursor based pagination",
+		Parameters: listParameters,
+		Data:       []interface{}{&openApiIterable{Item: &model.ObClusterSummary{}}},
+		Raw:        true,
+	},
+	{
+		Method:     http.MethodGet,
+		Path:       V3_API_PREFIX + "/clusters/:name",
+		Summary:    "get rootservice info of all the clusters with the name",
+		Parameters: []string{"Namespace", "name"},
+		Data:       []interface{}{[]*model.ObRootServiceInfo{}},
+		Raw:        true,
+	},
+	{
+		Method:     http.MethodGet,
+		Path:       V3_API_PREFIX + "/clusters/:name/:id",
+		Summary:    "get rootservice info of the cluster",
+		Parameters: []string{"Namespace", "name", "id"},
+		Data:       []interface{}{&model.ObRootServiceInfo{}},
+		Raw:        true,
+	},
+	{
+		Method:      http.MethodPut,
+		Path:        V3_API_PREFIX + "/clusters/:name/:id",
+		Summary:     "register rootservice info of the cluster",
+		Parameters:  []string{"Namespace", "name", "id"},
+		RequestBody: &model.ObRootServiceInfo{},
+		Data:        []interface{}{&model.ObRootServiceInfo{}},
+		Status:      []int{http.StatusOK, http.StatusCreated},
+		Raw:         true,
+	},
+	{
+		Method:     http.MethodDelete,
+		Path:       V3_API_PREFIX + "/clusters/:name/:id",
+		Summary:    "move the cluster into recycle bin",
+		Parameters: []string{"Namespace", "name", "id"},
+		Status:     []int{http.StatusNoContent},
+		Raw:        true,
+	},
+	{
+		Method:     http.MethodGet,
+		Path:       V3_API_PREFIX + "/proxy-config",
+		Summary:    "query obproxy config with rootservice info urls of all clusters",
+		Parameters: []string{"Namespace", "ObProxyGroup", "VersionOnly"},
+		Data:       []interface{}{&model.ObProxyConfig{}, &model.ObProxyConfigVersionOnly{}},
+		Raw:        true,
+	},
+	{
+		Method:     http.MethodGet,
+		Path:       V3_API_PREFIX + "/proxy-config/template",
+		Summary:    "query obproxy config with rootservice info url templates",
+		Parameters: []string{"Namespace", "ObProxyGroup", "VersionOnly"},
+		Data:       []interface{}{&model.ObProxyConfigWithTemplate{}, &model.ObProxyConfigVersionOnly{}},
+		Raw:        true,
+	},
+	{
+		Method:     http.MethodGet,
+		Path:       CLIENT_API_PATH,
+		Action:     "GetObProxy",
+		Summary:    "download obproxy binary of the version selected for the client, with sha256 checksum in header X-Checksum-Sha256, range requests are supported",
+		Parameters: []string{"Namespace", "Arch", "Version"},
+		Status:     []int{http.StatusOK, http.StatusPartialContent},
+		Binary:     true,
+	},
+	{
+		Method:     http.MethodGet,
+		Path:       CLIENT_API_PATH,
+		Action:     "ListObProxyVersions",
+		Summary:    "list obproxy binaries in the repository, latest versions first",
+		Parameters: []string{"Namespace", "Arch"},
+		Data:       []interface{}{[]*model.ObProxyPackage{}},
+	},
+	{
+		Method:  http.MethodGet,
+		Path:    OPENAPI_PATH,
+		Summary: "openapi specification of configserver",
+		Data:    []interface{}{map[string]interface{}{}},
+		Raw:     true,
+	},
+}
+
+func queryParameter(name, description string, schema openapi.Schema) openapi.Schema {
+	return openapi.Schema{"name": name, "in": "query", "description": description, "schema": schema}
+}
+
+func pathParameter(name, description string, schema openapi.Schema) openapi.Schema {
+	return openapi.Schema{"name": name, "in": "path", "required": true, "description": description, "schema": schema}
+}
+
+// openApiPath converts the route of the operation to path in openapi format,
+// Actions are described by the operation of their path and method, distinguished by parameter Action
+func openApiPath(operation *openApiOperation) string {
+	if operation.Action != "" && operation.Path == "" {
+		return ACTION_API_PATH
+	}
+	segments := strings.Split(operation.Path, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") {
+			segments[i] = "{" + segment[1:] + "}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+func openApiOperationId(method, path string) string {
+	return strings.ToLower(method) + strings.NewReplacer("/", "_", "{", "", "}", "", "-", "_", ".", "_").Replace(path)
+}
+
+func openApiDataSchema(components *openapi.Components, data interface{}) openapi.Schema {
+	if iterable, ok := data.(*openApiIterable); ok {
+		return openapi.Schema{
+			"type": "object",
+			"properties": openapi.Schema{
+				"Contents":   openapi.Schema{"type": "array", "items": components.SchemaOf(iterable.Item)},
+				"NextCursor": openapi.Schema{"type": "string"},
+			},
+		}
+	}
+	return components.SchemaOf(data)
+}
+
+func openApiJsonContent(schema openapi.Schema) openapi.Schema {
+	return openapi.Schema{OPENAPI_CONTENT_TYPE: openapi.Schema{"schema": schema}}
+}
+
+// openApiOneOf returns the only schema, or oneOf the distinct schemas
+func openApiOneOf(schemas []openapi.Schema) openapi.Schema {
+	distinct := make([]openapi.Schema, 0, len(schemas))
+	seen := make(map[string]bool)
+	for _, schema := range schemas {
+		key, _ := codec.MarshalToJsonString(schema)
+		if !seen[key] {
+			seen[key] = true
+			distinct = append(distinct, schema)
+		}
+	}
+	if len(distinct) == 1 {
+		return distinct[0]
+	}
+	return openapi.Schema{"oneOf": distinct}
+}
+
+// openApiSuccessContent returns the content type and schema of the successful response of the operation,
+// the schema is nil if nothing is returned
+func openApiSuccessContent(components *openapi.Components, operation *openApiOperation) (string, openapi.Schema) {
+	var dataSchema openapi.Schema
+	if len(operation.Data) > 0 {
+		schemas := make([]openapi.Schema, 0, len(operation.Data))
+		for _, data := range operation.Data {
+			schemas = append(schemas, openApiDataSchema(components, data))
+		}
+		dataSchema = openApiOneOf(schemas)
+	}
+	if operation.Binary {
+		return OPENAPI_BINARY_CONTENT_TYPE, openapi.Schema{"type": "string", "format": "binary"}
+	}
+	if operation.Raw {
+		return OPENAPI_CONTENT_TYPE, dataSchema
+	}
+	return OPENAPI_CONTENT_TYPE, openapi.Schema{
+		"allOf": []openapi.Schema{
+			components.SchemaOf(&ApiResponse{}),
+			{"type": "object", "properties": openapi.Schema{"Data": dataSchema}},
+		},
+	}
+}
+
+func openApiErrorSchema(components *openapi.Components, operation *openApiOperation) openapi.Schema {
+	if operation.Raw {
+		return components.SchemaOf(&V3ErrorResponse{})
+	}
+	return components.SchemaOf(&ApiResponse{})
+}
+
+func openApiStatuses(operation *openApiOperation) []int {
+	if len(operation.Status) == 0 {
+		return []int{http.StatusOK}
+	}
+	return operation.Status
+}
+
+// buildOpenApiResponses merges the responses of operations sharing the same path and method
+func buildOpenApiResponses(components *openapi.Components, operations []*openApiOperation) openapi.Schema {
+	statuses := make([]int, 0)
+	contents := make(map[int]map[string][]openapi.Schema)
+	errorSchemas := make([]openapi.Schema, 0, len(operations))
+	for _, operation := range operations {
+		contentType, schema := openApiSuccessContent(components, operation)
+		for _, status := range openApiStatuses(operation) {
+			if _, ok := contents[status]; !ok {
+				statuses = append(statuses, status)
+				contents[status] = make(map[string][]openapi.Schema)
+			}
+			if schema != nil {
+				contents[status][contentType] = append(contents[status][contentType], schema)
+			}
+		}
+		errorSchemas = append(errorSchemas, openApiErrorSchema(components, operation))
+	}
+	responses := openapi.Schema{
+		"default": openapi.Schema{"description": "failed", "content": openApiJsonContent(openApiOneOf(errorSchemas))},
+	}
+	for _, status := range statuses {
+		response := openapi.Schema{"description": http.StatusText(status)}
+		if len(contents[status]) > 0 {
+			content := openapi.Schema{}
+			for contentType, schemas := range contents[status] {
+				content[contentType] = openapi.Schema{"schema": openApiOneOf(schemas)}
+			}
+			response["content"] = content
+		}
+		responses[fmt.Sprintf("%d", status)] = response
+	}
+	return responses
+}
+
+func buildOpenApiOperation(components *openapi.Components, operation *openApiOperation) openapi.Schema {
+	parameters := make([]openapi.Schema, 0, len(operation.Parameters))
+	for _, name := range operation.Parameters {
+		parameters = append(parameters, openApiParameters[name])
+	}
+	result := openapi.Schema{
+		"operationId": openApiOperationId(operation.Method, openApiPath(operation)),
+		"summary":     operation.Summary,
+		"parameters":  parameters,
+		"responses":   buildOpenApiResponses(components, []*openApiOperation{operation}),
+	}
+	if operation.RequestBody != nil {
+		result["requestBody"] = openapi.Schema{
+			"required": true,
+			"content":  openApiJsonContent(components.SchemaOf(operation.RequestBody)),
+		}
+	}
+	return result
+}
+
+// buildOpenApiActionOperation describes the Actions served with the same path and method as one operation,
+// parameter Action is an enum of them, parameters and request bodies are the union of the Actions,
+// which Action uses each parameter is described in the parameter and the description of the operation
+func buildOpenApiActionOperation(components *openapi.Components, method, path string, operations []*openApiOperation) openapi.Schema {
+	actions := make([]string, 0, len(operations))
+	descriptions := make([]string, 0, len(operations))
+	parameterNames := make([]string, 0)
+	parameterActions := make(map[string][]string)
+	requestBodies := make([]openapi.Schema, 0)
+	for _, operation := range operations {
+		actions = append(actions, operation.Action)
+		description := fmt.Sprintf("- %s: %s", operation.Action, operation.Summary)
+		if len(operation.Parameters) > 0 {
+			description += fmt.Sprintf(", parameters: %s", strings.Join(operation.Parameters, ", "))
+		}
+		if operation.RequestBody != nil {
+			description += ", with request body"
+			requestBodies = append(requestBodies, components.SchemaOf(operation.RequestBody))
+		}
+		descriptions = append(descriptions, description)
+		for _, name := range operation.Parameters {
+			if _, ok := parameterActions[name]; !ok {
+				parameterNames = append(parameterNames, name)
+			}
+			parameterActions[name] = append(parameterActions[name], operation.Action)
+		}
+	}
+
+	parameters := make([]openapi.Schema, 0, len(parameterNames)+1)
+	parameters = append(parameters, openapi.Schema{
+		"name":        "Action",
+		"in":          "query",
+		"required":    true,
+		"description": "the action to perform",
+		"schema":      openapi.Schema{"type": "string", "enum": actions},
+	})
+	for _, name := range parameterNames {
+		parameter := openapi.Schema{}
+		for k, v := range openApiParameters[name] {
+			parameter[k] = v
+		}
+		parameter["description"] = fmt.Sprintf("%s, used by %s", parameter["description"], strings.Join(parameterActions[name], ", "))
+		parameters = append(parameters, parameter)
+	}
+
+	result := openapi.Schema{
+		"operationId": openApiOperationId(method, path),
+		"summary":     fmt.Sprintf("actions served with %s %s", method, path),
+		"description": strings.Join(descriptions, "\n"),
+		"parameters":  parameters,
+		"responses":   buildOpenApiResponses(components, operations),
+	}
+	if len(requestBodies) > 0 {
+		result["requestBody"] = openapi.Schema{
+			"required": len(requestBodies) == len(operations),
+			"content":  openApiJsonContent(openApiOneOf(requestBodies)),
+		}
+	}
+	return result
+}
+
+func buildOpenApiSpec() openapi.Schema {
+	version := config.Version
+	if version == "" {
+		version = OPENAPI_DEV_VERSION
+	}
+	components := openapi.NewComponents()
+	paths := openapi.Schema{}
+	getPathItem := func(path string) openapi.Schema {
+		pathItem, ok := paths[path].(openapi.Schema)
+		if !ok {
+			pathItem = openapi.Schema{}
+			paths[path] = pathItem
+		}
+		return pathItem
+	}
+	// Actions of the same path and method are grouped in the order they are defined
+	actionKeys := make([]string, 0)
+	actionOperations := make(map[string][]*openApiOperation)
+	for _, operation := range openApiOperations {
+		path := openApiPath(operation)
+		if operation.Action == "" {
+			getPathItem(path)[strings.ToLower(operation.Method)] = buildOpenApiOperation(components, operation)
+			continue
+		}
+		key := operation.Method + " " + path
+		if _, ok := actionOperations[key]; !ok {
+			actionKeys = append(actionKeys, key)
+		}
+		actionOperations[key] = append(actionOperations[key], operation)
+	}
+	for _, key := range actionKeys {
+		method, path, _ := strings.Cut(key, " ")
+		getPathItem(path)[strings.ToLower(method)] = buildOpenApiActionOperation(components, method, path, actionOperations[key])
+	}
+	return openapi.Schema{
+		"openapi": OPENAPI_VERSION,
+		"info": openapi.Schema{
+			"title":       "ob-configserver",
+			"description": "Actions of the legacy api share path /services and are distinguished by parameter Action, which Action uses each parameter is described in the operation. All the apis are also served with prefix /ns/{namespace}.",
+			"version":     version,
+		},
+		"paths":      paths,
+		"components": openapi.Schema{"schemas": components.Schemas()},
+	}
+}
+
+var openApiSpecOnce sync.Once
+var openApiSpecJson string
+var openApiSpecErr error
+
+func getOpenApiSpecJson() (string, error) {
+	openApiSpecOnce.Do(func() {
+		openApiSpecJson, openApiSpecErr = codec.MarshalToJsonString(buildOpenApiSpec())
+	})
+	return openApiSpecJson, openApiSpecErr
+}
+
+func openApiHandler(c *gin.Context) {
+	specJson, err := getOpenApiSpecJson()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, &V3ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: fmt.Sprintf("serialize openapi spec: %v", err),
+		})
+		return
+	}
+	c.Data(http.StatusOK, "application/json; charset=utf-8", []byte(specJson))
+}
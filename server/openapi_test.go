@@ -0,0 +1,159 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+// routes for debugging are not part of the api
+var undocumentedRoutePrefixes = []string{"/debug/pprof", "/debug/vars"}
+
+func findOpenApiOperation(method, path, action string) *openApiOperation {
+	for _, operation := range openApiOperations {
+		if operation.Method == method && operation.Path == path && operation.Action == action {
+			return operation
+		}
+	}
+	return nil
+}
+
+func actionsOfRoute(method, path string) map[string]func() func(*gin.Context) {
+	if path == CLIENT_API_PATH {
+		if method == http.MethodGet {
+			return clientActions
+		}
+		return nil
+	}
+	switch method {
+	case http.MethodGet:
+		return getActions
+	case http.MethodPost:
+		return postActions
+	case http.MethodDelete:
+		return deleteActions
+	}
+	return nil
+}
+
+func TestOpenApiCoversAllRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	InitConfigServerRoutes(r)
+
+	for _, route := range r.Routes() {
+		documented := true
+		for _, prefix := range undocumentedRoutePrefixes {
+			if strings.HasPrefix(route.Path, prefix) {
+				documented = false
+			}
+		}
+		if !documented {
+			continue
+		}
+		// routes with namespace prefix are the same as the ones without
+		path := strings.TrimPrefix(route.Path, NAMESPACE_PATH_PREFIX+":namespace")
+		if path == ACTION_API_PATH || path == CLIENT_API_PATH {
+			actions := actionsOfRoute(route.Method, path)
+			require.NotNil(t, actions, "no actions of method %s", route.Method)
+			actionPath := ""
+			if path != ACTION_API_PATH {
+				actionPath = path
+			}
+			for action := range actions {
+				require.NotNil(t, findOpenApiOperation(route.Method, actionPath, action), "no openapi spec for %s action %s", route.Method, action)
+			}
+		} else {
+			require.NotNil(t, findOpenApiOperation(route.Method, path, ""), "no openapi spec for %s %s", route.Method, route.Path)
+		}
+	}
+}
+
+func TestOpenApiOperationsExist(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	InitConfigServerRoutes(r)
+	routes := make(map[string]bool)
+	for _, route := range r.Routes() {
+		routes[route.Method+" "+route.Path] = true
+	}
+
+	for _, operation := range openApiOperations {
+		if operation.Action != "" {
+			path := operation.Path
+			if path == "" {
+				path = ACTION_API_PATH
+			}
+			_, ok := actionsOfRoute(operation.Method, path)[operation.Action]
+			require.True(t, ok, "action %s of method %s in openapi spec not exists", operation.Action, operation.Method)
+		} else {
+			require.True(t, routes[operation.Method+" "+operation.Path], "route %s %s in openapi spec not exists", operation.Method, operation.Path)
+		}
+		for _, name := range operation.Parameters {
+			_, ok := openApiParameters[name]
+			require.True(t, ok, "parameter %s not defined", name)
+		}
+	}
+}
+
+func TestServeOpenApiSpec(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	InitConfigServerRoutes(r)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, OPENAPI_PATH, nil)
+	r.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	spec := make(map[string]interface{})
+	require.Nil(t, json.Unmarshal(w.Body.Bytes(), &spec))
+	require.Equal(t, OPENAPI_VERSION, spec["openapi"])
+	paths := spec["paths"].(map[string]interface{})
+	for path := range paths {
+		require.NotContains(t, path, "?", "path %s should not contain query string", path)
+	}
+	pathItem := paths[ACTION_API_PATH].(map[string]interface{})
+	require.Contains(t, pathItem, "get")
+	require.Contains(t, pathItem, "post")
+	require.Contains(t, pathItem, "delete")
+	actionParameter := pathItem["get"].(map[string]interface{})["parameters"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, "Action", actionParameter["name"])
+	require.Contains(t, actionParameter["schema"].(map[string]interface{})["enum"], "ObRootServiceInfo")
+	require.Contains(t, actionParameter["schema"].(map[string]interface{})["enum"], "GetObProxyConfig")
+	require.Contains(t, paths, "/api/v3/clusters/{name}/{id}")
+	schemas := spec["components"].(map[string]interface{})["schemas"].(map[string]interface{})
+	require.Contains(t, schemas, "ApiResponse")
+	require.Contains(t, schemas, "ObRootServiceInfo")
+}
+
+func TestValidateOpenApiSpec(t *testing.T) {
+	specJson, err := getOpenApiSpecJson()
+	require.Nil(t, err)
+	loader := openapi3.NewLoader()
+	doc, err := loader.LoadFromData([]byte(specJson))
+	require.Nil(t, err)
+	require.Nil(t, doc.Validate(loader.Context))
+}
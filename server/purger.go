@@ -0,0 +1,44 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// runPurger deletes records older than retention with purge periodically until ctx is cancelled
+func runPurger(ctx context.Context, name string, interval time.Duration, retention time.Duration, purge func(context.Context, time.Duration) (int, error)) {
+	log.WithContext(ctx).Infof("start %s purger, retention %s, interval %s", name, retention, interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			affected, err := purge(ctx, retention)
+			if err != nil {
+				log.WithContext(ctx).WithError(err).Errorf("purge expired %s failed", name)
+			} else if affected > 0 {
+				log.WithContext(ctx).Infof("purge %d expired %s", affected, name)
+			}
+		}
+	}
+}
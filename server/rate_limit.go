@@ -0,0 +1,229 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/oceanbase/configserver/config"
+	"github.com/oceanbase/configserver/lib/ratelimit"
+)
+
+const (
+	THROTTLE_REASON_RATE_LIMIT  = "rate_limit"
+	THROTTLE_REASON_CONCURRENCY = "concurrency"
+	RETRY_AFTER_HEADER          = "Retry-After"
+	// METRIC_UNKNOWN_ACTION labels throttled requests of actions or routes not served,
+	// so that clients can't create metric keys by made-up actions
+	METRIC_UNKNOWN_ACTION = "unknown"
+)
+
+// metrics of throttled requests, exposed at /debug/vars
+var (
+	throttledRequests       = expvar.NewMap("throttled_requests")
+	throttledActionRequests = expvar.NewMap("throttled_action_requests")
+)
+
+type rateLimiter struct {
+	read       *ratelimit.KeyedLimiter
+	write      *ratelimit.KeyedLimiter
+	heartbeat  *ratelimit.KeyedLimiter
+	actions    map[string]*ratelimit.KeyedLimiter
+	allowlist  []*net.IPNet
+	concurrent chan struct{}
+}
+
+func newKeyedLimiter(rule *config.RateLimitRuleConfig) *ratelimit.KeyedLimiter {
+	if rule == nil || rule.Rate <= 0 {
+		return nil
+	}
+	burst := rule.Burst
+	if burst <= 0 {
+		burst = int(math.Ceil(rule.Rate))
+	}
+	return ratelimit.NewKeyedLimiter(rule.Rate, burst)
+}
+
+// parseIpNets parses ip addresses and CIDR blocks, an ip address is considered as a block of itself
+func parseIpNets(addresses []string) ([]*net.IPNet, error) {
+	ipNets := make([]*net.IPNet, 0, len(addresses))
+	for _, address := range addresses {
+		address = strings.TrimSpace(address)
+		if !strings.Contains(address, "/") {
+			ip := net.ParseIP(address)
+			if ip == nil {
+				return nil, errors.Errorf("invalid ip address %s", address)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = 8 * net.IPv4len
+			}
+			ipNets = append(ipNets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(address)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse CIDR %s", address)
+		}
+		ipNets = append(ipNets, ipNet)
+	}
+	return ipNets, nil
+}
+
+func containsIp(ipNets []*net.IPNet, address string) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+	for _, ipNet := range ipNets {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func newRateLimiter(conf *config.RateLimitConfig) (*rateLimiter, error) {
+	limiter := &rateLimiter{
+		actions: make(map[string]*ratelimit.KeyedLimiter),
+	}
+	if conf == nil {
+		return limiter, nil
+	}
+	allowlist, err := parseIpNets(conf.Allowlist)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse rate limit allowlist")
+	}
+	limiter.allowlist = allowlist
+	limiter.read = newKeyedLimiter(conf.Read)
+	limiter.write = newKeyedLimiter(conf.Write)
+	limiter.heartbeat = limiter.read
+	if conf.Heartbeat != nil {
+		limiter.heartbeat = newKeyedLimiter(conf.Heartbeat)
+	}
+	for action, rule := range conf.Actions {
+		if keyedLimiter := newKeyedLimiter(rule); keyedLimiter != nil {
+			limiter.actions[action] = keyedLimiter
+		}
+	}
+	if conf.MaxConcurrency > 0 {
+		limiter.concurrent = make(chan struct{}, conf.MaxConcurrency)
+	}
+	return limiter, nil
+}
+
+// allow takes a token for the client from the bucket of the action if it has a rule, or the bucket of reads, writes or heartbeats,
+// buckets are keyed by client ip only, so clients can't get more tokens by made-up actions.
+// returns the time to wait if the request is throttled
+func (limiter *rateLimiter) allow(c *gin.Context, action string) (bool, time.Duration) {
+	clientIp := c.ClientIP()
+	if containsIp(limiter.allowlist, clientIp) {
+		return true, 0
+	}
+	keyedLimiter, ok := limiter.actions[action]
+	if !ok {
+		switch {
+		case isHeartbeatRequest(c):
+			keyedLimiter = limiter.heartbeat
+		case isWriteRequest(c):
+			keyedLimiter = limiter.write
+		default:
+			keyedLimiter = limiter.read
+		}
+	}
+	if keyedLimiter == nil {
+		return true, 0
+	}
+	return keyedLimiter.Allow(clientIp)
+}
+
+// getMetricAction returns the action of the request as the metric label, METRIC_UNKNOWN_ACTION if it's not served
+func getMetricAction(c *gin.Context, action string) string {
+	if c.Query("Action") == "" {
+		if c.FullPath() == "" {
+			return METRIC_UNKNOWN_ACTION
+		}
+		return action
+	}
+	for _, actions := range []map[string]func() func(*gin.Context){getActions, postActions, deleteActions, clientActions} {
+		if _, ok := actions[action]; ok {
+			return action
+		}
+	}
+	return METRIC_UNKNOWN_ACTION
+}
+
+func getRateLimitConfig() *config.RateLimitConfig {
+	if server := GetConfigServer(); server != nil && server.Config != nil {
+		return server.Config.RateLimit
+	}
+	return nil
+}
+
+func throttle(c *gin.Context, action, reason string, response *ApiResponse) {
+	throttledRequests.Add(reason, 1)
+	throttledActionRequests.Add(getMetricAction(c, action), 1)
+	handlerFunctionWrapper(func(ctxlog context.Context, c *gin.Context) *ApiResponse {
+		log.WithContext(ctxlog).Warnf("request of %s from %s throttled by %s", action, c.ClientIP(), reason)
+		return response
+	})(c)
+	c.Abort()
+}
+
+// rateLimitHandler rejects requests exceeding the rate limit of the client and action with 429,
+// and rejects requests with 503 when the server is handling max concurrent requests
+func rateLimitHandler(conf *config.RateLimitConfig) gin.HandlerFunc {
+	limiter, err := newRateLimiter(conf)
+	if err != nil {
+		log.WithError(err).Fatal("initialize rate limiter")
+	}
+	fn := func(c *gin.Context) {
+		action := getRequestAction(c)
+		if ok, wait := limiter.allow(c, action); !ok {
+			c.Header(RETRY_AFTER_HEADER, strconv.FormatInt(int64(math.Ceil(wait.Seconds())), 10))
+			throttle(c, action, THROTTLE_REASON_RATE_LIMIT, NewErrorCodeResponse(ErrorCodeTooManyRequests,
+				errors.New(fmt.Sprintf("rate limit of %s exceeded, retry after %s", action, wait.Round(time.Millisecond)))))
+			return
+		}
+		if limiter.concurrent == nil {
+			c.Next()
+			return
+		}
+		select {
+		case limiter.concurrent <- struct{}{}:
+			defer func() { <-limiter.concurrent }()
+			c.Next()
+		default:
+			c.Header(RETRY_AFTER_HEADER, "1")
+			throttle(c, action, THROTTLE_REASON_CONCURRENCY, NewErrorCodeResponse(ErrorCodeServerBusy,
+				errors.New(fmt.Sprintf("server is handling %d requests", cap(limiter.concurrent)))))
+		}
+	}
+	return gin.HandlerFunc(fn)
+}
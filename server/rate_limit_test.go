@@ -0,0 +1,214 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oceanbase/configserver/config"
+)
+
+func newRateLimitTestRouter(conf *config.RateLimitConfig, handler gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(rateLimitHandler(conf))
+	r.GET("/services", handler)
+	r.POST("/services", handler)
+	return r
+}
+
+func serveRateLimitTestRequest(r *gin.Engine, method, url, remoteAddr string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(method, url, nil)
+	req.RemoteAddr = remoteAddr
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func getThrottledRequestsForTest(reason string) int64 {
+	if value, ok := throttledRequests.Get(reason).(*expvar.Int); ok {
+		return value.Value()
+	}
+	return 0
+}
+
+func getThrottledActionRequestsForTest(action string) int64 {
+	if value, ok := throttledActionRequests.Get(action).(*expvar.Int); ok {
+		return value.Value()
+	}
+	return 0
+}
+
+func okHandler(c *gin.Context) {
+	c.Status(http.StatusOK)
+}
+
+func TestRateLimitReadAndWrite(t *testing.T) {
+	r := newRateLimitTestRouter(&config.RateLimitConfig{
+		Read:  &config.RateLimitRuleConfig{Rate: 0.001, Burst: 2},
+		Write: &config.RateLimitRuleConfig{Rate: 0.001, Burst: 1},
+	}, okHandler)
+
+	for i := 0; i < 2; i++ {
+		w := serveRateLimitTestRequest(r, http.MethodGet, "/services?Action=ObRootServiceInfo", "10.0.0.1:1000")
+		require.Equal(t, http.StatusOK, w.Code)
+	}
+	w := serveRateLimitTestRequest(r, http.MethodGet, "/services?Action=ObRootServiceInfo", "10.0.0.1:1000")
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.NotEmpty(t, w.Header().Get(RETRY_AFTER_HEADER))
+	response := new(ApiResponse)
+	require.Nil(t, json.Unmarshal(w.Body.Bytes(), response))
+	require.Equal(t, ErrorCodeTooManyRequests, response.ErrorCode)
+
+	// reads of other actions share the bucket of the client, including made-up actions, other clients have their own buckets
+	w = serveRateLimitTestRequest(r, http.MethodGet, "/services?Action=ListObClusters", "10.0.0.1:1000")
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	unknownThrottled := getThrottledActionRequestsForTest(METRIC_UNKNOWN_ACTION)
+	for i := 0; i < 3; i++ {
+		w = serveRateLimitTestRequest(r, http.MethodGet, fmt.Sprintf("/services?Action=MadeUp%d", i), "10.0.0.1:1000")
+		require.Equal(t, http.StatusTooManyRequests, w.Code)
+		require.Nil(t, throttledActionRequests.Get(fmt.Sprintf("MadeUp%d", i)))
+	}
+	require.Equal(t, unknownThrottled+3, getThrottledActionRequestsForTest(METRIC_UNKNOWN_ACTION))
+	w = serveRateLimitTestRequest(r, http.MethodGet, "/services?Action=ObRootServiceInfo", "10.0.0.2:1000")
+	require.Equal(t, http.StatusOK, w.Code)
+
+	// writes are limited by the write rule, reads served with POST by the read rule
+	w = serveRateLimitTestRequest(r, http.MethodPost, "/services?Action=ObRootServiceInfo", "10.0.0.1:1000")
+	require.Equal(t, http.StatusOK, w.Code)
+	w = serveRateLimitTestRequest(r, http.MethodPost, "/services?Action=ObRootServiceInfo", "10.0.0.1:1000")
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	for i := 0; i < 2; i++ {
+		w = serveRateLimitTestRequest(r, http.MethodPost, "/services?Action=GetObProxyConfig", "10.0.0.3:1000")
+		require.Equal(t, http.StatusOK, w.Code)
+	}
+}
+
+func TestRateLimitHeartbeat(t *testing.T) {
+	// heartbeats share the bucket of reads by default instead of the small one of writes
+	r := newRateLimitTestRouter(&config.RateLimitConfig{
+		Read:  &config.RateLimitRuleConfig{Rate: 0.001, Burst: 2},
+		Write: &config.RateLimitRuleConfig{Rate: 0.001, Burst: 1},
+	}, okHandler)
+	for i := 0; i < 2; i++ {
+		w := serveRateLimitTestRequest(r, http.MethodPost, "/services?Action=ObProxyHeartbeat", "10.0.0.1:1000")
+		require.Equal(t, http.StatusOK, w.Code)
+	}
+	w := serveRateLimitTestRequest(r, http.MethodGet, "/services?Action=ObRootServiceInfo", "10.0.0.1:1000")
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	w = serveRateLimitTestRequest(r, http.MethodPost, "/services?Action=ObRootServiceInfo", "10.0.0.1:1000")
+	require.Equal(t, http.StatusOK, w.Code)
+
+	// heartbeats have their own bucket if configured
+	r = newRateLimitTestRouter(&config.RateLimitConfig{
+		Read:      &config.RateLimitRuleConfig{Rate: 0.001, Burst: 1},
+		Write:     &config.RateLimitRuleConfig{Rate: 0.001, Burst: 1},
+		Heartbeat: &config.RateLimitRuleConfig{Rate: 0.001, Burst: 3},
+	}, okHandler)
+	for i := 0; i < 3; i++ {
+		w = serveRateLimitTestRequest(r, http.MethodPost, "/services?Action=ObProxyHeartbeat", "10.0.0.1:1000")
+		require.Equal(t, http.StatusOK, w.Code)
+	}
+	w = serveRateLimitTestRequest(r, http.MethodPost, "/services?Action=ObProxyHeartbeat", "10.0.0.1:1000")
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	w = serveRateLimitTestRequest(r, http.MethodGet, "/services?Action=ObRootServiceInfo", "10.0.0.1:1000")
+	require.Equal(t, http.StatusOK, w.Code)
+	w = serveRateLimitTestRequest(r, http.MethodPost, "/services?Action=ObRootServiceInfo", "10.0.0.1:1000")
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestRateLimitActionAndAllowlist(t *testing.T) {
+	r := newRateLimitTestRouter(&config.RateLimitConfig{
+		Read: &config.RateLimitRuleConfig{Rate: 0.001, Burst: 1},
+		Actions: map[string]*config.RateLimitRuleConfig{
+			"GetObProxyConfig": {Rate: 0.001, Burst: 3},
+		},
+		Allowlist: []string{"10.1.0.0/16", "10.2.0.1"},
+	}, okHandler)
+
+	for i := 0; i < 3; i++ {
+		w := serveRateLimitTestRequest(r, http.MethodGet, "/services?Action=GetObProxyConfig", "10.0.0.1:1000")
+		require.Equal(t, http.StatusOK, w.Code)
+	}
+	w := serveRateLimitTestRequest(r, http.MethodGet, "/services?Action=GetObProxyConfig", "10.0.0.1:1000")
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+
+	for _, remoteAddr := range []string{"10.1.2.3:1000", "10.2.0.1:1000"} {
+		for i := 0; i < 5; i++ {
+			w = serveRateLimitTestRequest(r, http.MethodGet, "/services?Action=ObRootServiceInfo", remoteAddr)
+			require.Equal(t, http.StatusOK, w.Code)
+		}
+	}
+	w = serveRateLimitTestRequest(r, http.MethodGet, "/services?Action=ObRootServiceInfo", "10.2.0.2:1000")
+	require.Equal(t, http.StatusOK, w.Code)
+	w = serveRateLimitTestRequest(r, http.MethodGet, "/services?Action=ObRootServiceInfo", "10.2.0.2:1000")
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+}
+
+func TestRateLimitConcurrency(t *testing.T) {
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	r := newRateLimitTestRouter(&config.RateLimitConfig{MaxConcurrency: 1}, func(c *gin.Context) {
+		if c.Query("Action") == "Block" {
+			entered <- struct{}{}
+			<-release
+		}
+		c.Status(http.StatusOK)
+	})
+
+	done := make(chan int)
+	go func() {
+		done <- serveRateLimitTestRequest(r, http.MethodGet, "/services?Action=Block", "10.0.0.1:1000").Code
+	}()
+	<-entered
+
+	throttled := getThrottledRequestsForTest(THROTTLE_REASON_CONCURRENCY)
+	w := serveRateLimitTestRequest(r, http.MethodGet, "/services?Action=ObRootServiceInfo", "10.0.0.2:1000")
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	response := new(ApiResponse)
+	require.Nil(t, json.Unmarshal(w.Body.Bytes(), response))
+	require.Equal(t, ErrorCodeServerBusy, response.ErrorCode)
+	require.Equal(t, throttled+1, getThrottledRequestsForTest(THROTTLE_REASON_CONCURRENCY))
+
+	close(release)
+	require.Equal(t, http.StatusOK, <-done)
+	w = serveRateLimitTestRequest(r, http.MethodGet, "/services?Action=ObRootServiceInfo", "10.0.0.2:1000")
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestParseIpNets(t *testing.T) {
+	ipNets, err := parseIpNets([]string{"192.168.0.0/24", "10.0.0.1", "::1"})
+	require.Nil(t, err)
+	require.True(t, containsIp(ipNets, "192.168.0.100"))
+	require.True(t, containsIp(ipNets, "10.0.0.1"))
+	require.True(t, containsIp(ipNets, "::1"))
+	require.False(t, containsIp(ipNets, "10.0.0.2"))
+	require.False(t, containsIp(ipNets, "invalid"))
+
+	_, err = parseIpNets([]string{"10.0.0.300"})
+	require.NotNil(t, err)
+	_, err = parseIpNets([]string{"10.0.0.0/33"})
+	require.NotNil(t, err)
+}
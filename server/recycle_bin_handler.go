@@ -0,0 +1,217 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/oceanbase/configserver/ent"
+	"github.com/oceanbase/configserver/ent/obcluster"
+	"github.com/oceanbase/configserver/model"
+)
+
+const (
+	DEFAULT_RECYCLE_BIN_RETENTION      = 7 * 24 * time.Hour
+	DEFAULT_RECYCLE_BIN_PURGE_INTERVAL = time.Hour
+)
+
+var deletedObClusterListOnce sync.Once
+var deletedObClusterListFunc func(*gin.Context)
+var obClusterRestoreOnce sync.Once
+var obClusterRestoreFunc func(*gin.Context)
+var obClusterPurgeOnce sync.Once
+var obClusterPurgeFunc func(*gin.Context)
+
+func getDeletedObClusterListFunc() func(*gin.Context) {
+	deletedObClusterListOnce.Do(func() {
+		deletedObClusterListFunc = handlerFunctionWrapper(listDeletedObClusters)
+	})
+	return deletedObClusterListFunc
+}
+
+func getObClusterRestoreFunc() func(*gin.Context) {
+	obClusterRestoreOnce.Do(func() {
+		obClusterRestoreFunc = handlerFunctionWrapper(restoreObCluster)
+	})
+	return obClusterRestoreFunc
+}
+
+func getObClusterPurgeFunc() func(*gin.Context) {
+	obClusterPurgeOnce.Do(func() {
+		obClusterPurgeFunc = handlerFunctionWrapper(purgeObCluster)
+	})
+	return obClusterPurgeFunc
+}
+
+func getRecycleBinRetention() time.Duration {
+	recycleBinConfig := GetConfigServer().Config.RecycleBin
+	if recycleBinConfig == nil || recycleBinConfig.Retention <= 0 {
+		return DEFAULT_RECYCLE_BIN_RETENTION
+	}
+	return recycleBinConfig.Retention
+}
+
+func getRecycleBinPurgeInterval() time.Duration {
+	recycleBinConfig := GetConfigServer().Config.RecycleBin
+	if recycleBinConfig == nil || recycleBinConfig.PurgeInterval <= 0 {
+		return DEFAULT_RECYCLE_BIN_PURGE_INTERVAL
+	}
+	return recycleBinConfig.PurgeInterval
+}
+
+// queryDeletedObClusters returns clusters in recycle bin in the namespace, filtered by name if it's specified
+func queryDeletedObClusters(ctxlog context.Context, namespace string, obCluster string) ([]*model.DeletedObCluster, error) {
+	query := GetConfigServer().Client.ObCluster.Query().Where(obcluster.Namespace(namespace), obcluster.DeletedAtNotNil())
+	if obCluster != "" {
+		query = query.Where(obcluster.Name(obCluster))
+	}
+	clusters, err := query.Order(ent.Asc(obcluster.FieldName), ent.Asc(obcluster.FieldObClusterID)).All(ctxlog)
+	if err != nil {
+		return nil, wrapStorageError(err, "query deleted ob clusters")
+	}
+	retention := getRecycleBinRetention()
+	deletedClusters := make([]*model.DeletedObCluster, 0, len(clusters))
+	for _, cluster := range clusters {
+		rootServiceInfo, err := decodeRootServiceInfo(cluster)
+		if err != nil {
+			return nil, err
+		}
+		deletedClusters = append(deletedClusters, &model.DeletedObCluster{
+			ObCluster:       cluster.Name,
+			ObClusterId:     cluster.ObClusterID,
+			Type:            cluster.Type,
+			DeleteTime:      *cluster.DeletedAt,
+			PurgeTime:       cluster.DeletedAt.Add(retention),
+			RootServiceInfo: rootServiceInfo,
+		})
+	}
+	return deletedClusters, nil
+}
+
+// restoreDeletedObCluster moves the cluster out of recycle bin with its original rootservice info and updates its group
+func restoreDeletedObCluster(ctxlog context.Context, namespace string, obCluster string, obClusterId int64) error {
+	return withTx(ctxlog, GetConfigServer().Client, func(tx *ent.Tx) error {
+		affected, err := tx.ObCluster.
+			Update().
+			Where(obcluster.Namespace(namespace), obcluster.Name(obCluster), obcluster.ObClusterID(obClusterId), obcluster.DeletedAtNotNil()).
+			ClearDeletedAt().
+			Save(ctxlog)
+		if err != nil {
+			return wrapStorageError(err, "restore ob cluster")
+		}
+		if affected == 0 {
+			return NewApiErrorf(ErrorCodeClusterNotFound, "no deleted obcluster found with name %s and ob cluster id %d in namespace %s", obCluster, obClusterId, namespace)
+		}
+		return syncObClusterGroup(ctxlog, tx, namespace, obCluster)
+	})
+}
+
+// purgeDeletedObCluster deletes the cluster in recycle bin permanently, returns the affected rows
+func purgeDeletedObCluster(ctxlog context.Context, namespace string, obCluster string, obClusterId int64) (int, error) {
+	affected, err := GetConfigServer().Client.ObCluster.
+		Delete().
+		Where(obcluster.Namespace(namespace), obcluster.Name(obCluster), obcluster.ObClusterID(obClusterId), obcluster.DeletedAtNotNil()).
+		Exec(ctxlog)
+	if err != nil {
+		return 0, wrapStorageError(err, "purge ob cluster")
+	}
+	log.WithContext(ctxlog).Infof("purge obcluster %s with ob cluster id %d in namespace %s, affected rows %d", obCluster, obClusterId, namespace, affected)
+	return affected, nil
+}
+
+// purgeExpiredObClusters deletes clusters stayed in recycle bin longer than retention permanently, returns the affected rows
+func purgeExpiredObClusters(ctxlog context.Context, retention time.Duration) (int, error) {
+	affected, err := GetConfigServer().Client.ObCluster.
+		Delete().
+		Where(obcluster.DeletedAtLT(time.Now().Add(-retention))).
+		Exec(ctxlog)
+	if err != nil {
+		return 0, wrapStorageError(err, "purge expired ob clusters")
+	}
+	return affected, nil
+}
+
+// runRecycleBinPurger purges expired clusters periodically until ctx is cancelled
+func runRecycleBinPurger(ctx context.Context) {
+	ctx = contextWithAuditInfo(ctx, &auditInfo{
+		Action:    "PurgeExpiredObClusters",
+		Principal: AUDIT_PRINCIPAL_SYSTEM,
+	})
+	runPurger(ctx, "ob clusters", getRecycleBinPurgeInterval(), getRecycleBinRetention(), purgeExpiredObClusters)
+}
+
+func listDeletedObClusters(ctxlog context.Context, c *gin.Context) *ApiResponse {
+	namespace, err := getNamespace(c)
+	if err != nil {
+		return NewIllegalArgumentResponse(errors.Wrap(err, "parse namespace"))
+	}
+	obCluster := c.Query("ObCluster")
+	if obCluster == "" {
+		obCluster = c.Query("ObRegion")
+	}
+	deletedClusters, err := queryDeletedObClusters(ctxlog, namespace, obCluster)
+	if err != nil {
+		return NewErrorResponse(errors.Wrap(err, fmt.Sprintf("list deleted ob clusters in namespace %s", namespace)))
+	}
+	return NewSuccessResponse(&IterableData{Contents: deletedClusters})
+}
+
+func getRecycleBinParam(c *gin.Context) (*RootServiceInfoParam, error) {
+	param, err := getCommonParam(c)
+	if err != nil {
+		return nil, err
+	}
+	if param.ObClusterId == 0 {
+		return nil, errors.New("ob cluster id is required")
+	}
+	return param, nil
+}
+
+func restoreObCluster(ctxlog context.Context, c *gin.Context) *ApiResponse {
+	param, err := getRecycleBinParam(c)
+	if err != nil {
+		return NewIllegalArgumentResponse(errors.Wrap(err, "parse restore ob cluster parameter"))
+	}
+	log.WithContext(ctxlog).Infof("restore obcluster %s with ob cluster id %d in namespace %s", param.ObCluster, param.ObClusterId, param.Namespace)
+	err = restoreDeletedObCluster(ctxlog, param.Namespace, param.ObCluster, param.ObClusterId)
+	if err != nil {
+		return NewErrorResponse(errors.Wrap(err, "restore ob cluster"))
+	}
+	return NewSuccessResponse("successful")
+}
+
+func purgeObCluster(ctxlog context.Context, c *gin.Context) *ApiResponse {
+	param, err := getRecycleBinParam(c)
+	if err != nil {
+		return NewIllegalArgumentResponse(errors.Wrap(err, "parse purge ob cluster parameter"))
+	}
+	affected, err := purgeDeletedObCluster(ctxlog, param.Namespace, param.ObCluster, param.ObClusterId)
+	if err != nil {
+		return NewErrorResponse(err)
+	}
+	if affected == 0 {
+		return NewErrorCodeResponse(ErrorCodeClusterNotFound, errors.Errorf("no deleted obcluster found with name %s and ob cluster id %d", param.ObCluster, param.ObClusterId))
+	}
+	return NewSuccessResponse("successful")
+}
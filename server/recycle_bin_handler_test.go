@@ -0,0 +1,165 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oceanbase/configserver/ent"
+	"github.com/oceanbase/configserver/ent/auditlog"
+	"github.com/oceanbase/configserver/model"
+)
+
+func recycleBinTestRequest(method string, url string, f func(context.Context, *gin.Context) *ApiResponse) *ApiResponse {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest(method, url, nil)
+	return f(context.Background(), c)
+}
+
+func listRecycleBinTestClusters(t *testing.T) []*model.DeletedObCluster {
+	response := recycleBinTestRequest("GET", "http://1.1.1.1:8080/services?Action=ListDeletedObClusters&ObCluster=g1", listDeletedObClusters)
+	require.Equal(t, http.StatusOK, response.Code)
+	return response.Data.(*IterableData).Contents.([]*model.DeletedObCluster)
+}
+
+func TestRecycleBinRestore(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_recycle_bin_restore")
+
+	registerGroupTestCluster(t, model.OB_CLUSTER_TYPE_PRIMARY, 1, 100)
+	registerGroupTestCluster(t, model.OB_CLUSTER_TYPE_STANDBY, 2, 100)
+
+	response := recycleBinTestRequest("DELETE", "http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster=g1&ObClusterId=1&version=2", deleteObRootServiceInfo)
+	require.Equal(t, http.StatusOK, response.Code)
+
+	// deleted cluster is hidden from read paths
+	response = recycleBinTestRequest("GET", "http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster=g1&ObClusterId=1&version=2", getObRootServiceInfo)
+	require.Equal(t, http.StatusNotFound, response.Code)
+	response = recycleBinTestRequest("GET", "http://1.1.1.1:8080/services?Action=ObClusterGroup&ObCluster=g1", getObClusterGroup)
+	require.Equal(t, http.StatusOK, response.Code)
+	require.Equal(t, 1, len(response.Data.(*model.ObClusterGroupInfo).Clusters))
+
+	deletedClusters := listRecycleBinTestClusters(t)
+	require.Equal(t, 1, len(deletedClusters))
+	require.Equal(t, int64(1), deletedClusters[0].ObClusterId)
+	require.Equal(t, DEFAULT_RECYCLE_BIN_RETENTION, deletedClusters[0].PurgeTime.Sub(deletedClusters[0].DeleteTime))
+
+	// deleting again doesn't change the cluster in recycle bin
+	response = recycleBinTestRequest("DELETE", "http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster=g1&ObClusterId=1&version=2", deleteObRootServiceInfo)
+	require.Equal(t, http.StatusOK, response.Code)
+	require.Equal(t, deletedClusters[0].DeleteTime, listRecycleBinTestClusters(t)[0].DeleteTime)
+
+	response = recycleBinTestRequest("POST", "http://1.1.1.1:8080/services?Action=RestoreObCluster&ObCluster=g1&ObClusterId=1", restoreObCluster)
+	require.Equal(t, http.StatusOK, response.Code)
+	require.Equal(t, 0, len(listRecycleBinTestClusters(t)))
+
+	response = recycleBinTestRequest("GET", "http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster=g1&ObClusterId=1&version=2", getObRootServiceInfo)
+	require.Equal(t, http.StatusOK, response.Code)
+	rootServiceInfo := response.Data.(*model.ObRootServiceInfo)
+	require.Equal(t, "1.1.1.1:2882", rootServiceInfo.RsList[0].Address)
+
+	// restore a cluster not in recycle bin
+	response = recycleBinTestRequest("POST", "http://1.1.1.1:8080/services?Action=RestoreObCluster&ObCluster=g1&ObClusterId=1", restoreObCluster)
+	require.Equal(t, http.StatusNotFound, response.Code)
+	require.Equal(t, ErrorCodeClusterNotFound, response.ErrorCode)
+}
+
+func TestRecycleBinRegisterDeletedCluster(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_recycle_bin_register")
+	r := gin.New()
+	InitConfigServerRoutes(r)
+
+	registerGroupTestCluster(t, model.OB_CLUSTER_TYPE_PRIMARY, 1, 100)
+	response := recycleBinTestRequest("DELETE", "http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster=g1&ObClusterId=1&version=2", deleteObRootServiceInfo)
+	require.Equal(t, http.StatusOK, response.Code)
+
+	// registering the cluster in recycle bin is rejected by all the write paths, it's not restored implicitly
+	body := []byte(fmt.Sprintf(testGroupRootServiceJsonFormat, model.OB_CLUSTER_TYPE_PRIMARY, 1, 1, 200))
+	w := serveV3RequestForTest(r, http.MethodPut, "/api/v3/clusters/g1/1", body)
+	require.Equal(t, http.StatusConflict, w.Code)
+	w = serveV3RequestForTest(r, http.MethodPost, "/services?Action=ObRootServiceInfo&ObCluster=g1&ObClusterId=1&version=2", body)
+	require.Equal(t, http.StatusConflict, w.Code)
+	apiResponse := new(ApiResponse)
+	require.Nil(t, json.Unmarshal(w.Body.Bytes(), apiResponse))
+	require.Equal(t, ErrorCodeConflict, apiResponse.ErrorCode)
+	require.Equal(t, 1, len(listRecycleBinTestClusters(t)))
+	response = recycleBinTestRequest("GET", "http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster=g1&ObClusterId=1&version=2", getObRootServiceInfo)
+	require.Equal(t, http.StatusNotFound, response.Code)
+
+	// registering it after restored explicitly
+	w = serveV3RequestForTest(r, http.MethodPost, "/services?Action=RestoreObCluster&ObCluster=g1&ObClusterId=1", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	w = serveV3RequestForTest(r, http.MethodPost, "/services?Action=ObRootServiceInfo&ObCluster=g1&ObClusterId=1&version=2", body)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, 0, len(listRecycleBinTestClusters(t)))
+	require.Equal(t, int64(200), getGroupTestPrimary(t).TimeStamp)
+
+	auditLogs, err := GetConfigServer().Client.AuditLog.Query().Where(auditlog.Entity(AUDIT_ENTITY_OB_CLUSTER)).Order(ent.Asc(auditlog.FieldID)).All(context.Background())
+	require.Nil(t, err)
+	operations := make([]string, 0, len(auditLogs))
+	for _, auditLog := range auditLogs {
+		operations = append(operations, auditLog.Operation)
+	}
+	require.Equal(t, []string{CLUSTER_OPERATION_CREATE, CLUSTER_OPERATION_DELETE, CLUSTER_OPERATION_RESTORE, CLUSTER_OPERATION_UPDATE}, operations)
+	require.Equal(t, "RestoreObCluster", auditLogs[2].Action)
+
+	// registering it again after purged
+	response = recycleBinTestRequest("DELETE", "http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster=g1&ObClusterId=1&version=2", deleteObRootServiceInfo)
+	require.Equal(t, http.StatusOK, response.Code)
+	response = recycleBinTestRequest("DELETE", "http://1.1.1.1:8080/services?Action=PurgeObCluster&ObCluster=g1&ObClusterId=1", purgeObCluster)
+	require.Equal(t, http.StatusOK, response.Code)
+	w = serveV3RequestForTest(r, http.MethodPut, "/api/v3/clusters/g1/1", body)
+	require.Equal(t, http.StatusCreated, w.Code)
+}
+
+func TestRecycleBinPurge(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_recycle_bin_purge")
+
+	registerGroupTestCluster(t, model.OB_CLUSTER_TYPE_PRIMARY, 1, 100)
+	registerGroupTestCluster(t, model.OB_CLUSTER_TYPE_STANDBY, 2, 100)
+
+	// only clusters in recycle bin can be purged
+	response := recycleBinTestRequest("DELETE", "http://1.1.1.1:8080/services?Action=PurgeObCluster&ObCluster=g1&ObClusterId=1", purgeObCluster)
+	require.Equal(t, http.StatusNotFound, response.Code)
+
+	response = recycleBinTestRequest("DELETE", "http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster=g1&ObClusterId=1&version=2", deleteObRootServiceInfo)
+	require.Equal(t, http.StatusOK, response.Code)
+	response = recycleBinTestRequest("DELETE", "http://1.1.1.1:8080/services?Action=PurgeObCluster&ObCluster=g1&ObClusterId=1", purgeObCluster)
+	require.Equal(t, http.StatusOK, response.Code)
+	require.Equal(t, 0, len(listRecycleBinTestClusters(t)))
+
+	response = recycleBinTestRequest("DELETE", "http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster=g1&ObClusterId=2&version=2", deleteObRootServiceInfo)
+	require.Equal(t, http.StatusOK, response.Code)
+	affected, err := purgeExpiredObClusters(context.Background(), DEFAULT_RECYCLE_BIN_RETENTION)
+	require.Nil(t, err)
+	require.Equal(t, 0, affected)
+	affected, err = purgeExpiredObClusters(context.Background(), -1)
+	require.Nil(t, err)
+	require.Equal(t, 1, affected)
+	require.Equal(t, 0, len(listRecycleBinTestClusters(t)))
+}
@@ -0,0 +1,75 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+// fields of request logs, the trace id is carried by context
+const (
+	LOG_FIELD_CLIENT_IP     = "client_ip"
+	LOG_FIELD_METHOD        = "method"
+	LOG_FIELD_ACTION        = "action"
+	LOG_FIELD_OB_CLUSTER    = "ob_cluster"
+	LOG_FIELD_OB_CLUSTER_ID = "ob_cluster_id"
+	LOG_FIELD_STATUS        = "status"
+	LOG_FIELD_COST          = "cost"
+)
+
+// MAX_LOGGED_RESPONSE_SIZE is the max size of response body in debug logs, longer ones are truncated
+const MAX_LOGGED_RESPONSE_SIZE = 1024
+
+// getRequestLogFields returns the fields describing the request, cluster name and id are taken from query parameters,
+// old names ObRegion and ObRegionId are used if present like getCommonParam does, or from path of the v3 api
+func getRequestLogFields(c *gin.Context) log.Fields {
+	fields := log.Fields{
+		LOG_FIELD_CLIENT_IP: c.ClientIP(),
+		LOG_FIELD_METHOD:    c.Request.Method,
+		LOG_FIELD_ACTION:    getRequestAction(c),
+	}
+	if obCluster := getQueryOverridden(c, "ObCluster", "ObRegion"); obCluster != "" {
+		fields[LOG_FIELD_OB_CLUSTER] = obCluster
+	} else if obCluster := c.Param("name"); obCluster != "" {
+		fields[LOG_FIELD_OB_CLUSTER] = obCluster
+	}
+	if obClusterId := getQueryOverridden(c, "ObClusterId", "ObRegionId"); obClusterId != "" {
+		fields[LOG_FIELD_OB_CLUSTER_ID] = obClusterId
+	} else if obClusterId := c.Param("id"); obClusterId != "" {
+		fields[LOG_FIELD_OB_CLUSTER_ID] = obClusterId
+	}
+	return fields
+}
+
+// getQueryOverridden returns the query parameter key, or overriding if it's present
+func getQueryOverridden(c *gin.Context, key string, overriding string) string {
+	if value, ok := c.GetQuery(overriding); ok {
+		return value
+	}
+	return c.Query(key)
+}
+
+// truncateForLog returns the first maxSize bytes of s followed by the size of s if it's longer than maxSize
+func truncateForLog(s string, maxSize int) string {
+	if len(s) <= maxSize {
+		return s
+	}
+	return fmt.Sprintf("%s...(truncated, %d bytes)", s[:maxSize], len(s))
+}
@@ -0,0 +1,189 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oceanbase/configserver/config"
+	"github.com/oceanbase/configserver/logger"
+)
+
+func TestRequestLogFields(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_request_log")
+	r := gin.New()
+	InitConfigServerRoutes(r)
+
+	hook := test.NewLocal(log.StandardLogger())
+	defer log.StandardLogger().ReplaceHooks(make(log.LevelHooks))
+
+	w := serveV3RequestForTest(r, http.MethodGet, "/services?Action=ObRootServiceInfo&ObCluster=c1&ObRegionId=3", nil)
+	require.Equal(t, http.StatusNotFound, w.Code)
+
+	var requestEntry, responseEntry *log.Entry
+	for _, entry := range hook.AllEntries() {
+		if entry.Data[LOG_FIELD_ACTION] != "ObRootServiceInfo" {
+			continue
+		}
+		if requestEntry == nil {
+			requestEntry = entry
+		}
+		responseEntry = entry
+	}
+	require.NotNil(t, requestEntry)
+	require.NotEqual(t, requestEntry, responseEntry)
+	for _, entry := range []*log.Entry{requestEntry, responseEntry} {
+		require.Equal(t, http.MethodGet, entry.Data[LOG_FIELD_METHOD])
+		require.Equal(t, "c1", entry.Data[LOG_FIELD_OB_CLUSTER])
+		require.Equal(t, "3", entry.Data[LOG_FIELD_OB_CLUSTER_ID])
+		require.NotEmpty(t, entry.Data[LOG_FIELD_CLIENT_IP])
+		require.NotEmpty(t, entry.Context.Value(logger.TraceIdKey{}))
+	}
+	require.NotContains(t, requestEntry.Data, LOG_FIELD_STATUS)
+	require.Equal(t, http.StatusNotFound, responseEntry.Data[LOG_FIELD_STATUS])
+	require.Contains(t, responseEntry.Data, LOG_FIELD_COST)
+}
+
+func TestRequestLogFieldsV3(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_request_log_v3")
+	r := gin.New()
+	// trace id is kept in gin context for the access log
+	contextTraceId := ""
+	r.Use(func(c *gin.Context) {
+		c.Next()
+		contextTraceId = c.GetString(traceIdKey)
+	})
+	InitConfigServerRoutes(r)
+
+	hook := test.NewLocal(log.StandardLogger())
+	defer log.StandardLogger().ReplaceHooks(make(log.LevelHooks))
+
+	w := serveV3RequestForTest(r, http.MethodGet, "/api/v3/clusters/c1/3", nil)
+	require.Equal(t, http.StatusNotFound, w.Code)
+	traceId := w.Header().Get(TRACE_ID_HEADER)
+	require.NotEmpty(t, traceId)
+	require.Equal(t, traceId, contextTraceId)
+
+	var requestEntry, responseEntry *log.Entry
+	for _, entry := range hook.AllEntries() {
+		if entry.Data[LOG_FIELD_ACTION] != "GET /api/v3/clusters/:name/:id" {
+			continue
+		}
+		if requestEntry == nil {
+			requestEntry = entry
+		}
+		responseEntry = entry
+	}
+	require.NotNil(t, requestEntry)
+	require.NotEqual(t, requestEntry, responseEntry)
+	for _, entry := range []*log.Entry{requestEntry, responseEntry} {
+		require.Equal(t, http.MethodGet, entry.Data[LOG_FIELD_METHOD])
+		require.Equal(t, "c1", entry.Data[LOG_FIELD_OB_CLUSTER])
+		require.Equal(t, "3", entry.Data[LOG_FIELD_OB_CLUSTER_ID])
+		require.NotEmpty(t, entry.Data[LOG_FIELD_CLIENT_IP])
+		require.Equal(t, traceId, entry.Context.Value(logger.TraceIdKey{}))
+	}
+	require.Equal(t, http.StatusNotFound, responseEntry.Data[LOG_FIELD_STATUS])
+	require.Contains(t, responseEntry.Data, LOG_FIELD_COST)
+}
+
+func TestResponseBodyLog(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	largeResponse := func(ctxlog context.Context, c *gin.Context) *ApiResponse {
+		return NewSuccessResponse(strings.Repeat("a", 2*MAX_LOGGED_RESPONSE_SIZE))
+	}
+	r := gin.New()
+	r.GET("/services", handlerFunctionWrapper(largeResponse))
+	r.GET(V3_API_PREFIX+"/large", v3HandlerWrapper(largeResponse))
+
+	hook := test.NewLocal(log.StandardLogger())
+	defer log.StandardLogger().ReplaceHooks(make(log.LevelHooks))
+	level := log.GetLevel()
+	defer log.SetLevel(level)
+
+	for _, url := range []string{"/services?Action=Large", V3_API_PREFIX + "/large"} {
+		// response body is not logged at info level
+		log.SetLevel(log.InfoLevel)
+		hook.Reset()
+		w := serveV3RequestForTest(r, http.MethodGet, url, nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		for _, entry := range hook.AllEntries() {
+			require.NotContains(t, entry.Message, "aaaa", url)
+		}
+
+		// it's logged at debug level and truncated
+		log.SetLevel(log.DebugLevel)
+		hook.Reset()
+		w = serveV3RequestForTest(r, http.MethodGet, url, nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		var bodyEntry *log.Entry
+		for _, entry := range hook.AllEntries() {
+			if strings.HasPrefix(entry.Message, "response: ") {
+				bodyEntry = entry
+			}
+		}
+		require.NotNil(t, bodyEntry, url)
+		require.Equal(t, log.DebugLevel, bodyEntry.Level)
+		require.Contains(t, bodyEntry.Message, "truncated")
+		require.Less(t, len(bodyEntry.Message), w.Body.Len())
+		require.Equal(t, http.StatusOK, bodyEntry.Data[LOG_FIELD_STATUS])
+	}
+}
+
+func TestTruncateForLog(t *testing.T) {
+	require.Equal(t, "abc", truncateForLog("abc", 3))
+	require.Equal(t, "ab...(truncated, 3 bytes)", truncateForLog("abc", 2))
+}
+
+func TestRequestLogOfGetObProxy(t *testing.T) {
+	r := initObProxyRepositoryTestServer(t, &config.ObProxyRepositoryConfig{})
+
+	hook := test.NewLocal(log.StandardLogger())
+	defer log.StandardLogger().ReplaceHooks(make(log.LevelHooks))
+
+	w := serveObProxyRepositoryTestRequest(r, "/client?Action=GetObProxy&Version=4.2.1.0-1", "10.0.0.1:1000", map[string]string{"Range": "bytes=7-"})
+	require.Equal(t, http.StatusPartialContent, w.Code)
+
+	var serveEntry, responseEntry *log.Entry
+	for _, entry := range hook.AllEntries() {
+		switch {
+		case strings.HasPrefix(entry.Message, "serve obproxy"):
+			serveEntry = entry
+		case entry.Message == "request handled":
+			responseEntry = entry
+		}
+	}
+	require.NotNil(t, serveEntry)
+	require.NotNil(t, responseEntry)
+	for _, entry := range []*log.Entry{serveEntry, responseEntry} {
+		require.Equal(t, "GetObProxy", entry.Data[LOG_FIELD_ACTION])
+		require.Equal(t, "10.0.0.1", entry.Data[LOG_FIELD_CLIENT_IP])
+		require.NotEmpty(t, entry.Context.Value(logger.TraceIdKey{}))
+	}
+	require.Equal(t, serveEntry.Context.Value(logger.TraceIdKey{}), responseEntry.Context.Value(logger.TraceIdKey{}))
+	require.Equal(t, http.StatusPartialContent, responseEntry.Data[LOG_FIELD_STATUS])
+}
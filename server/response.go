@@ -19,12 +19,15 @@ package server
 import (
 	"fmt"
 	"net/http"
+
+	"github.com/oceanbase/configserver/model"
 )
 
 type ApiResponse struct {
 	Code       int         `json:"Code"`
 	Message    string      `json:"Message"`
 	Successful bool        `json:"Success"`
+	ErrorCode  ErrorCode   `json:"ErrorCode,omitempty"`
 	Data       interface{} `json:"Data"`
 	TraceId    string      `json:"Trace"`
 	Server     string      `json:"Server"`
@@ -32,7 +35,8 @@ type ApiResponse struct {
 }
 
 type IterableData struct {
-	Contents interface{} `json:"Contents"`
+	Contents   interface{} `json:"Contents"`
+	NextCursor string      `json:"NextCursor,omitempty"`
 }
 
 func NewSuccessResponse(data interface{}) *ApiResponse {
@@ -44,42 +48,76 @@ func NewSuccessResponse(data interface{}) *ApiResponse {
 	}
 }
 
-func NewBadRequestResponse(err error) *ApiResponse {
+func NewCreatedResponse(data interface{}) *ApiResponse {
 	return &ApiResponse{
-		Code:       http.StatusBadRequest,
-		Message:    fmt.Sprintf("bad request: %v", err),
-		Successful: false,
+		Code:       http.StatusCreated,
+		Message:    "created",
+		Successful: true,
+		Data:       data,
 	}
 }
 
-func NewIllegalArgumentResponse(err error) *ApiResponse {
+func NewNoContentResponse() *ApiResponse {
 	return &ApiResponse{
-		Code:       http.StatusBadRequest,
-		Message:    fmt.Sprintf("illegal argument: %v", err),
-		Successful: false,
+		Code:       http.StatusNoContent,
+		Message:    "no content",
+		Successful: true,
 	}
 }
 
-func NewNotFoundResponse(err error) *ApiResponse {
+func NewNotModifiedResponse() *ApiResponse {
 	return &ApiResponse{
-		Code:       http.StatusNotFound,
-		Message:    fmt.Sprintf("resource not found: %v", err),
-		Successful: false,
+		Code:       http.StatusNotModified,
+		Message:    "not modified",
+		Successful: true,
 	}
 }
 
-func NewNotImplementedResponse(err error) *ApiResponse {
+// NewErrorCodeResponse returns a failed response with status and message decided by the error code
+func NewErrorCodeResponse(code ErrorCode, err error) *ApiResponse {
 	return &ApiResponse{
-		Code:       http.StatusNotImplemented,
-		Message:    fmt.Sprintf("request not implemented: %v", err),
+		Code:       code.HttpStatus(),
+		Message:    fmt.Sprintf("%s: %v", code.Description(), err),
 		Successful: false,
+		ErrorCode:  code,
 	}
 }
 
+// NewBadRequestResponse returns InvalidParameter with the message prefix clients of the legacy api have always seen
+func NewBadRequestResponse(err error) *ApiResponse {
+	response := NewErrorCodeResponse(ErrorCodeInvalidParameter, err)
+	response.Message = fmt.Sprintf("bad request: %v", err)
+	return response
+}
+
+func NewIllegalArgumentResponse(err error) *ApiResponse {
+	return NewErrorCodeResponse(ErrorCodeInvalidParameter, err)
+}
+
+func NewUnauthorizedResponse(err error) *ApiResponse {
+	return NewErrorCodeResponse(ErrorCodeUnauthorized, err)
+}
+
+func NewForbiddenResponse(err error) *ApiResponse {
+	return NewErrorCodeResponse(ErrorCodeForbidden, err)
+}
+
+// NewValidationErrorResponse returns all the problems found in the request as data
+func NewValidationErrorResponse(errs model.ValidationErrors) *ApiResponse {
+	response := NewErrorCodeResponse(ErrorCodeInvalidParameter, errs)
+	response.Data = errs
+	return response
+}
+
+func NewNotFoundResponse(err error) *ApiResponse {
+	return NewErrorCodeResponse(ErrorCodeResourceNotFound, err)
+}
+
+func NewNotImplementedResponse(err error) *ApiResponse {
+	return NewErrorCodeResponse(ErrorCodeNotImplemented, err)
+}
+
+// NewErrorResponse returns a failed response with the code carried by err, internal error if err carries no code
 func NewErrorResponse(err error) *ApiResponse {
-	return &ApiResponse{
-		Code:       http.StatusInternalServerError,
-		Message:    fmt.Sprintf("got internal error: %v", err),
-		Successful: false,
-	}
+	return NewErrorCodeResponse(GetErrorCode(err), err)
 }
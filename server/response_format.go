@@ -0,0 +1,107 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/oceanbase/configserver/model"
+)
+
+const (
+	FORMAT_PARAM = "Format"
+
+	RESPONSE_FORMAT_JSON = "json"
+	RESPONSE_FORMAT_YAML = "yaml"
+	RESPONSE_FORMAT_TEXT = "text"
+
+	YAML_CONTENT_TYPE = "application/yaml; charset=utf-8"
+	TEXT_CONTENT_TYPE = "text/plain; charset=utf-8"
+)
+
+// getResponseFormat returns the format the client asks for with parameter Format, json by default
+func getResponseFormat(c *gin.Context) (string, error) {
+	format := strings.ToLower(c.Query(FORMAT_PARAM))
+	switch format {
+	case "":
+		return RESPONSE_FORMAT_JSON, nil
+	case RESPONSE_FORMAT_JSON, RESPONSE_FORMAT_YAML, RESPONSE_FORMAT_TEXT:
+		return format, nil
+	default:
+		return "", errors.Errorf("unsupported format %s", format)
+	}
+}
+
+// renderYaml converts the response in json to yaml, so keys keep the same with the ones in json
+func renderYaml(responseJson string) ([]byte, error) {
+	var content interface{}
+	if err := json.Unmarshal([]byte(responseJson), &content); err != nil {
+		return nil, errors.Wrap(err, "parse response json")
+	}
+	return yaml.Marshal(content)
+}
+
+// renderText returns the response in plain text for shell scripts, rootservice info is rendered as rs list,
+// failed responses are rendered as the message
+func renderText(response *ApiResponse) (string, error) {
+	if !response.Successful {
+		return response.Message + "\n", nil
+	}
+	switch data := response.Data.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return data + "\n", nil
+	case *model.ObRootServiceInfo:
+		return data.RsListString() + "\n", nil
+	case []*model.ObRootServiceInfo:
+		var builder strings.Builder
+		for _, info := range data {
+			builder.WriteString(info.RsListString())
+			builder.WriteString("\n")
+		}
+		return builder.String(), nil
+	default:
+		return "", errors.Errorf("response of %T can not be rendered as text", response.Data)
+	}
+}
+
+// writeResponse writes the response in format, responseJson is written as is for json
+func writeResponse(c *gin.Context, format string, response *ApiResponse, responseJson string) error {
+	switch format {
+	case RESPONSE_FORMAT_YAML:
+		content, err := renderYaml(responseJson)
+		if err != nil {
+			return err
+		}
+		c.Data(response.Code, YAML_CONTENT_TYPE, content)
+	case RESPONSE_FORMAT_TEXT:
+		content, err := renderText(response)
+		if err != nil {
+			return err
+		}
+		c.Data(response.Code, TEXT_CONTENT_TYPE, []byte(content))
+	default:
+		c.String(response.Code, responseJson)
+	}
+	return nil
+}
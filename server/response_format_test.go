@@ -0,0 +1,71 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestResponseFormat(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_response_format")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster=c1&ObClusterId=1&version=2", bytes.NewBuffer([]byte(testRootServiceJson)))
+	require.Equal(t, http.StatusOK, createOrUpdateObRootServiceInfo(context.Background(), c).Code)
+
+	r := gin.New()
+	InitConfigServerRoutes(r)
+
+	w = serveV3RequestForTest(r, http.MethodGet, "/services?Action=ObRootServiceInfo&ObCluster=c1&Format=text", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, TEXT_CONTENT_TYPE, w.Header().Get("Content-Type"))
+	require.Equal(t, "1.1.1.1:2881\n", w.Body.String())
+
+	w = serveV3RequestForTest(r, http.MethodGet, "/services?Action=ObRootServiceInfo&ObCluster=c1&version=2&Format=text", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "1.1.1.1:2881\n", w.Body.String())
+
+	w = serveV3RequestForTest(r, http.MethodGet, "/services?Action=ObRootServiceInfo&ObCluster=c1&Format=yaml", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, YAML_CONTENT_TYPE, w.Header().Get("Content-Type"))
+	response := make(map[string]interface{})
+	require.Nil(t, yaml.Unmarshal(w.Body.Bytes(), &response))
+	require.Equal(t, 200, response["Code"])
+	require.Equal(t, "c1", response["Data"].(map[string]interface{})["ObCluster"])
+
+	// failed responses are rendered as message in text
+	w = serveV3RequestForTest(r, http.MethodGet, "/services?Action=ObRootServiceInfo&ObCluster=c2&Format=text", nil)
+	require.Equal(t, http.StatusNotFound, w.Code)
+	require.Contains(t, w.Body.String(), "c2")
+
+	// data not supported in text
+	w = serveV3RequestForTest(r, http.MethodGet, "/services?Action=ListObClusters&Format=text", nil)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = serveV3RequestForTest(r, http.MethodGet, "/services?Action=ObRootServiceInfo&ObCluster=c1&Format=xml", nil)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Contains(t, w.Body.String(), string(ErrorCodeInvalidParameter))
+}
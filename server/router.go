@@ -17,20 +17,53 @@
 package server
 
 import (
+	"expvar"
+
 	"github.com/gin-contrib/pprof"
 	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
 )
 
 func InitConfigServerRoutes(r *gin.Engine) {
+	if err := setTrustedProxies(r, getAclConfig()); err != nil {
+		log.WithError(err).Fatal("initialize routes")
+	}
+	accessLogger, err := newAccessLogger(getAccessLogConfig())
+	if err != nil {
+		log.WithError(err).Fatal("initialize routes")
+	}
 	r.Use(
+		accessLogHandler(accessLogger),
 		gin.Recovery(), // gin's crash-free middleware
+		compressionHandler(getCompressionConfig()),
+		aclHandler(getAclConfig()),
+		rateLimitHandler(getRateLimitConfig()),
+		authHandler(),
 	)
 
 	// register pprof for debug
 	pprof.Register(r, "debug/pprof")
+	// register metrics for debug
+	r.GET("/debug/vars", gin.WrapH(expvar.Handler()))
 
 	// register route
 	r.GET("/services", getHandler())
 	r.POST("/services", postHandler())
 	r.DELETE("/services", deleteHandler())
+
+	// register route with namespace prefix
+	r.GET(NAMESPACE_PATH_PREFIX+":namespace/services", getHandler())
+	r.POST(NAMESPACE_PATH_PREFIX+":namespace/services", postHandler())
+	r.DELETE(NAMESPACE_PATH_PREFIX+":namespace/services", deleteHandler())
+
+	// register obproxy repository
+	r.GET(CLIENT_API_PATH, clientHandler())
+	r.GET(NAMESPACE_PATH_PREFIX+":namespace"+CLIENT_API_PATH, clientHandler())
+
+	// register openapi spec
+	r.GET(OPENAPI_PATH, openApiHandler)
+
+	// register v3 api
+	registerV3Routes(r.Group(V3_API_PREFIX))
+	registerV3Routes(r.Group(NAMESPACE_PATH_PREFIX + ":namespace" + V3_API_PREFIX))
 }
@@ -0,0 +1,85 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/oceanbase/configserver/config"
+)
+
+const SERVICE_ADDRESS_FORMAT = "http://%s:%d"
+
+// getDefaultServiceAddress returns the address built from vip address and port,
+// it's used when no endpoint matches the request or there is no request at all
+func getDefaultServiceAddress() string {
+	vip := GetConfigServer().Config.Vip
+	return fmt.Sprintf(SERVICE_ADDRESS_FORMAT, vip.Address, vip.Port)
+}
+
+// getServiceAddress returns the address prefix of urls generated for the request,
+// the first endpoint matching client ip or request host is used, or the default one if none matches
+func getServiceAddress(ctxlog context.Context, c *gin.Context) string {
+	endpoint := matchVipEndpoint(ctxlog, GetConfigServer().Config.Vip.Endpoints, c.ClientIP(), c.Request.Host)
+	if endpoint == nil {
+		return getDefaultServiceAddress()
+	}
+	return fmt.Sprintf(SERVICE_ADDRESS_FORMAT, endpoint.Address, endpoint.Port)
+}
+
+func matchVipEndpoint(ctxlog context.Context, endpoints []*config.VipEndpointConfig, clientIp, host string) *config.VipEndpointConfig {
+	for i, endpoint := range endpoints {
+		if endpoint == nil {
+			continue
+		}
+		if matchHost(endpoint.Hosts, host) {
+			return endpoint
+		}
+		if len(endpoint.Clients) == 0 {
+			continue
+		}
+		ipNets, err := parseIpNets(endpoint.Clients)
+		if err != nil {
+			log.WithContext(ctxlog).WithError(err).Warnf("ignore invalid clients of vip endpoint %d", i)
+			continue
+		}
+		if containsIp(ipNets, clientIp) {
+			return endpoint
+		}
+	}
+	return nil
+}
+
+// matchHost checks whether host of the request is in hosts, hosts without port match any port
+func matchHost(hosts []string, host string) bool {
+	hostname := host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		hostname = h
+	}
+	for _, h := range hosts {
+		if strings.EqualFold(h, host) || strings.EqualFold(h, hostname) {
+			return true
+		}
+	}
+	return false
+}
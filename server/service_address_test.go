@@ -0,0 +1,95 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oceanbase/configserver/config"
+	"github.com/oceanbase/configserver/model"
+)
+
+func TestMatchVipEndpoint(t *testing.T) {
+	endpoints := []*config.VipEndpointConfig{
+		{Address: "invalid", Port: 8080, Clients: []string{"invalid"}},
+		{Address: "10.0.0.100", Port: 8080, Clients: []string{"10.0.0.0/16"}},
+		{Address: "configserver.svc", Port: 8080, Hosts: []string{"configserver.svc"}},
+		{Address: "192.168.0.100", Port: 8081, Clients: []string{"192.168.0.1"}, Hosts: []string{"office:8081"}},
+	}
+	ctx := context.Background()
+	require.Nil(t, matchVipEndpoint(ctx, endpoints, "1.1.1.1", "1.1.1.1:8080"))
+	require.Nil(t, matchVipEndpoint(ctx, nil, "10.0.1.1", ""))
+	require.Equal(t, endpoints[1], matchVipEndpoint(ctx, endpoints, "10.0.1.1", "1.1.1.1:8080"))
+	require.Equal(t, endpoints[2], matchVipEndpoint(ctx, endpoints, "1.1.1.1", "configserver.svc:8080"))
+	require.Equal(t, endpoints[2], matchVipEndpoint(ctx, endpoints, "1.1.1.1", "ConfigServer.svc"))
+	require.Equal(t, endpoints[3], matchVipEndpoint(ctx, endpoints, "192.168.0.1", ""))
+	require.Equal(t, endpoints[3], matchVipEndpoint(ctx, endpoints, "1.1.1.1", "office:8081"))
+	require.Nil(t, matchVipEndpoint(ctx, endpoints, "1.1.1.1", "office:8080"))
+	// endpoints are matched in order
+	require.Equal(t, endpoints[1], matchVipEndpoint(ctx, endpoints, "10.0.0.1", "configserver.svc"))
+}
+
+func TestGetObProxyConfigWithVipEndpoints(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_vip_endpoint")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster=c1&ObClusterId=1&version=2", bytes.NewBuffer([]byte(testRootServiceJson)))
+	require.Equal(t, http.StatusOK, createOrUpdateObRootServiceInfo(context.Background(), c).Code)
+
+	vip := configServer.Config.Vip
+	defer func() {
+		configServer.Config.Vip = vip
+	}()
+	configServer.Config.Vip = &config.VipConfig{
+		Address: vip.Address,
+		Port:    vip.Port,
+		Endpoints: []*config.VipEndpointConfig{
+			{Address: "10.0.0.100", Port: 8080, Clients: []string{"10.0.0.0/16"}},
+			{Address: "configserver.svc", Port: 8080, Hosts: []string{"configserver.svc"}},
+		},
+	}
+
+	response := obProxyGroupTestRequest("GET", "http://1.1.1.1:8080/services?Action=GetObProxyConfig", nil, "10.0.1.1:1000", getObProxyConfig)
+	require.Equal(t, http.StatusOK, response.Code)
+	obProxyConfig := response.Data.(*model.ObProxyConfig)
+	require.Equal(t, "http://10.0.0.100:8080/services?Action=ObRootServiceInfo&ObCluster=c1", obProxyConfig.ConfigUrlList[0].Url)
+	require.Equal(t, "http://10.0.0.100:8080/client?Action=GetObProxy", obProxyConfig.ObProxyBinUrl)
+
+	response = obProxyGroupTestRequest("GET", "http://configserver.svc:8080/ns/ns1/services?Action=GetObProxyConfig", nil, "172.16.0.1:1000", func(ctxlog context.Context, c *gin.Context) *ApiResponse {
+		c.Params = gin.Params{{Key: "namespace", Value: "ns1"}}
+		return getObProxyConfig(ctxlog, c)
+	})
+	require.Equal(t, http.StatusOK, response.Code)
+	obProxyConfig = response.Data.(*model.ObProxyConfig)
+	require.Equal(t, "http://configserver.svc:8080/ns/ns1/client?Action=GetObProxy", obProxyConfig.ObProxyBinUrl)
+	require.True(t, strings.HasPrefix(obProxyConfig.MetaDatabase.ConfigUrl, "http://configserver.svc:8080/ns/ns1/services?"))
+
+	// the default address is used if no endpoint matches
+	response = obProxyGroupTestRequest("GET", "http://1.1.1.1:8080/services?Action=GetObProxyConfigWithTemplate", nil, "172.16.0.1:1000", getObProxyConfigWithTemplate)
+	require.Equal(t, http.StatusOK, response.Code)
+	obProxyConfigWithTemplate := response.Data.(*model.ObProxyConfigWithTemplate)
+	require.Equal(t, getDefaultServiceAddress()+"/client?Action=GetObProxy", obProxyConfigWithTemplate.ObProxyBinUrl)
+}
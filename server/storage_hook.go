@@ -0,0 +1,512 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"sort"
+
+	"github.com/oceanbase/configserver/ent"
+	"github.com/oceanbase/configserver/ent/hook"
+	"github.com/oceanbase/configserver/ent/obcluster"
+	"github.com/oceanbase/configserver/ent/obclustergroup"
+	"github.com/oceanbase/configserver/ent/obproxy"
+	"github.com/oceanbase/configserver/ent/obproxygroup"
+	"github.com/oceanbase/configserver/ent/webhookdelivery"
+)
+
+const (
+	CLUSTER_OPERATION_CREATE  = "create"
+	CLUSTER_OPERATION_UPDATE  = "update"
+	CLUSTER_OPERATION_DELETE  = "delete"
+	CLUSTER_OPERATION_RESTORE = "restore"
+	CLUSTER_OPERATION_PURGE   = "purge"
+)
+
+// obClusterChange is a change of ObCluster, Before is nil for a created cluster and After is nil for a purged one
+type obClusterChange struct {
+	Operation string
+	Before    *ent.ObCluster
+	After     *ent.ObCluster
+}
+
+func (change *obClusterChange) Cluster() *ent.ObCluster {
+	if change.After != nil {
+		return change.After
+	}
+	return change.Before
+}
+
+// obClusterGroupChange is a change of primary of ObClusterGroup, Before is nil for a created group and After is nil for a deleted one
+type obClusterGroupChange struct {
+	Before *ent.ObClusterGroup
+	After  *ent.ObClusterGroup
+}
+
+func (change *obClusterGroupChange) Group() *ent.ObClusterGroup {
+	if change.After != nil {
+		return change.After
+	}
+	return change.Before
+}
+
+// obProxyGroupChange is a change of ObProxyGroup, Before is nil for a created group and After is nil for a deleted one
+type obProxyGroupChange struct {
+	Operation string
+	Before    *ent.ObProxyGroup
+	After     *ent.ObProxyGroup
+}
+
+func (change *obProxyGroupChange) Group() *ent.ObProxyGroup {
+	if change.After != nil {
+		return change.After
+	}
+	return change.Before
+}
+
+// obProxyChange is a change of ObProxy, Before is nil for a newly reported obproxy and After is nil for a purged one
+type obProxyChange struct {
+	Operation string
+	Before    *ent.ObProxy
+	After     *ent.ObProxy
+}
+
+func (change *obProxyChange) ObProxy() *ent.ObProxy {
+	if change.After != nil {
+		return change.After
+	}
+	return change.Before
+}
+
+// webhookDeliveryChange is an update of WebhookDelivery, deliveries are never created or deleted by the update
+type webhookDeliveryChange struct {
+	Before *ent.WebhookDelivery
+	After  *ent.WebhookDelivery
+}
+
+// the handlers are called with the same client as the change after the change is applied,
+// so whatever they write is committed or rolled back together with the change
+type obClusterChangeHandler func(ctx context.Context, client *ent.Client, changes []*obClusterChange) error
+type obClusterGroupChangeHandler func(ctx context.Context, client *ent.Client, changes []*obClusterGroupChange) error
+type obProxyGroupChangeHandler func(ctx context.Context, client *ent.Client, changes []*obProxyGroupChange) error
+type obProxyChangeHandler func(ctx context.Context, client *ent.Client, changes []*obProxyChange) error
+type webhookDeliveryChangeHandler func(ctx context.Context, client *ent.Client, changes []*webhookDeliveryChange) error
+
+// registerStorageHooks registers hooks on the storage client, it should be called before the client is used
+func registerStorageHooks(client *ent.Client) {
+	client.ObCluster.Use(obClusterChangeHook(saveAuditLogs, enqueueObClusterWebhooks))
+	client.ObClusterGroup.Use(obClusterGroupChangeHook(saveObClusterGroupAuditLogs, enqueueObClusterGroupWebhooks))
+	client.ObProxyGroup.Use(obProxyGroupChangeHook(saveObProxyGroupAuditLogs))
+	client.ObProxy.Use(obProxyChangeHook(saveObProxyAuditLogs))
+	client.WebhookDelivery.Use(hook.On(webhookDeliveryChangeHook(saveWebhookDeliveryAuditLogs), ent.OpUpdate|ent.OpUpdateOne))
+}
+
+// queryChangedObClusters returns the clusters affected by the mutation indexed by id
+func queryChangedObClusters(ctx context.Context, m *ent.ObClusterMutation, ids []int) (map[int]*ent.ObCluster, error) {
+	query := m.Client().ObCluster.Query()
+	if m.Op().Is(ent.OpCreate) {
+		namespace, _ := m.Namespace()
+		name, _ := m.Name()
+		obClusterId, _ := m.ObClusterID()
+		query = query.Where(obcluster.Namespace(namespace), obcluster.Name(name), obcluster.ObClusterID(obClusterId))
+	} else {
+		query = query.Where(obcluster.IDIn(ids...))
+	}
+	clusters, err := query.All(ctx)
+	if err != nil {
+		return nil, wrapStorageError(err, "query changed ob clusters")
+	}
+	result := make(map[int]*ent.ObCluster, len(clusters))
+	for _, cluster := range clusters {
+		result[cluster.ID] = cluster
+	}
+	return result, nil
+}
+
+func getObClusterOperation(op ent.Op, before, after *ent.ObCluster) string {
+	switch {
+	case op.Is(ent.OpDelete | ent.OpDeleteOne):
+		return CLUSTER_OPERATION_PURGE
+	case before == nil || (op.Is(ent.OpCreate) && before.DeletedAt != nil):
+		return CLUSTER_OPERATION_CREATE
+	case before.DeletedAt == nil && after.DeletedAt != nil:
+		return CLUSTER_OPERATION_DELETE
+	case before.DeletedAt != nil && after.DeletedAt == nil:
+		return CLUSTER_OPERATION_RESTORE
+	default:
+		return CLUSTER_OPERATION_UPDATE
+	}
+}
+
+func getMutationIds(ctx context.Context, op ent.Op, ids func(context.Context) ([]int, error)) ([]int, error) {
+	if op.Is(ent.OpCreate) {
+		return nil, nil
+	}
+	result, err := ids(ctx)
+	return result, wrapStorageError(err, "query ids of mutation")
+}
+
+func sortedIds[T any](before, after map[int]T) []int {
+	ids := make([]int, 0, len(before)+len(after))
+	for id := range after {
+		ids = append(ids, id)
+	}
+	for id := range before {
+		if _, ok := after[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// obClusterChangeHook collects the clusters before and after every mutation of ObCluster and passes the changes to handlers
+func obClusterChangeHook(handlers ...obClusterChangeHandler) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.ObClusterFunc(func(ctx context.Context, m *ent.ObClusterMutation) (ent.Value, error) {
+			ids, err := getMutationIds(ctx, m.Op(), m.IDs)
+			if err != nil {
+				return nil, err
+			}
+			beforeClusters, err := queryChangedObClusters(ctx, m, ids)
+			if err != nil {
+				return nil, err
+			}
+			value, err := next.Mutate(ctx, m)
+			if err != nil {
+				return value, err
+			}
+			afterClusters := make(map[int]*ent.ObCluster)
+			if !m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+				afterClusters, err = queryChangedObClusters(ctx, m, ids)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			changes := make([]*obClusterChange, 0, len(afterClusters)+len(beforeClusters))
+			for _, id := range sortedIds(beforeClusters, afterClusters) {
+				before, after := beforeClusters[id], afterClusters[id]
+				changes = append(changes, &obClusterChange{
+					Operation: getObClusterOperation(m.Op(), before, after),
+					Before:    before,
+					After:     after,
+				})
+			}
+			if len(changes) == 0 {
+				return value, nil
+			}
+			for _, handler := range handlers {
+				if err := handler(ctx, m.Client(), changes); err != nil {
+					return nil, err
+				}
+			}
+			return value, nil
+		})
+	}
+}
+
+// queryChangedObClusterGroups returns the groups affected by the mutation indexed by id
+func queryChangedObClusterGroups(ctx context.Context, m *ent.ObClusterGroupMutation, ids []int) (map[int]*ent.ObClusterGroup, error) {
+	query := m.Client().ObClusterGroup.Query()
+	if m.Op().Is(ent.OpCreate) {
+		namespace, _ := m.Namespace()
+		name, _ := m.Name()
+		query = query.Where(obclustergroup.Namespace(namespace), obclustergroup.Name(name))
+	} else {
+		query = query.Where(obclustergroup.IDIn(ids...))
+	}
+	groups, err := query.All(ctx)
+	if err != nil {
+		return nil, wrapStorageError(err, "query changed ob cluster groups")
+	}
+	result := make(map[int]*ent.ObClusterGroup, len(groups))
+	for _, group := range groups {
+		result[group.ID] = group
+	}
+	return result, nil
+}
+
+// obClusterGroupChangeHook passes the groups whose primary changed to handlers
+func obClusterGroupChangeHook(handlers ...obClusterGroupChangeHandler) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.ObClusterGroupFunc(func(ctx context.Context, m *ent.ObClusterGroupMutation) (ent.Value, error) {
+			ids, err := getMutationIds(ctx, m.Op(), m.IDs)
+			if err != nil {
+				return nil, err
+			}
+			beforeGroups, err := queryChangedObClusterGroups(ctx, m, ids)
+			if err != nil {
+				return nil, err
+			}
+			value, err := next.Mutate(ctx, m)
+			if err != nil {
+				return value, err
+			}
+			afterGroups := make(map[int]*ent.ObClusterGroup)
+			if !m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+				afterGroups, err = queryChangedObClusterGroups(ctx, m, ids)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			changes := make([]*obClusterGroupChange, 0, len(afterGroups))
+			for _, id := range sortedIds(beforeGroups, afterGroups) {
+				before, after := beforeGroups[id], afterGroups[id]
+				if before != nil && after != nil && before.PrimaryClusterID == after.PrimaryClusterID {
+					continue
+				}
+				changes = append(changes, &obClusterGroupChange{
+					Before: before,
+					After:  after,
+				})
+			}
+			if len(changes) == 0 {
+				return value, nil
+			}
+			for _, handler := range handlers {
+				if err := handler(ctx, m.Client(), changes); err != nil {
+					return nil, err
+				}
+			}
+			return value, nil
+		})
+	}
+}
+
+// queryChangedObProxyGroups returns the proxy groups affected by the mutation indexed by id
+func queryChangedObProxyGroups(ctx context.Context, m *ent.ObProxyGroupMutation, ids []int) (map[int]*ent.ObProxyGroup, error) {
+	query := m.Client().ObProxyGroup.Query()
+	if m.Op().Is(ent.OpCreate) {
+		namespace, _ := m.Namespace()
+		name, _ := m.Name()
+		query = query.Where(obproxygroup.Namespace(namespace), obproxygroup.Name(name))
+	} else {
+		query = query.Where(obproxygroup.IDIn(ids...))
+	}
+	groups, err := query.All(ctx)
+	if err != nil {
+		return nil, wrapStorageError(err, "query changed obproxy groups")
+	}
+	result := make(map[int]*ent.ObProxyGroup, len(groups))
+	for _, group := range groups {
+		result[group.ID] = group
+	}
+	return result, nil
+}
+
+// getEntityOperation returns the operation of a change of entities without recycle bin
+func getEntityOperation[T any](before, after *T) string {
+	switch {
+	case before == nil:
+		return CLUSTER_OPERATION_CREATE
+	case after == nil:
+		return CLUSTER_OPERATION_DELETE
+	default:
+		return CLUSTER_OPERATION_UPDATE
+	}
+}
+
+// obProxyGroupChangeHook collects the proxy groups before and after every mutation of ObProxyGroup and passes the changes to handlers
+func obProxyGroupChangeHook(handlers ...obProxyGroupChangeHandler) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.ObProxyGroupFunc(func(ctx context.Context, m *ent.ObProxyGroupMutation) (ent.Value, error) {
+			ids, err := getMutationIds(ctx, m.Op(), m.IDs)
+			if err != nil {
+				return nil, err
+			}
+			beforeGroups, err := queryChangedObProxyGroups(ctx, m, ids)
+			if err != nil {
+				return nil, err
+			}
+			value, err := next.Mutate(ctx, m)
+			if err != nil {
+				return value, err
+			}
+			afterGroups := make(map[int]*ent.ObProxyGroup)
+			if !m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+				afterGroups, err = queryChangedObProxyGroups(ctx, m, ids)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			changes := make([]*obProxyGroupChange, 0, len(afterGroups)+len(beforeGroups))
+			for _, id := range sortedIds(beforeGroups, afterGroups) {
+				before, after := beforeGroups[id], afterGroups[id]
+				changes = append(changes, &obProxyGroupChange{
+					Operation: getEntityOperation(before, after),
+					Before:    before,
+					After:     after,
+				})
+			}
+			if len(changes) == 0 {
+				return value, nil
+			}
+			for _, handler := range handlers {
+				if err := handler(ctx, m.Client(), changes); err != nil {
+					return nil, err
+				}
+			}
+			return value, nil
+		})
+	}
+}
+
+// queryChangedObProxies returns the obproxies affected by the mutation indexed by id
+func queryChangedObProxies(ctx context.Context, m *ent.ObProxyMutation, ids []int) (map[int]*ent.ObProxy, error) {
+	query := m.Client().ObProxy.Query()
+	if m.Op().Is(ent.OpCreate) {
+		namespace, _ := m.Namespace()
+		name, _ := m.Name()
+		query = query.Where(obproxy.Namespace(namespace), obproxy.Name(name))
+	} else {
+		query = query.Where(obproxy.IDIn(ids...))
+	}
+	proxies, err := query.All(ctx)
+	if err != nil {
+		return nil, wrapStorageError(err, "query changed obproxies")
+	}
+	result := make(map[int]*ent.ObProxy, len(proxies))
+	for _, proxy := range proxies {
+		result[proxy.ID] = proxy
+	}
+	return result, nil
+}
+
+// obProxyChanged returns whether anything reported by the obproxy changed, a heartbeat only refreshing the last seen time is not a change
+func obProxyChanged(before, after *ent.ObProxy) bool {
+	return before == nil || after == nil ||
+		before.Address != after.Address ||
+		before.Version != after.Version ||
+		before.ConfigVersion != after.ConfigVersion ||
+		before.ObproxyGroup != after.ObproxyGroup ||
+		before.ServiceAddress != after.ServiceAddress
+}
+
+// obProxyChangeHook passes the obproxies created, deleted or reporting something new to handlers
+func obProxyChangeHook(handlers ...obProxyChangeHandler) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.ObProxyFunc(func(ctx context.Context, m *ent.ObProxyMutation) (ent.Value, error) {
+			ids, err := getMutationIds(ctx, m.Op(), m.IDs)
+			if err != nil {
+				return nil, err
+			}
+			beforeProxies, err := queryChangedObProxies(ctx, m, ids)
+			if err != nil {
+				return nil, err
+			}
+			value, err := next.Mutate(ctx, m)
+			if err != nil {
+				return value, err
+			}
+			afterProxies := make(map[int]*ent.ObProxy)
+			if !m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+				afterProxies, err = queryChangedObProxies(ctx, m, ids)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			changes := make([]*obProxyChange, 0, len(afterProxies)+len(beforeProxies))
+			for _, id := range sortedIds(beforeProxies, afterProxies) {
+				before, after := beforeProxies[id], afterProxies[id]
+				if !obProxyChanged(before, after) {
+					continue
+				}
+				changes = append(changes, &obProxyChange{
+					Operation: getEntityOperation(before, after),
+					Before:    before,
+					After:     after,
+				})
+			}
+			if len(changes) == 0 {
+				return value, nil
+			}
+			for _, handler := range handlers {
+				if err := handler(ctx, m.Client(), changes); err != nil {
+					return nil, err
+				}
+			}
+			return value, nil
+		})
+	}
+}
+
+// queryChangedWebhookDeliveries returns the deliveries affected by the mutation indexed by id
+func queryChangedWebhookDeliveries(ctx context.Context, m *ent.WebhookDeliveryMutation, ids []int) (map[int]*ent.WebhookDelivery, error) {
+	deliveries, err := m.Client().WebhookDelivery.Query().Where(webhookdelivery.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, wrapStorageError(err, "query changed webhook deliveries")
+	}
+	result := make(map[int]*ent.WebhookDelivery, len(deliveries))
+	for _, delivery := range deliveries {
+		result[delivery.ID] = delivery
+	}
+	return result, nil
+}
+
+// webhookDeliveryChangeHook passes the finished deliveries sent again to handlers,
+// the other updates are made by the dispatcher on every attempt as a result of changes recorded already
+func webhookDeliveryChangeHook(handlers ...webhookDeliveryChangeHandler) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.WebhookDeliveryFunc(func(ctx context.Context, m *ent.WebhookDeliveryMutation) (ent.Value, error) {
+			status, ok := m.Status()
+			if !ok || status != WEBHOOK_DELIVERY_STATUS_PENDING {
+				return next.Mutate(ctx, m)
+			}
+			ids, err := getMutationIds(ctx, m.Op(), m.IDs)
+			if err != nil {
+				return nil, err
+			}
+			beforeDeliveries, err := queryChangedWebhookDeliveries(ctx, m, ids)
+			if err != nil {
+				return nil, err
+			}
+			value, err := next.Mutate(ctx, m)
+			if err != nil {
+				return value, err
+			}
+			afterDeliveries, err := queryChangedWebhookDeliveries(ctx, m, ids)
+			if err != nil {
+				return nil, err
+			}
+
+			changes := make([]*webhookDeliveryChange, 0, len(afterDeliveries))
+			for _, id := range sortedIds(beforeDeliveries, afterDeliveries) {
+				before, after := beforeDeliveries[id], afterDeliveries[id]
+				if before == nil || after == nil || before.Status == after.Status {
+					continue
+				}
+				changes = append(changes, &webhookDeliveryChange{
+					Before: before,
+					After:  after,
+				})
+			}
+			if len(changes) == 0 {
+				return value, nil
+			}
+			for _, handler := range handlers {
+				if err := handler(ctx, m.Client(), changes); err != nil {
+					return nil, err
+				}
+			}
+			return value, nil
+		})
+	}
+}
@@ -0,0 +1,214 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/oceanbase/configserver/lib/codec"
+	"github.com/oceanbase/configserver/model"
+)
+
+const (
+	V3_API_PREFIX   = "/api/v3"
+	TRACE_ID_HEADER = "X-Trace-Id"
+)
+
+// V3ErrorResponse is the body of failed v3 api requests, successful requests return the resource itself
+type V3ErrorResponse struct {
+	Code      int         `json:"Code"`
+	ErrorCode ErrorCode   `json:"ErrorCode"`
+	Message   string      `json:"Message"`
+	Details   interface{} `json:"Details,omitempty"`
+	TraceId   string      `json:"Trace"`
+}
+
+// v3HandlerWrapper renders the ApiResponse in v3 style,
+// status code is taken from the response, and only the data is written to the body
+func v3HandlerWrapper(f func(context.Context, *gin.Context) *ApiResponse) func(*gin.Context) {
+	fn := func(c *gin.Context) {
+		request := startApiRequest(c)
+		response := f(request.ctxlog, c)
+		c.Header(TRACE_ID_HEADER, request.traceId)
+
+		var body interface{}
+		if response.Successful {
+			body = response.Data
+		} else {
+			body = &V3ErrorResponse{
+				Code:      response.Code,
+				ErrorCode: response.ErrorCode,
+				Message:   response.Message,
+				Details:   response.Data,
+				TraceId:   request.traceId,
+			}
+		}
+		if body == nil {
+			request.logResponse(response.Code, "")
+			c.Status(response.Code)
+			return
+		}
+		responseJson, err := codec.MarshalToJsonString(body)
+		if err != nil {
+			request.logError(http.StatusInternalServerError, err, "response serialization error")
+			c.JSON(http.StatusInternalServerError, &V3ErrorResponse{
+				Code:      http.StatusInternalServerError,
+				ErrorCode: ErrorCodeInternalError,
+				Message:   fmt.Sprintf("serialize response: %v", err),
+				TraceId:   request.traceId,
+			})
+			return
+		}
+		request.logResponse(response.Code, responseJson)
+		c.Data(response.Code, "application/json; charset=utf-8", []byte(responseJson))
+	}
+	return fn
+}
+
+func registerV3Routes(r *gin.RouterGroup) {
+	r.GET("/clusters", v3HandlerWrapper(listClustersV3))
+	r.GET("/clusters/:name", v3HandlerWrapper(getClusterV3))
+	r.GET("/clusters/:name/:id", v3HandlerWrapper(getClusterByIdV3))
+	r.PUT("/clusters/:name/:id", v3HandlerWrapper(putClusterV3))
+	r.DELETE("/clusters/:name/:id", v3HandlerWrapper(deleteClusterV3))
+	r.GET("/proxy-config", v3HandlerWrapper(getObProxyConfig))
+	r.GET("/proxy-config/template", v3HandlerWrapper(getObProxyConfigWithTemplate))
+}
+
+type clusterPathParam struct {
+	Namespace   string
+	ObCluster   string
+	ObClusterId int64
+}
+
+func getClusterPathParam(c *gin.Context, withId bool) (*clusterPathParam, error) {
+	namespace, err := getNamespace(c)
+	if err != nil {
+		return nil, err
+	}
+	param := &clusterPathParam{
+		Namespace: namespace,
+		ObCluster: c.Param("name"),
+	}
+	if withId {
+		param.ObClusterId, err = strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse ob cluster id")
+		}
+		if param.ObClusterId <= 0 {
+			return nil, errors.New("ob cluster id should be positive")
+		}
+	}
+	return param, nil
+}
+
+func listClustersV3(ctxlog context.Context, c *gin.Context) *ApiResponse {
+	param, err := getObClusterListParam(c)
+	if err != nil {
+		return NewIllegalArgumentResponse(err)
+	}
+	summaries, nextCursor, err := queryObClusterSummaries(ctxlog, param)
+	if err != nil {
+		return NewErrorResponse(err)
+	}
+	return NewSuccessResponse(&IterableData{
+		Contents:   summaries,
+		NextCursor: nextCursor,
+	})
+}
+
+func getClusterV3(ctxlog context.Context, c *gin.Context) *ApiResponse {
+	param, err := getClusterPathParam(c, false)
+	if err != nil {
+		return NewIllegalArgumentResponse(err)
+	}
+	rootServiceInfoList, err := getRootServiceInfoList(ctxlog, param.Namespace, param.ObCluster, 0)
+	if err != nil {
+		return NewErrorResponse(err)
+	}
+	return NewSuccessResponse(rootServiceInfoList)
+}
+
+func getClusterByIdV3(ctxlog context.Context, c *gin.Context) *ApiResponse {
+	param, err := getClusterPathParam(c, true)
+	if err != nil {
+		return NewIllegalArgumentResponse(err)
+	}
+	rootServiceInfoList, err := getRootServiceInfoList(ctxlog, param.Namespace, param.ObCluster, param.ObClusterId)
+	if err != nil {
+		return NewErrorResponse(err)
+	}
+	return NewSuccessResponse(rootServiceInfoList[0])
+}
+
+func putClusterV3(ctxlog context.Context, c *gin.Context) *ApiResponse {
+	param, err := getClusterPathParam(c, true)
+	if err != nil {
+		return NewIllegalArgumentResponse(err)
+	}
+	obRootServiceInfo := new(model.ObRootServiceInfo)
+	err = c.ShouldBindJSON(obRootServiceInfo)
+	if err != nil {
+		return NewBadRequestResponse(errors.Wrap(err, "bind rootservice info"))
+	}
+	obRootServiceInfo.Fill()
+	if obRootServiceInfo.ObCluster != "" && obRootServiceInfo.ObCluster != param.ObCluster {
+		return NewIllegalArgumentResponse(errors.Errorf("ob cluster name %s in body doesn't match %s in path", obRootServiceInfo.ObCluster, param.ObCluster))
+	}
+	if obRootServiceInfo.ObClusterId != 0 && obRootServiceInfo.ObClusterId != param.ObClusterId {
+		return NewIllegalArgumentResponse(errors.Errorf("ob cluster id %d in body doesn't match %d in path", obRootServiceInfo.ObClusterId, param.ObClusterId))
+	}
+	if len(obRootServiceInfo.Type) == 0 {
+		return NewIllegalArgumentResponse(errors.New("ob cluster type is required"))
+	}
+	obRootServiceInfo.ObCluster = param.ObCluster
+	obRootServiceInfo.ObClusterId = param.ObClusterId
+	obRootServiceInfo.Fill()
+	if errs := obRootServiceInfo.Validate(); len(errs) > 0 {
+		return NewValidationErrorResponse(errs)
+	}
+
+	created, err := saveRootServiceInfo(ctxlog, param.Namespace, obRootServiceInfo)
+	if err != nil {
+		return NewErrorResponse(errors.Wrap(err, "save ob rootservice info"))
+	}
+	if created {
+		return NewCreatedResponse(obRootServiceInfo)
+	}
+	return NewSuccessResponse(obRootServiceInfo)
+}
+
+func deleteClusterV3(ctxlog context.Context, c *gin.Context) *ApiResponse {
+	param, err := getClusterPathParam(c, true)
+	if err != nil {
+		return NewIllegalArgumentResponse(err)
+	}
+	affected, err := deleteRootServiceInfo(ctxlog, param.Namespace, param.ObCluster, param.ObClusterId)
+	if err != nil {
+		return NewErrorResponse(errors.Wrap(err, "delete ob rootservice info"))
+	}
+	if affected == 0 {
+		return NewErrorCodeResponse(ErrorCodeClusterNotFound, errors.Errorf("no obcluster found with name %s and ob cluster id %d", param.ObCluster, param.ObClusterId))
+	}
+	return NewNoContentResponse()
+}
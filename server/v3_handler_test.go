@@ -0,0 +1,96 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oceanbase/configserver/model"
+)
+
+func serveV3RequestForTest(r *gin.Engine, method, url string, body []byte) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(method, url, bytes.NewBuffer(body))
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestV3ClusterLifecycle(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_v3")
+	r := gin.New()
+	InitConfigServerRoutes(r)
+
+	w := serveV3RequestForTest(r, http.MethodPut, "/api/v3/clusters/c1/1", []byte(testRootServiceJson))
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.NotEmpty(t, w.Header().Get(TRACE_ID_HEADER))
+
+	w = serveV3RequestForTest(r, http.MethodPut, "/api/v3/clusters/c1/1", []byte(testRootServiceJson))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	w = serveV3RequestForTest(r, http.MethodPut, "/api/v3/clusters/c1/2", []byte(testRootServiceJson))
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	errorResponse := new(V3ErrorResponse)
+	require.Nil(t, json.Unmarshal(w.Body.Bytes(), errorResponse))
+	require.Equal(t, http.StatusBadRequest, errorResponse.Code)
+
+	w = serveV3RequestForTest(r, http.MethodGet, "/api/v3/clusters/c1/1", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	rootServiceInfo := new(model.ObRootServiceInfo)
+	require.Nil(t, json.Unmarshal(w.Body.Bytes(), rootServiceInfo))
+	require.Equal(t, "c1", rootServiceInfo.ObCluster)
+	require.Equal(t, int64(1), rootServiceInfo.ObClusterId)
+
+	w = serveV3RequestForTest(r, http.MethodGet, "/api/v3/clusters/c1", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	w = serveV3RequestForTest(r, http.MethodGet, "/api/v3/proxy-config", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	obProxyConfig := new(model.ObProxyConfig)
+	require.Nil(t, json.Unmarshal(w.Body.Bytes(), obProxyConfig))
+	require.Equal(t, 1, len(obProxyConfig.ConfigUrlList))
+
+	// legacy api shares the same data
+	w = serveV3RequestForTest(r, http.MethodGet, "/services?Action=ObRootServiceInfo&ObCluster=c1", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	w = serveV3RequestForTest(r, http.MethodDelete, "/api/v3/clusters/c1/1", nil)
+	require.Equal(t, http.StatusNoContent, w.Code)
+
+	w = serveV3RequestForTest(r, http.MethodDelete, "/api/v3/clusters/c1/1", nil)
+	require.Equal(t, http.StatusNotFound, w.Code)
+
+	w = serveV3RequestForTest(r, http.MethodGet, "/api/v3/clusters/c1/1", nil)
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestV3InvalidClusterId(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	initObClusterGroupTestServer(t, "ent_v3_invalid")
+	r := gin.New()
+	InitConfigServerRoutes(r)
+
+	w := serveV3RequestForTest(r, http.MethodGet, "/api/v3/clusters/c1/abc", nil)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,381 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/oceanbase/configserver/config"
+	"github.com/oceanbase/configserver/ent"
+	"github.com/oceanbase/configserver/ent/webhookdelivery"
+	"github.com/oceanbase/configserver/model"
+)
+
+const (
+	WEBHOOK_EVENT_CLUSTER_CREATED  = "cluster.created"
+	WEBHOOK_EVENT_CLUSTER_UPDATED  = "cluster.updated"
+	WEBHOOK_EVENT_CLUSTER_DELETED  = "cluster.deleted"
+	WEBHOOK_EVENT_CLUSTER_RESTORED = "cluster.restored"
+	WEBHOOK_EVENT_CLUSTER_PURGED   = "cluster.purged"
+	WEBHOOK_EVENT_PRIMARY_CHANGED  = "group.primary_changed"
+
+	WEBHOOK_DELIVERY_STATUS_PENDING   = "PENDING"
+	WEBHOOK_DELIVERY_STATUS_SUCCEEDED = "SUCCEEDED"
+	WEBHOOK_DELIVERY_STATUS_DEAD      = "DEAD"
+
+	WEBHOOK_EVENT_HEADER     = "X-Configserver-Event"
+	WEBHOOK_DELIVERY_HEADER  = "X-Configserver-Delivery"
+	WEBHOOK_SIGNATURE_HEADER = "X-Configserver-Signature"
+	WEBHOOK_SIGNATURE_PREFIX = "sha256="
+
+	DEFAULT_WEBHOOK_MAX_ATTEMPTS      = 5
+	DEFAULT_WEBHOOK_INITIAL_BACKOFF   = time.Second
+	DEFAULT_WEBHOOK_MAX_BACKOFF       = 5 * time.Minute
+	DEFAULT_WEBHOOK_TIMEOUT           = 5 * time.Second
+	DEFAULT_WEBHOOK_DISPATCH_INTERVAL = time.Second
+	DEFAULT_WEBHOOK_RETENTION         = 7 * 24 * time.Hour
+	DEFAULT_WEBHOOK_PURGE_INTERVAL    = time.Hour
+
+	WEBHOOK_DISPATCH_BATCH_SIZE = 100
+	// error message of a delivery is truncated to fit in storage
+	WEBHOOK_MAX_ERROR_LENGTH = 1024
+)
+
+var clusterOperationEvents = map[string]string{
+	CLUSTER_OPERATION_CREATE:  WEBHOOK_EVENT_CLUSTER_CREATED,
+	CLUSTER_OPERATION_UPDATE:  WEBHOOK_EVENT_CLUSTER_UPDATED,
+	CLUSTER_OPERATION_DELETE:  WEBHOOK_EVENT_CLUSTER_DELETED,
+	CLUSTER_OPERATION_RESTORE: WEBHOOK_EVENT_CLUSTER_RESTORED,
+	CLUSTER_OPERATION_PURGE:   WEBHOOK_EVENT_CLUSTER_PURGED,
+}
+
+// getWebhookConfig returns webhook config with defaults filled, it's never nil
+func getWebhookConfig() *config.WebhookConfig {
+	webhookConfig := &config.WebhookConfig{}
+	if configured := GetConfigServer().Config.Webhook; configured != nil {
+		*webhookConfig = *configured
+	}
+	if webhookConfig.MaxAttempts <= 0 {
+		webhookConfig.MaxAttempts = DEFAULT_WEBHOOK_MAX_ATTEMPTS
+	}
+	if webhookConfig.InitialBackoff <= 0 {
+		webhookConfig.InitialBackoff = DEFAULT_WEBHOOK_INITIAL_BACKOFF
+	}
+	if webhookConfig.MaxBackoff <= 0 {
+		webhookConfig.MaxBackoff = DEFAULT_WEBHOOK_MAX_BACKOFF
+	}
+	if webhookConfig.Timeout <= 0 {
+		webhookConfig.Timeout = DEFAULT_WEBHOOK_TIMEOUT
+	}
+	if webhookConfig.DispatchInterval <= 0 {
+		webhookConfig.DispatchInterval = DEFAULT_WEBHOOK_DISPATCH_INTERVAL
+	}
+	if webhookConfig.Retention <= 0 {
+		webhookConfig.Retention = DEFAULT_WEBHOOK_RETENTION
+	}
+	return webhookConfig
+}
+
+func findWebhookSubscriber(webhookConfig *config.WebhookConfig, name string) *config.WebhookSubscriberConfig {
+	for _, subscriber := range webhookConfig.Subscribers {
+		if subscriber.Name == name {
+			return subscriber
+		}
+	}
+	return nil
+}
+
+// matchFilter returns whether value is in filter, an empty filter matches everything
+func matchFilter(filter []string, value string) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	for _, item := range filter {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+func webhookSubscriberMatches(subscriber *config.WebhookSubscriberConfig, event *model.WebhookEvent) bool {
+	return matchFilter(subscriber.Events, event.Event) &&
+		matchFilter(subscriber.Namespaces, event.Namespace) &&
+		matchFilter(subscriber.Clusters, event.ObCluster)
+}
+
+// enqueueWebhookEvent saves a delivery for every subscriber interested in the event,
+// deliveries are saved with the same client as the change and sent asynchronously by the dispatcher
+func enqueueWebhookEvent(ctx context.Context, client *ent.Client, event *model.WebhookEvent) error {
+	var payload []byte
+	builders := make([]*ent.WebhookDeliveryCreate, 0)
+	for _, subscriber := range getWebhookConfig().Subscribers {
+		if !webhookSubscriberMatches(subscriber, event) {
+			continue
+		}
+		if payload == nil {
+			var err error
+			payload, err = json.Marshal(event)
+			if err != nil {
+				return errors.Wrap(err, "serialize webhook event")
+			}
+		}
+		builders = append(builders, client.WebhookDelivery.Create().
+			SetSubscriber(subscriber.Name).
+			SetURL(subscriber.Url).
+			SetEvent(event.Event).
+			SetNamespace(event.Namespace).
+			SetObCluster(event.ObCluster).
+			SetObClusterID(event.ObClusterId).
+			SetPayload(string(payload)).
+			SetStatus(WEBHOOK_DELIVERY_STATUS_PENDING))
+	}
+	if len(builders) == 0 {
+		return nil
+	}
+	return wrapStorageError(client.WebhookDelivery.CreateBulk(builders...).Exec(ctx), "save webhook deliveries")
+}
+
+// rsListChanged returns whether the change is worth notifying, the timestamp reported by observer is ignored
+func rsListChanged(before, after *model.ObRootServiceInfo) bool {
+	return before.Type != after.Type ||
+		!reflect.DeepEqual(before.RsList, after.RsList) ||
+		!reflect.DeepEqual(before.ReadonlyRsList, after.ReadonlyRsList)
+}
+
+func enqueueObClusterWebhooks(ctx context.Context, client *ent.Client, changes []*obClusterChange) error {
+	for _, change := range changes {
+		cluster := change.Cluster()
+		event := &model.WebhookEvent{
+			Event:       clusterOperationEvents[change.Operation],
+			Time:        time.Now(),
+			Namespace:   cluster.Namespace,
+			ObCluster:   cluster.Name,
+			ObClusterId: cluster.ObClusterID,
+		}
+		var err error
+		if change.Before != nil {
+			if event.Before, err = decodeRootServiceInfo(change.Before); err != nil {
+				return err
+			}
+		}
+		if change.After != nil {
+			if event.After, err = decodeRootServiceInfo(change.After); err != nil {
+				return err
+			}
+		}
+		if change.Operation == CLUSTER_OPERATION_UPDATE && !rsListChanged(event.Before, event.After) {
+			continue
+		}
+		if err := enqueueWebhookEvent(ctx, client, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func enqueueObClusterGroupWebhooks(ctx context.Context, client *ent.Client, changes []*obClusterGroupChange) error {
+	for _, change := range changes {
+		// a deleted group has no primary to notify
+		if change.After == nil || change.After.PrimaryClusterID == 0 {
+			continue
+		}
+		event := &model.WebhookEvent{
+			Event:            WEBHOOK_EVENT_PRIMARY_CHANGED,
+			Time:             time.Now(),
+			Namespace:        change.After.Namespace,
+			ObCluster:        change.After.Name,
+			PrimaryClusterId: change.After.PrimaryClusterID,
+		}
+		if change.Before != nil {
+			event.PreviousPrimaryClusterId = change.Before.PrimaryClusterID
+		}
+		if err := enqueueWebhookEvent(ctx, client, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// signWebhookPayload returns the hex encoded HMAC-SHA256 of payload with prefix, receivers verify it with the shared secret
+func signWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return WEBHOOK_SIGNATURE_PREFIX + hex.EncodeToString(mac.Sum(nil))
+}
+
+// getWebhookBackoff returns the delay before the next attempt, it's doubled after every failed attempt
+func getWebhookBackoff(webhookConfig *config.WebhookConfig, attempts int) time.Duration {
+	backoff := webhookConfig.InitialBackoff
+	for i := 1; i < attempts && backoff < webhookConfig.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > webhookConfig.MaxBackoff {
+		backoff = webhookConfig.MaxBackoff
+	}
+	return backoff
+}
+
+func sendWebhookDelivery(ctx context.Context, httpClient *http.Client, delivery *ent.WebhookDelivery, subscriber *config.WebhookSubscriberConfig) (int, error) {
+	payload := []byte(delivery.Payload)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(payload))
+	if err != nil {
+		return 0, errors.Wrap(err, "create webhook request")
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(WEBHOOK_EVENT_HEADER, delivery.Event)
+	request.Header.Set(WEBHOOK_DELIVERY_HEADER, strconv.Itoa(delivery.ID))
+	if subscriber.Secret != "" {
+		request.Header.Set(WEBHOOK_SIGNATURE_HEADER, signWebhookPayload(subscriber.Secret, payload))
+	}
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return 0, errors.Wrap(err, "send webhook request")
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, response.Body)
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return response.StatusCode, errors.Errorf("webhook receiver responded with status %d", response.StatusCode)
+	}
+	return response.StatusCode, nil
+}
+
+// deliverWebhook sends the delivery and records the result,
+// a failed delivery is retried with backoff until max attempts, then it's kept as dead letter
+func deliverWebhook(ctx context.Context, webhookConfig *config.WebhookConfig, httpClient *http.Client, delivery *ent.WebhookDelivery) error {
+	attempts := delivery.Attempts + 1
+	statusCode := 0
+	err := errors.Errorf("subscriber %s is not configured", delivery.Subscriber)
+	subscriber := findWebhookSubscriber(webhookConfig, delivery.Subscriber)
+	if subscriber != nil {
+		statusCode, err = sendWebhookDelivery(ctx, httpClient, delivery, subscriber)
+	}
+	update := GetConfigServer().Client.WebhookDelivery.
+		UpdateOneID(delivery.ID).
+		SetAttempts(attempts).
+		SetLastStatusCode(statusCode)
+	switch {
+	case err == nil:
+		update.SetStatus(WEBHOOK_DELIVERY_STATUS_SUCCEEDED).SetLastError("")
+	case subscriber == nil || attempts >= webhookConfig.MaxAttempts:
+		log.WithContext(ctx).WithError(err).Warnf("webhook delivery %d to %s is dead after %d attempts", delivery.ID, delivery.Subscriber, attempts)
+		update.SetStatus(WEBHOOK_DELIVERY_STATUS_DEAD).SetLastError(truncateString(err.Error(), WEBHOOK_MAX_ERROR_LENGTH))
+	default:
+		update.SetNextAttemptTime(time.Now().Add(getWebhookBackoff(webhookConfig, attempts))).SetLastError(truncateString(err.Error(), WEBHOOK_MAX_ERROR_LENGTH))
+	}
+	return wrapStorageError(update.Exec(ctx), fmt.Sprintf("save result of webhook delivery %d", delivery.ID))
+}
+
+func truncateString(s string, length int) string {
+	if len(s) > length {
+		return s[:length]
+	}
+	return s
+}
+
+// dispatchWebhookDeliveries sends the pending deliveries due, returns the number of deliveries sent,
+// a delivery is claimed before sent, so it's sent by only one of the configservers sharing the storage
+func dispatchWebhookDeliveries(ctx context.Context) (int, error) {
+	webhookConfig := getWebhookConfig()
+	client := GetConfigServer().Client
+	now := time.Now()
+	deliveries, err := client.WebhookDelivery.Query().
+		Where(webhookdelivery.Status(WEBHOOK_DELIVERY_STATUS_PENDING), webhookdelivery.NextAttemptTimeLTE(now)).
+		Order(ent.Asc(webhookdelivery.FieldNextAttemptTime), ent.Asc(webhookdelivery.FieldID)).
+		Limit(WEBHOOK_DISPATCH_BATCH_SIZE).
+		All(ctx)
+	if err != nil {
+		return 0, wrapStorageError(err, "query pending webhook deliveries")
+	}
+
+	lease := now.Add(webhookConfig.Timeout + webhookConfig.DispatchInterval)
+	httpClient := &http.Client{Timeout: webhookConfig.Timeout}
+	var wg sync.WaitGroup
+	claimed := 0
+	for _, delivery := range deliveries {
+		affected, err := client.WebhookDelivery.Update().
+			Where(webhookdelivery.ID(delivery.ID), webhookdelivery.Status(WEBHOOK_DELIVERY_STATUS_PENDING), webhookdelivery.NextAttemptTime(delivery.NextAttemptTime)).
+			SetNextAttemptTime(lease).
+			Save(ctx)
+		if err != nil {
+			return claimed, wrapStorageError(err, fmt.Sprintf("claim webhook delivery %d", delivery.ID))
+		}
+		if affected == 0 {
+			continue
+		}
+		claimed++
+		wg.Add(1)
+		go func(delivery *ent.WebhookDelivery) {
+			defer wg.Done()
+			if err := deliverWebhook(ctx, webhookConfig, httpClient, delivery); err != nil {
+				log.WithContext(ctx).WithError(err).Errorf("deliver webhook %d failed", delivery.ID)
+			}
+		}(delivery)
+	}
+	wg.Wait()
+	return claimed, nil
+}
+
+// runWebhookDispatcher sends pending deliveries periodically until ctx is cancelled
+func runWebhookDispatcher(ctx context.Context) {
+	interval := getWebhookConfig().DispatchInterval
+	log.WithContext(ctx).Infof("start webhook dispatcher, interval %s", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := dispatchWebhookDeliveries(ctx); err != nil {
+				log.WithContext(ctx).WithError(err).Error("dispatch webhook deliveries failed")
+			}
+		}
+	}
+}
+
+// purgeExpiredWebhookDeliveries deletes finished deliveries older than retention, pending ones are always kept
+func purgeExpiredWebhookDeliveries(ctx context.Context, retention time.Duration) (int, error) {
+	affected, err := GetConfigServer().Client.WebhookDelivery.
+		Delete().
+		Where(webhookdelivery.StatusNEQ(WEBHOOK_DELIVERY_STATUS_PENDING), webhookdelivery.UpdateTimeLT(time.Now().Add(-retention))).
+		Exec(ctx)
+	if err != nil {
+		return 0, wrapStorageError(err, "purge expired webhook deliveries")
+	}
+	return affected, nil
+}
+
+// runWebhookDeliveryPurger purges expired webhook deliveries periodically until ctx is cancelled
+func runWebhookDeliveryPurger(ctx context.Context) {
+	runPurger(ctx, "webhook deliveries", DEFAULT_WEBHOOK_PURGE_INTERVAL, getWebhookConfig().Retention, purgeExpiredWebhookDeliveries)
+}
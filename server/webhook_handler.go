@@ -0,0 +1,190 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/oceanbase/configserver/ent"
+	"github.com/oceanbase/configserver/ent/webhookdelivery"
+	"github.com/oceanbase/configserver/model"
+)
+
+var webhookDeliveryListOnce sync.Once
+var webhookDeliveryListFunc func(*gin.Context)
+var webhookDeliveryRetryOnce sync.Once
+var webhookDeliveryRetryFunc func(*gin.Context)
+
+func getWebhookDeliveryListFunc() func(*gin.Context) {
+	webhookDeliveryListOnce.Do(func() {
+		webhookDeliveryListFunc = handlerFunctionWrapper(listWebhookDeliveries)
+	})
+	return webhookDeliveryListFunc
+}
+
+func getWebhookDeliveryRetryFunc() func(*gin.Context) {
+	webhookDeliveryRetryOnce.Do(func() {
+		webhookDeliveryRetryFunc = handlerFunctionWrapper(retryWebhookDelivery)
+	})
+	return webhookDeliveryRetryFunc
+}
+
+type WebhookDeliveryListParam struct {
+	Namespace  string
+	Subscriber string
+	Status     string
+	ObCluster  string
+	Limit      int
+	// id of the last delivery in previous page, deliveries are returned from the latest
+	Cursor int
+}
+
+func getWebhookDeliveryListParam(c *gin.Context) (*WebhookDeliveryListParam, error) {
+	namespace, err := getNamespace(c)
+	if err != nil {
+		return nil, err
+	}
+	param := &WebhookDeliveryListParam{
+		Namespace:  namespace,
+		Subscriber: c.Query("Subscriber"),
+		Status:     c.Query("Status"),
+		ObCluster:  c.Query("ObCluster"),
+	}
+	switch param.Status {
+	case "", WEBHOOK_DELIVERY_STATUS_PENDING, WEBHOOK_DELIVERY_STATUS_SUCCEEDED, WEBHOOK_DELIVERY_STATUS_DEAD:
+	default:
+		return nil, errors.Errorf("unsupported status %s", param.Status)
+	}
+	param.Limit, err = getLimitParam(c)
+	if err != nil {
+		return nil, err
+	}
+	if cursor, ok := c.GetQuery("Cursor"); ok && cursor != "" {
+		param.Cursor, err = strconv.Atoi(cursor)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse cursor")
+		}
+	}
+	return param, nil
+}
+
+func convertWebhookDelivery(delivery *ent.WebhookDelivery) *model.WebhookDeliveryInfo {
+	return &model.WebhookDeliveryInfo{
+		Id:              delivery.ID,
+		Subscriber:      delivery.Subscriber,
+		Url:             delivery.URL,
+		Event:           delivery.Event,
+		ObCluster:       delivery.ObCluster,
+		ObClusterId:     delivery.ObClusterID,
+		Status:          delivery.Status,
+		Attempts:        delivery.Attempts,
+		NextAttemptTime: delivery.NextAttemptTime,
+		LastStatusCode:  delivery.LastStatusCode,
+		LastError:       delivery.LastError,
+		CreateTime:      delivery.CreateTime,
+		UpdateTime:      delivery.UpdateTime,
+	}
+}
+
+// queryWebhookDeliveries returns deliveries from the latest along with the cursor of next page, the cursor is empty if there's no more
+func queryWebhookDeliveries(ctxlog context.Context, param *WebhookDeliveryListParam) ([]*model.WebhookDeliveryInfo, string, error) {
+	query := GetConfigServer().Client.WebhookDelivery.Query().Where(webhookdelivery.Namespace(param.Namespace))
+	if param.Subscriber != "" {
+		query = query.Where(webhookdelivery.Subscriber(param.Subscriber))
+	}
+	if param.Status != "" {
+		query = query.Where(webhookdelivery.Status(param.Status))
+	}
+	if param.ObCluster != "" {
+		query = query.Where(webhookdelivery.ObCluster(param.ObCluster))
+	}
+	if param.Cursor > 0 {
+		query = query.Where(webhookdelivery.IDLT(param.Cursor))
+	}
+	deliveries, err := query.Order(ent.Desc(webhookdelivery.FieldID)).Limit(param.Limit + 1).All(ctxlog)
+	if err != nil {
+		return nil, "", wrapStorageError(err, "query webhook deliveries")
+	}
+	nextCursor := ""
+	if len(deliveries) > param.Limit {
+		deliveries = deliveries[:param.Limit]
+		nextCursor = strconv.Itoa(deliveries[len(deliveries)-1].ID)
+	}
+	deliveryInfos := make([]*model.WebhookDeliveryInfo, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		deliveryInfos = append(deliveryInfos, convertWebhookDelivery(delivery))
+	}
+	return deliveryInfos, nextCursor, nil
+}
+
+// requeueWebhookDelivery sends the delivery again from the first attempt, a pending delivery is not affected
+func requeueWebhookDelivery(ctxlog context.Context, namespace string, id int) error {
+	affected, err := GetConfigServer().Client.WebhookDelivery.
+		Update().
+		Where(webhookdelivery.ID(id), webhookdelivery.Namespace(namespace), webhookdelivery.StatusNEQ(WEBHOOK_DELIVERY_STATUS_PENDING)).
+		SetStatus(WEBHOOK_DELIVERY_STATUS_PENDING).
+		SetAttempts(0).
+		SetNextAttemptTime(time.Now()).
+		Save(ctxlog)
+	if err != nil {
+		return wrapStorageError(err, "requeue webhook delivery")
+	}
+	if affected == 0 {
+		return NewApiErrorf(ErrorCodeResourceNotFound, "no finished webhook delivery found with id %d in namespace %s", id, namespace)
+	}
+	return nil
+}
+
+func listWebhookDeliveries(ctxlog context.Context, c *gin.Context) *ApiResponse {
+	param, err := getWebhookDeliveryListParam(c)
+	if err != nil {
+		return NewIllegalArgumentResponse(errors.Wrap(err, "parse list webhook deliveries parameter"))
+	}
+	deliveries, nextCursor, err := queryWebhookDeliveries(ctxlog, param)
+	if err != nil {
+		return NewErrorResponse(errors.Wrap(err, fmt.Sprintf("list webhook deliveries in namespace %s", param.Namespace)))
+	}
+	return NewSuccessResponse(&IterableData{
+		Contents:   deliveries,
+		NextCursor: nextCursor,
+	})
+}
+
+func retryWebhookDelivery(ctxlog context.Context, c *gin.Context) *ApiResponse {
+	namespace, err := getNamespace(c)
+	if err != nil {
+		return NewIllegalArgumentResponse(errors.Wrap(err, "parse namespace"))
+	}
+	id, err := strconv.Atoi(c.Query("Id"))
+	if err != nil {
+		return NewIllegalArgumentResponse(errors.Wrap(err, "parse webhook delivery id"))
+	}
+	log.WithContext(ctxlog).Infof("retry webhook delivery %d in namespace %s", id, namespace)
+	err = requeueWebhookDelivery(ctxlog, namespace, id)
+	if err != nil {
+		return NewErrorResponse(errors.Wrap(err, "retry webhook delivery"))
+	}
+	return NewSuccessResponse("successful")
+}
@@ -0,0 +1,186 @@
+/**
+ * Copyright 2025 OceanBase
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oceanbase/configserver/config"
+	"github.com/oceanbase/configserver/model"
+)
+
+// webhookTestReceiver is a local stand-in of webhook subscriber, it verifies the signature and responds with status
+type webhookTestReceiver struct {
+	sync.Mutex
+	status int
+	events []*model.WebhookEvent
+}
+
+func (r *webhookTestReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.Lock()
+	defer r.Unlock()
+	body, _ := io.ReadAll(req.Body)
+	event := new(model.WebhookEvent)
+	_ = json.Unmarshal(body, event)
+	r.events = append(r.events, event)
+	if req.Header.Get(WEBHOOK_SIGNATURE_HEADER) != signWebhookPayload("secret1", body) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	w.WriteHeader(r.status)
+}
+
+func initWebhookTestServer(t *testing.T, dbName string, status int, events []string) (*webhookTestReceiver, *httptest.Server) {
+	initObClusterGroupTestServer(t, dbName)
+	receiver := &webhookTestReceiver{status: status}
+	server := httptest.NewServer(receiver)
+	GetConfigServer().Config.Webhook = &config.WebhookConfig{
+		Subscribers: []*config.WebhookSubscriberConfig{
+			{
+				Name:     "cmdb",
+				Url:      server.URL,
+				Secret:   "secret1",
+				Events:   events,
+				Clusters: []string{"g1"},
+			},
+		},
+		MaxAttempts:    2,
+		InitialBackoff: time.Millisecond,
+	}
+	return receiver, server
+}
+
+func listWebhookTestDeliveries(t *testing.T, query string) []*model.WebhookDeliveryInfo {
+	response := recycleBinTestRequest("GET", "http://1.1.1.1:8080/services?Action=ListWebhookDeliveries&"+query, listWebhookDeliveries)
+	require.Equal(t, http.StatusOK, response.Code)
+	return response.Data.(*IterableData).Contents.([]*model.WebhookDeliveryInfo)
+}
+
+func TestWebhookDelivery(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	receiver, server := initWebhookTestServer(t, "ent_webhook_delivery", http.StatusOK, nil)
+	defer server.Close()
+
+	registerGroupTestCluster(t, model.OB_CLUSTER_TYPE_PRIMARY, 1, 100)
+	// only timestamp changed, not notified
+	registerGroupTestCluster(t, model.OB_CLUSTER_TYPE_PRIMARY, 1, 200)
+	registerGroupTestCluster(t, model.OB_CLUSTER_TYPE_STANDBY, 2, 100)
+	response := recycleBinTestRequest("POST", "http://1.1.1.1:8080/services?Action=FailoverObCluster&ObCluster=g1&ObClusterId=2", failoverObCluster)
+	require.Equal(t, http.StatusOK, response.Code)
+
+	deliveries := listWebhookTestDeliveries(t, "Status=PENDING")
+	require.Equal(t, 6, len(deliveries))
+
+	dispatched, err := dispatchWebhookDeliveries(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, 6, dispatched)
+	require.Equal(t, 6, len(listWebhookTestDeliveries(t, "Status=SUCCEEDED")))
+
+	events := make(map[string]int)
+	for _, event := range receiver.events {
+		events[event.Event]++
+	}
+	require.Equal(t, 2, events[WEBHOOK_EVENT_CLUSTER_CREATED])
+	require.Equal(t, 2, events[WEBHOOK_EVENT_CLUSTER_UPDATED])
+	require.Equal(t, 2, events[WEBHOOK_EVENT_PRIMARY_CHANGED])
+	for _, event := range receiver.events {
+		if event.Event == WEBHOOK_EVENT_PRIMARY_CHANGED && event.PrimaryClusterId == 2 {
+			require.Equal(t, int64(1), event.PreviousPrimaryClusterId)
+		}
+	}
+
+	// nothing left to send
+	dispatched, err = dispatchWebhookDeliveries(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, 0, dispatched)
+}
+
+func TestWebhookDeliveryFilter(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	_, server := initWebhookTestServer(t, "ent_webhook_filter", http.StatusOK, []string{WEBHOOK_EVENT_CLUSTER_DELETED})
+	defer server.Close()
+
+	registerGroupTestCluster(t, model.OB_CLUSTER_TYPE_PRIMARY, 1, 100)
+	require.Equal(t, 0, len(listWebhookTestDeliveries(t, "")))
+	response := recycleBinTestRequest("DELETE", "http://1.1.1.1:8080/services?Action=ObRootServiceInfo&ObCluster=g1&ObClusterId=1&version=2", deleteObRootServiceInfo)
+	require.Equal(t, http.StatusOK, response.Code)
+	deliveries := listWebhookTestDeliveries(t, "")
+	require.Equal(t, 1, len(deliveries))
+	require.Equal(t, WEBHOOK_EVENT_CLUSTER_DELETED, deliveries[0].Event)
+}
+
+func TestWebhookDeliveryDeadLetter(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	receiver, server := initWebhookTestServer(t, "ent_webhook_dead", http.StatusInternalServerError, []string{WEBHOOK_EVENT_CLUSTER_CREATED})
+	defer server.Close()
+
+	registerGroupTestCluster(t, model.OB_CLUSTER_TYPE_PRIMARY, 1, 100)
+
+	dispatched, err := dispatchWebhookDeliveries(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, 1, dispatched)
+	deliveries := listWebhookTestDeliveries(t, "Subscriber=cmdb")
+	require.Equal(t, WEBHOOK_DELIVERY_STATUS_PENDING, deliveries[0].Status)
+	require.Equal(t, 1, deliveries[0].Attempts)
+	require.Equal(t, http.StatusInternalServerError, deliveries[0].LastStatusCode)
+
+	time.Sleep(10 * time.Millisecond)
+	dispatched, err = dispatchWebhookDeliveries(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, 1, dispatched)
+	deliveries = listWebhookTestDeliveries(t, "Status=DEAD")
+	require.Equal(t, 1, len(deliveries))
+	require.Equal(t, 2, deliveries[0].Attempts)
+	require.NotEmpty(t, deliveries[0].LastError)
+	require.Equal(t, 2, len(receiver.events))
+	require.Equal(t, WEBHOOK_EVENT_CLUSTER_CREATED, receiver.events[0].Event)
+
+	// retry the dead letter after the receiver recovers
+	receiver.status = http.StatusOK
+	response := recycleBinTestRequest("POST", "http://1.1.1.1:8080/services?Action=RetryWebhookDelivery&Id=1", retryWebhookDelivery)
+	require.Equal(t, http.StatusOK, response.Code)
+	dispatched, err = dispatchWebhookDeliveries(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, 1, dispatched)
+	require.Equal(t, 1, len(listWebhookTestDeliveries(t, "Status=SUCCEEDED")))
+
+	// pending or unknown delivery can't be retried
+	response = recycleBinTestRequest("POST", "http://1.1.1.1:8080/services?Action=RetryWebhookDelivery&Id=100", retryWebhookDelivery)
+	require.Equal(t, http.StatusNotFound, response.Code)
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	webhookConfig := &config.WebhookConfig{
+		InitialBackoff: time.Second,
+		MaxBackoff:     5 * time.Second,
+	}
+	require.Equal(t, time.Second, getWebhookBackoff(webhookConfig, 1))
+	require.Equal(t, 2*time.Second, getWebhookBackoff(webhookConfig, 2))
+	require.Equal(t, 4*time.Second, getWebhookBackoff(webhookConfig, 3))
+	require.Equal(t, 5*time.Second, getWebhookBackoff(webhookConfig, 4))
+	require.Equal(t, 5*time.Second, getWebhookBackoff(webhookConfig, 100))
+}